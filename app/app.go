@@ -108,6 +108,7 @@ import (
 	epochskeeper "github.com/NibiruChain/nibiru/x/epochs/keeper"
 	epochstypes "github.com/NibiruChain/nibiru/x/epochs/types"
 	"github.com/NibiruChain/nibiru/x/perp"
+	perpcli "github.com/NibiruChain/nibiru/x/perp/client/cli"
 	perpkeeper "github.com/NibiruChain/nibiru/x/perp/keeper"
 	perptypes "github.com/NibiruChain/nibiru/x/perp/types"
 	"github.com/NibiruChain/nibiru/x/pricefeed"
@@ -151,6 +152,14 @@ var (
 			upgradeclient.CancelProposalHandler,
 			pricefeedcli.AddOracleProposalHandler,
 			vpoolcli.CreatePoolProposalHandler,
+			vpoolcli.SettlePoolProposalHandler,
+			vpoolcli.PoolStatusProposalHandler,
+			vpoolcli.EditPoolConfigProposalHandler,
+			perpcli.PairFeeRatiosProposalHandler,
+			perpcli.MarginPricePolicyProposalHandler,
+			perpcli.RepegPoolProposalHandler,
+			perpcli.LiquidityDepthPolicyProposalHandler,
+			perpcli.OpenInterestCapsProposalHandler,
 			// pricefeedcli.RemoveOracleProposalHandler, // TODO
 			ibcclientclient.UpdateClientProposalHandler,
 			ibcclientclient.UpgradeProposalHandler,
@@ -177,20 +186,21 @@ var (
 
 	// module account permissions
 	maccPerms = map[string][]string{
-		authtypes.FeeCollectorName:       nil,
-		distrtypes.ModuleName:            nil,
-		minttypes.ModuleName:             {authtypes.Minter},
-		stakingtypes.BondedPoolName:      {authtypes.Burner, authtypes.Staking},
-		stakingtypes.NotBondedPoolName:   {authtypes.Burner, authtypes.Staking},
-		govtypes.ModuleName:              {authtypes.Burner},
-		ibctransfertypes.ModuleName:      {authtypes.Minter, authtypes.Burner},
-		perptypes.ModuleName:             {authtypes.Minter, authtypes.Burner},
-		perptypes.VaultModuleAccount:     {},
-		perptypes.PerpEFModuleAccount:    {},
-		perptypes.FeePoolModuleAccount:   {},
-		epochstypes.ModuleName:           {},
-		common.TreasuryPoolModuleAccount: {},
-		wasm.ModuleName:                  {},
+		authtypes.FeeCollectorName:           nil,
+		distrtypes.ModuleName:                nil,
+		minttypes.ModuleName:                 {authtypes.Minter},
+		stakingtypes.BondedPoolName:          {authtypes.Burner, authtypes.Staking},
+		stakingtypes.NotBondedPoolName:       {authtypes.Burner, authtypes.Staking},
+		govtypes.ModuleName:                  {authtypes.Burner},
+		ibctransfertypes.ModuleName:          {authtypes.Minter, authtypes.Burner},
+		perptypes.ModuleName:                 {authtypes.Minter, authtypes.Burner},
+		perptypes.VaultModuleAccount:         {},
+		perptypes.PerpEFModuleAccount:        {},
+		perptypes.InsuranceFundModuleAccount: {},
+		perptypes.FeePoolModuleAccount:       {},
+		epochstypes.ModuleName:               {},
+		common.TreasuryPoolModuleAccount:     {},
+		wasm.ModuleName:                      {},
 	}
 )
 
@@ -431,6 +441,7 @@ func NewNibiruApp(
 	app.vpoolKeeper = vpoolkeeper.NewKeeper(
 		appCodec,
 		keys[vpooltypes.StoreKey],
+		app.GetSubspace(vpooltypes.ModuleName),
 		app.pricefeedKeeper,
 	)
 
@@ -444,6 +455,8 @@ func NewNibiruApp(
 		app.accountKeeper, app.bankKeeper, app.pricefeedKeeper, app.vpoolKeeper, app.epochsKeeper,
 	)
 
+	app.vpoolKeeper.SetTwapLookbackKeeper(app.perpKeeper)
+
 	app.epochsKeeper.SetHooks(
 		epochstypes.NewMultiEpochHooks(app.perpKeeper.Hooks()),
 	)
@@ -500,7 +513,8 @@ func NewNibiruApp(
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.upgradeKeeper)).
 		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(app.ibcKeeper.ClientKeeper)).
 		AddRoute(pricefeedtypes.RouterKey, pricefeed.NewPricefeedProposalHandler(app.pricefeedKeeper)).
-		AddRoute(vpooltypes.RouterKey, vpool.NewCreatePoolProposalHandler(app.vpoolKeeper))
+		AddRoute(vpooltypes.RouterKey, vpool.NewCreatePoolProposalHandler(app.vpoolKeeper)).
+		AddRoute(perptypes.RouterKey, perp.NewPerpProposalHandler(app.perpKeeper))
 
 	app.transferKeeper = ibctransferkeeper.NewKeeper(
 		appCodec,
@@ -988,6 +1002,7 @@ func initParamsKeeper(
 	// ibc params keepers
 	paramsKeeper.Subspace(ibctransfertypes.ModuleName)
 	paramsKeeper.Subspace(ibchost.ModuleName)
+	paramsKeeper.Subspace(vpooltypes.ModuleName)
 	paramsKeeper.Subspace(perptypes.ModuleName)
 
 	paramsKeeper.Subspace(wasm.ModuleName)
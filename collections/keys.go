@@ -2,6 +2,8 @@ package collections
 
 import (
 	"fmt"
+	"math"
+	"math/big"
 	"strconv"
 	"time"
 
@@ -19,6 +21,8 @@ var (
 	Uint64KeyEncoder KeyEncoder[uint64] = uint64Key{}
 	// ValAddressKeyEncoder can be used to encode sdk.ValAddress keys.
 	ValAddressKeyEncoder KeyEncoder[sdk.ValAddress] = valAddressKeyEncoder{}
+	// DecKeyEncoder can be used to encode non-negative sdk.Dec keys.
+	DecKeyEncoder KeyEncoder[sdk.Dec] = decKey{}
 )
 
 type stringKey struct{}
@@ -54,11 +58,17 @@ type timeKey struct{}
 func (timeKey) Stringify(t time.Time) string { return t.String() }
 func (timeKey) Encode(t time.Time) []byte    { return sdk.FormatTimeBytes(t) }
 func (timeKey) Decode(b []byte) (int, time.Time) {
-	t, err := sdk.ParseTimeBytes(b)
+	// the sortable time format has a fixed size, which allows
+	// time keys to be used as the first part of a Pair key.
+	size := len(sdk.SortableTimeFormat)
+	if len(b) < size {
+		panic("invalid TimeKey bytes")
+	}
+	t, err := sdk.ParseTimeBytes(b[:size])
 	if err != nil {
 		panic(err)
 	}
-	return len(b), t
+	return size, t
 }
 
 type accAddressKey struct{}
@@ -87,6 +97,30 @@ func (v valAddressKeyEncoder) Decode(b []byte) (int, sdk.ValAddress) {
 }
 func (v valAddressKeyEncoder) Stringify(key sdk.ValAddress) string { return key.String() }
 
+// decKey encodes a non-negative sdk.Dec as the length of its big endian
+// representation followed by the big endian bytes themselves, which preserves
+// the numerical ordering of the keys.
+type decKey struct{}
+
+func (decKey) Stringify(d sdk.Dec) string { return d.String() }
+func (decKey) Encode(d sdk.Dec) []byte {
+	if d.IsNegative() {
+		panic(fmt.Errorf("invalid DecKey: negative decimal %s", d))
+	}
+	bz := d.BigInt().Bytes()
+	if len(bz) > math.MaxUint8 {
+		panic(fmt.Errorf("invalid DecKey: decimal too large %s", d))
+	}
+	return append([]byte{uint8(len(bz))}, bz...)
+}
+func (decKey) Decode(b []byte) (int, sdk.Dec) {
+	if len(b) < 1 || len(b) < int(b[0])+1 {
+		panic("invalid DecKey bytes")
+	}
+	l := int(b[0]) + 1
+	return l, sdk.NewDecFromBigIntWithPrec(new(big.Int).SetBytes(b[1:l]), sdk.Precision)
+}
+
 func (stringKey) Stringify(s string) string {
 	return s
 }
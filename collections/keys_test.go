@@ -82,6 +82,10 @@ func TestTimeKey(t *testing.T) {
 		key := tmtime.Now()
 		assertBijective[time.Time](t, TimeKeyEncoder, key)
 	})
+
+	t.Run("as first part of a pair", func(t *testing.T) {
+		assertBijective(t, PairKeyEncoder[time.Time, uint64](TimeKeyEncoder, Uint64KeyEncoder), Join(tmtime.Now(), uint64(1)))
+	})
 }
 
 func TestValAddressKey(t *testing.T) {
@@ -89,3 +93,36 @@ func TestValAddressKey(t *testing.T) {
 		assertBijective(t, ValAddressKeyEncoder, sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address()))
 	})
 }
+
+func TestDecKey(t *testing.T) {
+	t.Run("bijective", func(t *testing.T) {
+		assertBijective(t, DecKeyEncoder, sdk.MustNewDecFromStr("1234.5678"))
+		assertBijective(t, DecKeyEncoder, sdk.ZeroDec())
+	})
+
+	t.Run("panics", func(t *testing.T) {
+		require.Panics(t, func() {
+			DecKeyEncoder.Encode(sdk.NewDec(-1))
+		})
+		require.Panics(t, func() {
+			DecKeyEncoder.Decode([]byte{0x2, 0x1})
+		})
+	})
+
+	t.Run("proper ordering", func(t *testing.T) {
+		decs := []sdk.Dec{
+			sdk.ZeroDec(),
+			sdk.SmallestDec(),
+			sdk.MustNewDecFromStr("0.5"),
+			sdk.OneDec(),
+			sdk.MustNewDecFromStr("1.000000000000000001"),
+			sdk.NewDec(255),
+			sdk.NewDec(256),
+			sdk.NewDec(1_000_000_000),
+		}
+
+		for i := 1; i < len(decs); i++ {
+			require.Equal(t, -1, bytes.Compare(DecKeyEncoder.Encode(decs[i-1]), DecKeyEncoder.Encode(decs[i])))
+		}
+	})
+}
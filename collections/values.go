@@ -1,6 +1,8 @@
 package collections
 
 import (
+	"time"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/gogo/protobuf/proto"
@@ -10,6 +12,7 @@ var (
 	AccAddressValueEncoder ValueEncoder[sdk.AccAddress] = accAddressValueEncoder{}
 	DecValueEncoder        ValueEncoder[sdk.Dec]        = decValue{}
 	Uint64ValueEncoder     ValueEncoder[uint64]         = uint64Value{}
+	TimeValueEncoder       ValueEncoder[time.Time]      = timeValue{}
 )
 
 // ProtoValueEncoder returns a protobuf value encoder given the codec.BinaryCodec.
@@ -73,3 +76,16 @@ func (a accAddressValueEncoder) Encode(value sdk.AccAddress) []byte    { return
 func (a accAddressValueEncoder) Decode(b []byte) sdk.AccAddress        { return b }
 func (a accAddressValueEncoder) Stringify(value sdk.AccAddress) string { return value.String() }
 func (a accAddressValueEncoder) Name() string                          { return "sdk.AccAddress" }
+
+type timeValue struct{}
+
+func (timeValue) Encode(value time.Time) []byte    { return sdk.FormatTimeBytes(value) }
+func (timeValue) Stringify(value time.Time) string { return value.String() }
+func (timeValue) Name() string                     { return "time.Time" }
+func (timeValue) Decode(b []byte) time.Time {
+	t, err := sdk.ParseTimeBytes(b)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
@@ -2,6 +2,7 @@ package collections
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -40,3 +41,9 @@ func TestUint64ValueEncoder(t *testing.T) {
 		assertValueBijective(t, Uint64ValueEncoder, 1000)
 	})
 }
+
+func TestTimeValueEncoder(t *testing.T) {
+	t.Run("bijectivity", func(t *testing.T) {
+		assertValueBijective(t, TimeValueEncoder, time.Unix(1_700_000_000, 123).UTC())
+	})
+}
@@ -118,6 +118,7 @@ import (
 	oraclekeeper "github.com/NibiruChain/nibiru/x/oracle/keeper"
 	oracletypes "github.com/NibiruChain/nibiru/x/oracle/types"
 	"github.com/NibiruChain/nibiru/x/perp"
+	perpcli "github.com/NibiruChain/nibiru/x/perp/client/cli"
 	perpkeeper "github.com/NibiruChain/nibiru/x/perp/keeper"
 	perptypes "github.com/NibiruChain/nibiru/x/perp/types"
 	"github.com/NibiruChain/nibiru/x/pricefeed"
@@ -161,6 +162,14 @@ var (
 			upgradeclient.CancelProposalHandler,
 			pricefeedcli.AddOracleProposalHandler,
 			vpoolcli.CreatePoolProposalHandler,
+			vpoolcli.SettlePoolProposalHandler,
+			vpoolcli.PoolStatusProposalHandler,
+			vpoolcli.EditPoolConfigProposalHandler,
+			perpcli.PairFeeRatiosProposalHandler,
+			perpcli.MarginPricePolicyProposalHandler,
+			perpcli.RepegPoolProposalHandler,
+			perpcli.LiquidityDepthPolicyProposalHandler,
+			perpcli.OpenInterestCapsProposalHandler,
 			// pricefeedcli.RemoveOracleProposalHandler, // TODO
 			ibcclientclient.UpdateClientProposalHandler,
 			ibcclientclient.UpgradeProposalHandler,
@@ -205,6 +214,7 @@ var (
 		perptypes.ModuleName:                  {authtypes.Minter, authtypes.Burner},
 		perptypes.VaultModuleAccount:          {},
 		perptypes.PerpEFModuleAccount:         {},
+		perptypes.InsuranceFundModuleAccount:  {},
 		perptypes.FeePoolModuleAccount:        {},
 		epochstypes.ModuleName:                {},
 		lockuptypes.ModuleName:                {authtypes.Minter, authtypes.Burner},
@@ -470,6 +480,7 @@ func NewNibiruTestApp(
 	app.VpoolKeeper = vpoolkeeper.NewKeeper(
 		appCodec,
 		keys[vpooltypes.StoreKey],
+		app.GetSubspace(vpooltypes.ModuleName),
 		app.PricefeedKeeper,
 	)
 
@@ -483,6 +494,8 @@ func NewNibiruTestApp(
 		app.AccountKeeper, app.BankKeeper, app.PricefeedKeeper, app.VpoolKeeper, app.EpochsKeeper,
 	)
 
+	app.VpoolKeeper.SetTwapLookbackKeeper(app.PerpKeeper)
+
 	app.EpochsKeeper.SetHooks(
 		epochstypes.NewMultiEpochHooks(app.StablecoinKeeper.Hooks(), app.PerpKeeper.Hooks()),
 	)
@@ -548,7 +561,8 @@ func NewNibiruTestApp(
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper)).
 		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper)).
 		AddRoute(pricefeedtypes.RouterKey, pricefeed.NewPricefeedProposalHandler(app.PricefeedKeeper)).
-		AddRoute(vpooltypes.RouterKey, vpool.NewCreatePoolProposalHandler(app.VpoolKeeper))
+		AddRoute(vpooltypes.RouterKey, vpool.NewCreatePoolProposalHandler(app.VpoolKeeper)).
+		AddRoute(perptypes.RouterKey, perp.NewPerpProposalHandler(app.PerpKeeper))
 
 	app.TransferKeeper = ibctransferkeeper.NewKeeper(
 		appCodec,
@@ -1069,6 +1083,7 @@ func initParamsKeeper(
 	paramsKeeper.Subspace(epochstypes.ModuleName)
 	paramsKeeper.Subspace(stablecointypes.ModuleName)
 	paramsKeeper.Subspace(oracletypes.ModuleName)
+	paramsKeeper.Subspace(vpooltypes.ModuleName)
 	paramsKeeper.Subspace(perptypes.ModuleName)
 	// ibc params keepers
 	paramsKeeper.Subspace(ibctransfertypes.ModuleName)
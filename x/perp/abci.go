@@ -0,0 +1,37 @@
+package perp
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/NibiruChain/nibiru/x/perp/keeper"
+	"github.com/NibiruChain/nibiru/x/perp/types"
+	vpooltypes "github.com/NibiruChain/nibiru/x/vpool/types"
+)
+
+// EndBlocker Called every block to remove the expired conditional orders,
+// execute the ones whose trigger price has been crossed on the markets that are
+// not frozen, both up to a per-block cap, end the liquidation auctions past
+// their end time and settle the positions of the markets settled by governance.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) []abci.ValidatorUpdate {
+	k.RemoveExpiredOrders(ctx)
+
+	remaining := types.MaxTriggeredOrdersExecutedPerBlock
+	for _, pool := range k.VpoolKeeper.GetAllPools(ctx) {
+		if remaining == 0 {
+			break
+		}
+		switch k.VpoolKeeper.GetPoolStatus(ctx, pool.Pair) {
+		case vpooltypes.PoolStatus_FROZEN, vpooltypes.PoolStatus_SETTLED:
+			// the orders of a frozen market wait for it to be unfrozen
+			continue
+		}
+		remaining -= k.ExecuteTriggeredOrders(ctx, pool.Pair, remaining)
+	}
+
+	k.EndLiquidationAuctions(ctx)
+
+	k.SettleSettledMarkets(ctx)
+
+	return []abci.ValidatorUpdate{}
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/NibiruChain/nibiru/collections"
 	"github.com/NibiruChain/nibiru/simapp"
 	"github.com/NibiruChain/nibiru/x/common"
+	epochstypes "github.com/NibiruChain/nibiru/x/epochs/types"
 	"github.com/NibiruChain/nibiru/x/perp/client/cli"
 	perptypes "github.com/NibiruChain/nibiru/x/perp/types"
 	pftypes "github.com/NibiruChain/nibiru/x/pricefeed/types"
@@ -100,6 +101,7 @@ func (s *IntegrationTestSuite) SetupSuite() {
 	perpGenesis := perptypes.DefaultGenesis()
 	perpGenesis.PairMetadata = []perptypes.PairMetadata{
 		{
+			// the funding history of the old genesis layout, migrated at genesis
 			Pair: common.Pair_BTC_NUSD,
 			CumulativePremiumFractions: []sdk.Dec{
 				sdk.ZeroDec(),
@@ -108,15 +110,26 @@ func (s *IntegrationTestSuite) SetupSuite() {
 			},
 		},
 		{
-			Pair: common.Pair_ETH_NUSD,
-			CumulativePremiumFractions: []sdk.Dec{
-				sdk.ZeroDec(),
-			},
+			Pair:                            common.Pair_ETH_NUSD,
+			LatestCumulativePremiumFraction: sdk.ZeroDec(),
 		},
 	}
 	perpGenesis.Params.WhitelistedLiquidators = []string{"nibi1w89pf5yq8ntjg89048qmtaz929fdxup0a57d8m"} // address associated with mnemonic below
 	genesisState[perptypes.ModuleName] = encodingConfig.Marshaler.MustMarshalJSON(perpGenesis)
 
+	// the migrated funding history is numbered back from the last funding epoch
+	epochsGenesis := epochstypes.DefaultGenesis()
+	for i, epochInfo := range epochsGenesis.Epochs {
+		if epochInfo.Identifier == perpGenesis.Params.FundingRateInterval {
+			epochInfo.StartTime = time.Now().Add(-4 * epochInfo.Duration)
+			epochInfo.CurrentEpoch = 4
+			epochInfo.CurrentEpochStartTime = time.Now()
+			epochInfo.EpochCountingStarted = true
+			epochsGenesis.Epochs[i] = epochInfo
+		}
+	}
+	genesisState[epochstypes.ModuleName] = encodingConfig.Marshaler.MustMarshalJSON(epochsGenesis)
+
 	// set up pricefeed
 	genesisState[pftypes.ModuleName] = encodingConfig.Marshaler.MustMarshalJSON(NewPricefeedGen())
 
@@ -0,0 +1,488 @@
+package cli
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govclientrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/spf13/cobra"
+
+	"github.com/NibiruChain/nibiru/x/perp/types"
+)
+
+var (
+	PairFeeRatiosProposalHandler = govclient.NewProposalHandler(
+		/* govclient.CLIHandlerFn */ CmdPairFeeRatiosProposal,
+		/* govclient.RESTHandlerFn */ func(context client.Context) govclientrest.ProposalRESTHandler {
+			return govclientrest.ProposalRESTHandler{
+				SubRoute: "pair_fee_ratios",
+				Handler: func(writer http.ResponseWriter, request *http.Request) {
+					_, _ = writer.Write([]byte("deprecated"))
+					writer.WriteHeader(http.StatusMethodNotAllowed)
+				},
+			}
+		})
+
+	MarginPricePolicyProposalHandler = govclient.NewProposalHandler(
+		/* govclient.CLIHandlerFn */ CmdMarginPricePolicyProposal,
+		/* govclient.RESTHandlerFn */ func(context client.Context) govclientrest.ProposalRESTHandler {
+			return govclientrest.ProposalRESTHandler{
+				SubRoute: "margin_price_policy",
+				Handler: func(writer http.ResponseWriter, request *http.Request) {
+					_, _ = writer.Write([]byte("deprecated"))
+					writer.WriteHeader(http.StatusMethodNotAllowed)
+				},
+			}
+		})
+
+	RepegPoolProposalHandler = govclient.NewProposalHandler(
+		/* govclient.CLIHandlerFn */ CmdRepegPoolProposal,
+		/* govclient.RESTHandlerFn */ func(context client.Context) govclientrest.ProposalRESTHandler {
+			return govclientrest.ProposalRESTHandler{
+				SubRoute: "repeg_pool",
+				Handler: func(writer http.ResponseWriter, request *http.Request) {
+					_, _ = writer.Write([]byte("deprecated"))
+					writer.WriteHeader(http.StatusMethodNotAllowed)
+				},
+			}
+		})
+
+	LiquidityDepthPolicyProposalHandler = govclient.NewProposalHandler(
+		/* govclient.CLIHandlerFn */ CmdLiquidityDepthPolicyProposal,
+		/* govclient.RESTHandlerFn */ func(context client.Context) govclientrest.ProposalRESTHandler {
+			return govclientrest.ProposalRESTHandler{
+				SubRoute: "liquidity_depth_policy",
+				Handler: func(writer http.ResponseWriter, request *http.Request) {
+					_, _ = writer.Write([]byte("deprecated"))
+					writer.WriteHeader(http.StatusMethodNotAllowed)
+				},
+			}
+		})
+
+	OpenInterestCapsProposalHandler = govclient.NewProposalHandler(
+		/* govclient.CLIHandlerFn */ CmdOpenInterestCapsProposal,
+		/* govclient.RESTHandlerFn */ func(context client.Context) govclientrest.ProposalRESTHandler {
+			return govclientrest.ProposalRESTHandler{
+				SubRoute: "open_interest_caps",
+				Handler: func(writer http.ResponseWriter, request *http.Request) {
+					_, _ = writer.Write([]byte("deprecated"))
+					writer.WriteHeader(http.StatusMethodNotAllowed)
+				},
+			}
+		})
+)
+
+// CmdPairFeeRatiosProposal implements the client command to submit a
+// governance proposal to override the trading fees of a pair.
+func CmdPairFeeRatiosProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pair-fee-ratios [proposal-json] --deposit=[deposit]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to override the trading fees of a pair",
+		Example: strings.TrimSpace(fmt.Sprintf(`
+			Example: 
+			$ %s tx gov submit-proposal pair-fee-ratios <path/to/proposal.json> --deposit="1000unibi" --from=<key_or_address> 
+			`, version.AppName)),
+		Long: strings.TrimSpace(
+			`Submits a proposal to override the fee ratios and the fee tiers of the
+			x/perp params on a pair. Leaving out "fee_ratios" removes the override.
+
+			A proposal.json for 'PairFeeRatiosProposal' contains:
+			{
+			  "title": "Lower ETH:USDT fees",
+			  "description": "Halve the trading fees of ETH:USDT",
+			  "pair": "ETH:USDT",
+			  "fee_ratios": {
+			    "fee_pool_fee_ratio": "0.0005",
+			    "ecosystem_fund_fee_ratio": "0.0005"
+			  }
+			}
+			`),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			proposal := &types.PairFeeRatiosProposal{}
+			contents, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			// marshals the contents into the proto.Message to which 'proposal' points.
+			if err = clientCtx.Codec.UnmarshalJSON(contents, proposal); err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(proposal, deposit, from)
+			if err != nil {
+				return err
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(
+		/*name=*/ govcli.FlagDeposit,
+		/*defaultValue=*/ "",
+		/*usage=*/ "governance deposit for proposal")
+	if err := cmd.MarkFlagRequired(govcli.FlagDeposit); err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
+
+// CmdMarginPricePolicyProposal implements the client command to submit a
+// governance proposal to override the margin price policy of a pair.
+func CmdMarginPricePolicyProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "margin-price-policy [proposal-json] --deposit=[deposit]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to override the margin price policy of a pair",
+		Example: strings.TrimSpace(fmt.Sprintf(`
+			Example: 
+			$ %s tx gov submit-proposal margin-price-policy <path/to/proposal.json> --deposit="1000unibi" --from=<key_or_address> 
+			`, version.AppName)),
+		Long: strings.TrimSpace(
+			`Submits a proposal to override the prices the margin ratio of the isolated
+			margin positions of a pair is measured at, for the margin checks and the
+			liquidations. Leaving out "policy" removes the override.
+
+			The sources are MARGIN_PRICE_SOURCE_SPOT, MARGIN_PRICE_SOURCE_MARK_TWAP,
+			MARGIN_PRICE_SOURCE_INDEX and MARGIN_PRICE_SOURCE_INDEX_TWAP, and only
+			MARK_TWAP sources take a lookback window. The preference keeps the
+			highest (MAX) or the lowest (MIN) PnL of the sources.
+
+			A proposal.json for 'MarginPricePolicyProposal' contains:
+			{
+			  "title": "Conservative margin prices for ETH:USDT",
+			  "description": "Measure the ETH:USDT margin ratios at the lowest PnL",
+			  "pair": "ETH:USDT",
+			  "policy": {
+			    "sources": [
+			      {"type": "MARGIN_PRICE_SOURCE_SPOT"},
+			      {"type": "MARGIN_PRICE_SOURCE_MARK_TWAP", "lookback_window": "3600s"},
+			      {"type": "MARGIN_PRICE_SOURCE_INDEX"}
+			    ],
+			    "preference": "MIN",
+			    "full_liquidation_source": {"type": "MARGIN_PRICE_SOURCE_SPOT"},
+			    "index_over_spread_limit": false
+			  }
+			}
+			`),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			proposal := &types.MarginPricePolicyProposal{}
+			contents, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			// marshals the contents into the proto.Message to which 'proposal' points.
+			if err = clientCtx.Codec.UnmarshalJSON(contents, proposal); err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(proposal, deposit, from)
+			if err != nil {
+				return err
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(
+		/*name=*/ govcli.FlagDeposit,
+		/*defaultValue=*/ "",
+		/*usage=*/ "governance deposit for proposal")
+	if err := cmd.MarkFlagRequired(govcli.FlagDeposit); err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
+
+// CmdRepegPoolProposal implements the client command to submit a governance
+// proposal to repeg the vpool of a pair to the pricefeed price.
+func CmdRepegPoolProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "repeg-pool [proposal-json] --deposit=[deposit]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to repeg the vpool of a pair to the pricefeed price",
+		Example: strings.TrimSpace(fmt.Sprintf(`
+			Example: 
+			$ %s tx gov submit-proposal repeg-pool <path/to/proposal.json> --deposit="1000unibi" --from=<key_or_address> 
+			`, version.AppName)),
+		Long: strings.TrimSpace(
+			`Submits a proposal to rescale the reserves of the vpool of a pair so that
+			its mark price matches the pricefeed price, keeping the product of the
+			reserves unchanged. The ecosystem fund pays the vault the change of the
+			value of the net position of the pair, or receives it when negative.
+
+			A proposal.json for 'RepegPoolProposal' contains:
+			{
+			  "title": "Repeg ETH:USDT",
+			  "description": "Move the ETH:USDT mark price back to the index price",
+			  "pair": "ETH:USDT"
+			}
+			`),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			proposal := &types.RepegPoolProposal{}
+			contents, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			// marshals the contents into the proto.Message to which 'proposal' points.
+			if err = clientCtx.Codec.UnmarshalJSON(contents, proposal); err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(proposal, deposit, from)
+			if err != nil {
+				return err
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(
+		/*name=*/ govcli.FlagDeposit,
+		/*defaultValue=*/ "",
+		/*usage=*/ "governance deposit for proposal")
+	if err := cmd.MarkFlagRequired(govcli.FlagDeposit); err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
+
+// CmdLiquidityDepthPolicyProposal implements the client command to submit a
+// governance proposal to set the liquidity depth policy of a pair.
+func CmdLiquidityDepthPolicyProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "liquidity-depth-policy [proposal-json] --deposit=[deposit]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to set the liquidity depth policy of a pair",
+		Example: strings.TrimSpace(fmt.Sprintf(`
+			Example: 
+			$ %s tx gov submit-proposal liquidity-depth-policy <path/to/proposal.json> --deposit="1000unibi" --from=<key_or_address> 
+			`, version.AppName)),
+		Long: strings.TrimSpace(
+			`Submits a proposal to scale the reserves of the vpool of a pair at the end
+			of every epoch of "epoch_identifier", keeping its mark price unchanged.
+			Leaving out "policy" fixes the depth of the vpool.
+
+			The target quote reserve is open_interest_depth_ratio times the notional
+			of the open interest plus volume_depth_ratio times the quote volume of
+			the epoch, bounded by min_quote_reserve and max_quote_reserve (0 for no
+			bound). The reserves move by at most max_change_ratio per epoch. The
+			ecosystem fund pays the vault the change of the value of the net
+			position of the pair, or receives it when negative.
+
+			A proposal.json for 'LiquidityDepthPolicyProposal' contains:
+			{
+			  "title": "Dynamic depth for ETH:USDT",
+			  "description": "Scale the ETH:USDT depth with its open interest and volume",
+			  "pair": "ETH:USDT",
+			  "policy": {
+			    "epoch_identifier": "30 min",
+			    "open_interest_depth_ratio": "2",
+			    "volume_depth_ratio": "0.5",
+			    "max_change_ratio": "0.05",
+			    "min_quote_reserve": "10000000",
+			    "max_quote_reserve": "0"
+			  }
+			}
+			`),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			proposal := &types.LiquidityDepthPolicyProposal{}
+			contents, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			// marshals the contents into the proto.Message to which 'proposal' points.
+			if err = clientCtx.Codec.UnmarshalJSON(contents, proposal); err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(proposal, deposit, from)
+			if err != nil {
+				return err
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(
+		/*name=*/ govcli.FlagDeposit,
+		/*defaultValue=*/ "",
+		/*usage=*/ "governance deposit for proposal")
+	if err := cmd.MarkFlagRequired(govcli.FlagDeposit); err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
+
+// CmdOpenInterestCapsProposal implements the client command to submit a
+// governance proposal to cap the open interest of a pair.
+func CmdOpenInterestCapsProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "open-interest-caps [proposal-json] --deposit=[deposit]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to cap the open interest of a pair",
+		Example: strings.TrimSpace(fmt.Sprintf(`
+			Example: 
+			$ %s tx gov submit-proposal open-interest-caps <path/to/proposal.json> --deposit="1000unibi" --from=<key_or_address> 
+			`, version.AppName)),
+		Long: strings.TrimSpace(
+			`Submits a proposal to cap the total size of the longs and of the shorts
+			of a pair, and the size of a single trader's position. A zero cap means
+			no cap.
+
+			A proposal.json for 'OpenInterestCapsProposal' contains:
+			{
+			  "title": "Cap ETH:USDT open interest",
+			  "description": "Cap the ETH:USDT open interest at 10k ETH per side",
+			  "pair": "ETH:USDT",
+			  "max_open_interest": "10000",
+			  "max_position_size": "500"
+			}
+			`),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			proposal := &types.OpenInterestCapsProposal{}
+			contents, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			// marshals the contents into the proto.Message to which 'proposal' points.
+			if err = clientCtx.Codec.UnmarshalJSON(contents, proposal); err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(proposal, deposit, from)
+			if err != nil {
+				return err
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(
+		/*name=*/ govcli.FlagDeposit,
+		/*defaultValue=*/ "",
+		/*usage=*/ "governance deposit for proposal")
+	if err := cmd.MarkFlagRequired(govcli.FlagDeposit); err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/spf13/cobra"
 
 	"github.com/NibiruChain/nibiru/x/common"
@@ -29,6 +30,20 @@ func GetQueryCmd() *cobra.Command {
 		CmdQueryPosition(),
 		CmdQueryPositions(),
 		CmdQueryFundingRates(),
+		CmdQueryOrders(),
+		CmdQueryCrossMarginAccount(),
+		CmdQueryInsuranceFund(),
+		CmdQueryShortfalls(),
+		CmdQueryADLRank(),
+		CmdQueryLiquidatablePositions(),
+		CmdQueryLiquidationPreview(),
+		CmdQueryLiquidationAuctions(),
+		CmdEstimateOpenPosition(),
+		CmdEstimateClosePosition(),
+		CmdEstimateRemoveMargin(),
+		CmdQueryOpenInterest(),
+		CmdQueryTraderFeeTier(),
+		CmdQueryMarginPricePolicy(),
 	}
 	for _, cmd := range cmds {
 		perpQueryCmd.AddCommand(cmd)
@@ -149,7 +164,7 @@ func CmdQueryPositions() *cobra.Command {
 func CmdQueryFundingRates() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "funding-rates [token-pair]",
-		Short: "the cumulative funding payments for a market, up to 48 most recent payments",
+		Short: "the cumulative funding payments for a market, up to 48 most recent payments without pagination flags",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -159,10 +174,36 @@ func CmdQueryFundingRates() *cobra.Command {
 
 			queryClient := types.NewQueryClient(clientCtx)
 
+			// without pagination flags, the query returns the most recent payments
+			var pageReq *query.PageRequest
+			for _, flag := range []string{
+				flags.FlagPageKey, flags.FlagOffset, flags.FlagLimit,
+				flags.FlagPage, flags.FlagCountTotal, flags.FlagReverse,
+			} {
+				if cmd.Flags().Changed(flag) {
+					if pageReq, err = client.ReadPageRequest(cmd.Flags()); err != nil {
+						return err
+					}
+					break
+				}
+			}
+
+			startTimeMs, err := cmd.Flags().GetInt64("start-time-ms")
+			if err != nil {
+				return err
+			}
+			endTimeMs, err := cmd.Flags().GetInt64("end-time-ms")
+			if err != nil {
+				return err
+			}
+
 			res, err := queryClient.FundingRates(
 				cmd.Context(),
 				&types.QueryFundingRatesRequest{
-					Pair: args[0],
+					Pair:        args[0],
+					Pagination:  pageReq,
+					StartTimeMs: startTimeMs,
+					EndTimeMs:   endTimeMs,
 				},
 			)
 			if err != nil {
@@ -173,6 +214,584 @@ func CmdQueryFundingRates() *cobra.Command {
 		},
 	}
 
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "funding-rates")
+	cmd.Flags().Int64("start-time-ms", 0, "only return the funding payments at or after this unix time in milliseconds")
+	cmd.Flags().Int64("end-time-ms", 0, "only return the funding payments before this unix time in milliseconds")
+
+	return cmd
+}
+
+func CmdQueryOrders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "orders [trader] [token-pair (optional)]",
+		Short: "return all of a trader's open conditional orders",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			trader, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid trader address: %w", err)
+			}
+
+			req := &types.QueryOrdersRequest{Trader: trader.String()}
+			if len(args) == 2 {
+				tokenPair, err := common.NewAssetPair(args[1])
+				if err != nil {
+					return err
+				}
+				req.Pair = tokenPair.String()
+			}
+
+			res, err := queryClient.QueryOrders(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryCrossMarginAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cross-margin-account [trader] [denom]",
+		Short: "return the margin ratio, free collateral and leverage of a trader's cross margin account",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			trader, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid trader address: %w", err)
+			}
+
+			res, err := queryClient.QueryCrossMarginAccount(
+				cmd.Context(), &types.QueryCrossMarginAccountRequest{
+					Trader: trader.String(),
+					Denom:  args[1],
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryInsuranceFund() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "insurance-fund [denom]",
+		Short: "return the balance, lifetime statistics and coverage of the insurance fund of a denom",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryInsuranceFund(
+				cmd.Context(), &types.QueryInsuranceFundRequest{
+					Denom: args[0],
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryShortfalls() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "shortfalls [denom]",
+		Short: "return the bad debt shortfalls of a denom and how they were covered",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.QueryShortfalls(
+				cmd.Context(), &types.QueryShortfallsRequest{
+					Denom:      args[0],
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "shortfalls")
+
+	return cmd
+}
+
+func CmdQueryADLRank() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "adl-rank [trader] [token-pair]",
+		Short: "the place of a trader's position in the auto-deleveraging queue",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			trader, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid trader address: %w", err)
+			}
+
+			tokenPair, err := common.NewAssetPair(args[1])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.QueryADLRank(
+				cmd.Context(), &types.QueryADLRankRequest{
+					Trader:    trader.String(),
+					TokenPair: tokenPair.String(),
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryLiquidatablePositions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "liquidatable-positions [token-pair]",
+		Short: "positions of a token pair below the maintenance margin ratio",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			tokenPair, err := common.NewAssetPair(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.QueryLiquidatablePositions(
+				cmd.Context(), &types.QueryLiquidatablePositionsRequest{
+					TokenPair:  tokenPair.String(),
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "liquidatable-positions")
+
+	return cmd
+}
+
+func CmdQueryLiquidationPreview() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "liquidation-preview [trader] [token-pair]",
+		Short: "simulate the liquidation of a trader's position",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			trader, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid trader address: %w", err)
+			}
+
+			tokenPair, err := common.NewAssetPair(args[1])
+			if err != nil {
+				return err
+			}
+
+			liquidator, err := cmd.Flags().GetString("liquidator")
+			if err != nil {
+				return err
+			}
+			if liquidator != "" {
+				if _, err = sdk.AccAddressFromBech32(liquidator); err != nil {
+					return fmt.Errorf("invalid liquidator address: %w", err)
+				}
+			}
+
+			res, err := queryClient.QueryLiquidationPreview(
+				cmd.Context(), &types.QueryLiquidationPreviewRequest{
+					Trader:     trader.String(),
+					TokenPair:  tokenPair.String(),
+					Liquidator: liquidator,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String("liquidator", "", "the whitelisted liquidator to preview the liquidation for")
+
+	return cmd
+}
+
+func CmdQueryLiquidationAuctions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "liquidation-auctions [token-pair (optional)]",
+		Short: "return the running liquidation auctions, optionally of a single pair",
+		Args:  cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryLiquidationAuctionsRequest{}
+			if len(args) == 1 {
+				tokenPair, err := common.NewAssetPair(args[0])
+				if err != nil {
+					return err
+				}
+				req.TokenPair = tokenPair.String()
+			}
+
+			res, err := queryClient.QueryLiquidationAuctions(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdEstimateOpenPosition() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimate-open-position [trader] [buy/sell] [pair] [leverage] [quoteAmt / sdk.Int] [baseAmtLimit / sdk.Int]",
+		Short: "simulate opening a position",
+		Args:  cobra.ExactArgs(6),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			trader, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid trader address: %w", err)
+			}
+
+			var side types.Side
+			switch args[1] {
+			case "buy":
+				side = types.Side_BUY
+			case "sell":
+				side = types.Side_SELL
+			default:
+				return fmt.Errorf("invalid side: %s", args[1])
+			}
+
+			tokenPair, err := common.NewAssetPair(args[2])
+			if err != nil {
+				return err
+			}
+
+			leverage, err := sdk.NewDecFromStr(args[3])
+			if err != nil {
+				return fmt.Errorf("invalid leverage: %w", err)
+			}
+
+			amount, ok := sdk.NewIntFromString(args[4])
+			if !ok {
+				return fmt.Errorf("invalid quote amount: %s", args[4])
+			}
+
+			baseAmtLimit, ok := sdk.NewIntFromString(args[5])
+			if !ok {
+				return fmt.Errorf("invalid base amount limit: %s", args[5])
+			}
+
+			res, err := queryClient.EstimateOpenPosition(
+				cmd.Context(), &types.QueryEstimateOpenPositionRequest{
+					TokenPair:            tokenPair.String(),
+					Trader:               trader.String(),
+					Side:                 side,
+					QuoteAssetAmount:     amount,
+					Leverage:             leverage,
+					BaseAssetAmountLimit: baseAmtLimit,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdEstimateClosePosition() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimate-close-position [trader] [token-pair]",
+		Short: "simulate closing a trader's position",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			trader, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid trader address: %w", err)
+			}
+
+			tokenPair, err := common.NewAssetPair(args[1])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.EstimateClosePosition(
+				cmd.Context(), &types.QueryEstimateClosePositionRequest{
+					TokenPair: tokenPair.String(),
+					Trader:    trader.String(),
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdEstimateRemoveMargin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimate-remove-margin [trader] [token-pair] [margin]",
+		Short: "simulate removing margin from a trader's position",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			trader, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid trader address: %w", err)
+			}
+
+			tokenPair, err := common.NewAssetPair(args[1])
+			if err != nil {
+				return err
+			}
+
+			margin, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.EstimateRemoveMargin(
+				cmd.Context(), &types.QueryEstimateRemoveMarginRequest{
+					TokenPair: tokenPair.String(),
+					Trader:    trader.String(),
+					Margin:    margin,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryOpenInterest() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "open-interest [token-pair]",
+		Short: "return the long and short open interest of a pair and its caps",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryOpenInterest(
+				cmd.Context(), &types.QueryOpenInterestRequest{
+					TokenPair: args[0],
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryTraderFeeTier() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trader-fee-tier [trader] [token-pair]",
+		Short: "return the 30-day volume, fee tier and fee ratios of a trader, optionally on a pair",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryTraderFeeTierRequest{Trader: args[0]}
+			if len(args) == 2 {
+				req.TokenPair = args[1]
+			}
+
+			res, err := queryClient.QueryTraderFeeTier(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryMarginPricePolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "margin-price-policy [token-pair]",
+		Short: "return the margin price policy active on a pair",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryMarginPricePolicy(
+				cmd.Context(), &types.QueryMarginPricePolicyRequest{TokenPair: args[0]},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
@@ -2,13 +2,16 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	"github.com/spf13/cobra"
 
 	"github.com/NibiruChain/nibiru/x/common"
@@ -30,7 +33,17 @@ func GetTxCmd() *cobra.Command {
 		LiquidateCmd(),
 		OpenPositionCmd(),
 		ClosePositionCmd(),
+		PartialCloseCmd(),
+		SettlePositionCmd(),
 		DonateToEcosystemFundCmd(),
+		PlaceOrderCmd(),
+		CancelOrderCmd(),
+		DepositCrossMarginCmd(),
+		WithdrawCrossMarginCmd(),
+		TransferPositionCmd(),
+		BidLiquidationAuctionCmd(),
+		GrantAgentCmd(),
+		RevokeAgentCmd(),
 	)
 
 	return txCmd
@@ -122,6 +135,79 @@ func ClosePositionCmd() *cobra.Command {
 	return cmd
 }
 
+func PartialCloseCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "partial-close [pair]",
+		Short: "Reduces a position by a base asset size (--size) or a quote notional (--quote-amount)",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			decFlags := make(map[string]sdk.Dec)
+			for _, flag := range []string{"size", "quote-amount", "limit"} {
+				str, err := cmd.Flags().GetString(flag)
+				if err != nil {
+					return err
+				}
+				if decFlags[flag], err = sdk.NewDecFromStr(str); err != nil {
+					return fmt.Errorf("invalid %s: %w", flag, err)
+				}
+			}
+
+			msg := &types.MsgPartialClose{
+				Sender:           clientCtx.GetFromAddress().String(),
+				TokenPair:        args[0],
+				Size_:            decFlags["size"],
+				QuoteAssetAmount: decFlags["quote-amount"],
+				Limit:            decFlags["limit"],
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String("size", "0", "amount of base assets to close")
+	cmd.Flags().String("quote-amount", "0", "notional value to close, in quote units")
+	cmd.Flags().String("limit", "0", "slippage limit on the quote amount when closing by size, or on the base amount when closing by notional")
+
+	return cmd
+}
+
+func SettlePositionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "settle-position [pair]",
+		Short: "Settles a position of a market settled by governance at its settlement price",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSettlePosition{
+				Sender:    clientCtx.GetFromAddress().String(),
+				TokenPair: args[0],
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 /*
 RemoveMarginCmd is a CLI command that removes margin from a position,
 realizing any outstanding funding payments and decreasing the margin ratio.
@@ -280,3 +366,415 @@ func DonateToEcosystemFundCmd() *cobra.Command {
 
 	return cmd
 }
+
+func PlaceOrderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "place-order [limit/stop-loss/take-profit] [buy/sell] [pair] [trigger-price]",
+		Short: "Places a conditional order, executed once the trigger price is crossed",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+			$ %s tx perp place-order limit buy ubtc:unusd 19000 --leverage 10 --quote-amount 100 --base-amount-limit 0
+			$ %s tx perp place-order stop-loss sell ubtc:unusd 18000 --expiry 2022-12-31T00:00:00Z
+			`, version.AppName, version.AppName),
+		),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var orderType types.OrderType
+			switch args[0] {
+			case "limit":
+				orderType = types.OrderType_LIMIT
+			case "stop-loss":
+				orderType = types.OrderType_STOP_LOSS
+			case "take-profit":
+				orderType = types.OrderType_TAKE_PROFIT
+			default:
+				return fmt.Errorf("invalid order type: %s", args[0])
+			}
+
+			var side types.Side
+			switch args[1] {
+			case "buy":
+				side = types.Side_BUY
+			case "sell":
+				side = types.Side_SELL
+			default:
+				return fmt.Errorf("invalid side: %s", args[1])
+			}
+
+			assetPair, err := common.NewAssetPair(args[2])
+			if err != nil {
+				return err
+			}
+
+			triggerPrice, err := sdk.NewDecFromStr(args[3])
+			if err != nil {
+				return fmt.Errorf("invalid trigger price: %w", err)
+			}
+
+			leverageStr, err := cmd.Flags().GetString("leverage")
+			if err != nil {
+				return err
+			}
+			leverage, err := sdk.NewDecFromStr(leverageStr)
+			if err != nil {
+				return fmt.Errorf("invalid leverage: %w", err)
+			}
+
+			quoteAmtStr, err := cmd.Flags().GetString("quote-amount")
+			if err != nil {
+				return err
+			}
+			quoteAmt, ok := sdk.NewIntFromString(quoteAmtStr)
+			if !ok {
+				return fmt.Errorf("invalid quote amount: %s", quoteAmtStr)
+			}
+
+			baseAmtLimitStr, err := cmd.Flags().GetString("base-amount-limit")
+			if err != nil {
+				return err
+			}
+			baseAmtLimit, err := sdk.NewDecFromStr(baseAmtLimitStr)
+			if err != nil {
+				return fmt.Errorf("invalid base amount limit: %w", err)
+			}
+
+			expiryStr, err := cmd.Flags().GetString("expiry")
+			if err != nil {
+				return err
+			}
+			var expiry time.Time
+			if expiryStr != "" {
+				expiry, err = time.Parse(time.RFC3339, expiryStr)
+				if err != nil {
+					return fmt.Errorf("invalid expiry: %w", err)
+				}
+			}
+
+			msg := &types.MsgPlaceOrder{
+				Sender:               clientCtx.GetFromAddress().String(),
+				TokenPair:            assetPair.String(),
+				OrderType:            orderType,
+				Side:                 side,
+				TriggerPrice:         triggerPrice,
+				QuoteAssetAmount:     quoteAmt,
+				Leverage:             leverage,
+				BaseAssetAmountLimit: baseAmtLimit.RoundInt(),
+				Expiry:               expiry,
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String("leverage", "0", "leverage of the position opened by a limit order")
+	cmd.Flags().String("quote-amount", "0", "margin of the position opened by a limit order")
+	cmd.Flags().String("base-amount-limit", "0", "limit on the base amount of the position opened by a limit order")
+	cmd.Flags().String("expiry", "", "expiry time (RFC3339) of the order [default: max order duration]")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CancelOrderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-order [order-id]",
+		Short: "Cancels a conditional order and refunds its execution reward",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			orderID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid order id: %w", err)
+			}
+
+			msg := &types.MsgCancelOrder{
+				Sender:  clientCtx.GetFromAddress().String(),
+				OrderId: orderID,
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func DepositCrossMarginCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposit-cross-margin [collateral]",
+		Short: "Deposits collateral into the cross margin account shared by all of the sender's positions",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			collateral, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgDepositCrossMargin{
+				Sender:     clientCtx.GetFromAddress().String(),
+				Collateral: collateral,
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func WithdrawCrossMarginCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-cross-margin [collateral]",
+		Short: "Withdraws free collateral from the sender's cross margin account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			collateral, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgWithdrawCrossMargin{
+				Sender:     clientCtx.GetFromAddress().String(),
+				Collateral: collateral,
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func TransferPositionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-position [pair] [receiver]",
+		Short: "Transfers the sender's position on a pair, along with its margin, to a receiver without a position on the pair",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+			$ %s tx perp transfer-position osmo:nusd nibi1...
+			`, version.AppName),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgTransferPosition{
+				Sender:    clientCtx.GetFromAddress().String(),
+				TokenPair: args[0],
+				Receiver:  args[1],
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func BidLiquidationAuctionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bid-liquidation-auction [pair] [trader] [price] [margin]",
+		Short: "Bids to take over the auctioned position of a trader at a price, with a margin escrowed until the auction ends",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+			$ %s tx perp bid-liquidation-auction osmo:nusd nibi1... 0.95 1000nusd
+			`, version.AppName),
+		),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			price, err := sdk.NewDecFromStr(args[2])
+			if err != nil {
+				return err
+			}
+
+			margin, err := sdk.ParseCoinNormalized(args[3])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgBidLiquidationAuction{
+				Sender:    clientCtx.GetFromAddress().String(),
+				TokenPair: args[0],
+				Trader:    args[1],
+				Price:     price,
+				Margin:    margin,
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GrantAgentCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-agent [agent]",
+		Short: "Authorizes an agent to open, close and adjust the margin of the sender's positions",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+			Grants the agent a trading authorization for each trading msg through x/authz.
+			The agent executes the msgs with "tx authz exec", with the sender as the trader,
+			so the margin is always taken from and paid back to the sender.
+
+			$ %s tx perp grant-agent nibi1... --pairs ubtc:unusd --max-leverage 5 --max-notional 100000
+			`, version.AppName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			agent, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pairs, err := cmd.Flags().GetStringSlice("pairs")
+			if err != nil {
+				return err
+			}
+
+			maxLeverageStr, err := cmd.Flags().GetString("max-leverage")
+			if err != nil {
+				return err
+			}
+			maxLeverage, err := sdk.NewDecFromStr(maxLeverageStr)
+			if err != nil {
+				return fmt.Errorf("invalid max leverage: %w", err)
+			}
+
+			maxNotionalStr, err := cmd.Flags().GetString("max-notional")
+			if err != nil {
+				return err
+			}
+			maxNotional, ok := sdk.NewIntFromString(maxNotionalStr)
+			if !ok {
+				return fmt.Errorf("invalid max notional: %s", maxNotionalStr)
+			}
+
+			expiration, err := cmd.Flags().GetInt64("expiration")
+			if err != nil {
+				return err
+			}
+
+			var msgs []sdk.Msg
+			for _, msgTypeURL := range types.TradingMsgTypeURLs() {
+				authorization := types.NewTradingAuthorization(msgTypeURL, pairs, maxLeverage, maxNotional)
+				if err = authorization.ValidateBasic(); err != nil {
+					return err
+				}
+				msg, err := authz.NewMsgGrant(
+					clientCtx.GetFromAddress(), agent, authorization, time.Unix(expiration, 0))
+				if err != nil {
+					return err
+				}
+				if err = msg.ValidateBasic(); err != nil {
+					return err
+				}
+				msgs = append(msgs, msg)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msgs...)
+		},
+	}
+
+	cmd.Flags().StringSlice("pairs", []string{}, "pairs the agent is allowed to trade [default: all pairs]")
+	cmd.Flags().String("max-leverage", "0", "max leverage of the positions opened by the agent [default: no limit]")
+	cmd.Flags().String("max-notional", "0", "max total notional the agent can trade over the lifetime of the grant [default: no limit]")
+	cmd.Flags().Int64("expiration", time.Now().AddDate(0, 1, 0).Unix(), "unix timestamp at which the authorization expires [default: in one month]")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func RevokeAgentCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-agent [agent]",
+		Short: "Revokes the trading authorizations of an agent",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			agent, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			var msgs []sdk.Msg
+			for _, msgTypeURL := range types.TradingMsgTypeURLs() {
+				msg := authz.NewMsgRevoke(clientCtx.GetFromAddress(), agent, msgTypeURL)
+				if err = msg.ValidateBasic(); err != nil {
+					return err
+				}
+				msgs = append(msgs, &msg)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msgs...)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -13,23 +13,75 @@ import (
 // InitGenesis initializes the capability module's state from a provided genesis
 // state.
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
-	// set pair metadata
+	// set params, the funding history migration needs the funding rate interval
+	k.SetParams(ctx, genState.Params)
+
+	// set pair metadata, migrating the funding history of the old layout
 	for _, p := range genState.PairMetadata {
+		p = k.MigratePairMetadata(ctx, p)
 		k.PairsMetadata.Insert(ctx, p.Pair, p)
 	}
 
+	// set funding rates
+	for _, r := range genState.FundingRates {
+		k.FundingRates.Insert(ctx, collections.Join(r.Pair, r.Epoch), r)
+	}
+
 	// create positions
 	for _, p := range genState.Positions {
-		k.Positions.Insert(ctx, collections.Join(p.Pair, sdk.MustAccAddressFromBech32(p.TraderAddress)), p)
+		k.SetPosition(ctx, p)
 	}
 
-	// set params
-	k.SetParams(ctx, genState.Params)
-
 	// set prepaid debt position
 	for _, pbd := range genState.PrepaidBadDebts {
 		k.PrepaidBadDebt.Insert(ctx, pbd.Denom, pbd)
 	}
+
+	// set conditional orders
+	for _, o := range genState.Orders {
+		k.Orders.Insert(ctx, o.Id, o)
+	}
+	if genState.NextOrderId != 0 {
+		k.OrderID.Set(ctx, genState.NextOrderId)
+	}
+
+	// set cross margin accounts
+	for _, a := range genState.CrossMarginAccounts {
+		k.SetCrossMarginAccount(ctx, a)
+	}
+
+	// set settled pairs
+	for _, pair := range genState.SettledPairs {
+		k.SettledPairs.Insert(ctx, pair)
+	}
+
+	// set the settlement cursors of the markets being swept
+	for _, c := range genState.SettlementCursors {
+		k.SettlementCursors.Insert(ctx, c.Pair, c)
+	}
+
+	// set insurance funds
+	for _, f := range genState.InsuranceFunds {
+		k.InsuranceFunds.Insert(ctx, f.Denom, f)
+	}
+
+	// set shortfalls
+	for _, s := range genState.Shortfalls {
+		k.Shortfalls.Insert(ctx, collections.Join(s.Denom, s.Id), s)
+	}
+	if genState.NextShortfallId != 0 {
+		k.ShortfallID.Set(ctx, genState.NextShortfallId)
+	}
+
+	// set trader volumes
+	for _, v := range genState.TraderVolumes {
+		k.TraderVolumes.Insert(ctx, collections.Join(sdk.MustAccAddressFromBech32(v.TraderAddress), v.Day), v.Volume)
+	}
+
+	// set liquidation auctions
+	for _, a := range genState.LiquidationAuctions {
+		k.LiquidationAuctions.Insert(ctx, collections.Join(a.Pair, sdk.MustAccAddressFromBech32(a.TraderAddress)), a)
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
@@ -39,7 +91,15 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis.Params = k.GetParams(ctx)
 
 	// export positions
-	genesis.Positions = k.Positions.Iterate(ctx, collections.PairRange[common.AssetPair, sdk.AccAddress]{}).Values()
+	// the socialized losses are not exported, the positions and accounts are
+	// exported net of them instead
+	for _, key := range k.Positions.Iterate(ctx, collections.PairRange[common.AssetPair, sdk.AccAddress]{}).Keys() {
+		position, err := k.GetPosition(ctx, key.K1(), key.K2())
+		if err != nil {
+			panic(err)
+		}
+		genesis.Positions = append(genesis.Positions, position)
+	}
 
 	// export prepaid bad debt
 	genesis.PrepaidBadDebts = k.PrepaidBadDebt.Iterate(ctx, collections.Range[string]{}).Values()
@@ -47,5 +107,44 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	// export pairMetadata
 	genesis.PairMetadata = k.PairsMetadata.Iterate(ctx, collections.Range[common.AssetPair]{}).Values()
 
+	// export conditional orders
+	genesis.Orders = k.Orders.Iterate(ctx, collections.Range[uint64]{}).Values()
+	genesis.NextOrderId = k.OrderID.Peek(ctx)
+
+	// export cross margin accounts
+	for _, traderAddr := range k.CrossMarginAccounts.Iterate(ctx, collections.Range[sdk.AccAddress]{}).Keys() {
+		account, err := k.GetCrossMarginAccount(ctx, traderAddr)
+		if err != nil {
+			panic(err)
+		}
+		genesis.CrossMarginAccounts = append(genesis.CrossMarginAccounts, account)
+	}
+
+	// export settled pairs
+	genesis.SettledPairs = k.SettledPairs.Iterate(ctx, collections.Range[common.AssetPair]{}).Keys()
+
+	// export settlement cursors
+	genesis.SettlementCursors = k.SettlementCursors.Iterate(ctx, collections.Range[common.AssetPair]{}).Values()
+
+	// export insurance funds
+	genesis.InsuranceFunds = k.InsuranceFunds.Iterate(ctx, collections.Range[string]{}).Values()
+	genesis.Shortfalls = k.Shortfalls.Iterate(ctx, collections.PairRange[string, uint64]{}).Values()
+	genesis.NextShortfallId = k.ShortfallID.Peek(ctx)
+
+	// export trader volumes
+	for _, kv := range k.TraderVolumes.Iterate(ctx, collections.PairRange[sdk.AccAddress, uint64]{}).KeyValues() {
+		genesis.TraderVolumes = append(genesis.TraderVolumes, types.TraderVolume{
+			TraderAddress: kv.Key.K1().String(),
+			Day:           kv.Key.K2(),
+			Volume:        kv.Value,
+		})
+	}
+
+	// export funding rates
+	genesis.FundingRates = k.FundingRates.Iterate(ctx, collections.PairRange[common.AssetPair, uint64]{}).Values()
+
+	// export liquidation auctions
+	genesis.LiquidationAuctions = k.LiquidationAuctions.Iterate(ctx, collections.PairRange[common.AssetPair, sdk.AccAddress]{}).Values()
+
 	return genesis
 }
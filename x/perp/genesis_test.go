@@ -16,6 +16,8 @@ import (
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/epochs"
+	epochstypes "github.com/NibiruChain/nibiru/x/epochs/types"
 	"github.com/NibiruChain/nibiru/x/perp"
 	"github.com/NibiruChain/nibiru/x/perp/types"
 )
@@ -34,12 +36,23 @@ func TestGenesis(t *testing.T) {
 			LiquidationFeeRatio:     sdk.MustNewDecFromStr("0.000007"),
 			PartialLiquidationRatio: sdk.MustNewDecFromStr("0.00001"),
 			TwapLookbackWindow:      15 * time.Minute,
+			OrderExecutionReward:    sdk.ZeroInt(),
+			MaxOrderDuration:        24 * time.Hour,
+
+			InsuranceFundFeeShare:         sdk.MustNewDecFromStr("0.5"),
+			InsuranceFundLiquidationShare: sdk.MustNewDecFromStr("0.5"),
+			BadDebtPayoutOrder:            []types.BadDebtPayer{types.BadDebtPayer_ECOSYSTEM_FUND},
+			MaxFundingRate:                sdk.ZeroDec(),
+			PremiumSmoothingFactor:        sdk.ZeroDec(),
+			LiquidationAuctionThreshold:   sdk.ZeroDec(),
+			LiquidationAuctionMaxDiscount: sdk.ZeroDec(),
+			LiquidationAuctionDuration:    time.Minute,
 		})
 
 		// create some positions
 		for i := int64(0); i < 100; i++ {
 			addr := testutil.AccAddress()
-			app.PerpKeeper.Positions.Insert(ctx, collections.Join(common.Pair_NIBI_NUSD, addr), types.Position{
+			app.PerpKeeper.SetPosition(ctx, types.Position{
 				TraderAddress:                   addr.String(),
 				Pair:                            common.Pair_NIBI_NUSD,
 				Size_:                           sdk.NewDec(i + 1),
@@ -58,8 +71,66 @@ func TestGenesis(t *testing.T) {
 			})
 		}
 
+		// create some insurance funds and shortfalls
+		for i := 0; i < 10; i++ {
+			denom := fmt.Sprintf("%d", i)
+			app.PerpKeeper.InsuranceFunds.Insert(ctx, denom, types.InsuranceFund{
+				Denom:               denom,
+				TotalContributions:  sdk.NewInt(int64(i * 3)),
+				TotalPayouts:        sdk.NewInt(int64(i * 2)),
+				TotalSocializedLoss: sdk.NewInt(int64(i)),
+			})
+			id := app.PerpKeeper.ShortfallID.Next(ctx)
+			app.PerpKeeper.Shortfalls.Insert(ctx, collections.Join(denom, id), types.Shortfall{
+				Id:                        id,
+				Denom:                     denom,
+				BadDebt:                   sdk.NewInt(int64(i * 3)),
+				CoveredByInsuranceFund:    sdk.NewInt(int64(i * 2)),
+				CoveredByEcosystemFund:    sdk.ZeroInt(),
+				CoveredByAutoDeleveraging: sdk.ZeroInt(),
+				SocializedLoss:            sdk.NewInt(int64(i)),
+				BlockHeight:               int64(i),
+			})
+		}
+
+		// create some trader volumes
+		for i := uint64(0); i < 10; i++ {
+			app.PerpKeeper.TraderVolumes.Insert(ctx, collections.Join(testutil.AccAddress(), 19_000+i), sdk.NewDec(int64(i*1_000)))
+		}
+
+		// create a pair metadata with open interest caps
+		app.PerpKeeper.PairsMetadata.Insert(ctx, common.Pair_NIBI_NUSD, types.PairMetadata{
+			Pair:                            common.Pair_NIBI_NUSD,
+			LatestCumulativePremiumFraction: sdk.NewDec(10),
+			MaxOpenInterest:                 sdk.NewDec(1_000),
+			MaxPositionSize:                 sdk.NewDec(100),
+		})
+
+		// create some funding rates
+		for i := uint64(1); i <= 10; i++ {
+			app.PerpKeeper.FundingRates.Insert(ctx, collections.Join(common.Pair_NIBI_NUSD, i), types.FundingRate{
+				Pair:                      common.Pair_NIBI_NUSD,
+				Epoch:                     i,
+				PremiumFraction:           sdk.OneDec(),
+				CumulativePremiumFraction: sdk.NewDec(int64(i)),
+				MarkPrice:                 sdk.NewDec(11),
+				IndexPrice:                sdk.NewDec(10),
+				BlockHeight:               int64(i),
+				BlockTimeMs:               int64(i) * 1_000,
+			})
+		}
+
+		// a settled market, and a market whose settlement sweep is under way
+		app.PerpKeeper.SettledPairs.Insert(ctx, common.Pair_BTC_NUSD)
+		app.PerpKeeper.SettlementCursors.Insert(ctx, common.Pair_ETH_NUSD, types.SettlementCursor{
+			Pair:          common.Pair_ETH_NUSD,
+			TraderAddress: testutil.AccAddress().String(),
+			LastOrderId:   3,
+		})
+
 		// export genesis
 		genState := perp.ExportGenesis(ctx, app.PerpKeeper)
+		openInterest := app.PerpKeeper.GetOpenInterest(ctx, common.Pair_NIBI_NUSD)
 
 		// create new context and init genesis
 		ctx, _ = ctxUncached.CacheContext()
@@ -73,9 +144,122 @@ func TestGenesis(t *testing.T) {
 		}
 		require.Equalf(t, genState.PairMetadata, genStateAfterInit.PairMetadata, "%s <-> %s", genState.PairMetadata, genStateAfterInit.PairMetadata)
 		require.Equal(t, genState.PrepaidBadDebts, genStateAfterInit.PrepaidBadDebts)
+		require.Equal(t, genState.InsuranceFunds, genStateAfterInit.InsuranceFunds)
+		require.Equal(t, genState.Shortfalls, genStateAfterInit.Shortfalls)
+		require.Equal(t, genState.NextShortfallId, genStateAfterInit.NextShortfallId)
+		require.Len(t, genState.TraderVolumes, 10)
+		require.Equal(t, genState.TraderVolumes, genStateAfterInit.TraderVolumes)
+		require.Len(t, genState.FundingRates, 10)
+		require.Equal(t, genState.FundingRates, genStateAfterInit.FundingRates)
+		require.Equal(t, []common.AssetPair{common.Pair_BTC_NUSD}, genStateAfterInit.SettledPairs)
+		require.Len(t, genState.SettlementCursors, 1)
+		require.Equal(t, genState.SettlementCursors, genStateAfterInit.SettlementCursors)
 		require.Equal(t, len(genState.Positions), len(genStateAfterInit.Positions))
 		for i, pos := range genState.Positions {
 			require.Equalf(t, pos, genStateAfterInit.Positions[i], "%s <-> %s", pos, genStateAfterInit.Positions[i])
 		}
+		// the open interest is rebuilt from the positions
+		require.Equal(t, openInterest, app.PerpKeeper.GetOpenInterest(ctx, common.Pair_NIBI_NUSD))
 	})
 }
+
+func TestGenesisMigratesCumulativePremiumFractions(t *testing.T) {
+	app, ctx := simapp2.NewTestNibiruAppAndContext(true)
+	ctx = ctx.WithBlockHeight(1).WithBlockTime(time.Now())
+
+	t.Log("the chain is in epoch 2000 of the funding rate interval")
+	app.EpochsKeeper.UpsertEpochInfo(ctx, epochstypes.EpochInfo{
+		Identifier:              "30 min",
+		StartTime:               ctx.BlockTime().Add(-1000 * time.Hour),
+		Duration:                30 * time.Minute,
+		CurrentEpoch:            2_000,
+		CurrentEpochStartTime:   ctx.BlockTime().Add(-31 * time.Minute),
+		CurrentEpochStartHeight: 0,
+		EpochCountingStarted:    true,
+	})
+
+	genState := types.DefaultGenesis()
+	genState.PairMetadata = []types.PairMetadata{{
+		Pair:                       common.Pair_BTC_NUSD,
+		CumulativePremiumFractions: []sdk.Dec{sdk.ZeroDec(), sdk.NewDec(2), sdk.NewDec(3)},
+	}}
+	require.NoError(t, genState.Validate())
+	perp.InitGenesis(ctx, app.PerpKeeper, *genState)
+
+	t.Log("the latest cumulative premium fraction is cached on the pair metadata")
+	pairMetadata, err := app.PerpKeeper.PairsMetadata.Get(ctx, common.Pair_BTC_NUSD)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(3), pairMetadata.LatestCumulativePremiumFraction)
+	require.Empty(t, pairMetadata.CumulativePremiumFractions)
+
+	t.Log("the list is moved to funding rates numbered up to the last epoch")
+	fundingRates := app.PerpKeeper.FundingRates.Iterate(ctx, collections.PairRange[common.AssetPair, uint64]{}).Values()
+	require.Len(t, fundingRates, 3)
+	for i, expected := range []struct {
+		premiumFraction           sdk.Dec
+		cumulativePremiumFraction sdk.Dec
+	}{
+		{sdk.ZeroDec(), sdk.ZeroDec()},
+		{sdk.NewDec(2), sdk.NewDec(2)},
+		{sdk.OneDec(), sdk.NewDec(3)},
+	} {
+		require.EqualValues(t, 1_997+i, fundingRates[i].Epoch)
+		require.Equal(t, expected.premiumFraction, fundingRates[i].PremiumFraction)
+		require.Equal(t, expected.cumulativePremiumFraction, fundingRates[i].CumulativePremiumFraction)
+		require.NoError(t, fundingRates[i].Validate())
+	}
+
+	t.Log("the export is in the new layout")
+	exported := perp.ExportGenesis(ctx, app.PerpKeeper)
+	require.NoError(t, exported.Validate())
+	require.Equal(t, []types.PairMetadata{pairMetadata}, exported.PairMetadata)
+	require.Equal(t, fundingRates, exported.FundingRates)
+
+	t.Log("the next funding epoch follows the migrated history")
+	app.VpoolKeeper.CreatePool(
+		ctx,
+		common.Pair_BTC_NUSD,
+		/* tradeLimitRatio */ sdk.OneDec(),
+		/* quoteReserve */ sdk.NewDec(1_000_000),
+		/* baseReserve */ sdk.NewDec(1_000_000),
+		/* fluctuationLimit */ sdk.OneDec(),
+		/* maxOracleSpreadRatio */ sdk.OneDec(),
+		/* maintenanceMarginRatio */ sdk.MustNewDecFromStr("0.0625"),
+		/* maxLeverage */ sdk.NewDec(15),
+	)
+	oracle := testutil.AccAddress()
+	app.PricefeedKeeper.WhitelistOracles(ctx, []sdk.AccAddress{oracle})
+	require.NoError(t, app.PricefeedKeeper.PostRawPrice(ctx, oracle, common.Pair_BTC_NUSD.String(), sdk.OneDec(), ctx.BlockTime().Add(time.Hour)))
+	require.NoError(t, app.PricefeedKeeper.GatherRawPrices(ctx, common.DenomBTC, common.DenomNUSD))
+
+	// the epoch ends in the next block, after the index price snapshot
+	ctx = ctx.WithBlockHeight(2).WithBlockTime(ctx.BlockTime().Add(time.Second))
+	epochs.BeginBlocker(ctx, app.EpochsKeeper)
+
+	epochNumbers := app.PerpKeeper.FundingRates.Iterate(ctx, collections.PairRange[common.AssetPair, uint64]{}).Keys()
+	require.Len(t, epochNumbers, 4)
+	for i, key := range epochNumbers {
+		require.EqualValues(t, 1_997+i, key.K2())
+	}
+	latest, err := app.PerpKeeper.FundingRates.Get(ctx, collections.Join(common.Pair_BTC_NUSD, uint64(2_000)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(3).Add(latest.PremiumFraction), latest.CumulativePremiumFraction)
+}
+
+func TestGenesisMigrationBeforeTheFirstEpoch(t *testing.T) {
+	app := simapp2.NewTestNibiruApp(false)
+	ctx := app.NewContext(false, tmproto.Header{})
+
+	genState := types.DefaultGenesis()
+	genState.PairMetadata = []types.PairMetadata{{
+		Pair:                       common.Pair_BTC_NUSD,
+		CumulativePremiumFractions: []sdk.Dec{sdk.ZeroDec(), sdk.NewDec(2)},
+	}}
+	perp.InitGenesis(ctx, app.PerpKeeper, *genState)
+
+	t.Log("no epoch has ended, the history is dropped and only the latest cumulative premium fraction is kept")
+	require.Empty(t, app.PerpKeeper.FundingRates.Iterate(ctx, collections.PairRange[common.AssetPair, uint64]{}).Keys())
+	pairMetadata, err := app.PerpKeeper.PairsMetadata.Get(ctx, common.Pair_BTC_NUSD)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(2), pairMetadata.LatestCumulativePremiumFraction)
+}
@@ -5,7 +5,9 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
+	"github.com/NibiruChain/nibiru/x/common"
 	"github.com/NibiruChain/nibiru/x/perp/keeper"
 	"github.com/NibiruChain/nibiru/x/perp/types"
 )
@@ -40,6 +42,30 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgClosePosition:
 			res, err := msgServer.ClosePosition(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgPartialClose:
+			res, err := msgServer.PartialClose(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSettlePosition:
+			res, err := msgServer.SettlePosition(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgPlaceOrder:
+			res, err := msgServer.PlaceOrder(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgCancelOrder:
+			res, err := msgServer.CancelOrder(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgDepositCrossMargin:
+			res, err := msgServer.DepositCrossMargin(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgWithdrawCrossMargin:
+			res, err := msgServer.WithdrawCrossMargin(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgTransferPosition:
+			res, err := msgServer.TransferPosition(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgBidLiquidationAuction:
+			res, err := msgServer.BidLiquidationAuction(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		default:
 			errMsg := fmt.Sprintf(
 				"unrecognized %s message type: %T", types.ModuleName, msg)
@@ -47,3 +73,46 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		}
 	}
 }
+
+// NewPerpProposalHandler handles the governance proposals of the perp module.
+func NewPerpProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch m := content.(type) {
+		case *types.PairFeeRatiosProposal:
+			if err := m.ValidateBasic(); err != nil {
+				return err
+			}
+			return k.SetPairFeeRatios(ctx, common.MustNewAssetPair(m.Pair), m.FeeRatios)
+		case *types.MarginPricePolicyProposal:
+			if err := m.ValidateBasic(); err != nil {
+				return err
+			}
+			return k.SetMarginPricePolicy(ctx, common.MustNewAssetPair(m.Pair), m.Policy)
+		case *types.RepegPoolProposal:
+			if err := m.ValidateBasic(); err != nil {
+				return err
+			}
+			_, err := k.RepegPool(ctx, common.MustNewAssetPair(m.Pair))
+			return err
+		case *types.LiquidityDepthPolicyProposal:
+			if err := m.ValidateBasic(); err != nil {
+				return err
+			}
+			return k.SetLiquidityDepthPolicy(ctx, common.MustNewAssetPair(m.Pair), m.Policy)
+		case *types.OpenInterestCapsProposal:
+			if err := m.ValidateBasic(); err != nil {
+				return err
+			}
+			return k.SetOpenInterestCaps(
+				ctx,
+				common.MustNewAssetPair(m.Pair),
+				m.MaxOpenInterest,
+				m.MaxPositionSize,
+			)
+		default:
+			return sdkerrors.Wrapf(
+				sdkerrors.ErrUnknownRequest,
+				"unrecognized %s proposal content type: %T", types.ModuleName, m)
+		}
+	}
+}
@@ -0,0 +1,361 @@
+package keeper
+
+import (
+	"bytes"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/collections"
+	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/perp/types"
+	vpooltypes "github.com/NibiruChain/nibiru/x/vpool/types"
+)
+
+// ADLCandidate is a profitable position in the auto-deleveraging queue of its pair and side.
+type ADLCandidate struct {
+	Position      types.Position
+	UnrealizedPnl sdk.Dec
+	Leverage      sdk.Dec
+	// Score ranks the candidates, the highest score is deleveraged first.
+	Score sdk.Dec
+}
+
+/*
+GetADLQueue returns the auto-deleveraging queue of the longs (isLong) or the
+shorts of a pair. Only profitable positions are in the queue, ranked by their
+unrealized PnL over their open notional times their leverage, highest first.
+
+The positions are walked through the ADL index from the most profitable entry
+price, the lowest for the longs and the highest for the shorts, until the entry
+price is past the mark price. At most MaxADLCandidatesScanned positions are
+ranked, so the queue doesn't grow with the number of positions of the pair.
+
+args:
+  - ctx: cosmos-sdk context
+  - pair: the pair of the positions
+  - isLong: whether to rank the long or the short positions
+
+ret:
+  - queue: the candidates ordered by rank
+  - err: error
+*/
+func (k Keeper) GetADLQueue(ctx sdk.Context, pair common.AssetPair, isLong bool) (queue []ADLCandidate, err error) {
+	markPrice, err := k.VpoolKeeper.GetMarkPrice(ctx, pair)
+	if err != nil {
+		return nil, err
+	}
+
+	rng := collections.PairRange[common.AssetPair, collections.Pair[sdk.Dec, sdk.AccAddress]]{}.Prefix(pair)
+	index := k.ADLLongs
+	if !isLong {
+		index = k.ADLShorts
+		rng = rng.Descending()
+	}
+	iter := index.Iterate(ctx, rng)
+	defer iter.Close()
+
+	for scanned := 0; iter.Valid() && scanned < types.MaxADLCandidatesScanned; iter.Next() {
+		entryPrice, traderAddr := iter.Key().K2().K1(), iter.Key().K2().K2()
+		// the position can't be profitable once its entry price is past the mark price
+		if isLong && entryPrice.GTE(markPrice) || !isLong && entryPrice.LTE(markPrice) {
+			break
+		}
+		scanned++
+
+		position, err := k.GetPosition(ctx, pair, traderAddr)
+		if err != nil {
+			return nil, err
+		}
+		candidate, profitable, err := k.adlCandidate(ctx, position)
+		if err != nil {
+			return nil, err
+		}
+		if profitable {
+			queue = append(queue, candidate)
+		}
+	}
+
+	sort.SliceStable(queue, func(i, j int) bool {
+		if !queue[i].Score.Equal(queue[j].Score) {
+			return queue[i].Score.GT(queue[j].Score)
+		}
+		return bytes.Compare(
+			sdk.MustAccAddressFromBech32(queue[i].Position.TraderAddress),
+			sdk.MustAccAddressFromBech32(queue[j].Position.TraderAddress),
+		) < 0
+	})
+
+	return queue, nil
+}
+
+// indexADL adds a position to the ADL index of its pair and side, by entry price.
+func (k Keeper) indexADL(ctx sdk.Context, position types.Position) {
+	if key, ok := adlIndexKey(position); ok {
+		if position.Size_.IsPositive() {
+			k.ADLLongs.Insert(ctx, key)
+		} else {
+			k.ADLShorts.Insert(ctx, key)
+		}
+	}
+}
+
+// unindexADL removes a position from the ADL index of its pair and side.
+func (k Keeper) unindexADL(ctx sdk.Context, position types.Position) {
+	if key, ok := adlIndexKey(position); ok {
+		if position.Size_.IsPositive() {
+			k.ADLLongs.Delete(ctx, key)
+		} else {
+			k.ADLShorts.Delete(ctx, key)
+		}
+	}
+}
+
+// adlIndexKey returns the key of a position in the ADL index, returning false
+// for the positions which can't be auto-deleveraged.
+func adlIndexKey(position types.Position) (collections.Pair[common.AssetPair, collections.Pair[sdk.Dec, sdk.AccAddress]], bool) {
+	if position.Size_.IsNil() || position.Size_.IsZero() || position.OpenNotional.IsNil() || !position.OpenNotional.IsPositive() {
+		return collections.Pair[common.AssetPair, collections.Pair[sdk.Dec, sdk.AccAddress]]{}, false
+	}
+	entryPrice := position.OpenNotional.Quo(position.Size_.Abs())
+	return collections.Join(position.Pair, collections.Join(entryPrice, sdk.MustAccAddressFromBech32(position.TraderAddress))), true
+}
+
+// adlCandidate computes the ranking score of a position, returning false if the position is not profitable.
+func (k Keeper) adlCandidate(ctx sdk.Context, position types.Position) (candidate ADLCandidate, profitable bool, err error) {
+	positionNotional, unrealizedPnl, err := k.getPositionNotionalAndUnrealizedPnL(
+		ctx, position, types.PnLCalcOption_SPOT_PRICE)
+	if err != nil {
+		return ADLCandidate{}, false, err
+	}
+	if !unrealizedPnl.IsPositive() || !position.OpenNotional.IsPositive() {
+		return ADLCandidate{}, false, nil
+	}
+
+	leverage := positionNotional.Quo(position.Margin.Add(unrealizedPnl))
+	return ADLCandidate{
+		Position:      position,
+		UnrealizedPnl: unrealizedPnl,
+		Leverage:      leverage,
+		Score:         unrealizedPnl.Quo(position.OpenNotional).Mul(leverage),
+	}, true, nil
+}
+
+/*
+BankruptcyPrice returns the price at which the margin of a position is
+entirely lost.
+
+  - long: (openNotional - margin) / size
+  - short: (openNotional + margin) / |size|
+*/
+func BankruptcyPrice(position types.Position) sdk.Dec {
+	if position.Size_.IsPositive() {
+		return sdk.MaxDec(position.OpenNotional.Sub(position.Margin), sdk.ZeroDec()).Quo(position.Size_)
+	}
+	return position.OpenNotional.Add(position.Margin).Quo(position.Size_.Abs())
+}
+
+/*
+autoDeleverage covers the bad debt of a liquidated position by reducing the
+profitable positions on the opposite side of its pair, following the ADL queue.
+
+Each position is reduced through the vpool, but settled at the bankruptcy
+price of the liquidated position rather than at the market price. Since the
+market price is past the bankruptcy price, the trader realizes less profit and
+the difference stays in the vault to cover the bad debt. Positions are reduced
+until the bad debt is covered or the queue is exhausted.
+
+args:
+  - ctx: cosmos-sdk context
+  - shortfallID: the id of the shortfall being covered
+  - liquidated: the liquidated position, as it was before the liquidation
+  - badDebt: the amount of bad debt to cover
+
+ret:
+  - covered: the amount of bad debt covered
+  - err: error
+*/
+func (k Keeper) autoDeleverage(
+	ctx sdk.Context, shortfallID uint64, liquidated types.Position, badDebt sdk.Int,
+) (covered sdk.Int, err error) {
+	covered = sdk.ZeroInt()
+	bankruptcyPrice := BankruptcyPrice(liquidated)
+	if !bankruptcyPrice.IsPositive() {
+		return covered, nil
+	}
+
+	queue, err := k.GetADLQueue(ctx, liquidated.Pair, !liquidated.Size_.IsPositive())
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	for _, candidate := range queue {
+		remaining := badDebt.Sub(covered)
+		if !remaining.IsPositive() {
+			break
+		}
+
+		markPrice, err := k.VpoolKeeper.GetMarkPrice(ctx, liquidated.Pair)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+		// the bad debt covered per unit of size reduced, at the mark price
+		coveredPerUnit := bankruptcyPrice.Sub(markPrice)
+		if candidate.Position.Size_.IsPositive() {
+			coveredPerUnit = coveredPerUnit.Neg()
+		}
+		if !coveredPerUnit.IsPositive() {
+			// the market is back within the bankruptcy price
+			break
+		}
+
+		size := sdk.MinDec(remaining.ToDec().Quo(coveredPerUnit), candidate.Position.Size_.Abs())
+
+		cachedCtx, commit := ctx.CacheContext()
+		cachedCtx = cachedCtx.WithEventManager(sdk.NewEventManager())
+		deleveraged, err := k.deleveragePosition(
+			cachedCtx, shortfallID, candidate.Position, size, bankruptcyPrice, remaining)
+		if err != nil {
+			k.Logger(ctx).Error("failed to auto-deleverage position",
+				"pair", liquidated.Pair.String(), "trader", candidate.Position.TraderAddress, "error", err.Error())
+			continue
+		}
+		if !deleveraged.IsPositive() {
+			continue
+		}
+		commit()
+		ctx.EventManager().EmitEvents(cachedCtx.EventManager().Events())
+
+		covered = covered.Add(deleveraged)
+	}
+
+	return covered, nil
+}
+
+// deleveragePosition reduces a position by 'size' and withholds the profit
+// realized past the bankruptcy price, up to 'maxCovered'.
+func (k Keeper) deleveragePosition(
+	ctx sdk.Context,
+	shortfallID uint64,
+	position types.Position,
+	size sdk.Dec,
+	bankruptcyPrice sdk.Dec,
+	maxCovered sdk.Int,
+) (covered sdk.Int, err error) {
+	traderAddr, err := sdk.AccAddressFromBech32(position.TraderAddress)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	var positionResp *types.PositionResp
+	if size.GTE(position.Size_.Abs()) {
+		positionResp, err = k.closePositionEntirely(
+			ctx,
+			position,
+			/* quoteAssetAmountLimit */ sdk.ZeroDec(),
+			/* skipFluctuationLimitCheck */ true,
+		)
+	} else {
+		var baseAssetDirection vpooltypes.Direction
+		if position.Size_.IsPositive() {
+			baseAssetDirection = vpooltypes.Direction_ADD_TO_POOL
+		} else {
+			baseAssetDirection = vpooltypes.Direction_REMOVE_FROM_POOL
+		}
+		var notional sdk.Dec
+		notional, err = k.VpoolKeeper.GetBaseAssetPrice(ctx, position.Pair, baseAssetDirection, size)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+		positionResp, err = k.decreasePosition(
+			ctx,
+			position,
+			/* decreasedNotional */ notional,
+			/* baseAmtLimit */ sdk.ZeroDec(),
+			/* skipFluctuationLimitCheck */ true,
+		)
+	}
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	if positionResp.BadDebt.IsPositive() {
+		return sdk.ZeroInt(), nil
+	}
+
+	// the notional the reduction is settled at
+	bankruptcyNotional := bankruptcyPrice.Mul(positionResp.ExchangedPositionSize.Abs())
+	withheld := positionResp.ExchangedNotionalValue.Sub(bankruptcyNotional)
+	if position.Size_.IsNegative() {
+		withheld = withheld.Neg()
+	}
+
+	var margin sdk.Dec
+	if positionResp.Position.Size_.IsZero() {
+		margin = positionResp.MarginToVault.Neg()
+	} else {
+		margin = positionResp.Position.Margin
+	}
+	covered = sdk.MinInt(sdk.MinInt(withheld.TruncateInt(), maxCovered), margin.TruncateInt())
+	if !covered.IsPositive() {
+		return sdk.ZeroInt(), nil
+	}
+
+	denom := position.Pair.QuoteDenom()
+	if positionResp.Position.Size_.IsZero() {
+		payout := margin.Sub(covered.ToDec()).TruncateInt()
+		if k.isCrossMarginAccount(ctx, traderAddr) {
+			if err = k.allocateCrossMargin(ctx, traderAddr, denom, payout.Neg()); err != nil {
+				return sdk.Int{}, err
+			}
+		} else if err = k.Withdraw(ctx, denom, traderAddr, payout); err != nil {
+			return sdk.Int{}, err
+		}
+	} else {
+		positionResp.Position.Margin = margin.Sub(covered.ToDec())
+		k.SetPosition(ctx, *positionResp.Position)
+	}
+
+	if err = ctx.EventManager().EmitTypedEvent(&types.PositionAutoDeleveragedEvent{
+		Pair:                  position.Pair.String(),
+		TraderAddress:         position.TraderAddress,
+		ShortfallId:           shortfallID,
+		BankruptcyPrice:       bankruptcyPrice,
+		ExchangedPositionSize: positionResp.ExchangedPositionSize,
+		ExchangedQuoteAmount:  positionResp.ExchangedNotionalValue,
+		RealizedPnl:           positionResp.RealizedPnl.Sub(covered.ToDec()),
+		CoveredBadDebt:        sdk.NewCoin(denom, covered),
+		Margin:                sdk.NewCoin(denom, positionResp.Position.Margin.RoundInt()),
+		PositionSize:          positionResp.Position.Size_,
+		BlockHeight:           ctx.BlockHeight(),
+		BlockTimeMs:           ctx.BlockTime().UnixMilli(),
+	}); err != nil {
+		return sdk.Int{}, err
+	}
+
+	markPrice, err := k.VpoolKeeper.GetMarkPrice(ctx, position.Pair)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	if err = k.emitPositionChanged(ctx, position.Size_, &types.PositionChangedEvent{
+		Pair:                  position.Pair.String(),
+		TraderAddress:         position.TraderAddress,
+		Margin:                sdk.NewCoin(denom, positionResp.Position.Margin.RoundInt()),
+		PositionNotional:      positionResp.PositionNotional,
+		ExchangedPositionSize: positionResp.ExchangedPositionSize,
+		TransactionFee:        sdk.NewCoin(denom, sdk.ZeroInt()), // always zero when auto-deleveraging
+		PositionSize:          positionResp.Position.Size_,
+		RealizedPnl:           positionResp.RealizedPnl.Sub(covered.ToDec()),
+		UnrealizedPnlAfter:    positionResp.UnrealizedPnlAfter,
+		BadDebt:               sdk.NewCoin(denom, sdk.ZeroInt()),
+		FundingPayment:        positionResp.FundingPayment,
+		MarkPrice:             markPrice,
+		BlockHeight:           ctx.BlockHeight(),
+		BlockTimeMs:           ctx.BlockTime().UnixMilli(),
+		LiquidationPenalty:    sdk.ZeroDec(),
+	}); err != nil {
+		return sdk.Int{}, err
+	}
+
+	return covered, nil
+}
@@ -0,0 +1,218 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/NibiruChain/nibiru/collections"
+	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/perp/keeper"
+	"github.com/NibiruChain/nibiru/x/perp/types"
+	"github.com/NibiruChain/nibiru/x/testutil"
+)
+
+func TestAutoDeleverage(t *testing.T) {
+	// the mark price of the pool is 1
+	nibiruApp, ctx, _ := initOrdersTest(t, sdk.NewCoins())
+	hooks := &recordingHooks{}
+	nibiruApp.PerpKeeper.SetHooks(types.NewMultiPerpHooks(hooks))
+	perpKeeper := nibiruApp.PerpKeeper
+	querier := keeper.NewQuerier(perpKeeper)
+	liquidator := testutil.AccAddress()
+
+	// a long bought at 2, bankrupt at (2_000 - 100) / 1_000 = 1.9
+	bankruptTrader := testutil.AccAddress()
+	setPosition(perpKeeper, ctx, types.Position{
+		TraderAddress:                   bankruptTrader.String(),
+		Pair:                            common.Pair_BTC_NUSD,
+		Size_:                           sdk.NewDec(1_000),
+		Margin:                          sdk.NewDec(100),
+		OpenNotional:                    sdk.NewDec(2_000),
+		LatestCumulativePremiumFraction: sdk.ZeroDec(),
+	})
+
+	// profitable shorts, the first with a higher score
+	// score = unrealizedPnl / openNotional * positionNotional / (margin + unrealizedPnl)
+	firstShort := testutil.AccAddress()
+	setPosition(perpKeeper, ctx, types.Position{
+		TraderAddress:                   firstShort.String(),
+		Pair:                            common.Pair_BTC_NUSD,
+		Size_:                           sdk.NewDec(-600),
+		Margin:                          sdk.NewDec(100),
+		OpenNotional:                    sdk.NewDec(1_200),
+		LatestCumulativePremiumFraction: sdk.ZeroDec(),
+	})
+	secondShort := testutil.AccAddress()
+	setPosition(perpKeeper, ctx, types.Position{
+		TraderAddress:                   secondShort.String(),
+		Pair:                            common.Pair_BTC_NUSD,
+		Size_:                           sdk.NewDec(-2_000),
+		Margin:                          sdk.NewDec(2_000),
+		OpenNotional:                    sdk.NewDec(3_000),
+		LatestCumulativePremiumFraction: sdk.ZeroDec(),
+	})
+	// an unprofitable short is not deleveraged
+	losingShort := testutil.AccAddress()
+	setPosition(perpKeeper, ctx, types.Position{
+		TraderAddress:                   losingShort.String(),
+		Pair:                            common.Pair_BTC_NUSD,
+		Size_:                           sdk.NewDec(-1_000),
+		Margin:                          sdk.NewDec(1_000),
+		OpenNotional:                    sdk.NewDec(500),
+		LatestCumulativePremiumFraction: sdk.ZeroDec(),
+	})
+
+	// the vault holds the margins, the insurance fund and ecosystem fund are empty
+	require.NoError(t, simapp.FundModuleAccount(nibiruApp.BankKeeper, ctx, types.VaultModuleAccount,
+		sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 3_200))))
+
+	t.Log("query the ADL queue of the shorts")
+	rank, err := querier.QueryADLRank(sdk.WrapSDKContext(ctx), &types.QueryADLRankRequest{
+		Trader:    firstShort.String(),
+		TokenPair: common.Pair_BTC_NUSD.String(),
+	})
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, rank.Rank)
+	assert.EqualValues(t, 2, rank.QueueLength)
+
+	rank, err = querier.QueryADLRank(sdk.WrapSDKContext(ctx), &types.QueryADLRankRequest{
+		Trader:    losingShort.String(),
+		TokenPair: common.Pair_BTC_NUSD.String(),
+	})
+	require.NoError(t, err)
+	assert.EqualValues(t, 0, rank.Rank)
+	assert.EqualValues(t, 2, rank.QueueLength)
+
+	t.Log("liquidate the bankrupt long")
+	position, err := perpKeeper.Positions.Get(ctx, collections.Join(common.Pair_BTC_NUSD, bankruptTrader))
+	require.NoError(t, err)
+	resp, err := perpKeeper.ExecuteFullLiquidation(ctx, liquidator, &position)
+	require.NoError(t, err)
+	badDebt := resp.BadDebt
+	require.True(t, badDebt.IsPositive())
+
+	t.Log("the first short is closed, the second one is reduced")
+	_, err = perpKeeper.Positions.Get(ctx, collections.Join(common.Pair_BTC_NUSD, firstShort))
+	require.ErrorIs(t, err, collections.ErrNotFound)
+	second, err := perpKeeper.Positions.Get(ctx, collections.Join(common.Pair_BTC_NUSD, secondShort))
+	require.NoError(t, err)
+	assert.True(t, second.Size_.GT(sdk.NewDec(-2_000)))
+	assert.True(t, second.Size_.IsNegative())
+	losing, err := perpKeeper.Positions.Get(ctx, collections.Join(common.Pair_BTC_NUSD, losingShort))
+	require.NoError(t, err)
+	assert.EqualValues(t, sdk.NewDec(-1_000), losing.Size_)
+
+	t.Log("the first short is paid out at the bankruptcy price")
+	// covered = (1.9 - 1) * 600 = 540, payout = margin + unrealizedPnl - covered = 100 + 600 - 540
+	firstBalance := nibiruApp.BankKeeper.GetBalance(ctx, firstShort, common.DenomNUSD)
+	assert.InDelta(t, 160, firstBalance.Amount.Int64(), 1)
+	firstEvent := getADLEvent(t, ctx, firstShort)
+	assert.EqualValues(t, uint64(1), firstEvent.ShortfallId)
+	assert.EqualValues(t, sdk.MustNewDecFromStr("1.9"), firstEvent.BankruptcyPrice)
+	assert.EqualValues(t, sdk.NewDec(600), firstEvent.ExchangedPositionSize)
+	assert.True(t, firstEvent.PositionSize.IsZero())
+	assert.InDelta(t, 540, firstEvent.CoveredBadDebt.Amount.Int64(), 1)
+
+	t.Log("the shortfall is covered by auto-deleveraging")
+	shortfall, err := perpKeeper.Shortfalls.Get(ctx, collections.Join(common.DenomNUSD, uint64(1)))
+	require.NoError(t, err)
+	assert.EqualValues(t, badDebt, shortfall.BadDebt)
+	assert.True(t, shortfall.CoveredByInsuranceFund.IsZero())
+	assert.True(t, shortfall.CoveredByEcosystemFund.IsZero())
+	assert.EqualValues(t, badDebt, shortfall.CoveredByAutoDeleveraging.Add(shortfall.SocializedLoss))
+	assert.True(t, shortfall.SocializedLoss.LTE(sdk.OneInt()))
+	require.NoError(t, shortfall.Validate())
+
+	secondEvent := getADLEvent(t, ctx, secondShort)
+	assert.EqualValues(t, shortfall.CoveredByAutoDeleveraging, firstEvent.CoveredBadDebt.Amount.Add(secondEvent.CoveredBadDebt.Amount))
+	// the realized PnL net of the covered bad debt is added to the margin of a reduced position,
+	// less its share of the socialized rounding dust
+	assert.InDelta(t, sdk.NewDec(2_000).Add(secondEvent.RealizedPnl).MustFloat64(), second.Margin.MustFloat64(), 1)
+
+	t.Log("the position hooks run for the deleveraged positions")
+	assert.Contains(t, hooks.calls, "closed "+firstShort.String())
+	assert.Contains(t, hooks.calls, "changed "+secondShort.String())
+}
+
+func TestGetADLQueue(t *testing.T) {
+	// the mark price of the pool is 1
+	nibiruApp, ctx, _ := initOrdersTest(t, sdk.NewCoins())
+	perpKeeper := nibiruApp.PerpKeeper
+
+	t.Log("profitable shorts with entry prices 2, 3, 4, ...")
+	var shorts []sdk.AccAddress
+	for i := 0; i < types.MaxADLCandidatesScanned+10; i++ {
+		trader := testutil.AccAddress()
+		shorts = append(shorts, trader)
+		setPosition(perpKeeper, ctx, types.Position{
+			TraderAddress:                   trader.String(),
+			Pair:                            common.Pair_BTC_NUSD,
+			Size_:                           sdk.NewDec(-1),
+			Margin:                          sdk.NewDec(1),
+			OpenNotional:                    sdk.NewDec(int64(2 + i)),
+			LatestCumulativePremiumFraction: sdk.ZeroDec(),
+		})
+	}
+
+	inQueue := func(queue []keeper.ADLCandidate, trader sdk.AccAddress) bool {
+		for _, candidate := range queue {
+			if candidate.Position.TraderAddress == trader.String() {
+				return true
+			}
+		}
+		return false
+	}
+
+	t.Log("only the shorts with the highest entry prices are ranked")
+	queue, err := perpKeeper.GetADLQueue(ctx, common.Pair_BTC_NUSD, false)
+	require.NoError(t, err)
+	require.Len(t, queue, types.MaxADLCandidatesScanned)
+	assert.True(t, inQueue(queue, shorts[len(shorts)-1]))
+	assert.False(t, inQueue(queue, shorts[0]))
+
+	t.Log("the index follows the entry price of a position")
+	indexKey := func(entryPrice int64, trader sdk.AccAddress) collections.Pair[common.AssetPair, collections.Pair[sdk.Dec, sdk.AccAddress]] {
+		return collections.Join(common.Pair_BTC_NUSD, collections.Join(sdk.NewDec(entryPrice), trader))
+	}
+	position, err := perpKeeper.GetPosition(ctx, common.Pair_BTC_NUSD, shorts[0])
+	require.NoError(t, err)
+	position.OpenNotional = sdk.NewDec(1_000)
+	setPosition(perpKeeper, ctx, position)
+	assert.False(t, perpKeeper.ADLShorts.Has(ctx, indexKey(2, shorts[0])))
+	assert.True(t, perpKeeper.ADLShorts.Has(ctx, indexKey(1_000, shorts[0])))
+
+	queue, err = perpKeeper.GetADLQueue(ctx, common.Pair_BTC_NUSD, false)
+	require.NoError(t, err)
+	assert.True(t, inQueue(queue, shorts[0]))
+
+	t.Log("a deleted position leaves the index")
+	require.NoError(t, perpKeeper.DeletePosition(ctx, common.Pair_BTC_NUSD, shorts[0]))
+	assert.False(t, perpKeeper.ADLShorts.Has(ctx, indexKey(1_000, shorts[0])))
+
+	t.Log("no long is profitable above the mark price")
+	queue, err = perpKeeper.GetADLQueue(ctx, common.Pair_BTC_NUSD, true)
+	require.NoError(t, err)
+	assert.Empty(t, queue)
+}
+
+// getADLEvent returns the auto-deleveraging event of the trader.
+func getADLEvent(t *testing.T, ctx sdk.Context, trader sdk.AccAddress) types.PositionAutoDeleveragedEvent {
+	for _, abciEvent := range ctx.EventManager().Events() {
+		if abciEvent.Type != "nibiru.perp.v1.PositionAutoDeleveragedEvent" {
+			continue
+		}
+		typedEvent, err := sdk.ParseTypedEvent(abci.Event(abciEvent))
+		require.NoError(t, err)
+		event := typedEvent.(*types.PositionAutoDeleveragedEvent)
+		if event.TraderAddress == trader.String() {
+			return *event
+		}
+	}
+	t.Fatalf("no auto-deleveraging event for %s", trader)
+	return types.PositionAutoDeleveragedEvent{}
+}
@@ -0,0 +1,536 @@
+package keeper
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/collections"
+	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/perp/types"
+)
+
+/*
+shouldAuctionLiquidation returns true if a fully liquidated position is
+oversized: its notional is above the liquidation auction threshold, so closing
+it on the vpool would move the mark price too much.
+
+args:
+  - ctx: cosmos-sdk context
+  - position: the position to liquidate
+
+ret:
+  - shouldAuction: whether the position is auctioned instead of closed on the vpool
+  - err: error
+*/
+func (k Keeper) shouldAuctionLiquidation(ctx sdk.Context, position types.Position) (shouldAuction bool, err error) {
+	threshold := k.GetParams(ctx).LiquidationAuctionThreshold
+	if !threshold.IsPositive() {
+		return false, nil
+	}
+
+	positionNotional, _, err := k.getPositionNotionalAndUnrealizedPnL(ctx, position, types.PnLCalcOption_SPOT_PRICE)
+	if err != nil {
+		return false, err
+	}
+	return positionNotional.GT(threshold), nil
+}
+
+/*
+startLiquidationAuction puts a fully liquidated position up for auction. The
+position stays with its trader and is locked until the auction ends. Bids must
+be at or better than the index price discounted by the max discount.
+
+args:
+  - ctx: cosmos-sdk context
+  - liquidator: the liquidator who started the auction
+  - position: the position to auction
+
+ret:
+  - auction: the started auction
+  - err: error, e.g. when the oracles aren't posting prices
+*/
+func (k Keeper) startLiquidationAuction(
+	ctx sdk.Context, liquidator sdk.AccAddress, position types.Position,
+) (auction types.LiquidationAuction, err error) {
+	params := k.GetParams(ctx)
+
+	indexPrice, err := k.PricefeedKeeper.GetCurrentPrice(ctx, position.Pair.Token0, position.Pair.Token1)
+	if err != nil {
+		return types.LiquidationAuction{}, err
+	}
+	if !indexPrice.Price.IsPositive() {
+		return types.LiquidationAuction{}, types.ErrInvalidAuctionBid.Wrapf("invalid index price %s", indexPrice.Price)
+	}
+
+	// longs are sold below the index price, shorts above it
+	limitPrice := indexPrice.Price.Mul(sdk.OneDec().Sub(params.LiquidationAuctionMaxDiscount))
+	if position.Size_.IsNegative() {
+		limitPrice = indexPrice.Price.Mul(sdk.OneDec().Add(params.LiquidationAuctionMaxDiscount))
+	}
+
+	auction = types.LiquidationAuction{
+		Pair:              position.Pair,
+		TraderAddress:     position.TraderAddress,
+		LiquidatorAddress: liquidator.String(),
+		IndexPrice:        indexPrice.Price,
+		LimitPrice:        limitPrice,
+		EndTime:           ctx.BlockTime().Add(params.LiquidationAuctionDuration),
+		BestBidPrice:      sdk.ZeroDec(),
+		BestBidMargin:     sdk.NewCoin(position.Pair.QuoteDenom(), sdk.ZeroInt()),
+	}
+	k.LiquidationAuctions.Insert(ctx, collections.Join(position.Pair, sdk.MustAccAddressFromBech32(position.TraderAddress)), auction)
+
+	err = ctx.EventManager().EmitTypedEvent(&types.LiquidationAuctionStartedEvent{
+		Pair:              position.Pair.String(),
+		TraderAddress:     position.TraderAddress,
+		LiquidatorAddress: liquidator.String(),
+		PositionSize:      position.Size_,
+		IndexPrice:        auction.IndexPrice,
+		LimitPrice:        auction.LimitPrice,
+		EndTimeMs:         auction.EndTime.UnixMilli(),
+		BlockHeight:       ctx.BlockHeight(),
+		BlockTimeMs:       ctx.BlockTime().UnixMilli(),
+	})
+	return auction, err
+}
+
+// requireNotInAuction returns an error if the position of the trader is locked by a liquidation auction.
+func (k Keeper) requireNotInAuction(ctx sdk.Context, pair common.AssetPair, traderAddr sdk.AccAddress) error {
+	if _, err := k.LiquidationAuctions.Get(ctx, collections.Join(pair, traderAddr)); err == nil {
+		return types.ErrPositionInAuction.Wrapf("position of %s on %s", traderAddr, pair)
+	}
+	return nil
+}
+
+/*
+BidLiquidationAuction places the best bid of a liquidation auction: the bidder
+takes the auctioned position over at the bid price when the auction ends, with
+the bid margin as the margin of the position. The margin is escrowed in the
+vault and the margin of the previous best bid is refunded.
+
+A long position must be bid at or above the limit price and above the best bid,
+a short position at or below the limit price and below the best bid. The
+position of the bidder after the take over must stay within the position size
+cap and above the maintenance margin ratio.
+
+args:
+  - ctx: cosmos-sdk context
+  - bidder: the whitelisted liquidator placing the bid
+  - pair: the pair of the auctioned position
+  - traderAddr: the owner of the auctioned position
+  - price: the price to take the position over at
+  - margin: the margin of the position taken over
+
+ret:
+  - auction: the auction after the bid
+  - err: error
+*/
+func (k Keeper) BidLiquidationAuction(
+	ctx sdk.Context,
+	bidder sdk.AccAddress,
+	pair common.AssetPair,
+	traderAddr sdk.AccAddress,
+	price sdk.Dec,
+	margin sdk.Coin,
+) (auction types.LiquidationAuction, err error) {
+	if !k.canLiquidate(ctx, bidder) {
+		return types.LiquidationAuction{}, types.ErrUnauthorized.Wrapf("not allowed to bid on liquidation auctions: %s", bidder)
+	}
+	if err = k.requirePoolNotSettled(ctx, pair); err != nil {
+		return types.LiquidationAuction{}, err
+	}
+
+	auction, err = k.LiquidationAuctions.Get(ctx, collections.Join(pair, traderAddr))
+	if err != nil {
+		return types.LiquidationAuction{}, types.ErrInvalidAuctionBid.Wrapf(
+			"no liquidation auction for the position of %s on %s", traderAddr, pair)
+	}
+	if !ctx.BlockTime().Before(auction.EndTime) {
+		return types.LiquidationAuction{}, types.ErrInvalidAuctionBid.Wrap("the auction has ended")
+	}
+	if bidder.Equals(traderAddr) {
+		return types.LiquidationAuction{}, types.ErrInvalidAuctionBid.Wrap("cannot bid on the auction of the own position")
+	}
+	if margin.Denom != pair.QuoteDenom() || !margin.Amount.IsPositive() {
+		return types.LiquidationAuction{}, types.ErrInvalidAuctionBid.Wrapf("invalid margin %s", margin)
+	}
+
+	position, err := k.GetPosition(ctx, pair, traderAddr)
+	if err != nil {
+		return types.LiquidationAuction{}, err
+	}
+
+	hasBid := auction.BestBidder != ""
+	if position.Size_.IsPositive() {
+		if price.LT(auction.LimitPrice) {
+			return types.LiquidationAuction{}, types.ErrInvalidAuctionBid.Wrapf(
+				"price %s is below the limit price %s", price, auction.LimitPrice)
+		}
+		if hasBid && price.LTE(auction.BestBidPrice) {
+			return types.LiquidationAuction{}, types.ErrInvalidAuctionBid.Wrapf(
+				"price %s is not above the best bid %s", price, auction.BestBidPrice)
+		}
+	} else {
+		if price.GT(auction.LimitPrice) {
+			return types.LiquidationAuction{}, types.ErrInvalidAuctionBid.Wrapf(
+				"price %s is above the limit price %s", price, auction.LimitPrice)
+		}
+		if hasBid && price.GTE(auction.BestBidPrice) {
+			return types.LiquidationAuction{}, types.ErrInvalidAuctionBid.Wrapf(
+				"price %s is not below the best bid %s", price, auction.BestBidPrice)
+		}
+	}
+
+	if _, _, _, err = k.auctionWinnerPosition(ctx, position, bidder, price, margin.Amount); err != nil {
+		return types.LiquidationAuction{}, err
+	}
+
+	if err = k.BankKeeper.SendCoinsFromAccountToModule(
+		ctx,
+		/* from */ bidder,
+		/* to */ types.VaultModuleAccount,
+		/* amount */ sdk.NewCoins(margin),
+	); err != nil {
+		return types.LiquidationAuction{}, err
+	}
+	if err = k.refundBestBid(ctx, auction); err != nil {
+		return types.LiquidationAuction{}, err
+	}
+
+	auction.BestBidder = bidder.String()
+	auction.BestBidPrice = price
+	auction.BestBidMargin = margin
+	k.LiquidationAuctions.Insert(ctx, collections.Join(pair, traderAddr), auction)
+
+	err = ctx.EventManager().EmitTypedEvent(&types.LiquidationAuctionBidEvent{
+		Pair:          pair.String(),
+		TraderAddress: traderAddr.String(),
+		Bidder:        bidder.String(),
+		Price:         price,
+		Margin:        margin,
+		BlockHeight:   ctx.BlockHeight(),
+		BlockTimeMs:   ctx.BlockTime().UnixMilli(),
+	})
+	return auction, err
+}
+
+// refundBestBid returns the escrowed margin of the best bid of an auction, if any, to its bidder.
+func (k Keeper) refundBestBid(ctx sdk.Context, auction types.LiquidationAuction) error {
+	if auction.BestBidder == "" {
+		return nil
+	}
+	return k.Withdraw(ctx, auction.BestBidMargin.Denom, sdk.MustAccAddressFromBech32(auction.BestBidder), auction.BestBidMargin.Amount)
+}
+
+/*
+auctionWinnerPosition builds the position of a bidder after taking an auctioned
+position over at a price with a margin. A position of the bidder on the same
+side is merged into it after settling its funding payments, while a position on
+the opposite side makes the bid invalid.
+
+args:
+  - ctx: cosmos-sdk context
+  - auctioned: the auctioned position
+  - bidder: the bidder taking the position over
+  - price: the price the position is taken over at
+  - margin: the margin the bidder adds
+
+ret:
+  - winner: the position of the bidder after the take over
+  - previousSize: the size of the position of the bidder before the take over
+  - fundingPayment: the funding payment settled on the position of the bidder
+  - err: error if the position of the bidder would be invalid
+*/
+func (k Keeper) auctionWinnerPosition(
+	ctx sdk.Context, auctioned types.Position, bidder sdk.AccAddress, price sdk.Dec, margin sdk.Int,
+) (winner types.Position, previousSize sdk.Dec, fundingPayment sdk.Dec, err error) {
+	pair := auctioned.Pair
+	if err = k.requireNotInAuction(ctx, pair, bidder); err != nil {
+		return types.Position{}, sdk.Dec{}, sdk.Dec{}, err
+	}
+
+	latestCumulativePremiumFraction, err := k.getLatestCumulativePremiumFraction(ctx, pair)
+	if err != nil {
+		return types.Position{}, sdk.Dec{}, sdk.Dec{}, err
+	}
+
+	winner = types.Position{
+		TraderAddress:                   bidder.String(),
+		Pair:                            pair,
+		Size_:                           auctioned.Size_,
+		Margin:                          margin.ToDec(),
+		OpenNotional:                    price.Mul(auctioned.Size_.Abs()),
+		LatestCumulativePremiumFraction: latestCumulativePremiumFraction,
+		BlockNumber:                     ctx.BlockHeight(),
+	}
+
+	previousSize = sdk.ZeroDec()
+	fundingPayment = sdk.ZeroDec()
+	existing, err := k.GetPosition(ctx, pair, bidder)
+	switch {
+	case err == nil && !existing.Size_.IsZero():
+		if existing.Size_.IsPositive() != auctioned.Size_.IsPositive() {
+			return types.Position{}, sdk.Dec{}, sdk.Dec{}, types.ErrInvalidAuctionBid.Wrap(
+				"the bidder has a position on the opposite side of the pair")
+		}
+		existingMargin, err := k.CalcRemainMarginWithFundingPayment(ctx, existing, sdk.ZeroDec())
+		if err != nil {
+			return types.Position{}, sdk.Dec{}, sdk.Dec{}, err
+		}
+		if !existingMargin.BadDebt.IsZero() {
+			return types.Position{}, sdk.Dec{}, sdk.Dec{}, types.ErrInvalidAuctionBid.Wrap("the position of the bidder has bad debt")
+		}
+		previousSize = existing.Size_
+		fundingPayment = existingMargin.FundingPayment
+		winner.Size_ = winner.Size_.Add(existing.Size_)
+		winner.Margin = winner.Margin.Add(existingMargin.Margin)
+		winner.OpenNotional = winner.OpenNotional.Add(existing.OpenNotional)
+	case err != nil && !errors.Is(err, collections.ErrNotFound):
+		return types.Position{}, sdk.Dec{}, sdk.Dec{}, err
+	}
+
+	_, maxPositionSize := k.getOpenInterestCaps(ctx, pair)
+	if maxPositionSize.IsPositive() && winner.Size_.Abs().GT(maxPositionSize) {
+		return types.Position{}, sdk.Dec{}, sdk.Dec{}, types.ErrOpenInterestCapExceeded.Wrapf(
+			"position size %s is over the cap of %s", winner.Size_.Abs(), maxPositionSize)
+	}
+
+	marginRatio, err := k.getMarginCheckRatio(ctx, winner)
+	if err != nil {
+		return types.Position{}, sdk.Dec{}, sdk.Dec{}, err
+	}
+	if err = requireMoreMarginRatio(
+		marginRatio, k.VpoolKeeper.GetMaintenanceMarginRatio(ctx, pair), true); err != nil {
+		return types.Position{}, sdk.Dec{}, sdk.Dec{}, types.ErrMarginRatioTooLow
+	}
+
+	return winner, previousSize, fundingPayment, nil
+}
+
+/*
+EndLiquidationAuctions ends the liquidation auctions past their end time. The
+auctioned position is taken over by the best bidder or, if there was no bid or
+the take over fails, closed on the vpool like any full liquidation. An auction
+whose position is gone, is no longer liquidatable or whose market is settled
+is cancelled, the position of a settled market being settled at the settlement
+price instead.
+*/
+func (k Keeper) EndLiquidationAuctions(ctx sdk.Context) {
+	for _, auction := range k.LiquidationAuctions.Iterate(ctx, collections.PairRange[common.AssetPair, sdk.AccAddress]{}).Values() {
+		if ctx.BlockTime().Before(auction.EndTime) {
+			continue
+		}
+
+		cachedCtx, commit := ctx.CacheContext()
+		if err := k.endLiquidationAuction(cachedCtx, auction); err != nil {
+			k.Logger(ctx).Error("failed to end liquidation auction",
+				"pair", auction.Pair.String(), "trader", auction.TraderAddress, "error", err.Error())
+			continue
+		}
+		commit()
+		ctx.EventManager().EmitEvents(cachedCtx.EventManager().Events())
+	}
+}
+
+// endLiquidationAuction ends a liquidation auction, see EndLiquidationAuctions.
+func (k Keeper) endLiquidationAuction(ctx sdk.Context, auction types.LiquidationAuction) error {
+	traderAddr := sdk.MustAccAddressFromBech32(auction.TraderAddress)
+	if err := k.LiquidationAuctions.Delete(ctx, collections.Join(auction.Pair, traderAddr)); err != nil {
+		return err
+	}
+
+	position, err := k.GetPosition(ctx, auction.Pair, traderAddr)
+	if errors.Is(err, collections.ErrNotFound) || (err == nil && position.Size_.IsZero()) ||
+		k.VpoolKeeper.IsPoolSettled(ctx, auction.Pair) {
+		if err = k.refundBestBid(ctx, auction); err != nil {
+			return err
+		}
+		return k.emitLiquidationAuctionEnded(ctx, auction, types.LiquidationAuctionOutcome_CANCELLED)
+	} else if err != nil {
+		return err
+	}
+
+	// a position back above the maintenance margin ratio is released to its trader
+	check, err := k.checkLiquidation(ctx, position)
+	if err != nil {
+		return err
+	}
+	if !check.isLiquidatable {
+		if err = k.refundBestBid(ctx, auction); err != nil {
+			return err
+		}
+		return k.emitLiquidationAuctionEnded(ctx, auction, types.LiquidationAuctionOutcome_CANCELLED)
+	}
+
+	if auction.BestBidder != "" {
+		cachedCtx, commit := ctx.CacheContext()
+		err = k.sellAuctionedPosition(cachedCtx, auction, position)
+		if err == nil {
+			commit()
+			ctx.EventManager().EmitEvents(cachedCtx.EventManager().Events())
+			return k.emitLiquidationAuctionEnded(ctx, auction, types.LiquidationAuctionOutcome_SOLD)
+		}
+
+		k.Logger(ctx).Error("failed to sell auctioned position, closing it on the vpool",
+			"pair", auction.Pair.String(), "trader", auction.TraderAddress, "error", err.Error())
+		if err = k.refundBestBid(ctx, auction); err != nil {
+			return err
+		}
+	}
+
+	if _, err = k.ExecuteFullLiquidation(ctx, sdk.MustAccAddressFromBech32(auction.LiquidatorAddress), &position); err != nil {
+		return err
+	}
+	return k.emitLiquidationAuctionEnded(ctx, auction, types.LiquidationAuctionOutcome_VPOOL_CLOSE)
+}
+
+/*
+sellAuctionedPosition closes the auctioned position at the best bid price and
+opens it for the best bidder with the escrowed margin, without trading on the
+vpool. The liquidation fee and the rest of the margin of the trader are paid
+out like in a full liquidation, and the bad debt is realized.
+*/
+func (k Keeper) sellAuctionedPosition(ctx sdk.Context, auction types.LiquidationAuction, position types.Position) error {
+	params := k.GetParams(ctx)
+	pair := position.Pair
+	denom := pair.QuoteDenom()
+	traderAddr := sdk.MustAccAddressFromBech32(position.TraderAddress)
+	bidder := sdk.MustAccAddressFromBech32(auction.BestBidder)
+
+	winner, previousSize, winnerFundingPayment, err := k.auctionWinnerPosition(
+		ctx, position, bidder, auction.BestBidPrice, auction.BestBidMargin.Amount)
+	if err != nil {
+		return err
+	}
+
+	exchangedNotional := auction.BestBidPrice.Mul(position.Size_.Abs())
+	realizedPnl := exchangedNotional.Sub(position.OpenNotional)
+	if position.Size_.IsNegative() {
+		realizedPnl = realizedPnl.Neg()
+	}
+	remaining, err := k.CalcRemainMarginWithFundingPayment(ctx, position, realizedPnl)
+	if err != nil {
+		return err
+	}
+
+	remainMargin := remaining.Margin
+	feeToLiquidator := params.LiquidationFeeRatio.Mul(exchangedNotional).QuoInt64(2)
+	totalBadDebt := remaining.BadDebt
+	if feeToLiquidator.GT(remainMargin) {
+		// if the remainMargin is not enough for liquidationFee, count it as bad debt
+		totalBadDebt = totalBadDebt.Add(feeToLiquidator.Sub(remainMargin))
+		remainMargin = sdk.ZeroDec()
+	} else {
+		// Otherwise, the remaining margin will be transferred to ecosystemFund
+		remainMargin = remainMargin.Sub(feeToLiquidator)
+	}
+
+	if totalBadDebt.IsPositive() {
+		totalBadDebt, err = k.coverBadDebtWithCrossMargin(ctx, traderAddr, denom, totalBadDebt)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err = k.DeletePosition(ctx, pair, traderAddr); err != nil {
+		return err
+	}
+	k.SetPosition(ctx, winner)
+
+	if totalBadDebt.IsPositive() {
+		if err = k.realizeBadDebt(ctx, denom, totalBadDebt.RoundInt(), &position); err != nil {
+			return err
+		}
+	}
+
+	closedPosition := types.ZeroPosition(ctx, pair, traderAddr)
+	closedPosition.LatestCumulativePremiumFraction = remaining.LatestCumulativePremiumFraction
+	liquidationResp := types.LiquidateResp{
+		BadDebt:                totalBadDebt.RoundInt(),
+		FeeToLiquidator:        feeToLiquidator.RoundInt(),
+		FeeToPerpEcosystemFund: remainMargin.RoundInt(),
+		Liquidator:             auction.LiquidatorAddress,
+		PositionResp: &types.PositionResp{
+			Position:               &closedPosition,
+			ExchangedNotionalValue: exchangedNotional,
+			ExchangedPositionSize:  position.Size_.Neg(),
+			BadDebt:                remaining.BadDebt,
+			FundingPayment:         remaining.FundingPayment,
+			RealizedPnl:            realizedPnl,
+			UnrealizedPnlAfter:     sdk.ZeroDec(),
+			MarginToVault:          remaining.Margin.Neg(),
+			PositionNotional:       sdk.ZeroDec(),
+		},
+	}
+	if err = k.distributeLiquidateRewards(ctx, liquidationResp); err != nil {
+		return err
+	}
+
+	markPrice, err := k.VpoolKeeper.GetMarkPrice(ctx, pair)
+	if err != nil {
+		return err
+	}
+
+	if err = k.emitPositionLiquidated(ctx, &types.PositionLiquidatedEvent{
+		Pair:                  pair.String(),
+		TraderAddress:         traderAddr.String(),
+		ExchangedQuoteAmount:  exchangedNotional,
+		ExchangedPositionSize: position.Size_.Neg(),
+		LiquidatorAddress:     auction.LiquidatorAddress,
+		FeeToLiquidator:       sdk.NewCoin(denom, liquidationResp.FeeToLiquidator),
+		FeeToEcosystemFund:    sdk.NewCoin(denom, liquidationResp.FeeToPerpEcosystemFund),
+		BadDebt:               sdk.NewCoin(denom, liquidationResp.BadDebt),
+		Margin:                sdk.NewCoin(denom, sdk.ZeroInt()),
+		PositionNotional:      sdk.ZeroDec(),
+		PositionSize:          sdk.ZeroDec(),
+		UnrealizedPnl:         sdk.ZeroDec(),
+		MarkPrice:             markPrice,
+		BlockHeight:           ctx.BlockHeight(),
+		BlockTimeMs:           ctx.BlockTime().UnixMilli(),
+	}); err != nil {
+		return err
+	}
+
+	positionNotional, unrealizedPnl, err := k.getPositionNotionalAndUnrealizedPnL(ctx, winner, types.PnLCalcOption_SPOT_PRICE)
+	if err != nil {
+		return err
+	}
+
+	return k.emitPositionChanged(ctx, previousSize, &types.PositionChangedEvent{
+		Pair:                  pair.String(),
+		TraderAddress:         bidder.String(),
+		Margin:                sdk.NewCoin(denom, winner.Margin.RoundInt()),
+		PositionNotional:      positionNotional,
+		ExchangedPositionSize: position.Size_,
+		TransactionFee:        sdk.NewCoin(denom, sdk.ZeroInt()), // always zero when taking over an auctioned position
+		PositionSize:          winner.Size_,
+		RealizedPnl:           sdk.ZeroDec(), // always zero when taking over an auctioned position
+		UnrealizedPnlAfter:    unrealizedPnl,
+		BadDebt:               sdk.NewCoin(denom, sdk.ZeroInt()),
+		FundingPayment:        winnerFundingPayment,
+		MarkPrice:             markPrice,
+		BlockHeight:           ctx.BlockHeight(),
+		BlockTimeMs:           ctx.BlockTime().UnixMilli(),
+		LiquidationPenalty:    sdk.ZeroDec(),
+	})
+}
+
+// emitLiquidationAuctionEnded emits the LiquidationAuctionEndedEvent of an auction.
+func (k Keeper) emitLiquidationAuctionEnded(
+	ctx sdk.Context, auction types.LiquidationAuction, outcome types.LiquidationAuctionOutcome,
+) error {
+	event := &types.LiquidationAuctionEndedEvent{
+		Pair:          auction.Pair.String(),
+		TraderAddress: auction.TraderAddress,
+		Outcome:       outcome,
+		Price:         sdk.ZeroDec(),
+		BlockHeight:   ctx.BlockHeight(),
+		BlockTimeMs:   ctx.BlockTime().UnixMilli(),
+	}
+	if outcome == types.LiquidationAuctionOutcome_SOLD {
+		event.Winner = auction.BestBidder
+		event.Price = auction.BestBidPrice
+	}
+	return ctx.EventManager().EmitTypedEvent(event)
+}
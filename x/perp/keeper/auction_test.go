@@ -0,0 +1,260 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/collections"
+	nibisimapp "github.com/NibiruChain/nibiru/simapp"
+	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/perp/keeper"
+	"github.com/NibiruChain/nibiru/x/perp/types"
+	"github.com/NibiruChain/nibiru/x/testutil"
+	vpooltypes "github.com/NibiruChain/nibiru/x/vpool/types"
+)
+
+// initAuctionTest opens an oversized long of 100_000 BTC at a price of 1 with
+// a margin of 2_000, which is below the liquidation fee ratio of 0.025 so that
+// it is fully liquidated, and whitelists a liquidator and two bidders.
+func initAuctionTest(t *testing.T) (
+	nibiruApp *nibisimapp.NibiruTestApp, ctx sdk.Context, trader sdk.AccAddress, liquidator sdk.AccAddress, bidders []sdk.AccAddress,
+) {
+	nibiruApp, ctx, trader = initOrdersTest(t, sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 1_000)))
+	perpKeeper := nibiruApp.PerpKeeper
+
+	oracle := testutil.AccAddress()
+	nibiruApp.PricefeedKeeper.WhitelistOracles(ctx, []sdk.AccAddress{oracle})
+	require.NoError(t, nibiruApp.PricefeedKeeper.PostRawPrice(ctx, oracle, common.Pair_BTC_NUSD.String(), sdk.OneDec(), time.Now().Add(time.Hour)))
+	require.NoError(t, nibiruApp.PricefeedKeeper.GatherRawPrices(ctx, common.DenomBTC, common.DenomNUSD))
+
+	liquidator = testutil.AccAddress()
+	bidders = []sdk.AccAddress{testutil.AccAddress(), testutil.AccAddress()}
+	for _, bidder := range bidders {
+		require.NoError(t, simapp.FundAccount(nibiruApp.BankKeeper, ctx, bidder,
+			sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 10_000))))
+	}
+
+	params := perpKeeper.GetParams(ctx)
+	params.WhitelistedLiquidators = []string{liquidator.String(), bidders[0].String(), bidders[1].String()}
+	params.LiquidationAuctionThreshold = sdk.NewDec(50_000)
+	params.LiquidationAuctionMaxDiscount = sdk.MustNewDecFromStr("0.05")
+	params.LiquidationAuctionDuration = time.Minute
+	perpKeeper.SetParams(ctx, params)
+
+	setPosition(perpKeeper, ctx, types.Position{
+		TraderAddress:                   trader.String(),
+		Pair:                            common.Pair_BTC_NUSD,
+		Size_:                           sdk.NewDec(100_000),
+		Margin:                          sdk.NewDec(2_000),
+		OpenNotional:                    sdk.NewDec(100_000),
+		LatestCumulativePremiumFraction: sdk.ZeroDec(),
+	})
+	require.NoError(t, simapp.FundModuleAccount(nibiruApp.BankKeeper, ctx, types.VaultModuleAccount,
+		sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 2_000))))
+
+	return nibiruApp, ctx, trader, liquidator, bidders
+}
+
+func TestLiquidationAuction(t *testing.T) {
+	nibiruApp, ctx, trader, liquidator, bidders := initAuctionTest(t)
+	perpKeeper := nibiruApp.PerpKeeper
+
+	t.Log("the preview of the liquidation reports an auction")
+	querier := keeper.NewQuerier(perpKeeper)
+	preview, err := querier.QueryLiquidationPreview(sdk.WrapSDKContext(ctx), &types.QueryLiquidationPreviewRequest{
+		TokenPair:  common.Pair_BTC_NUSD.String(),
+		Trader:     trader.String(),
+		Liquidator: liquidator.String(),
+	})
+	require.NoError(t, err)
+	assert.True(t, preview.Auction)
+	assert.True(t, preview.FullLiquidation)
+	assert.True(t, preview.FeeToLiquidator.IsZero())
+	assert.True(t, preview.FeeToEcosystemFund.IsZero())
+	assert.True(t, preview.FeeToInsuranceFund.IsZero())
+	assert.True(t, preview.ExchangedPositionSize.IsZero())
+	assert.EqualValues(t, sdk.NewDec(100_000), preview.Position.Size_)
+
+	liquidatable, err := querier.QueryLiquidatablePositions(sdk.WrapSDKContext(ctx), &types.QueryLiquidatablePositionsRequest{
+		TokenPair: common.Pair_BTC_NUSD.String(),
+	})
+	require.NoError(t, err)
+	require.Len(t, liquidatable.Positions, 1)
+
+	t.Log("liquidating the oversized position starts an auction")
+	feeToLiquidator, feeToFund, err := perpKeeper.Liquidate(ctx, liquidator, common.Pair_BTC_NUSD, trader)
+	require.NoError(t, err)
+	assert.True(t, feeToLiquidator.IsZero())
+	assert.True(t, feeToFund.IsZero())
+
+	auction, err := perpKeeper.LiquidationAuctions.Get(ctx, collections.Join(common.Pair_BTC_NUSD, trader))
+	require.NoError(t, err)
+	assert.Equal(t, liquidator.String(), auction.LiquidatorAddress)
+	assert.Equal(t, sdk.OneDec(), auction.IndexPrice)
+	assert.Equal(t, sdk.MustNewDecFromStr("0.95"), auction.LimitPrice)
+	assert.Equal(t, ctx.BlockTime().Add(time.Minute), auction.EndTime)
+
+	t.Log("the position is locked")
+	_, err = perpKeeper.ClosePosition(ctx, common.Pair_BTC_NUSD, trader)
+	require.ErrorIs(t, err, types.ErrPositionInAuction)
+	_, err = perpKeeper.AddMargin(ctx, common.Pair_BTC_NUSD, trader, sdk.NewInt64Coin(common.DenomNUSD, 100))
+	require.ErrorIs(t, err, types.ErrPositionInAuction)
+	_, err = perpKeeper.OpenPosition(ctx, common.Pair_BTC_NUSD, types.Side_BUY, trader,
+		sdk.NewInt(100), sdk.NewDec(10), sdk.ZeroDec())
+	require.ErrorIs(t, err, types.ErrPositionInAuction)
+	_, err = perpKeeper.TransferPosition(ctx, common.Pair_BTC_NUSD, trader, testutil.AccAddress())
+	require.ErrorIs(t, err, types.ErrPositionInAuction)
+	_, _, err = perpKeeper.Liquidate(ctx, liquidator, common.Pair_BTC_NUSD, trader)
+	require.ErrorIs(t, err, types.ErrPositionInAuction)
+	_, _, _, err = perpKeeper.PreviewLiquidation(ctx, common.Pair_BTC_NUSD, trader, liquidator)
+	require.ErrorIs(t, err, types.ErrPositionInAuction)
+
+	t.Log("the locked position isn't listed as liquidatable")
+	liquidatable, err = querier.QueryLiquidatablePositions(sdk.WrapSDKContext(ctx), &types.QueryLiquidatablePositionsRequest{
+		TokenPair: common.Pair_BTC_NUSD.String(),
+	})
+	require.NoError(t, err)
+	assert.Empty(t, liquidatable.Positions)
+
+	t.Log("invalid bids are rejected")
+	margin := sdk.NewInt64Coin(common.DenomNUSD, 10_000)
+	_, err = perpKeeper.BidLiquidationAuction(ctx, testutil.AccAddress(), common.Pair_BTC_NUSD, trader, sdk.OneDec(), margin)
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = perpKeeper.BidLiquidationAuction(ctx, bidders[0], common.Pair_BTC_NUSD, trader, sdk.MustNewDecFromStr("0.94"), margin)
+	require.ErrorIs(t, err, types.ErrInvalidAuctionBid)
+	_, err = perpKeeper.BidLiquidationAuction(ctx, bidders[0], common.Pair_BTC_NUSD, trader, sdk.OneDec(),
+		sdk.NewInt64Coin(common.DenomNUSD, 1_000))
+	require.ErrorIs(t, err, types.ErrMarginRatioTooLow)
+
+	t.Log("the best bid escrows its margin and refunds the one it outbids")
+	_, err = perpKeeper.BidLiquidationAuction(ctx, bidders[0], common.Pair_BTC_NUSD, trader, sdk.MustNewDecFromStr("0.98"), margin)
+	require.NoError(t, err)
+	assert.True(t, nibiruApp.BankKeeper.GetBalance(ctx, bidders[0], common.DenomNUSD).IsZero())
+	_, err = perpKeeper.BidLiquidationAuction(ctx, bidders[1], common.Pair_BTC_NUSD, trader, sdk.MustNewDecFromStr("0.98"), margin)
+	require.ErrorIs(t, err, types.ErrInvalidAuctionBid)
+	auction, err = perpKeeper.BidLiquidationAuction(ctx, bidders[1], common.Pair_BTC_NUSD, trader, sdk.OneDec(), margin)
+	require.NoError(t, err)
+	assert.Equal(t, bidders[1].String(), auction.BestBidder)
+	assert.Equal(t, margin, nibiruApp.BankKeeper.GetBalance(ctx, bidders[0], common.DenomNUSD))
+	assert.True(t, nibiruApp.BankKeeper.GetBalance(ctx, bidders[1], common.DenomNUSD).IsZero())
+
+	t.Log("the auction only ends after its end time")
+	perpKeeper.EndLiquidationAuctions(ctx)
+	require.True(t, perpKeeper.LiquidationAuctions.Iterate(ctx, collections.PairRange[common.AssetPair, sdk.AccAddress]{}).Valid())
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(ctx.BlockTime().Add(time.Minute))
+	_, err = perpKeeper.BidLiquidationAuction(ctx, bidders[0], common.Pair_BTC_NUSD, trader, sdk.MustNewDecFromStr("1.01"), margin)
+	require.ErrorIs(t, err, types.ErrInvalidAuctionBid)
+	perpKeeper.EndLiquidationAuctions(ctx)
+
+	t.Log("the best bidder takes the position over at the bid price")
+	_, err = perpKeeper.LiquidationAuctions.Get(ctx, collections.Join(common.Pair_BTC_NUSD, trader))
+	require.ErrorIs(t, err, collections.ErrNotFound)
+	_, err = perpKeeper.Positions.Get(ctx, collections.Join(common.Pair_BTC_NUSD, trader))
+	require.ErrorIs(t, err, collections.ErrNotFound)
+	position, err := perpKeeper.Positions.Get(ctx, collections.Join(common.Pair_BTC_NUSD, bidders[1]))
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewDec(100_000), position.Size_)
+	assert.Equal(t, sdk.NewDec(100_000), position.OpenNotional)
+	assert.Equal(t, sdk.NewDec(10_000), position.Margin)
+	assert.Equal(t, sdk.NewDec(100_000), perpKeeper.GetOpenInterest(ctx, common.Pair_BTC_NUSD).LongSize)
+
+	t.Log("the liquidator is paid half of the liquidation fee of 0.025 * 100_000")
+	assert.Equal(t, sdk.NewInt64Coin(common.DenomNUSD, 1_250), nibiruApp.BankKeeper.GetBalance(ctx, liquidator, common.DenomNUSD))
+	testutil.RequireContainsTypedEvent(t, ctx, &types.LiquidationAuctionEndedEvent{
+		Pair:          common.Pair_BTC_NUSD.String(),
+		TraderAddress: trader.String(),
+		Outcome:       types.LiquidationAuctionOutcome_SOLD,
+		Winner:        bidders[1].String(),
+		Price:         sdk.OneDec(),
+		BlockHeight:   ctx.BlockHeight(),
+		BlockTimeMs:   ctx.BlockTime().UnixMilli(),
+	})
+}
+
+func TestLiquidationAuctionFallback(t *testing.T) {
+	testCases := []struct {
+		name   string
+		freeze bool
+
+		expectedOutcome    types.LiquidationAuctionOutcome
+		expectPositionLeft bool
+	}{
+		{
+			name:            "no bid closes the position on the vpool",
+			expectedOutcome: types.LiquidationAuctionOutcome_VPOOL_CLOSE,
+		},
+		{
+			name:               "frozen market cancels the auction",
+			freeze:             true,
+			expectedOutcome:    types.LiquidationAuctionOutcome_CANCELLED,
+			expectPositionLeft: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			nibiruApp, ctx, trader, liquidator, bidders := initAuctionTest(t)
+			perpKeeper := nibiruApp.PerpKeeper
+
+			_, _, err := perpKeeper.Liquidate(ctx, liquidator, common.Pair_BTC_NUSD, trader)
+			require.NoError(t, err)
+
+			margin := sdk.NewInt64Coin(common.DenomNUSD, 10_000)
+			if tc.freeze {
+				_, err = perpKeeper.BidLiquidationAuction(ctx, bidders[0], common.Pair_BTC_NUSD, trader, sdk.OneDec(), margin)
+				require.NoError(t, err)
+			}
+
+			ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(ctx.BlockTime().Add(time.Minute))
+			if tc.freeze {
+				_, err = nibiruApp.VpoolKeeper.SettlePool(ctx, common.Pair_BTC_NUSD, vpooltypes.SettlementPriceSource_MARK_TWAP, time.Hour)
+				require.NoError(t, err)
+			}
+			perpKeeper.EndLiquidationAuctions(ctx)
+
+			_, err = perpKeeper.LiquidationAuctions.Get(ctx, collections.Join(common.Pair_BTC_NUSD, trader))
+			require.ErrorIs(t, err, collections.ErrNotFound)
+			_, err = perpKeeper.Positions.Get(ctx, collections.Join(common.Pair_BTC_NUSD, trader))
+			if tc.expectPositionLeft {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, collections.ErrNotFound)
+			}
+
+			t.Log("the bid, if any, is refunded")
+			assert.Equal(t, margin, nibiruApp.BankKeeper.GetBalance(ctx, bidders[0], common.DenomNUSD))
+			testutil.RequireContainsTypedEvent(t, ctx, &types.LiquidationAuctionEndedEvent{
+				Pair:          common.Pair_BTC_NUSD.String(),
+				TraderAddress: trader.String(),
+				Outcome:       tc.expectedOutcome,
+				Price:         sdk.ZeroDec(),
+				BlockHeight:   ctx.BlockHeight(),
+				BlockTimeMs:   ctx.BlockTime().UnixMilli(),
+			})
+		})
+	}
+}
+
+func TestLiquidationBelowAuctionThreshold(t *testing.T) {
+	nibiruApp, ctx, trader, liquidator, _ := initAuctionTest(t)
+	perpKeeper := nibiruApp.PerpKeeper
+	params := perpKeeper.GetParams(ctx)
+	params.LiquidationAuctionThreshold = sdk.NewDec(1_000_000)
+	perpKeeper.SetParams(ctx, params)
+
+	feeToLiquidator, _, err := perpKeeper.Liquidate(ctx, liquidator, common.Pair_BTC_NUSD, trader)
+	require.NoError(t, err)
+	assert.True(t, feeToLiquidator.IsPositive())
+
+	_, err = perpKeeper.LiquidationAuctions.Get(ctx, collections.Join(common.Pair_BTC_NUSD, trader))
+	require.ErrorIs(t, err, collections.ErrNotFound)
+	_, err = perpKeeper.Positions.Get(ctx, collections.Join(common.Pair_BTC_NUSD, trader))
+	require.ErrorIs(t, err, collections.ErrNotFound)
+}
@@ -0,0 +1,73 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/collections"
+	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/perp/types"
+	"github.com/NibiruChain/nibiru/x/testutil"
+)
+
+func TestTradingAgent(t *testing.T) {
+	nibiruApp, ctx, owner := initOrdersTest(t, sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 1_020)))
+	agent := testutil.AccAddress()
+
+	for _, msgTypeURL := range types.TradingMsgTypeURLs() {
+		require.NoError(t, nibiruApp.AuthzKeeper.SaveGrant(ctx, agent, owner,
+			types.NewTradingAuthorization(
+				msgTypeURL,
+				/* pairs */ []string{common.Pair_BTC_NUSD.String()},
+				/* maxLeverage */ sdk.NewDec(10),
+				/* maxNotional */ sdk.NewInt(10_000),
+			),
+			ctx.BlockTime().Add(time.Hour)))
+	}
+
+	openPosition := func(pair common.AssetPair, leverage int64) error {
+		_, err := nibiruApp.AuthzKeeper.DispatchActions(ctx, agent, []sdk.Msg{&types.MsgOpenPosition{
+			Sender:               owner.String(),
+			TokenPair:            pair.String(),
+			Side:                 types.Side_BUY,
+			QuoteAssetAmount:     sdk.NewInt(1_000),
+			Leverage:             sdk.NewDec(leverage),
+			BaseAssetAmountLimit: sdk.ZeroInt(),
+		}})
+		return err
+	}
+
+	t.Log("the agent cannot go over its max leverage")
+	require.ErrorIs(t, openPosition(common.Pair_BTC_NUSD, 11), types.ErrUnauthorized)
+
+	t.Log("the agent cannot trade pairs outside of its whitelist")
+	require.ErrorIs(t, openPosition(common.Pair_ETH_NUSD, 5), types.ErrUnauthorized)
+
+	t.Log("the agent opens a position for the owner, with the owner's funds")
+	require.NoError(t, openPosition(common.Pair_BTC_NUSD, 10))
+	position, err := nibiruApp.PerpKeeper.Positions.Get(ctx, collections.Join(common.Pair_BTC_NUSD, owner))
+	require.NoError(t, err)
+	assert.EqualValues(t, sdk.NewDec(1_000), position.Margin)
+	assert.EqualValues(t, sdk.NewInt64Coin(common.DenomNUSD, 0), nibiruApp.BankKeeper.GetBalance(ctx, owner, common.DenomNUSD))
+	_, err = nibiruApp.PerpKeeper.Positions.Get(ctx, collections.Join(common.Pair_BTC_NUSD, agent))
+	require.ErrorIs(t, err, collections.ErrNotFound)
+
+	t.Log("the agent closes the position, the margin goes back to the owner")
+	_, err = nibiruApp.AuthzKeeper.DispatchActions(ctx, agent, []sdk.Msg{&types.MsgClosePosition{
+		Sender:    owner.String(),
+		TokenPair: common.Pair_BTC_NUSD.String(),
+	}})
+	require.NoError(t, err)
+	// the margin net of the fees to close
+	assert.EqualValues(t, sdk.NewInt64Coin(common.DenomNUSD, 980), nibiruApp.BankKeeper.GetBalance(ctx, owner, common.DenomNUSD))
+	assert.True(t, nibiruApp.BankKeeper.GetAllBalances(ctx, agent).IsZero())
+
+	t.Log("the agent cannot trade once the grant expires")
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(2 * time.Hour))
+	require.ErrorIs(t, openPosition(common.Pair_BTC_NUSD, 1), sdkerrors.ErrUnauthorized)
+}
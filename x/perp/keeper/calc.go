@@ -97,12 +97,13 @@ func (k Keeper) calcFreeCollateral(
 		return sdk.Dec{}, err
 	}
 
-	positionNotional, unrealizedPnL, err := k.
-		GetPreferencePositionNotionalAndUnrealizedPnL(
-			ctx,
-			pos,
-			types.PnLPreferenceOption_MIN,
-		)
+	policy, _ := k.getMarginPricePolicy(ctx, pos.Pair)
+	positionNotional, unrealizedPnL, err := k.getSourcesNotionalAndUnrealizedPnL(
+		ctx,
+		pos,
+		policy.Sources,
+		types.PnLPreferenceOption_MIN,
+	)
 	if err != nil {
 		return sdk.Dec{}, err
 	}
@@ -128,6 +129,5 @@ func (k Keeper) getLatestCumulativePremiumFraction(
 		)
 		return sdk.Dec{}, err
 	}
-	// this should never fail
-	return pairMetadata.CumulativePremiumFractions[len(pairMetadata.CumulativePremiumFractions)-1], nil
+	return pairMetadata.LatestCumulativePremiumFraction, nil
 }
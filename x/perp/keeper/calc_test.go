@@ -60,8 +60,8 @@ func TestCalcRemainMarginWithFundingPayment(t *testing.T) {
 
 				t.Log("Set vpool defined by pair on PerpKeeper")
 				setPairMetadata(nibiruApp.PerpKeeper, ctx, types.PairMetadata{
-					Pair:                       pair,
-					CumulativePremiumFractions: fundingRates,
+					Pair:                            pair,
+					LatestCumulativePremiumFraction: fundingRates[len(fundingRates)-1],
 				})
 
 				pos := &types.Position{
@@ -116,8 +116,8 @@ func TestCalcRemainMarginWithFundingPayment(t *testing.T) {
 
 				t.Log("Set vpool defined by pair on PerpKeeper")
 				setPairMetadata(nibiruApp.PerpKeeper, ctx, types.PairMetadata{
-					Pair:                       pair,
-					CumulativePremiumFractions: fundingRates,
+					Pair:                            pair,
+					LatestCumulativePremiumFraction: fundingRates[len(fundingRates)-1],
 				})
 
 				pos := &types.Position{
@@ -38,7 +38,7 @@ func (k Keeper) OpenPosition(
 	leverage sdk.Dec,
 	baseAmtLimit sdk.Dec,
 ) (positionResp *types.PositionResp, err error) {
-	err = k.checkOpenPositionRequirements(ctx, pair, quoteAssetAmount, leverage)
+	err = k.checkOpenPositionRequirements(ctx, pair, side, traderAddr, quoteAssetAmount, leverage)
 	if err != nil {
 		return nil, err
 	}
@@ -46,13 +46,37 @@ func (k Keeper) OpenPosition(
 	// require params
 	params := k.GetParams(ctx)
 
-	position, err := k.Positions.Get(ctx, collections.Join(pair, traderAddr))
-	isNewPosition := errors.Is(err, collections.ErrNotFound)
+	positionResp, isNewPosition, err := k.tradePosition(ctx, pair, side, traderAddr, quoteAssetAmount, leverage, baseAmtLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = k.afterPositionUpdate(ctx, pair, traderAddr, params, isNewPosition, *positionResp); err != nil {
+		return nil, err
+	}
+
+	return positionResp, nil
+}
+
+// tradePosition increases, decreases or reverses the position of the trader
+// through the vpool, without settling margin nor fees. Only the trades
+// decreasing or closing a position go through on a REDUCE_ONLY vpool.
+func (k Keeper) tradePosition(
+	ctx sdk.Context,
+	pair common.AssetPair,
+	side types.Side,
+	traderAddr sdk.AccAddress,
+	quoteAssetAmount sdk.Int,
+	leverage sdk.Dec,
+	baseAmtLimit sdk.Dec,
+) (positionResp *types.PositionResp, isNewPosition bool, err error) {
+	position, err := k.GetPosition(ctx, pair, traderAddr)
+	isNewPosition = errors.Is(err, collections.ErrNotFound)
 	if isNewPosition {
 		position = types.ZeroPosition(ctx, pair, traderAddr)
-		k.Positions.Insert(ctx, collections.Join(pair, traderAddr), position)
+		k.SetPosition(ctx, position)
 	} else if err != nil && !isNewPosition {
-		return nil, err
+		return nil, false, err
 	}
 
 	sameSideLong := position.Size_.IsPositive() && side == types.Side_BUY
@@ -68,7 +92,7 @@ func (k Keeper) OpenPosition(
 			/* minPositionSize */ baseAmtLimit,
 			/* leverage */ leverage)
 		if err != nil {
-			return nil, err
+			return nil, false, err
 		}
 	} else {
 		// everything else decreases the position
@@ -80,15 +104,25 @@ func (k Keeper) OpenPosition(
 			/* baseAmtLimit */ baseAmtLimit,
 		)
 		if err != nil {
-			return nil, err
+			return nil, false, err
 		}
 	}
 
-	if err = k.afterPositionUpdate(ctx, pair, traderAddr, params, isNewPosition, *positionResp); err != nil {
-		return nil, err
+	if k.VpoolKeeper.GetPoolStatus(ctx, pair) == vpooltypes.PoolStatus_REDUCE_ONLY &&
+		!isPositionDecrease(position.Size_, positionResp.Position.Size_) {
+		return nil, false, types.ErrPairReduceOnly.Wrap(pair.String())
 	}
 
-	return positionResp, nil
+	return positionResp, isNewPosition, nil
+}
+
+// isPositionDecrease returns true if a position of size oldSize is closed, or
+// decreased without being reversed, by a trade leaving it at size newSize.
+func isPositionDecrease(oldSize sdk.Dec, newSize sdk.Dec) bool {
+	if newSize.IsZero() {
+		return !oldSize.IsZero()
+	}
+	return oldSize.IsPositive() == newSize.IsPositive() && newSize.Abs().LT(oldSize.Abs())
 }
 
 // checkOpenPositionRequirements checks the minimum requirements to open a position.
@@ -97,7 +131,16 @@ func (k Keeper) OpenPosition(
 // - Checks that quote asset is not zero.
 // - Checks that leverage is not zero.
 // - Checks that leverage is below requirement.
-func (k Keeper) checkOpenPositionRequirements(ctx sdk.Context, pair common.AssetPair, quoteAssetAmount sdk.Int, leverage sdk.Dec) error {
+// - Checks that the position is not locked by a liquidation auction.
+// - Checks that the open interest and position size caps are not exceeded.
+func (k Keeper) checkOpenPositionRequirements(
+	ctx sdk.Context,
+	pair common.AssetPair,
+	side types.Side,
+	traderAddr sdk.AccAddress,
+	quoteAssetAmount sdk.Int,
+	leverage sdk.Dec,
+) error {
 	if err := k.requireVpool(ctx, pair); err != nil {
 		return err
 	}
@@ -114,7 +157,11 @@ func (k Keeper) checkOpenPositionRequirements(ctx sdk.Context, pair common.Asset
 		return types.ErrLeverageIsTooHigh
 	}
 
-	return nil
+	if err := k.requireNotInAuction(ctx, pair, traderAddr); err != nil {
+		return err
+	}
+
+	return k.checkOpenInterestCaps(ctx, pair, side, traderAddr, leverage.MulInt(quoteAssetAmount))
 }
 
 // afterPositionUpdate is called when a position has been updated.
@@ -128,32 +175,51 @@ func (k Keeper) afterPositionUpdate(
 ) (err error) {
 	// update position in state
 	if !positionResp.Position.Size_.IsZero() {
-		k.Positions.Insert(ctx, collections.Join(pair, traderAddr), *positionResp.Position)
+		k.SetPosition(ctx, *positionResp.Position)
 	}
 
 	if !positionResp.BadDebt.IsZero() {
 		return fmt.Errorf("bad debt must be zero to prevent attacker from leveraging it")
 	}
 
-	if !positionResp.Position.Size_.IsZero() {
-		marginRatio, err := k.GetMarginRatio(
-			ctx,
-			*positionResp.Position,
-			types.MarginCalculationPriceOption_MAX_PNL,
-		)
-		if err != nil {
+	marginToVault := positionResp.MarginToVault.RoundInt()
+	isCrossMargin := k.isCrossMarginAccount(ctx, traderAddr)
+	if isCrossMargin {
+		// the margin of cross margin positions comes from and goes back to the account collateral
+		if err = k.allocateCrossMargin(ctx, traderAddr, pair.QuoteDenom(), marginToVault); err != nil {
 			return err
 		}
+	}
+
+	if !positionResp.Position.Size_.IsZero() {
+		var marginRatio, maintenanceMarginRatio sdk.Dec
+		if isCrossMargin {
+			marginRatio, maintenanceMarginRatio, err = k.GetCrossMarginRatio(
+				ctx,
+				traderAddr,
+				pair.QuoteDenom(),
+				types.MarginCalculationPriceOption_MAX_PNL,
+			)
+			if err != nil {
+				return err
+			}
+		} else {
+			marginRatio, err = k.getMarginCheckRatio(ctx, *positionResp.Position)
+			if err != nil {
+				return err
+			}
+			maintenanceMarginRatio = k.VpoolKeeper.GetMaintenanceMarginRatio(ctx, pair)
+		}
 
-		maintenanceMarginRatio := k.VpoolKeeper.GetMaintenanceMarginRatio(ctx, pair)
 		if err = requireMoreMarginRatio(marginRatio, maintenanceMarginRatio, true); err != nil {
 			return types.ErrMarginRatioTooLow
 		}
 	}
 
 	// transfer trader <=> vault
-	marginToVault := positionResp.MarginToVault.RoundInt()
 	switch {
+	case isCrossMargin:
+		// already moved between the vault and the account collateral
 	case marginToVault.IsPositive():
 		coinToSend := sdk.NewCoin(pair.QuoteDenom(), marginToVault)
 		if err = k.BankKeeper.SendCoinsFromAccountToModule(
@@ -186,7 +252,8 @@ func (k Keeper) afterPositionUpdate(
 		positionNotional = positionResp.Position.OpenNotional.Sub(positionResp.UnrealizedPnlAfter)
 	}
 
-	return ctx.EventManager().EmitTypedEvent(&types.PositionChangedEvent{
+	previousSize := positionResp.Position.Size_.Sub(positionResp.ExchangedPositionSize)
+	return k.emitPositionChanged(ctx, previousSize, &types.PositionChangedEvent{
 		TraderAddress:         traderAddr.String(),
 		Pair:                  pair.String(),
 		Margin:                sdk.NewCoin(pair.QuoteDenom(), positionResp.Position.Margin.RoundInt()),
@@ -643,7 +710,7 @@ func (k Keeper) closePositionEntirely(
 		BlockNumber:                     ctx.BlockHeight(),
 	}
 
-	err = k.Positions.Delete(ctx, collections.Join(currentPosition.Pair, trader))
+	err = k.DeletePosition(ctx, currentPosition.Pair, trader)
 	if err != nil {
 		return nil, err
 	}
@@ -665,7 +732,11 @@ ret:
   - err: error if any
 */
 func (k Keeper) ClosePosition(ctx sdk.Context, pair common.AssetPair, traderAddr sdk.AccAddress) (*types.PositionResp, error) {
-	position, err := k.Positions.Get(ctx, collections.Join(pair, traderAddr))
+	if err := k.requireNotInAuction(ctx, pair, traderAddr); err != nil {
+		return nil, err
+	}
+
+	position, err := k.GetPosition(ctx, pair, traderAddr)
 	if err != nil {
 		return nil, err
 	}
@@ -698,14 +769,127 @@ func (k Keeper) ClosePosition(ctx sdk.Context, pair common.AssetPair, traderAddr
 	return positionResp, nil
 }
 
+/*
+PartialClose reduces a position by either a base asset size or a quote
+notional value, realizing the corresponding share of its PnL. Unlike opening a
+position on the opposite side, it never closes nor reverses the position.
+
+args:
+  - ctx: the cosmos-sdk context
+  - pair: the trading pair
+  - traderAddr: the trader's address
+  - size: the amount of base assets to close, zero if closing by notional
+  - quoteAssetAmount: the notional value to close, zero if closing by size
+  - limit: the slippage limit, see MsgPartialClose
+
+ret:
+  - positionResp: the response containing the updated position and applied funding payment, bad debt, PnL
+  - err: error if any
+*/
+func (k Keeper) PartialClose(
+	ctx sdk.Context,
+	pair common.AssetPair,
+	traderAddr sdk.AccAddress,
+	size sdk.Dec,
+	quoteAssetAmount sdk.Dec,
+	limit sdk.Dec,
+) (positionResp *types.PositionResp, err error) {
+	if err = k.requireVpool(ctx, pair); err != nil {
+		return nil, err
+	}
+	if err = k.requireNotInAuction(ctx, pair, traderAddr); err != nil {
+		return nil, err
+	}
+
+	position, err := k.GetPosition(ctx, pair, traderAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	positionNotional, _, err := k.getPositionNotionalAndUnrealizedPnL(
+		ctx,
+		position,
+		types.PnLCalcOption_SPOT_PRICE,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var baseAmtLimit sdk.Dec
+	if size.IsPositive() {
+		if size.GTE(position.Size_.Abs()) {
+			return nil, types.ErrInvalidPartialClose.Wrapf(
+				"size %s is not smaller than the position size %s", size, position.Size_.Abs())
+		}
+
+		// the notional value of 'size', as the quote amount a long would receive or a short would pay
+		var baseAssetDirection vpooltypes.Direction
+		if position.Size_.IsPositive() {
+			baseAssetDirection = vpooltypes.Direction_ADD_TO_POOL
+		} else {
+			baseAssetDirection = vpooltypes.Direction_REMOVE_FROM_POOL
+		}
+		quoteAssetAmount, err = k.VpoolKeeper.GetBaseAssetPrice(ctx, pair, baseAssetDirection, size)
+		if err != nil {
+			return nil, err
+		}
+
+		if limit.IsPositive() {
+			if position.Size_.IsPositive() && quoteAssetAmount.LT(limit) {
+				return nil, fmt.Errorf("quote amount received (%s) is less than selected limit (%s)", quoteAssetAmount, limit)
+			} else if position.Size_.IsNegative() && quoteAssetAmount.GT(limit) {
+				return nil, fmt.Errorf("quote amount paid (%s) is greater than selected limit (%s)", quoteAssetAmount, limit)
+			}
+		}
+		baseAmtLimit = sdk.ZeroDec()
+	} else {
+		baseAmtLimit = limit
+	}
+
+	if !quoteAssetAmount.IsPositive() || quoteAssetAmount.GTE(positionNotional) {
+		return nil, types.ErrInvalidPartialClose.Wrapf(
+			"notional %s must be positive and smaller than the position notional %s", quoteAssetAmount, positionNotional)
+	}
+
+	positionResp, err = k.decreasePosition(
+		ctx,
+		position,
+		/* decreasedNotional */ quoteAssetAmount,
+		/* baseAmtLimit */ baseAmtLimit,
+		/* skipFluctuationLimitCheck */ false,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// should never happen as the decreased notional is smaller than the position notional
+	if positionResp.Position.Size_.IsZero() || positionResp.Position.Size_.IsPositive() != position.Size_.IsPositive() {
+		return nil, types.ErrInvalidPartialClose.Wrapf("position size would change from %s to %s", position.Size_, positionResp.Position.Size_)
+	}
+
+	if err = k.afterPositionUpdate(
+		ctx,
+		pair,
+		traderAddr,
+		k.GetParams(ctx),
+		/* isNewPosition */ false,
+		*positionResp,
+	); err != nil {
+		return nil, err
+	}
+
+	return positionResp, nil
+}
+
 func (k Keeper) transferFee(
 	ctx sdk.Context,
 	pair common.AssetPair,
 	trader sdk.AccAddress,
 	positionNotional sdk.Dec,
 ) (fees sdk.Int, err error) {
-	params := k.GetParams(ctx)
-	feeToFeePool := params.FeePoolFeeRatio.Mul(positionNotional).RoundInt()
+	feeToFeePool, feeToEcosystemFund, feeToInsuranceFund := k.calcFees(ctx, pair, trader, positionNotional)
+	k.recordTraderVolume(ctx, trader, positionNotional)
+
 	if feeToFeePool.IsPositive() {
 		if err = k.BankKeeper.SendCoinsFromAccountToModule(
 			ctx,
@@ -722,7 +906,19 @@ func (k Keeper) transferFee(
 		}
 	}
 
-	feeToEcosystemFund := params.EcosystemFundFeeRatio.Mul(positionNotional).RoundInt()
+	if feeToInsuranceFund.IsPositive() {
+		coinToInsuranceFund := sdk.NewCoin(pair.QuoteDenom(), feeToInsuranceFund)
+		if err = k.BankKeeper.SendCoinsFromAccountToModule(
+			ctx,
+			/* from */ trader,
+			/* to */ types.InsuranceFundModuleAccount,
+			/* coins */ sdk.NewCoins(coinToInsuranceFund),
+		); err != nil {
+			return sdk.Int{}, err
+		}
+		k.recordInsuranceFundContribution(ctx, coinToInsuranceFund)
+	}
+
 	if feeToEcosystemFund.IsPositive() {
 		if err = k.BankKeeper.SendCoinsFromAccountToModule(
 			ctx,
@@ -739,7 +935,19 @@ func (k Keeper) transferFee(
 		}
 	}
 
-	return feeToFeePool.Add(feeToEcosystemFund), nil
+	return feeToFeePool.Add(feeToInsuranceFund).Add(feeToEcosystemFund), nil
+}
+
+// calcFees returns the fees charged to a trader on a trade of the given
+// notional value, the insurance fund receiving its share of the ecosystem fund fee.
+func (k Keeper) calcFees(
+	ctx sdk.Context, pair common.AssetPair, trader sdk.AccAddress, positionNotional sdk.Dec,
+) (feeToFeePool sdk.Int, feeToEcosystemFund sdk.Int, feeToInsuranceFund sdk.Int) {
+	feeRatios, _ := k.getFeeRatios(ctx, pair, trader)
+	feeToFeePool = feeRatios.FeePoolFeeRatio.Mul(positionNotional).RoundInt()
+	feeToEcosystemFund = feeRatios.EcosystemFundFeeRatio.Mul(positionNotional).RoundInt()
+	feeToInsuranceFund = k.GetParams(ctx).InsuranceFundFeeShare.MulInt(feeToEcosystemFund).TruncateInt()
+	return feeToFeePool, feeToEcosystemFund.Sub(feeToInsuranceFund), feeToInsuranceFund
 }
 
 /*
@@ -271,8 +271,8 @@ func TestOpenPositionSuccess(t *testing.T) {
 				/* maxLeverage */ sdk.MustNewDecFromStr("15"),
 			)
 			setPairMetadata(nibiruApp.PerpKeeper, ctx, types.PairMetadata{
-				Pair:                       common.Pair_BTC_NUSD,
-				CumulativePremiumFractions: []sdk.Dec{sdk.ZeroDec()},
+				Pair:                            common.Pair_BTC_NUSD,
+				LatestCumulativePremiumFraction: sdk.ZeroDec(),
 			})
 
 			t.Log("initialize trader funds")
@@ -505,8 +505,8 @@ func TestOpenPositionError(t *testing.T) {
 				/* maxLeverage */ sdk.MustNewDecFromStr("15"),
 			)
 			setPairMetadata(nibiruApp.PerpKeeper, ctx, types.PairMetadata{
-				Pair:                       common.Pair_BTC_NUSD,
-				CumulativePremiumFractions: []sdk.Dec{sdk.ZeroDec()},
+				Pair:                            common.Pair_BTC_NUSD,
+				LatestCumulativePremiumFraction: sdk.ZeroDec(),
 			})
 
 			t.Log("initialize trader funds")
@@ -596,3 +596,44 @@ func TestOpenPositionInvalidPair(t *testing.T) {
 		})
 	}
 }
+
+func TestOpenPositionReduceOnlyPool(t *testing.T) {
+	nibiruApp, ctx, traderAddr := initOrdersTest(t, sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 10_000)))
+	perpKeeper := nibiruApp.PerpKeeper
+
+	_, err := perpKeeper.OpenPosition(ctx, common.Pair_BTC_NUSD, types.Side_BUY, traderAddr,
+		sdk.NewInt(1_000), sdk.NewDec(10), sdk.ZeroDec())
+	require.NoError(t, err)
+
+	require.NoError(t, nibiruApp.VpoolKeeper.SetPoolStatus(ctx, common.Pair_BTC_NUSD, vpooltypes.PoolStatus_REDUCE_ONLY))
+
+	// the rejected trades run in a cache context, as their state changes are
+	// reverted with the transaction
+	t.Log("increasing the position is rejected")
+	cacheCtx, _ := ctx.CacheContext()
+	_, err = perpKeeper.OpenPosition(cacheCtx, common.Pair_BTC_NUSD, types.Side_BUY, traderAddr,
+		sdk.NewInt(100), sdk.NewDec(10), sdk.ZeroDec())
+	require.ErrorIs(t, err, types.ErrPairReduceOnly)
+
+	t.Log("reversing the position is rejected")
+	cacheCtx, _ = ctx.CacheContext()
+	_, err = perpKeeper.OpenPosition(cacheCtx, common.Pair_BTC_NUSD, types.Side_SELL, traderAddr,
+		sdk.NewInt(2_000), sdk.NewDec(10), sdk.ZeroDec())
+	require.ErrorIs(t, err, types.ErrPairReduceOnly)
+
+	t.Log("a new position is rejected")
+	otherTrader := testutil.AccAddress()
+	require.NoError(t, simapp.FundAccount(nibiruApp.BankKeeper, ctx, otherTrader,
+		sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 10_000))))
+	cacheCtx, _ = ctx.CacheContext()
+	_, err = perpKeeper.OpenPosition(cacheCtx, common.Pair_BTC_NUSD, types.Side_SELL, otherTrader,
+		sdk.NewInt(100), sdk.NewDec(10), sdk.ZeroDec())
+	require.ErrorIs(t, err, types.ErrPairReduceOnly)
+
+	t.Log("decreasing and closing the position go through")
+	_, err = perpKeeper.OpenPosition(ctx, common.Pair_BTC_NUSD, types.Side_SELL, traderAddr,
+		sdk.NewInt(100), sdk.NewDec(10), sdk.ZeroDec())
+	require.NoError(t, err)
+	_, err = perpKeeper.ClosePosition(ctx, common.Pair_BTC_NUSD, traderAddr)
+	require.NoError(t, err)
+}
@@ -4,8 +4,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/NibiruChain/nibiru/collections"
-
 	testutilevents "github.com/NibiruChain/nibiru/x/testutil"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -212,11 +210,8 @@ func TestIncreasePosition(t *testing.T) {
 				t.Log("set up pair metadata and last cumulative funding rate")
 				setPairMetadata(perpKeeper, ctx,
 					types.PairMetadata{
-						Pair: common.Pair_BTC_NUSD,
-						CumulativePremiumFractions: []sdk.Dec{
-							sdk.ZeroDec(),
-							sdk.MustNewDecFromStr("0.02"), // 0.02 NUSD / BTC
-						},
+						Pair:                            common.Pair_BTC_NUSD,
+						LatestCumulativePremiumFraction: sdk.MustNewDecFromStr("0.02"), // 0.02 NUSD / BTC,
 					},
 				)
 			},
@@ -288,11 +283,8 @@ func TestIncreasePosition(t *testing.T) {
 
 				t.Log("set up pair metadata and last cumulative funding rate")
 				setPairMetadata(perpKeeper, ctx, types.PairMetadata{
-					Pair: common.Pair_BTC_NUSD,
-					CumulativePremiumFractions: []sdk.Dec{
-						sdk.ZeroDec(),
-						sdk.MustNewDecFromStr("0.02"), // 0.02 NUSD / BTC
-					},
+					Pair:                            common.Pair_BTC_NUSD,
+					LatestCumulativePremiumFraction: sdk.MustNewDecFromStr("0.02"), // 0.02 NUSD / BTC,
 				})
 			},
 			when: func(ctx sdk.Context, perpKeeper Keeper, initPosition types.Position) (*types.PositionResp, error) {
@@ -366,11 +358,8 @@ func TestIncreasePosition(t *testing.T) {
 
 				t.Log("set up pair metadata and last cumulative funding rate")
 				setPairMetadata(perpKeeper, ctx, types.PairMetadata{
-					Pair: common.Pair_BTC_NUSD,
-					CumulativePremiumFractions: []sdk.Dec{
-						sdk.ZeroDec(),
-						sdk.MustNewDecFromStr("0.2"), // 0.2 NUSD / BTC
-					},
+					Pair:                            common.Pair_BTC_NUSD,
+					LatestCumulativePremiumFraction: sdk.MustNewDecFromStr("0.2"), // 0.2 NUSD / BTC,
 				})
 			},
 			when: func(ctx sdk.Context, perpKeeper Keeper, initPosition types.Position) (*types.PositionResp, error) {
@@ -442,11 +431,8 @@ func TestIncreasePosition(t *testing.T) {
 
 				t.Log("set up pair metadata and last cumulative funding rate")
 				setPairMetadata(perpKeeper, ctx, types.PairMetadata{
-					Pair: common.Pair_BTC_NUSD,
-					CumulativePremiumFractions: []sdk.Dec{
-						sdk.ZeroDec(),
-						sdk.MustNewDecFromStr("0.02"), // 0.02 NUSD / BTC
-					},
+					Pair:                            common.Pair_BTC_NUSD,
+					LatestCumulativePremiumFraction: sdk.MustNewDecFromStr("0.02"), // 0.02 NUSD / BTC,
 				})
 			},
 			when: func(ctx sdk.Context, perpKeeper Keeper, initPosition types.Position) (*types.PositionResp, error) {
@@ -518,11 +504,8 @@ func TestIncreasePosition(t *testing.T) {
 
 				t.Log("set up pair metadata and last cumulative funding rate")
 				setPairMetadata(perpKeeper, ctx, types.PairMetadata{
-					Pair: common.Pair_BTC_NUSD,
-					CumulativePremiumFractions: []sdk.Dec{
-						sdk.ZeroDec(),
-						sdk.MustNewDecFromStr("0.02"), // 0.02 NUSD / BTC
-					},
+					Pair:                            common.Pair_BTC_NUSD,
+					LatestCumulativePremiumFraction: sdk.MustNewDecFromStr("0.02"), // 0.02 NUSD / BTC,
 				})
 			},
 			when: func(ctx sdk.Context, perpKeeper Keeper, initPosition types.Position) (*types.PositionResp, error) {
@@ -597,11 +580,8 @@ func TestIncreasePosition(t *testing.T) {
 
 				t.Log("set up pair metadata and last cumulative funding rate")
 				setPairMetadata(perpKeeper, ctx, types.PairMetadata{
-					Pair: common.Pair_BTC_NUSD,
-					CumulativePremiumFractions: []sdk.Dec{
-						sdk.ZeroDec(),
-						sdk.MustNewDecFromStr("-0.3"), // - 0.3 NUSD / BTC
-					},
+					Pair:                            common.Pair_BTC_NUSD,
+					LatestCumulativePremiumFraction: sdk.MustNewDecFromStr("-0.3"), // - 0.3 NUSD / BTC,
 				})
 			},
 			when: func(ctx sdk.Context, perpKeeper Keeper, initPosition types.Position) (*types.PositionResp, error) {
@@ -682,11 +662,8 @@ func TestClosePositionEntirely(t *testing.T) {
 				BlockNumber:                     0,
 			},
 			pairMetadata: types.PairMetadata{
-				Pair: common.Pair_BTC_NUSD,
-				CumulativePremiumFractions: []sdk.Dec{
-					sdk.ZeroDec(),
-					sdk.MustNewDecFromStr("0.02"), // 0.02 NUSD / BTC
-				},
+				Pair:                            common.Pair_BTC_NUSD,
+				LatestCumulativePremiumFraction: sdk.MustNewDecFromStr("0.02"), // 0.02 NUSD / BTC,
 			},
 			direction:              vpooltypes.Direction_ADD_TO_POOL,
 			newPositionNotional:    sdk.NewDec(200),
@@ -712,11 +689,8 @@ func TestClosePositionEntirely(t *testing.T) {
 				BlockNumber:                     0,
 			},
 			pairMetadata: types.PairMetadata{
-				Pair: common.Pair_BTC_NUSD,
-				CumulativePremiumFractions: []sdk.Dec{
-					sdk.ZeroDec(),
-					sdk.MustNewDecFromStr("0.02"), // 0.02 NUSD / BTC
-				},
+				Pair:                            common.Pair_BTC_NUSD,
+				LatestCumulativePremiumFraction: sdk.MustNewDecFromStr("0.02"), // 0.02 NUSD / BTC,
 			},
 			direction:              vpooltypes.Direction_ADD_TO_POOL,
 			newPositionNotional:    sdk.NewDec(100),
@@ -742,11 +716,8 @@ func TestClosePositionEntirely(t *testing.T) {
 				BlockNumber:                     0,
 			},
 			pairMetadata: types.PairMetadata{
-				Pair: common.Pair_BTC_NUSD,
-				CumulativePremiumFractions: []sdk.Dec{
-					sdk.ZeroDec(),
-					sdk.MustNewDecFromStr("0.02"), // 0.02 NUSD / BTC
-				},
+				Pair:                            common.Pair_BTC_NUSD,
+				LatestCumulativePremiumFraction: sdk.MustNewDecFromStr("0.02"), // 0.02 NUSD / BTC,
 			},
 			direction:              vpooltypes.Direction_ADD_TO_POOL,
 			newPositionNotional:    sdk.NewDec(100),
@@ -774,11 +745,8 @@ func TestClosePositionEntirely(t *testing.T) {
 				BlockNumber:                     0,
 			},
 			pairMetadata: types.PairMetadata{
-				Pair: common.Pair_BTC_NUSD,
-				CumulativePremiumFractions: []sdk.Dec{
-					sdk.ZeroDec(),
-					sdk.MustNewDecFromStr("0.02"), // 0.02 NUSD / BTC
-				},
+				Pair:                            common.Pair_BTC_NUSD,
+				LatestCumulativePremiumFraction: sdk.MustNewDecFromStr("0.02"), // 0.02 NUSD / BTC,
 			},
 			direction:              vpooltypes.Direction_REMOVE_FROM_POOL,
 			newPositionNotional:    sdk.NewDec(100),
@@ -804,11 +772,8 @@ func TestClosePositionEntirely(t *testing.T) {
 				BlockNumber:                     0,
 			},
 			pairMetadata: types.PairMetadata{
-				Pair: common.Pair_BTC_NUSD,
-				CumulativePremiumFractions: []sdk.Dec{
-					sdk.ZeroDec(),
-					sdk.MustNewDecFromStr("0.02"), // 0.02 NUSD / BTC
-				},
+				Pair:                            common.Pair_BTC_NUSD,
+				LatestCumulativePremiumFraction: sdk.MustNewDecFromStr("0.02"), // 0.02 NUSD / BTC,
 			},
 			direction:              vpooltypes.Direction_REMOVE_FROM_POOL,
 			newPositionNotional:    sdk.NewDec(105),
@@ -834,11 +799,8 @@ func TestClosePositionEntirely(t *testing.T) {
 				BlockNumber:                     0,
 			},
 			pairMetadata: types.PairMetadata{
-				Pair: common.Pair_BTC_NUSD,
-				CumulativePremiumFractions: []sdk.Dec{
-					sdk.ZeroDec(),
-					sdk.MustNewDecFromStr("0.02"), // 0.02 NUSD / BTC
-				},
+				Pair:                            common.Pair_BTC_NUSD,
+				LatestCumulativePremiumFraction: sdk.MustNewDecFromStr("0.02"), // 0.02 NUSD / BTC,
 			},
 			direction:              vpooltypes.Direction_REMOVE_FROM_POOL,
 			newPositionNotional:    sdk.NewDec(150),
@@ -905,7 +867,7 @@ func TestClosePositionEntirely(t *testing.T) {
 			assert.EqualValues(t, sdk.ZeroDec(), resp.Position.Margin)       // always zero
 			assert.EqualValues(t, sdk.ZeroDec(), resp.Position.OpenNotional) // always zero
 			assert.EqualValues(t,
-				tc.pairMetadata.CumulativePremiumFractions[len(tc.pairMetadata.CumulativePremiumFractions)-1],
+				tc.pairMetadata.LatestCumulativePremiumFraction,
 				resp.Position.LatestCumulativePremiumFraction,
 			)
 			assert.EqualValues(t, ctx.BlockHeight(), resp.Position.BlockNumber)
@@ -1176,11 +1138,8 @@ func TestDecreasePosition(t *testing.T) {
 
 			t.Log("set up pair metadata and last cumulative funding rate")
 			setPairMetadata(perpKeeper, ctx, types.PairMetadata{
-				Pair: common.Pair_BTC_NUSD,
-				CumulativePremiumFractions: []sdk.Dec{
-					sdk.ZeroDec(),
-					sdk.MustNewDecFromStr("0.02"), // 0.02 NUSD / BTC
-				},
+				Pair:                            common.Pair_BTC_NUSD,
+				LatestCumulativePremiumFraction: sdk.MustNewDecFromStr("0.02"), // 0.02 NUSD / BTC,
 			})
 
 			t.Log("decrease position")
@@ -1590,10 +1549,8 @@ func TestCloseAndOpenReversePosition(t *testing.T) {
 
 			t.Log("set up pair metadata and last cumulative funding rate")
 			setPairMetadata(perpKeeper, ctx, types.PairMetadata{
-				Pair: common.Pair_BTC_NUSD,
-				CumulativePremiumFractions: []sdk.Dec{
-					sdk.MustNewDecFromStr("0.02"), // 0.02 NUSD / BTC
-				},
+				Pair:                            common.Pair_BTC_NUSD,
+				LatestCumulativePremiumFraction: sdk.MustNewDecFromStr("0.02"), // 0.02 NUSD / BTC
 			})
 
 			t.Log("close position and open reverse")
@@ -1657,14 +1614,20 @@ func TestTransferFee(t *testing.T) {
 				ctx, trader, types.FeePoolModuleAccount,
 				sdk.NewCoins(sdk.NewInt64Coin(pair.QuoteDenom(), 5)),
 			).Return(wantError)
+			mocks.mockBankKeeper.EXPECT().SendCoinsFromAccountToModule(
+				ctx, trader, types.InsuranceFundModuleAccount,
+				sdk.NewCoins(sdk.NewInt64Coin(pair.QuoteDenom(), 2)),
+			).Return(wantError)
 			mocks.mockBankKeeper.EXPECT().SendCoinsFromAccountToModule(
 				ctx, trader, types.PerpEFModuleAccount,
-				sdk.NewCoins(sdk.NewInt64Coin(pair.QuoteDenom(), 5)),
+				sdk.NewCoins(sdk.NewInt64Coin(pair.QuoteDenom(), 3)),
 			).Return(wantError)
 
-			_, err := k.transferFee(
+			fees, err := k.transferFee(
 				ctx, pair, trader, positionNotional)
 			require.NoError(t, err)
+			assert.EqualValues(t, sdk.NewInt(10), fees)
+			assert.EqualValues(t, sdk.NewInt(2), k.GetInsuranceFund(ctx, pair.QuoteDenom()).TotalContributions)
 		})
 
 	t.Run("not enough funds for Perp Ecosystem Fund (spread) - error",
@@ -1676,11 +1639,16 @@ func TestTransferFee(t *testing.T) {
 				sdk.NewCoins(sdk.NewInt64Coin(pair.QuoteDenom(), 5)),
 			).Return(nil)
 
+			mocks.mockBankKeeper.EXPECT().SendCoinsFromAccountToModule(
+				ctx, trader, types.InsuranceFundModuleAccount,
+				sdk.NewCoins(sdk.NewInt64Coin(pair.QuoteDenom(), 2)),
+			).Return(nil)
+
 			expectedError := fmt.Errorf(
 				"trader missing funds for %s", types.PerpEFModuleAccount)
 			mocks.mockBankKeeper.EXPECT().SendCoinsFromAccountToModule(
 				ctx, trader, types.PerpEFModuleAccount,
-				sdk.NewCoins(sdk.NewInt64Coin(pair.QuoteDenom(), 5))).
+				sdk.NewCoins(sdk.NewInt64Coin(pair.QuoteDenom(), 3))).
 				Return(expectedError)
 			_, err := k.transferFee(
 				ctx, pair, trader, positionNotional)
@@ -1893,10 +1861,8 @@ func TestClosePosition(t *testing.T) {
 
 			t.Log("set up pair metadata and last cumulative funding rate")
 			setPairMetadata(perpKeeper, ctx, types.PairMetadata{
-				Pair: common.Pair_BTC_NUSD,
-				CumulativePremiumFractions: []sdk.Dec{
-					sdk.MustNewDecFromStr("0.02"), // 0.02 NUSD / BTC
-				},
+				Pair:                            common.Pair_BTC_NUSD,
+				LatestCumulativePremiumFraction: sdk.MustNewDecFromStr("0.02"), // 0.02 NUSD / BTC
 			})
 
 			t.Log("close position")
@@ -2031,10 +1997,8 @@ func TestClosePositionWithBadDebt(t *testing.T) {
 
 			t.Log("set up pair metadata and last cumulative funding rate")
 			setPairMetadata(perpKeeper, ctx, types.PairMetadata{
-				Pair: common.Pair_BTC_NUSD,
-				CumulativePremiumFractions: []sdk.Dec{
-					sdk.MustNewDecFromStr("0.02"), // 0.02 NUSD / BTC
-				},
+				Pair:                            common.Pair_BTC_NUSD,
+				LatestCumulativePremiumFraction: sdk.MustNewDecFromStr("0.02"), // 0.02 NUSD / BTC
 			})
 
 			t.Log("close position")
@@ -2051,7 +2015,7 @@ func TestClosePositionWithBadDebt(t *testing.T) {
 }
 
 func setPosition(k Keeper, ctx sdk.Context, pos types.Position) {
-	k.Positions.Insert(ctx, collections.Join(pos.Pair, sdk.MustAccAddressFromBech32(pos.TraderAddress)), pos)
+	k.SetPosition(ctx, pos)
 }
 
 func setPairMetadata(k Keeper, ctx sdk.Context, pm types.PairMetadata) {
@@ -0,0 +1,504 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/collections"
+	"github.com/NibiruChain/nibiru/x/perp/types"
+)
+
+/*
+DepositCrossMargin moves collateral from the trader's wallet to the vault and
+credits it to the trader's cross margin account. The first deposit opens the
+account, from then on every position of the trader draws its margin from the
+account collateral and is margined account-wide.
+
+args:
+  - ctx: the cosmos-sdk context
+  - traderAddr: the owner of the account
+  - collateral: the amount of collateral to deposit
+
+ret:
+  - account: the cross margin account after the deposit
+  - err: error if any
+*/
+func (k Keeper) DepositCrossMargin(
+	ctx sdk.Context, traderAddr sdk.AccAddress, collateral sdk.Coin,
+) (account types.CrossMarginAccount, err error) {
+	if !collateral.Amount.IsPositive() {
+		return types.CrossMarginAccount{}, fmt.Errorf("collateral must be positive, not: %s", collateral.Amount)
+	}
+
+	if err = k.BankKeeper.SendCoinsFromAccountToModule(
+		ctx,
+		/* from */ traderAddr,
+		/* to */ types.VaultModuleAccount,
+		/* amount */ sdk.NewCoins(collateral),
+	); err != nil {
+		return types.CrossMarginAccount{}, err
+	}
+
+	account, err = k.GetCrossMarginAccount(ctx, traderAddr)
+	if errors.Is(err, collections.ErrNotFound) {
+		account = types.CrossMarginAccount{TraderAddress: traderAddr.String(), Collateral: sdk.NewCoins()}
+	} else if err != nil {
+		return types.CrossMarginAccount{}, err
+	}
+	account.Collateral = account.Collateral.Add(collateral)
+	k.SetCrossMarginAccount(ctx, account)
+
+	if err = k.emitCrossMarginCollateralChanged(ctx, account, collateral.Denom, collateral.Amount); err != nil {
+		return types.CrossMarginAccount{}, err
+	}
+
+	return account, nil
+}
+
+/*
+WithdrawCrossMargin withdraws collateral from the trader's cross margin account.
+Only collateral not required to keep the account above the maintenance margin
+requirement can be withdrawn. Withdrawing all of the collateral closes the
+account, after which the trader's positions are isolated again. The account
+is kept while the trader has open positions, since they were opened against
+the account's collateral.
+
+args:
+  - ctx: the cosmos-sdk context
+  - traderAddr: the owner of the account
+  - collateral: the amount of collateral to withdraw
+
+ret:
+  - account: the cross margin account after the withdrawal
+  - err: error if any
+*/
+func (k Keeper) WithdrawCrossMargin(
+	ctx sdk.Context, traderAddr sdk.AccAddress, collateral sdk.Coin,
+) (account types.CrossMarginAccount, err error) {
+	if !collateral.Amount.IsPositive() {
+		return types.CrossMarginAccount{}, fmt.Errorf("collateral must be positive, not: %s", collateral.Amount)
+	}
+
+	account, err = k.GetCrossMarginAccount(ctx, traderAddr)
+	if err != nil {
+		return types.CrossMarginAccount{}, err
+	}
+
+	if account.Collateral.AmountOf(collateral.Denom).LT(collateral.Amount) {
+		return types.CrossMarginAccount{}, types.ErrNotEnoughCrossMargin.Wrapf(
+			"account collateral %s is lower than %s", account.Collateral, collateral)
+	}
+
+	freeCollateral, err := k.calcCrossMarginFreeCollateral(ctx, account, collateral.Denom)
+	if err != nil {
+		return types.CrossMarginAccount{}, err
+	}
+	if freeCollateral.LT(collateral.Amount.ToDec()) {
+		return types.CrossMarginAccount{}, types.ErrNotEnoughCrossMargin.Wrapf(
+			"free collateral %s%s is lower than %s", freeCollateral, collateral.Denom, collateral)
+	}
+
+	account.Collateral = account.Collateral.Sub(sdk.NewCoins(collateral))
+	hasPositions, err := k.hasOpenPositions(ctx, traderAddr)
+	if err != nil {
+		return types.CrossMarginAccount{}, err
+	}
+	if account.Collateral.IsZero() && !hasPositions {
+		if err = k.deleteCrossMarginAccount(ctx, traderAddr); err != nil {
+			return types.CrossMarginAccount{}, err
+		}
+	} else {
+		k.SetCrossMarginAccount(ctx, account)
+	}
+
+	if err = k.Withdraw(ctx, collateral.Denom, traderAddr, collateral.Amount); err != nil {
+		return types.CrossMarginAccount{}, err
+	}
+
+	if err = k.emitCrossMarginCollateralChanged(ctx, account, collateral.Denom, collateral.Amount.Neg()); err != nil {
+		return types.CrossMarginAccount{}, err
+	}
+
+	return account, nil
+}
+
+// GetCrossMarginAccount returns the cross margin account of the trader with the
+// socialized losses it has not yet taken deducted from its collateral.
+func (k Keeper) GetCrossMarginAccount(ctx sdk.Context, traderAddr sdk.AccAddress) (types.CrossMarginAccount, error) {
+	account, err := k.CrossMarginAccounts.Get(ctx, traderAddr)
+	if err != nil {
+		return types.CrossMarginAccount{}, err
+	}
+
+	return k.crossMarginWithSocializedLosses(ctx, account), nil
+}
+
+// crossMarginWithSocializedLosses takes the socialized losses past the cursor
+// of an account from its collateral and moves the cursor past them.
+func (k Keeper) crossMarginWithSocializedLosses(
+	ctx sdk.Context, account types.CrossMarginAccount,
+) types.CrossMarginAccount {
+	collateral := sdk.NewCoins()
+	for _, coin := range account.Collateral {
+		amount := k.applySocializedLosses(ctx, coin.Denom, coin.Amount.ToDec(), account.SocializedLossCursor)
+		collateral = collateral.Add(sdk.NewCoin(coin.Denom, amount.TruncateInt()))
+	}
+	account.Collateral = collateral
+	account.SocializedLossCursor = k.lastSocializedLossID(ctx)
+	return account
+}
+
+// SetCrossMarginAccount saves a cross margin account and updates the total
+// margins with the change of its collateral. The collateral is taken to be net
+// of all the socialized losses so far.
+func (k Keeper) SetCrossMarginAccount(ctx sdk.Context, account types.CrossMarginAccount) {
+	traderAddr := sdk.MustAccAddressFromBech32(account.TraderAddress)
+	previous, err := k.GetCrossMarginAccount(ctx, traderAddr)
+	if err != nil {
+		previous = types.CrossMarginAccount{Collateral: sdk.NewCoins(), SocializedLossCursor: k.lastSocializedLossID(ctx)}
+	}
+	account.SocializedLossCursor = previous.SocializedLossCursor
+
+	k.updateCrossMarginTotals(ctx, previous.Collateral, account.Collateral)
+	k.CrossMarginAccounts.Insert(ctx, traderAddr, account)
+}
+
+// deleteCrossMarginAccount removes a cross margin account and its collateral from the total margins.
+func (k Keeper) deleteCrossMarginAccount(ctx sdk.Context, traderAddr sdk.AccAddress) error {
+	previous, err := k.GetCrossMarginAccount(ctx, traderAddr)
+	if err != nil {
+		return err
+	}
+
+	k.updateCrossMarginTotals(ctx, previous.Collateral, sdk.NewCoins())
+	return k.CrossMarginAccounts.Delete(ctx, traderAddr)
+}
+
+// updateCrossMarginTotals replaces the previous collateral of an account with
+// the new one in the total margins.
+func (k Keeper) updateCrossMarginTotals(ctx sdk.Context, previous sdk.Coins, collateral sdk.Coins) {
+	for _, coin := range previous {
+		k.addToTotalMargin(ctx, coin.Denom, collateral.AmountOf(coin.Denom).Sub(coin.Amount).ToDec())
+	}
+	for _, coin := range collateral {
+		if previous.AmountOf(coin.Denom).IsZero() {
+			k.addToTotalMargin(ctx, coin.Denom, coin.Amount.ToDec())
+		}
+	}
+}
+
+// isCrossMarginAccount returns true if the trader opted into cross margin.
+func (k Keeper) isCrossMarginAccount(ctx sdk.Context, traderAddr sdk.AccAddress) bool {
+	_, err := k.CrossMarginAccounts.Get(ctx, traderAddr)
+	return err == nil
+}
+
+/*
+allocateCrossMargin moves margin between the collateral of a cross margin
+account and one of its positions. The vault already holds the collateral, so
+only the part of the margin the collateral can't cover is taken from the
+trader's wallet.
+
+args:
+  - ctx: the cosmos-sdk context
+  - traderAddr: the owner of the account
+  - denom: the quote denom of the position
+  - marginToVault: the margin the position requires, negative if the position released margin
+*/
+func (k Keeper) allocateCrossMargin(
+	ctx sdk.Context, traderAddr sdk.AccAddress, denom string, marginToVault sdk.Int,
+) (err error) {
+	if marginToVault.IsZero() {
+		return nil
+	}
+
+	account, err := k.GetCrossMarginAccount(ctx, traderAddr)
+	if err != nil {
+		return err
+	}
+
+	collateralChange := marginToVault.Neg()
+	if marginToVault.IsPositive() {
+		collateralChange = sdk.MinInt(account.Collateral.AmountOf(denom), marginToVault).Neg()
+		if shortfall := marginToVault.Add(collateralChange); shortfall.IsPositive() {
+			if err = k.BankKeeper.SendCoinsFromAccountToModule(
+				ctx, traderAddr, types.VaultModuleAccount, sdk.NewCoins(sdk.NewCoin(denom, shortfall))); err != nil {
+				return err
+			}
+		}
+	}
+
+	return k.changeCrossMarginCollateral(ctx, account, denom, collateralChange)
+}
+
+/*
+coverBadDebtWithCrossMargin uses the collateral of a cross margin account to
+pay for bad debt left by one of its positions before it is realized against the
+ecosystem fund.
+
+args:
+  - ctx: the cosmos-sdk context
+  - traderAddr: the owner of the position
+  - denom: the quote denom of the position
+  - badDebt: the bad debt of the position
+
+ret:
+  - remainingBadDebt: the bad debt the collateral could not cover
+  - err: error if any
+*/
+func (k Keeper) coverBadDebtWithCrossMargin(
+	ctx sdk.Context, traderAddr sdk.AccAddress, denom string, badDebt sdk.Dec,
+) (remainingBadDebt sdk.Dec, err error) {
+	account, err := k.GetCrossMarginAccount(ctx, traderAddr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return badDebt, nil
+	} else if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	covered := sdk.MinInt(account.Collateral.AmountOf(denom), badDebt.Ceil().TruncateInt())
+	if !covered.IsPositive() {
+		return badDebt, nil
+	}
+
+	if err = k.changeCrossMarginCollateral(ctx, account, denom, covered.Neg()); err != nil {
+		return sdk.Dec{}, err
+	}
+
+	return sdk.MaxDec(badDebt.Sub(covered.ToDec()), sdk.ZeroDec()), nil
+}
+
+// changeCrossMarginCollateral adds the (signed) amount to the collateral of the account.
+func (k Keeper) changeCrossMarginCollateral(
+	ctx sdk.Context, account types.CrossMarginAccount, denom string, amount sdk.Int,
+) error {
+	if amount.IsPositive() {
+		account.Collateral = account.Collateral.Add(sdk.NewCoin(denom, amount))
+	} else if amount.IsNegative() {
+		account.Collateral = account.Collateral.Sub(sdk.NewCoins(sdk.NewCoin(denom, amount.Neg())))
+	} else {
+		return nil
+	}
+
+	k.SetCrossMarginAccount(ctx, account)
+	return k.emitCrossMarginCollateralChanged(ctx, account, denom, amount)
+}
+
+func (k Keeper) emitCrossMarginCollateralChanged(
+	ctx sdk.Context, account types.CrossMarginAccount, denom string, amount sdk.Int,
+) error {
+	return ctx.EventManager().EmitTypedEvent(&types.CrossMarginCollateralChangedEvent{
+		TraderAddress:    account.TraderAddress,
+		Collateral:       account.Collateral,
+		CollateralChange: amount,
+		Denom:            denom,
+		BlockHeight:      ctx.BlockHeight(),
+		BlockTimeMs:      ctx.BlockTime().UnixMilli(),
+	})
+}
+
+// crossMarginPositions returns the positions of the trader whose pairs are quoted in denom.
+func (k Keeper) crossMarginPositions(
+	ctx sdk.Context, traderAddr sdk.AccAddress, denom string,
+) (positions []types.Position, err error) {
+	for _, pool := range k.VpoolKeeper.GetAllPools(ctx) {
+		if pool.Pair.QuoteDenom() != denom {
+			continue
+		}
+		position, err := k.GetPosition(ctx, pool.Pair, traderAddr)
+		if errors.Is(err, collections.ErrNotFound) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		positions = append(positions, position)
+	}
+	return positions, nil
+}
+
+// hasOpenPositions returns true if the trader has a position in any pair.
+func (k Keeper) hasOpenPositions(ctx sdk.Context, traderAddr sdk.AccAddress) (bool, error) {
+	for _, pool := range k.VpoolKeeper.GetAllPools(ctx) {
+		_, err := k.GetPosition(ctx, pool.Pair, traderAddr)
+		if errors.Is(err, collections.ErrNotFound) {
+			continue
+		} else if err != nil {
+			return false, err
+		}
+		return true, nil
+	}
+	return false, nil
+}
+
+// CrossMarginSummary aggregates the positions of a cross margin account that
+// are quoted in the same denom.
+type CrossMarginSummary struct {
+	// Collateral: collateral of the account not allocated to any position.
+	Collateral sdk.Dec
+
+	// Equity: the collateral plus the margin of every position after funding
+	// payments and unrealized PnL.
+	Equity sdk.Dec
+
+	// PositionNotional: the sum of the position notional values.
+	PositionNotional sdk.Dec
+
+	// UnrealizedPnl: the sum of the unrealized PnL of the positions.
+	UnrealizedPnl sdk.Dec
+
+	// MaintenanceMarginRequirement: the sum of the position notional values
+	// weighted by the maintenance margin ratio of their vpool.
+	MaintenanceMarginRequirement sdk.Dec
+}
+
+// MarginRatio is the account equity over the total position notional.
+func (s CrossMarginSummary) MarginRatio() (sdk.Dec, error) {
+	if s.PositionNotional.IsZero() {
+		// NOTE causes division by zero in margin ratio calculation
+		return sdk.Dec{}, fmt.Errorf("margin ratio doesn't make sense with zero position notional")
+	}
+	return s.Equity.Quo(s.PositionNotional), nil
+}
+
+// MaintenanceMarginRatio is the notional-weighted maintenance margin ratio of the positions.
+func (s CrossMarginSummary) MaintenanceMarginRatio() (sdk.Dec, error) {
+	if s.PositionNotional.IsZero() {
+		return sdk.Dec{}, fmt.Errorf("margin ratio doesn't make sense with zero position notional")
+	}
+	return s.MaintenanceMarginRequirement.Quo(s.PositionNotional), nil
+}
+
+// Leverage is the total position notional over the account equity, or zero if
+// the account has no equity left.
+func (s CrossMarginSummary) Leverage() sdk.Dec {
+	if !s.Equity.IsPositive() {
+		return sdk.ZeroDec()
+	}
+	return s.PositionNotional.Quo(s.Equity)
+}
+
+// GetCrossMarginSummary aggregates the positions of the trader's cross margin
+// account that are quoted in denom, pricing them with the given price option.
+func (k Keeper) GetCrossMarginSummary(
+	ctx sdk.Context, account types.CrossMarginAccount, denom string, priceOption types.MarginCalculationPriceOption,
+) (summary CrossMarginSummary, err error) {
+	traderAddr, err := sdk.AccAddressFromBech32(account.TraderAddress)
+	if err != nil {
+		return CrossMarginSummary{}, err
+	}
+
+	positions, err := k.crossMarginPositions(ctx, traderAddr, denom)
+	if err != nil {
+		return CrossMarginSummary{}, err
+	}
+
+	summary = CrossMarginSummary{
+		Collateral:                   account.Collateral.AmountOf(denom).ToDec(),
+		Equity:                       account.Collateral.AmountOf(denom).ToDec(),
+		PositionNotional:             sdk.ZeroDec(),
+		UnrealizedPnl:                sdk.ZeroDec(),
+		MaintenanceMarginRequirement: sdk.ZeroDec(),
+	}
+	for _, position := range positions {
+		positionNotional, unrealizedPnL, err := k.getMarginCalculationNotionalAndUnrealizedPnL(ctx, position, priceOption)
+		if err != nil {
+			return CrossMarginSummary{}, err
+		}
+
+		remaining, err := k.CalcRemainMarginWithFundingPayment(ctx, position, unrealizedPnL)
+		if err != nil {
+			return CrossMarginSummary{}, err
+		}
+
+		summary.Equity = summary.Equity.Add(remaining.Margin).Sub(remaining.BadDebt)
+		summary.PositionNotional = summary.PositionNotional.Add(positionNotional)
+		summary.UnrealizedPnl = summary.UnrealizedPnl.Add(unrealizedPnL)
+		summary.MaintenanceMarginRequirement = summary.MaintenanceMarginRequirement.Add(
+			positionNotional.Mul(k.VpoolKeeper.GetMaintenanceMarginRatio(ctx, position.Pair)))
+	}
+
+	return summary, nil
+}
+
+// GetCrossMarginRatio returns the account-wide margin ratio of the trader's
+// positions quoted in denom and the maintenance margin ratio it must stay above.
+func (k Keeper) GetCrossMarginRatio(
+	ctx sdk.Context, traderAddr sdk.AccAddress, denom string, priceOption types.MarginCalculationPriceOption,
+) (marginRatio sdk.Dec, maintenanceMarginRatio sdk.Dec, err error) {
+	account, err := k.GetCrossMarginAccount(ctx, traderAddr)
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, err
+	}
+
+	summary, err := k.GetCrossMarginSummary(ctx, account, denom, priceOption)
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, err
+	}
+
+	if marginRatio, err = summary.MarginRatio(); err != nil {
+		return sdk.Dec{}, sdk.Dec{}, err
+	}
+	if maintenanceMarginRatio, err = summary.MaintenanceMarginRatio(); err != nil {
+		return sdk.Dec{}, sdk.Dec{}, err
+	}
+	return marginRatio, maintenanceMarginRatio, nil
+}
+
+/*
+calcCrossMarginFreeCollateral computes the amount of collateral that can be
+withdrawn from a cross margin account without putting it below the maintenance
+margin requirement. Like calcFreeCollateral, unrealized profits don't count
+towards the free collateral while unrealized losses do. The pending funding
+payments are taken into account the same way: those owed by the positions
+count as losses, those owed to them are profits which only count once they are
+paid into the margin at the next update of the position.
+
+Args:
+- ctx: Carries information about the current state of the SDK application.
+- account: the cross margin account.
+- denom: the denom of the collateral.
+
+Returns:
+- freeCollateral: Amount of collateral that can be withdrawn, capped at the
+account collateral.
+- err: error
+*/
+func (k Keeper) calcCrossMarginFreeCollateral(
+	ctx sdk.Context, account types.CrossMarginAccount, denom string,
+) (freeCollateral sdk.Dec, err error) {
+	traderAddr, err := sdk.AccAddressFromBech32(account.TraderAddress)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	positions, err := k.crossMarginPositions(ctx, traderAddr, denom)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	collateral := account.Collateral.AmountOf(denom).ToDec()
+	freeCollateral = collateral
+	for _, pos := range positions {
+		positionNotional, unrealizedPnL, err := k.GetPreferencePositionNotionalAndUnrealizedPnL(
+			ctx,
+			pos,
+			types.PnLPreferenceOption_MIN,
+		)
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		latestCumulativePremiumFraction, err := k.getLatestCumulativePremiumFraction(ctx, pos.Pair)
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		// the pending funding payment comes out of the margin at the next update of the position
+		fundingPayment := latestCumulativePremiumFraction.Sub(pos.LatestCumulativePremiumFraction).Mul(pos.Size_)
+		remainingMargin := sdk.MinDec(pos.Margin, pos.Margin.Sub(fundingPayment).Add(unrealizedPnL))
+		maintenanceMarginRequirement := positionNotional.Mul(k.VpoolKeeper.GetMaintenanceMarginRatio(ctx, pos.Pair))
+		freeCollateral = freeCollateral.Add(remainingMargin).Sub(maintenanceMarginRequirement)
+	}
+
+	return sdk.MinDec(freeCollateral, collateral), nil
+}
@@ -0,0 +1,322 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/collections"
+	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/perp/types"
+	"github.com/NibiruChain/nibiru/x/testutil"
+)
+
+func TestDepositAndWithdrawCrossMargin(t *testing.T) {
+	nibiruApp, ctx, traderAddr := initOrdersTest(t, sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 1_000)))
+	perpKeeper := nibiruApp.PerpKeeper
+	vaultAddr := nibiruApp.AccountKeeper.GetModuleAddress(types.VaultModuleAccount)
+
+	t.Log("deposit opens the account")
+	account, err := perpKeeper.DepositCrossMargin(ctx, traderAddr, sdk.NewInt64Coin(common.DenomNUSD, 1_000))
+	require.NoError(t, err)
+	assert.EqualValues(t, sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 1_000)), account.Collateral)
+	assert.True(t, nibiruApp.BankKeeper.GetBalance(ctx, traderAddr, common.DenomNUSD).IsZero())
+	assert.EqualValues(t, sdk.NewInt64Coin(common.DenomNUSD, 1_000), nibiruApp.BankKeeper.GetBalance(ctx, vaultAddr, common.DenomNUSD))
+	testutil.RequireHasTypedEvent(t, ctx, &types.CrossMarginCollateralChangedEvent{
+		TraderAddress:    traderAddr.String(),
+		Collateral:       account.Collateral,
+		CollateralChange: sdk.NewInt(1_000),
+		Denom:            common.DenomNUSD,
+		BlockHeight:      ctx.BlockHeight(),
+		BlockTimeMs:      ctx.BlockTime().UnixMilli(),
+	})
+
+	t.Log("cannot withdraw more than the collateral")
+	_, err = perpKeeper.WithdrawCrossMargin(ctx, traderAddr, sdk.NewInt64Coin(common.DenomNUSD, 1_001))
+	require.ErrorIs(t, err, types.ErrNotEnoughCrossMargin)
+
+	t.Log("partial withdrawal")
+	account, err = perpKeeper.WithdrawCrossMargin(ctx, traderAddr, sdk.NewInt64Coin(common.DenomNUSD, 400))
+	require.NoError(t, err)
+	assert.EqualValues(t, sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 600)), account.Collateral)
+	assert.EqualValues(t, sdk.NewInt64Coin(common.DenomNUSD, 400), nibiruApp.BankKeeper.GetBalance(ctx, traderAddr, common.DenomNUSD))
+
+	t.Log("withdrawing everything closes the account")
+	_, err = perpKeeper.WithdrawCrossMargin(ctx, traderAddr, sdk.NewInt64Coin(common.DenomNUSD, 600))
+	require.NoError(t, err)
+	_, err = perpKeeper.CrossMarginAccounts.Get(ctx, traderAddr)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+	assert.EqualValues(t, sdk.NewInt64Coin(common.DenomNUSD, 1_000), nibiruApp.BankKeeper.GetBalance(ctx, traderAddr, common.DenomNUSD))
+}
+
+func TestWithdrawCrossMarginFreeCollateral(t *testing.T) {
+	nibiruApp, ctx, traderAddr := initOrdersTest(t, sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 1_000)))
+	perpKeeper := nibiruApp.PerpKeeper
+
+	_, err := perpKeeper.DepositCrossMargin(ctx, traderAddr, sdk.NewInt64Coin(common.DenomNUSD, 1_000))
+	require.NoError(t, err)
+
+	// the position alone is below the maintenance margin ratio of 0.0625
+	setPosition(perpKeeper, ctx, types.Position{
+		TraderAddress:                   traderAddr.String(),
+		Pair:                            common.Pair_BTC_NUSD,
+		Size_:                           sdk.NewDec(10_000),
+		Margin:                          sdk.NewDec(100),
+		OpenNotional:                    sdk.NewDec(10_000),
+		LatestCumulativePremiumFraction: sdk.ZeroDec(),
+	})
+
+	// freeCollateral = collateral + margin + unrealizedPnl - notional * mmr
+	//                = 1000 + 100 - 0.0001 - 625 = 474.9999
+	_, err = perpKeeper.WithdrawCrossMargin(ctx, traderAddr, sdk.NewInt64Coin(common.DenomNUSD, 475))
+	require.ErrorIs(t, err, types.ErrNotEnoughCrossMargin)
+
+	account, err := perpKeeper.WithdrawCrossMargin(ctx, traderAddr, sdk.NewInt64Coin(common.DenomNUSD, 474))
+	require.NoError(t, err)
+	assert.EqualValues(t, sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 526)), account.Collateral)
+}
+
+func TestWithdrawCrossMarginPendingFunding(t *testing.T) {
+	testCases := []struct {
+		name string
+		// the change of the cumulative premium fraction since the position was opened
+		cumulativePremiumFraction sdk.Dec
+		// the largest withdrawal allowed
+		freeCollateral int64
+	}{
+		{
+			name:                      "funding owed by the position is a loss",
+			cumulativePremiumFraction: sdk.MustNewDecFromStr("0.02"),
+			// freeCollateral = collateral + margin - fundingPayment + unrealizedPnl - notional * mmr
+			//                = 1000 + 100 - 200 - 0.0001 - 625 = 274.9999
+			freeCollateral: 274,
+		},
+		{
+			name:                      "funding owed to the position doesn't count until it is paid",
+			cumulativePremiumFraction: sdk.MustNewDecFromStr("-0.02"),
+			// freeCollateral = collateral + min(margin, margin - fundingPayment + unrealizedPnl) - notional * mmr
+			//                = 1000 + min(100, 100 + 200 - 0.0001) - 625 = 475
+			freeCollateral: 475,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			nibiruApp, ctx, traderAddr := initOrdersTest(t, sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 1_000)))
+			perpKeeper := nibiruApp.PerpKeeper
+
+			_, err := perpKeeper.DepositCrossMargin(ctx, traderAddr, sdk.NewInt64Coin(common.DenomNUSD, 1_000))
+			require.NoError(t, err)
+
+			setPairMetadata(perpKeeper, ctx, types.PairMetadata{
+				Pair:                            common.Pair_BTC_NUSD,
+				LatestCumulativePremiumFraction: tc.cumulativePremiumFraction,
+			})
+			setPosition(perpKeeper, ctx, types.Position{
+				TraderAddress:                   traderAddr.String(),
+				Pair:                            common.Pair_BTC_NUSD,
+				Size_:                           sdk.NewDec(10_000),
+				Margin:                          sdk.NewDec(100),
+				OpenNotional:                    sdk.NewDec(10_000),
+				LatestCumulativePremiumFraction: sdk.ZeroDec(),
+			})
+
+			_, err = perpKeeper.WithdrawCrossMargin(ctx, traderAddr, sdk.NewInt64Coin(common.DenomNUSD, tc.freeCollateral+1))
+			require.ErrorIs(t, err, types.ErrNotEnoughCrossMargin)
+
+			account, err := perpKeeper.WithdrawCrossMargin(ctx, traderAddr, sdk.NewInt64Coin(common.DenomNUSD, tc.freeCollateral))
+			require.NoError(t, err)
+			assert.EqualValues(t, sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 1_000-tc.freeCollateral)), account.Collateral)
+		})
+	}
+}
+
+func TestCrossMarginAddAndRemoveMargin(t *testing.T) {
+	nibiruApp, ctx, traderAddr := initOrdersTest(t, sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 1_000)))
+	perpKeeper := nibiruApp.PerpKeeper
+
+	_, err := perpKeeper.DepositCrossMargin(ctx, traderAddr, sdk.NewInt64Coin(common.DenomNUSD, 900))
+	require.NoError(t, err)
+	setPosition(perpKeeper, ctx, types.Position{
+		TraderAddress:                   traderAddr.String(),
+		Pair:                            common.Pair_BTC_NUSD,
+		Size_:                           sdk.NewDec(1_000),
+		Margin:                          sdk.NewDec(100),
+		OpenNotional:                    sdk.NewDec(1_000),
+		LatestCumulativePremiumFraction: sdk.ZeroDec(),
+	})
+	getCollateral := func() sdk.Coins {
+		account, err := perpKeeper.CrossMarginAccounts.Get(ctx, traderAddr)
+		require.NoError(t, err)
+		return account.Collateral
+	}
+
+	t.Log("the added margin comes from the account collateral")
+	_, err = perpKeeper.AddMargin(ctx, common.Pair_BTC_NUSD, traderAddr, sdk.NewInt64Coin(common.DenomNUSD, 50))
+	require.NoError(t, err)
+	assert.EqualValues(t, sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 850)), getCollateral())
+	assert.EqualValues(t, sdk.NewInt64Coin(common.DenomNUSD, 100), nibiruApp.BankKeeper.GetBalance(ctx, traderAddr, common.DenomNUSD))
+
+	t.Log("the removed margin goes back to the account collateral, past the isolated free collateral")
+	_, _, position, err := perpKeeper.RemoveMargin(ctx, common.Pair_BTC_NUSD, traderAddr, sdk.NewInt64Coin(common.DenomNUSD, 140))
+	require.NoError(t, err)
+	assert.EqualValues(t, sdk.NewDec(10), position.Margin)
+	assert.EqualValues(t, sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 990)), getCollateral())
+	assert.EqualValues(t, sdk.NewInt64Coin(common.DenomNUSD, 100), nibiruApp.BankKeeper.GetBalance(ctx, traderAddr, common.DenomNUSD))
+
+	t.Log("the collateral is only withdrawn within the account-wide free collateral")
+	// freeCollateral = collateral + margin + unrealizedPnl - notional * mmr
+	//                = 990 + 10 - 0.000001 - 62.5 = 937.499999
+	_, err = perpKeeper.WithdrawCrossMargin(ctx, traderAddr, sdk.NewInt64Coin(common.DenomNUSD, 938))
+	require.ErrorIs(t, err, types.ErrNotEnoughCrossMargin)
+}
+
+func TestWithdrawCrossMarginKeepsAccountWithOpenPositions(t *testing.T) {
+	nibiruApp, ctx, traderAddr := initOrdersTest(t, sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 1_000)))
+	perpKeeper := nibiruApp.PerpKeeper
+
+	_, err := perpKeeper.DepositCrossMargin(ctx, traderAddr, sdk.NewInt64Coin(common.DenomNUSD, 1_000))
+	require.NoError(t, err)
+
+	// the position alone covers its maintenance margin requirement
+	setPosition(perpKeeper, ctx, types.Position{
+		TraderAddress:                   traderAddr.String(),
+		Pair:                            common.Pair_BTC_NUSD,
+		Size_:                           sdk.NewDec(1_000),
+		Margin:                          sdk.NewDec(1_000),
+		OpenNotional:                    sdk.NewDec(1_000),
+		LatestCumulativePremiumFraction: sdk.ZeroDec(),
+	})
+
+	t.Log("withdrawing everything keeps the account while the position is open")
+	account, err := perpKeeper.WithdrawCrossMargin(ctx, traderAddr, sdk.NewInt64Coin(common.DenomNUSD, 1_000))
+	require.NoError(t, err)
+	assert.True(t, account.Collateral.IsZero())
+	_, err = perpKeeper.CrossMarginAccounts.Get(ctx, traderAddr)
+	require.NoError(t, err)
+}
+
+func TestCrossMarginPositions(t *testing.T) {
+	testCases := []struct {
+		name        string
+		collateral  int64
+		traderFunds int64
+
+		expectedCollateralAfterOpen sdk.Coins
+	}{
+		{
+			name:        "margin drawn from the collateral",
+			collateral:  1_000,
+			traderFunds: 1_000 + 4, // fees to open and close
+			expectedCollateralAfterOpen: sdk.NewCoins(
+				sdk.NewInt64Coin(common.DenomNUSD, 900)),
+		},
+		{
+			name:        "collateral shortfall taken from the wallet",
+			collateral:  50,
+			traderFunds: 50 + 50 + 4,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			nibiruApp, ctx, traderAddr := initOrdersTest(t, sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, tc.traderFunds)))
+			perpKeeper := nibiruApp.PerpKeeper
+
+			_, err := perpKeeper.DepositCrossMargin(ctx, traderAddr, sdk.NewInt64Coin(common.DenomNUSD, tc.collateral))
+			require.NoError(t, err)
+
+			_, err = perpKeeper.OpenPosition(ctx, common.Pair_BTC_NUSD, types.Side_BUY, traderAddr,
+				sdk.NewInt(100), sdk.NewDec(10), sdk.ZeroDec())
+			require.NoError(t, err)
+
+			account, err := perpKeeper.CrossMarginAccounts.Get(ctx, traderAddr)
+			require.NoError(t, err)
+			assert.EqualValues(t, tc.expectedCollateralAfterOpen, account.Collateral)
+			assert.EqualValues(t, sdk.NewInt64Coin(common.DenomNUSD, 2), nibiruApp.BankKeeper.GetBalance(ctx, traderAddr, common.DenomNUSD))
+
+			t.Log("closing the position credits the margin back to the collateral")
+			_, err = perpKeeper.ClosePosition(ctx, common.Pair_BTC_NUSD, traderAddr)
+			require.NoError(t, err)
+
+			account, err = perpKeeper.CrossMarginAccounts.Get(ctx, traderAddr)
+			require.NoError(t, err)
+			assert.EqualValues(t, account.Collateral.AmountOf(common.DenomNUSD), tc.expectedCollateralAfterOpen.AmountOf(common.DenomNUSD).AddRaw(100))
+			assert.True(t, nibiruApp.BankKeeper.GetBalance(ctx, traderAddr, common.DenomNUSD).IsZero())
+		})
+	}
+}
+
+func TestLiquidateCrossMargin(t *testing.T) {
+	testCases := []struct {
+		name       string
+		collateral int64
+
+		expectedErr        error
+		expectedCollateral sdk.Coins
+	}{
+		{
+			name:        "collateral keeps the account above the maintenance margin ratio",
+			collateral:  1_000,
+			expectedErr: types.ErrMarginHighEnough,
+		},
+		{
+			name:       "account below the maintenance margin ratio",
+			collateral: 100,
+			// the liquidation fee of 125 exceeds the margin of 100 minus a
+			// small unrealized loss, the collateral pays for the resulting bad
+			// debt of 25.0001 rounded up
+			expectedCollateral: sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 74)),
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			nibiruApp, ctx, traderAddr := initOrdersTest(t, sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, tc.collateral)))
+			perpKeeper := nibiruApp.PerpKeeper
+			liquidatorAddr := testutil.AccAddress()
+			setLiquidator(ctx, perpKeeper, liquidatorAddr.String())
+			oracle := testutil.AccAddress()
+			nibiruApp.PricefeedKeeper.WhitelistOracles(ctx, []sdk.AccAddress{oracle})
+			require.NoError(t, nibiruApp.PricefeedKeeper.PostRawPrice(ctx, oracle, common.Pair_BTC_NUSD.String(), sdk.OneDec(), time.Now().Add(time.Hour)))
+			require.NoError(t, nibiruApp.PricefeedKeeper.GatherRawPrices(ctx, common.DenomBTC, common.DenomNUSD))
+
+			_, err := perpKeeper.DepositCrossMargin(ctx, traderAddr, sdk.NewInt64Coin(common.DenomNUSD, tc.collateral))
+			require.NoError(t, err)
+
+			// the position alone is below the maintenance margin ratio of 0.0625
+			setPosition(perpKeeper, ctx, types.Position{
+				TraderAddress:                   traderAddr.String(),
+				Pair:                            common.Pair_BTC_NUSD,
+				Size_:                           sdk.NewDec(10_000),
+				Margin:                          sdk.NewDec(100),
+				OpenNotional:                    sdk.NewDec(10_000),
+				LatestCumulativePremiumFraction: sdk.ZeroDec(),
+			})
+			require.NoError(t, simapp.FundModuleAccount(nibiruApp.BankKeeper, ctx, types.VaultModuleAccount,
+				sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 100))))
+
+			feeToLiquidator, _, err := perpKeeper.Liquidate(ctx, liquidatorAddr, common.Pair_BTC_NUSD, traderAddr)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.EqualValues(t, sdk.NewInt64Coin(common.DenomNUSD, 125), feeToLiquidator)
+
+			account, err := perpKeeper.CrossMarginAccounts.Get(ctx, traderAddr)
+			require.NoError(t, err)
+			assert.EqualValues(t, tc.expectedCollateral, account.Collateral)
+
+			_, err = perpKeeper.Positions.Get(ctx, collections.Join(common.Pair_BTC_NUSD, traderAddr))
+			require.ErrorIs(t, err, collections.ErrNotFound)
+		})
+	}
+}
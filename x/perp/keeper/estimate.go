@@ -0,0 +1,209 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/perp/types"
+)
+
+/*
+EstimateOpenPosition simulates OpenPosition without changing state, by trading
+on a cached context that is never written. The trader doesn't need to hold the
+margin and fees.
+
+args:
+  - ctx: cosmos-sdk context
+  - pair: the pair where the position would be opened
+  - side: whether the position is in the BUY or SELL direction
+  - traderAddr: the address of the trader
+  - quoteAssetAmount: the amount of quote asset
+  - leverage: the amount of leverage to take
+  - baseAmtLimit: the limit on the base asset amount
+
+ret:
+  - estimate: the outcome of the trade
+  - err: error if the trade would fail
+*/
+func (k Keeper) EstimateOpenPosition(
+	ctx sdk.Context,
+	pair common.AssetPair,
+	side types.Side,
+	traderAddr sdk.AccAddress,
+	quoteAssetAmount sdk.Int,
+	leverage sdk.Dec,
+	baseAmtLimit sdk.Dec,
+) (estimate *types.QueryEstimateOpenPositionResponse, err error) {
+	if err = k.checkOpenPositionRequirements(ctx, pair, side, traderAddr, quoteAssetAmount, leverage); err != nil {
+		return nil, err
+	}
+
+	markPrice, err := k.VpoolKeeper.GetMarkPrice(ctx, pair)
+	if err != nil {
+		return nil, err
+	}
+
+	cachedCtx, _ := ctx.CacheContext()
+	positionResp, _, err := k.tradePosition(cachedCtx, pair, side, traderAddr, quoteAssetAmount, leverage, baseAmtLimit)
+	if err != nil {
+		return nil, err
+	}
+	if !positionResp.BadDebt.IsZero() {
+		return nil, fmt.Errorf("bad debt must be zero to prevent attacker from leveraging it")
+	}
+
+	denom := pair.QuoteDenom()
+	feeToFeePool, feeToEcosystemFund, feeToInsuranceFund := k.calcFees(ctx, pair, traderAddr, positionResp.ExchangedNotionalValue)
+	estimate = &types.QueryEstimateOpenPositionResponse{
+		Position:               *positionResp.Position,
+		ExchangedPositionSize:  positionResp.ExchangedPositionSize,
+		ExchangedNotionalValue: positionResp.ExchangedNotionalValue,
+		PriceImpact:            priceImpact(markPrice, positionResp.ExchangedNotionalValue, positionResp.ExchangedPositionSize),
+		MarginToVault:          positionResp.MarginToVault,
+		RealizedPnl:            positionResp.RealizedPnl,
+		FeeToFeePool:           sdk.NewCoin(denom, feeToFeePool),
+		FeeToEcosystemFund:     sdk.NewCoin(denom, feeToEcosystemFund),
+		FeeToInsuranceFund:     sdk.NewCoin(denom, feeToInsuranceFund),
+		MarginRatio:            sdk.ZeroDec(),
+		LiquidationPrice:       sdk.ZeroDec(),
+		BlockNumber:            ctx.BlockHeight(),
+	}
+
+	if !positionResp.Position.Size_.IsZero() {
+		k.SetPosition(cachedCtx, *positionResp.Position)
+		if estimate.MarginRatio, err = k.getMarginCheckRatio(cachedCtx, *positionResp.Position); err != nil {
+			return nil, err
+		}
+		estimate.LiquidationPrice = LiquidationPrice(
+			*positionResp.Position, k.VpoolKeeper.GetMaintenanceMarginRatio(ctx, pair))
+	}
+
+	return estimate, nil
+}
+
+/*
+EstimateClosePosition simulates ClosePosition without changing state, by
+closing the position on a cached context that is never written.
+
+args:
+  - ctx: cosmos-sdk context
+  - pair: the pair of the position
+  - traderAddr: the address of the trader
+
+ret:
+  - estimate: the outcome of the trade
+  - err: error if the position cannot be closed
+*/
+func (k Keeper) EstimateClosePosition(
+	ctx sdk.Context, pair common.AssetPair, traderAddr sdk.AccAddress,
+) (estimate *types.QueryEstimateClosePositionResponse, err error) {
+	if err = k.requireVpool(ctx, pair); err != nil {
+		return nil, err
+	}
+
+	position, err := k.GetPosition(ctx, pair, traderAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	markPrice, err := k.VpoolKeeper.GetMarkPrice(ctx, pair)
+	if err != nil {
+		return nil, err
+	}
+
+	cachedCtx, _ := ctx.CacheContext()
+	positionResp, err := k.closePositionEntirely(
+		cachedCtx,
+		position,
+		/* quoteAssetAmountLimit */ sdk.ZeroDec(),
+		/* skipFluctuationLimitCheck */ false,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if positionResp.BadDebt.IsPositive() {
+		return nil, fmt.Errorf("underwater position")
+	}
+
+	denom := pair.QuoteDenom()
+	feeToFeePool, feeToEcosystemFund, feeToInsuranceFund := k.calcFees(ctx, pair, traderAddr, positionResp.ExchangedNotionalValue)
+	return &types.QueryEstimateClosePositionResponse{
+		ExchangedPositionSize:  positionResp.ExchangedPositionSize,
+		ExchangedNotionalValue: positionResp.ExchangedNotionalValue,
+		PriceImpact:            priceImpact(markPrice, positionResp.ExchangedNotionalValue, positionResp.ExchangedPositionSize),
+		RealizedPnl:            positionResp.RealizedPnl,
+		FundingPayment:         positionResp.FundingPayment,
+		MarginToTrader:         sdk.NewCoin(denom, positionResp.MarginToVault.Neg().RoundInt()),
+		FeeToFeePool:           sdk.NewCoin(denom, feeToFeePool),
+		FeeToEcosystemFund:     sdk.NewCoin(denom, feeToEcosystemFund),
+		FeeToInsuranceFund:     sdk.NewCoin(denom, feeToInsuranceFund),
+		BlockNumber:            ctx.BlockHeight(),
+	}, nil
+}
+
+/*
+EstimateRemoveMargin simulates RemoveMargin without changing state, by removing
+the margin on a cached context that is never written.
+
+args:
+  - ctx: cosmos-sdk context
+  - pair: the pair of the position
+  - traderAddr: the address of the trader
+  - margin: the amount of margin to remove
+
+ret:
+  - estimate: the position after the margin is removed
+  - err: error if the margin cannot be removed
+*/
+func (k Keeper) EstimateRemoveMargin(
+	ctx sdk.Context, pair common.AssetPair, traderAddr sdk.AccAddress, margin sdk.Coin,
+) (estimate *types.QueryEstimateRemoveMarginResponse, err error) {
+	cachedCtx, _ := ctx.CacheContext()
+	_, fundingPayment, position, err := k.RemoveMargin(cachedCtx, pair, traderAddr, margin)
+	if err != nil {
+		return nil, err
+	}
+
+	marginRatio, err := k.getMarginCheckRatio(cachedCtx, position)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryEstimateRemoveMarginResponse{
+		Position:         position,
+		FundingPayment:   fundingPayment,
+		MarginRatio:      marginRatio,
+		LiquidationPrice: LiquidationPrice(position, k.VpoolKeeper.GetMaintenanceMarginRatio(ctx, pair)),
+		BlockNumber:      ctx.BlockHeight(),
+	}, nil
+}
+
+/*
+LiquidationPrice returns the mark price at which the margin ratio of a position
+reaches the maintenance margin ratio, ignoring slippage and funding payments.
+
+  - long: (openNotional - margin) / (size * (1 - mmr))
+  - short: (openNotional + margin) / (|size| * (1 + mmr))
+*/
+func LiquidationPrice(position types.Position, maintenanceMarginRatio sdk.Dec) sdk.Dec {
+	if position.Size_.IsZero() {
+		return sdk.ZeroDec()
+	}
+	if position.Size_.IsPositive() {
+		return sdk.MaxDec(position.OpenNotional.Sub(position.Margin), sdk.ZeroDec()).
+			Quo(position.Size_.Mul(sdk.OneDec().Sub(maintenanceMarginRatio)))
+	}
+	return position.OpenNotional.Add(position.Margin).
+		Quo(position.Size_.Abs().Mul(sdk.OneDec().Add(maintenanceMarginRatio)))
+}
+
+// priceImpact returns the relative difference between the average execution
+// price of a trade and the mark price before it.
+func priceImpact(markPrice sdk.Dec, exchangedNotional sdk.Dec, exchangedSize sdk.Dec) sdk.Dec {
+	if exchangedSize.IsZero() || markPrice.IsZero() {
+		return sdk.ZeroDec()
+	}
+	return exchangedNotional.Quo(exchangedSize.Abs()).Sub(markPrice).Quo(markPrice)
+}
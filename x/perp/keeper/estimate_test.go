@@ -0,0 +1,121 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/collections"
+	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/perp/keeper"
+	"github.com/NibiruChain/nibiru/x/perp/types"
+)
+
+func TestEstimateOpenPosition(t *testing.T) {
+	// the trader doesn't need funds to estimate
+	nibiruApp, ctx, traderAddr := initOrdersTest(t, sdk.NewCoins())
+	querier := keeper.NewQuerier(nibiruApp.PerpKeeper)
+	poolBefore, err := nibiruApp.VpoolKeeper.Pools.Get(ctx, common.Pair_BTC_NUSD)
+	require.NoError(t, err)
+
+	resp, err := querier.EstimateOpenPosition(sdk.WrapSDKContext(ctx), &types.QueryEstimateOpenPositionRequest{
+		TokenPair:            common.Pair_BTC_NUSD.String(),
+		Trader:               traderAddr.String(),
+		Side:                 types.Side_BUY,
+		QuoteAssetAmount:     sdk.NewInt(1_000),
+		Leverage:             sdk.NewDec(10),
+		BaseAssetAmountLimit: sdk.ZeroInt(),
+	})
+	require.NoError(t, err)
+
+	assert.EqualValues(t, sdk.NewDec(10_000), resp.ExchangedNotionalValue)
+	assert.InDelta(t, 10_000, resp.ExchangedPositionSize.MustFloat64(), 0.001)
+	assert.EqualValues(t, resp.ExchangedPositionSize, resp.Position.Size_)
+	assert.True(t, resp.PriceImpact.IsPositive())
+	assert.InDelta(t, 0, resp.PriceImpact.MustFloat64(), 0.000001)
+	assert.EqualValues(t, sdk.NewDec(1_000), resp.MarginToVault)
+	assert.EqualValues(t, sdk.NewInt64Coin(common.DenomNUSD, 10), resp.FeeToFeePool)
+	assert.EqualValues(t, sdk.NewInt64Coin(common.DenomNUSD, 5), resp.FeeToEcosystemFund)
+	assert.EqualValues(t, sdk.NewInt64Coin(common.DenomNUSD, 5), resp.FeeToInsuranceFund)
+	assert.InDelta(t, 0.1, resp.MarginRatio.MustFloat64(), 0.0001)
+	// (10_000 - 1_000) / (10_000 * (1 - 0.0625))
+	assert.InDelta(t, 0.96, resp.LiquidationPrice.MustFloat64(), 0.0001)
+
+	t.Log("the state is unchanged")
+	_, err = nibiruApp.PerpKeeper.Positions.Get(ctx, collections.Join(common.Pair_BTC_NUSD, traderAddr))
+	require.ErrorIs(t, err, collections.ErrNotFound)
+	poolAfter, err := nibiruApp.VpoolKeeper.Pools.Get(ctx, common.Pair_BTC_NUSD)
+	require.NoError(t, err)
+	assert.EqualValues(t, poolBefore, poolAfter)
+
+	t.Log("the leverage is checked")
+	_, err = querier.EstimateOpenPosition(sdk.WrapSDKContext(ctx), &types.QueryEstimateOpenPositionRequest{
+		TokenPair:            common.Pair_BTC_NUSD.String(),
+		Trader:               traderAddr.String(),
+		Side:                 types.Side_BUY,
+		QuoteAssetAmount:     sdk.NewInt(1_000),
+		Leverage:             sdk.NewDec(20),
+		BaseAssetAmountLimit: sdk.ZeroInt(),
+	})
+	require.ErrorIs(t, err, types.ErrLeverageIsTooHigh)
+}
+
+func TestEstimateCloseAndRemoveMargin(t *testing.T) {
+	nibiruApp, ctx, traderAddr := initOrdersTest(t, sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 1_020)))
+	querier := keeper.NewQuerier(nibiruApp.PerpKeeper)
+
+	_, err := nibiruApp.PerpKeeper.OpenPosition(ctx, common.Pair_BTC_NUSD, types.Side_BUY, traderAddr,
+		sdk.NewInt(1_000), sdk.NewDec(10), sdk.ZeroDec())
+	require.NoError(t, err)
+	position, err := nibiruApp.PerpKeeper.Positions.Get(ctx, collections.Join(common.Pair_BTC_NUSD, traderAddr))
+	require.NoError(t, err)
+
+	t.Log("estimate closing the position")
+	closeResp, err := querier.EstimateClosePosition(sdk.WrapSDKContext(ctx), &types.QueryEstimateClosePositionRequest{
+		TokenPair: common.Pair_BTC_NUSD.String(),
+		Trader:    traderAddr.String(),
+	})
+	require.NoError(t, err)
+	assert.EqualValues(t, position.Size_.Neg(), closeResp.ExchangedPositionSize)
+	assert.InDelta(t, 10_000, closeResp.ExchangedNotionalValue.MustFloat64(), 0.001)
+	assert.True(t, closeResp.PriceImpact.IsNegative())
+	assert.EqualValues(t, sdk.NewInt64Coin(common.DenomNUSD, 1_000), closeResp.MarginToTrader)
+	assert.EqualValues(t, sdk.NewInt64Coin(common.DenomNUSD, 10), closeResp.FeeToFeePool)
+
+	t.Log("estimate removing margin")
+	marginResp, err := querier.EstimateRemoveMargin(sdk.WrapSDKContext(ctx), &types.QueryEstimateRemoveMarginRequest{
+		TokenPair: common.Pair_BTC_NUSD.String(),
+		Trader:    traderAddr.String(),
+		Margin:    sdk.NewInt64Coin(common.DenomNUSD, 100),
+	})
+	require.NoError(t, err)
+	assert.EqualValues(t, sdk.NewDec(900), marginResp.Position.Margin)
+	assert.InDelta(t, 0.09, marginResp.MarginRatio.MustFloat64(), 0.0001)
+	// (10_000 - 900) / (10_000 * (1 - 0.0625))
+	assert.InDelta(t, 0.97066, marginResp.LiquidationPrice.MustFloat64(), 0.0001)
+
+	t.Log("the state is unchanged")
+	positionAfter, err := nibiruApp.PerpKeeper.Positions.Get(ctx, collections.Join(common.Pair_BTC_NUSD, traderAddr))
+	require.NoError(t, err)
+	assert.EqualValues(t, position, positionAfter)
+	assert.True(t, nibiruApp.BankKeeper.GetBalance(ctx, traderAddr, common.DenomNUSD).IsZero())
+}
+
+func TestLiquidationPrice(t *testing.T) {
+	mmr := sdk.MustNewDecFromStr("0.0625")
+
+	// long: (1_000 - 100) / (100 * 0.9375)
+	assert.EqualValues(t, sdk.NewDec(96).QuoInt64(10), keeper.LiquidationPrice(types.Position{
+		Size_: sdk.NewDec(100), Margin: sdk.NewDec(100), OpenNotional: sdk.NewDec(1_000),
+	}, mmr))
+	// short: (1_000 + 100) / (100 * 1.0625)
+	assert.EqualValues(t, sdk.NewDec(1_100).Quo(sdk.MustNewDecFromStr("106.25")), keeper.LiquidationPrice(types.Position{
+		Size_: sdk.NewDec(-100), Margin: sdk.NewDec(100), OpenNotional: sdk.NewDec(1_000),
+	}, mmr))
+	// a long with more margin than open notional cannot be liquidated
+	assert.EqualValues(t, sdk.ZeroDec(), keeper.LiquidationPrice(types.Position{
+		Size_: sdk.NewDec(100), Margin: sdk.NewDec(1_000), OpenNotional: sdk.NewDec(500),
+	}, mmr))
+}
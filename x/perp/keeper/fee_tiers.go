@@ -0,0 +1,125 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/collections"
+	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/perp/types"
+)
+
+// feeTierVolumeWindowDays is the number of days, today included, the volume
+// of a trader is summed over to find its fee tier.
+const feeTierVolumeWindowDays = 30
+
+// volumeDay returns the number of days since the unix epoch at the block time.
+func volumeDay(ctx sdk.Context) uint64 {
+	return uint64(ctx.BlockTime().Unix() / int64(24*time.Hour/time.Second))
+}
+
+// GetTraderVolume returns the notional traded by a trader over the rolling
+// 30-day window, today included.
+func (k Keeper) GetTraderVolume(ctx sdk.Context, traderAddr sdk.AccAddress) sdk.Dec {
+	rng := collections.PairRange[sdk.AccAddress, uint64]{}.Prefix(traderAddr)
+	if today := volumeDay(ctx); today >= feeTierVolumeWindowDays {
+		rng = rng.StartInclusive(today - feeTierVolumeWindowDays + 1)
+	}
+
+	volume := sdk.ZeroDec()
+	iter := k.TraderVolumes.Iterate(ctx, rng)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		volume = volume.Add(iter.Value())
+	}
+	return volume
+}
+
+// recordTraderVolume adds a traded notional to today's volume of the trader
+// and prunes the days that left the rolling window.
+func (k Keeper) recordTraderVolume(ctx sdk.Context, traderAddr sdk.AccAddress, notional sdk.Dec) {
+	today := volumeDay(ctx)
+	key := collections.Join(traderAddr, today)
+	k.TraderVolumes.Insert(ctx, key, k.TraderVolumes.GetOr(ctx, key, sdk.ZeroDec()).Add(notional.Abs()))
+
+	if today < feeTierVolumeWindowDays {
+		return
+	}
+	expired := k.TraderVolumes.Iterate(ctx, collections.PairRange[sdk.AccAddress, uint64]{}.
+		Prefix(traderAddr).
+		EndExclusive(today-feeTierVolumeWindowDays+1),
+	).Keys()
+	for _, expiredKey := range expired {
+		_ = k.TraderVolumes.Delete(ctx, expiredKey)
+	}
+}
+
+/*
+GetFeeTier returns the fee tier of a trader from its rolling 30-day volume.
+
+args:
+  - ctx: cosmos-sdk context
+  - traderAddr: the trader
+
+ret:
+  - tier: the tier of the trader, 1 for the first tier of the params, zero when
+    the volume is below the first tier
+  - volume: the rolling 30-day volume of the trader
+  - feeRatios: the fee ratios of the tier
+*/
+func (k Keeper) GetFeeTier(
+	ctx sdk.Context, traderAddr sdk.AccAddress,
+) (tier uint32, volume sdk.Dec, feeRatios types.PairFeeRatios) {
+	params := k.GetParams(ctx)
+	volume = k.GetTraderVolume(ctx, traderAddr)
+	feeRatios = types.PairFeeRatios{
+		FeePoolFeeRatio:       params.FeePoolFeeRatio,
+		EcosystemFundFeeRatio: params.EcosystemFundFeeRatio,
+	}
+
+	// the tiers are ordered by increasing min volume
+	for i, feeTier := range params.FeeTiers {
+		if volume.LT(feeTier.MinVolume) {
+			break
+		}
+		tier, feeRatios = uint32(i+1), feeTier.FeeRatios
+	}
+	return tier, volume, feeRatios
+}
+
+// getFeeRatios returns the fee ratios a trader pays on a pair: the override of
+// the pair if it has one, otherwise the ones of the trader's fee tier.
+func (k Keeper) getFeeRatios(
+	ctx sdk.Context, pair common.AssetPair, traderAddr sdk.AccAddress,
+) (feeRatios types.PairFeeRatios, pairOverride bool) {
+	if metadata, err := k.PairsMetadata.Get(ctx, pair); err == nil && metadata.FeeRatios != nil {
+		return *metadata.FeeRatios, true
+	}
+
+	_, _, feeRatios = k.GetFeeTier(ctx, traderAddr)
+	return feeRatios, false
+}
+
+/*
+SetPairFeeRatios overrides the fee ratios of a pair, or removes the override
+when feeRatios is nil.
+
+args:
+  - ctx: cosmos-sdk context
+  - pair: the pair
+  - feeRatios: the fee ratios of the pair, nil to use the ones of the params
+
+ret:
+  - err: error if the pair has no metadata
+*/
+func (k Keeper) SetPairFeeRatios(ctx sdk.Context, pair common.AssetPair, feeRatios *types.PairFeeRatios) error {
+	metadata, err := k.PairsMetadata.Get(ctx, pair)
+	if err != nil {
+		return types.ErrPairMetadataNotFound.Wrap(pair.String())
+	}
+
+	metadata.FeeRatios = feeRatios
+	k.PairsMetadata.Insert(ctx, pair, metadata)
+	return nil
+}
@@ -0,0 +1,99 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/collections"
+	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/perp/keeper"
+	"github.com/NibiruChain/nibiru/x/perp/types"
+)
+
+func TestFeeTiers(t *testing.T) {
+	nibiruApp, ctx, traderAddr := initOrdersTest(t, sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 100_000)))
+	perpKeeper := nibiruApp.PerpKeeper
+	querier := keeper.NewQuerier(perpKeeper)
+
+	params := perpKeeper.GetParams(ctx)
+	params.FeeTiers = []types.FeeTier{
+		{
+			MinVolume: sdk.NewDec(10_000),
+			FeeRatios: types.PairFeeRatios{
+				FeePoolFeeRatio:       sdk.MustNewDecFromStr("0.0005"),
+				EcosystemFundFeeRatio: sdk.MustNewDecFromStr("0.0005"),
+			},
+		},
+		{
+			MinVolume: sdk.NewDec(50_000),
+			FeeRatios: types.PairFeeRatios{
+				FeePoolFeeRatio:       sdk.ZeroDec(),
+				EcosystemFundFeeRatio: sdk.MustNewDecFromStr("0.0002"),
+			},
+		},
+	}
+	perpKeeper.SetParams(ctx, params)
+
+	// openPosition returns the fees paid to open a position with a leverage of 10
+	openPosition := func(quoteAmount int64) sdk.Int {
+		balanceBefore := nibiruApp.BankKeeper.GetBalance(ctx, traderAddr, common.DenomNUSD).Amount
+		_, err := perpKeeper.OpenPosition(ctx, common.Pair_BTC_NUSD, types.Side_BUY, traderAddr,
+			sdk.NewInt(quoteAmount), sdk.NewDec(10), sdk.ZeroDec())
+		require.NoError(t, err)
+		balanceAfter := nibiruApp.BankKeeper.GetBalance(ctx, traderAddr, common.DenomNUSD).Amount
+		return balanceBefore.Sub(balanceAfter).SubRaw(quoteAmount)
+	}
+
+	t.Log("the first trade pays the fees of the params")
+	// fee pool 10 bps + ecosystem fund 10 bps of 10_000
+	assert.EqualValues(t, sdk.NewInt(20), openPosition(1_000))
+
+	tier, volume, _ := perpKeeper.GetFeeTier(ctx, traderAddr)
+	assert.EqualValues(t, 1, tier)
+	assert.EqualValues(t, sdk.NewDec(10_000), volume)
+
+	t.Log("the second trade pays the fees of the first tier")
+	assert.EqualValues(t, sdk.NewInt(40), openPosition(4_000))
+
+	t.Log("the third trade pays the fees of the second tier")
+	assert.EqualValues(t, sdk.NewInt(2), openPosition(1_000))
+
+	resp, err := querier.QueryTraderFeeTier(sdk.WrapSDKContext(ctx), &types.QueryTraderFeeTierRequest{
+		Trader: traderAddr.String(),
+	})
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, resp.Tier)
+	assert.EqualValues(t, sdk.NewDec(60_000), resp.Volume)
+	assert.EqualValues(t, params.FeeTiers[1].FeeRatios, resp.FeeRatios)
+	assert.False(t, resp.PairOverride)
+
+	t.Log("the override of the pair takes precedence over the tiers")
+	override := types.PairFeeRatios{
+		FeePoolFeeRatio:       sdk.MustNewDecFromStr("0.002"),
+		EcosystemFundFeeRatio: sdk.ZeroDec(),
+	}
+	require.NoError(t, perpKeeper.SetPairFeeRatios(ctx, common.Pair_BTC_NUSD, &override))
+	resp, err = querier.QueryTraderFeeTier(sdk.WrapSDKContext(ctx), &types.QueryTraderFeeTierRequest{
+		Trader:    traderAddr.String(),
+		TokenPair: common.Pair_BTC_NUSD.String(),
+	})
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, resp.Tier)
+	assert.EqualValues(t, override, resp.FeeRatios)
+	assert.True(t, resp.PairOverride)
+	assert.EqualValues(t, sdk.NewInt(20), openPosition(1_000))
+	require.NoError(t, perpKeeper.SetPairFeeRatios(ctx, common.Pair_BTC_NUSD, nil))
+
+	t.Log("the volume leaves the rolling window after 30 days")
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(30 * 24 * time.Hour))
+	tier, volume, _ = perpKeeper.GetFeeTier(ctx, traderAddr)
+	assert.EqualValues(t, 0, tier)
+	assert.True(t, volume.IsZero())
+	assert.EqualValues(t, sdk.NewInt(20), openPosition(1_000))
+	// the expired days are pruned
+	assert.Len(t, perpKeeper.TraderVolumes.Iterate(ctx, collections.PairRange[sdk.AccAddress, uint64]{}).Keys(), 1)
+}
@@ -0,0 +1,147 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/collections"
+	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/perp/types"
+)
+
+// recordFundingRate saves the funding rate of an epoch, caches its cumulative
+// premium fraction on the pair metadata and prunes the epochs that fell out of
+// the funding rate history.
+func (k Keeper) recordFundingRate(ctx sdk.Context, pairMetadata types.PairMetadata, fundingRate types.FundingRate) {
+	k.FundingRates.Insert(ctx, collections.Join(fundingRate.Pair, fundingRate.Epoch), fundingRate)
+
+	pairMetadata.LatestCumulativePremiumFraction = fundingRate.CumulativePremiumFraction
+	k.PairsMetadata.Insert(ctx, pairMetadata.Pair, pairMetadata)
+
+	historyLength := k.GetParams(ctx).FundingRateHistoryLength
+	if historyLength == 0 || fundingRate.Epoch < historyLength {
+		return
+	}
+	expired := k.FundingRates.Iterate(ctx, collections.PairRange[common.AssetPair, uint64]{}.
+		Prefix(fundingRate.Pair).
+		EndExclusive(fundingRate.Epoch-historyLength+1),
+	).Keys()
+	for _, expiredKey := range expired {
+		_ = k.FundingRates.Delete(ctx, expiredKey)
+	}
+}
+
+// getLatestFundingRate returns the funding rate of the latest funding epoch of a pair.
+func (k Keeper) getLatestFundingRate(ctx sdk.Context, pair common.AssetPair) (fundingRate types.FundingRate, found bool) {
+	iter := k.FundingRates.Iterate(ctx, collections.PairRange[common.AssetPair, uint64]{}.Prefix(pair).Descending())
+	defer iter.Close()
+	if !iter.Valid() {
+		return types.FundingRate{}, false
+	}
+	return iter.Value(), true
+}
+
+/*
+clampPremiumFraction smooths the premium fraction of a pair with an exponential
+moving average over its previous premium fractions, then caps the funding rate
+it implies at the max funding rate of the params.
+
+args:
+  - ctx: cosmos-sdk context
+  - params: the perp params
+  - pair: the pair
+  - rawPremiumFraction: the premium fraction computed from the mark and index prices
+  - indexPrice: the index price the funding rate is relative to
+
+ret:
+  - premiumFraction: the premium fraction to charge
+  - reason: why the premium fraction differs from the raw one, if it does
+*/
+func (k Keeper) clampPremiumFraction(
+	ctx sdk.Context, params types.Params, pair common.AssetPair, rawPremiumFraction sdk.Dec, indexPrice sdk.Dec,
+) (premiumFraction sdk.Dec, reason types.FundingRateClampReason) {
+	premiumFraction, reason = rawPremiumFraction, types.FundingRateClampReason_NOT_CLAMPED
+
+	if smoothingFactor := params.PremiumSmoothingFactor; smoothingFactor.IsPositive() {
+		if previous, found := k.getLatestFundingRate(ctx, pair); found {
+			smoothed := rawPremiumFraction.Mul(sdk.OneDec().Sub(smoothingFactor)).
+				Add(previous.PremiumFraction.Mul(smoothingFactor))
+			if !smoothed.Equal(premiumFraction) {
+				premiumFraction, reason = smoothed, types.FundingRateClampReason_PREMIUM_SMOOTHING
+			}
+		}
+	}
+
+	if params.MaxFundingRate.IsPositive() {
+		maxPremiumFraction := params.MaxFundingRate.Mul(indexPrice)
+		if premiumFraction.Abs().GT(maxPremiumFraction) {
+			if premiumFraction.IsNegative() {
+				maxPremiumFraction = maxPremiumFraction.Neg()
+			}
+			premiumFraction, reason = maxPremiumFraction, types.FundingRateClampReason_MAX_FUNDING_RATE
+		}
+	}
+
+	return premiumFraction, reason
+}
+
+/*
+MigratePairMetadata moves the cumulative premium fractions of a pair metadata
+in the old genesis layout, where the funding history was a list on the pair
+metadata, to FundingRate records.
+
+The list doesn't record the epochs of its entries. Its last entry was recorded
+at the end of the last epoch of the funding rate interval, the one before the
+current epoch, so the entries are numbered backwards from it. This way the
+funding rate of the next epoch follows them without overwriting or pruning
+them. Entries which would be numbered before the first epoch are dropped, the
+latest cumulative premium fraction stays on the pair metadata. The entries have
+no block height or time, and their mark and index prices are unknown and set
+to zero.
+
+The params and the epochs must be initialized before the migration.
+
+args:
+  - ctx: cosmos-sdk context
+  - pairMetadata: the pair metadata, in either genesis layout
+
+ret:
+  - migrated: the pair metadata with its latest cumulative premium fraction
+    set and without the deprecated list
+*/
+func (k Keeper) MigratePairMetadata(ctx sdk.Context, pairMetadata types.PairMetadata) (migrated types.PairMetadata) {
+	cumulativePremiumFractions := pairMetadata.CumulativePremiumFractions
+	pairMetadata.CumulativePremiumFractions = nil
+	if len(cumulativePremiumFractions) == 0 {
+		return pairMetadata
+	}
+
+	var lastEpoch uint64
+	if epochInfo := k.EpochKeeper.GetEpochInfo(ctx, k.GetParams(ctx).FundingRateInterval); epochInfo.CurrentEpoch > 0 {
+		lastEpoch = epochInfo.CurrentEpoch - 1
+	}
+
+	previous := sdk.ZeroDec()
+	for i, cumulativePremiumFraction := range cumulativePremiumFractions {
+		premiumFraction := cumulativePremiumFraction.Sub(previous)
+		previous = cumulativePremiumFraction
+
+		entriesAfter := uint64(len(cumulativePremiumFractions) - 1 - i)
+		if entriesAfter >= lastEpoch {
+			continue
+		}
+		epoch := lastEpoch - entriesAfter
+		k.FundingRates.Insert(ctx, collections.Join(pairMetadata.Pair, epoch), types.FundingRate{
+			Pair:                      pairMetadata.Pair,
+			Epoch:                     epoch,
+			PremiumFraction:           premiumFraction,
+			CumulativePremiumFraction: cumulativePremiumFraction,
+			MarkPrice:                 sdk.ZeroDec(),
+			IndexPrice:                sdk.ZeroDec(),
+		})
+	}
+
+	if pairMetadata.LatestCumulativePremiumFraction.IsNil() {
+		pairMetadata.LatestCumulativePremiumFraction = previous
+	}
+	return pairMetadata
+}
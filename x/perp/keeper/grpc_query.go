@@ -5,7 +5,9 @@ import (
 
 	"github.com/NibiruChain/nibiru/collections"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -73,7 +75,7 @@ func (q queryServer) QueryPosition(
 }
 
 func (q queryServer) position(ctx sdk.Context, pair common.AssetPair, trader sdk.AccAddress) (*types.QueryPositionResponse, error) {
-	position, err := q.k.Positions.Get(ctx, collections.Join(pair, trader))
+	position, err := q.k.GetPosition(ctx, pair, trader)
 	if err != nil {
 		return nil, err
 	}
@@ -129,23 +131,639 @@ func (q queryServer) FundingRates(
 		return nil, status.Errorf(codes.InvalidArgument, "invalid pair: %s", req.Pair)
 	}
 
-	pairMetadata, err := q.k.PairsMetadata.Get(ctx, assetPair)
-	if err != nil {
+	if _, err = q.k.PairsMetadata.Get(ctx, assetPair); err != nil {
 		return nil, status.Errorf(codes.NotFound, "could not find pair: %s", req.Pair)
 	}
+	if req.EndTimeMs != 0 && req.EndTimeMs <= req.StartTimeMs {
+		return nil, status.Error(codes.InvalidArgument, "end time must be after start time")
+	}
 
-	var fundingRates []sdk.Dec
-
-	// truncate to most recent 48 funding payments
+	// without pagination, return the most recent 48 funding payments
 	// given 30 minute funding rate calculations, this should give the last 24 hours of funding payments
-	numFundingRates := len(pairMetadata.CumulativePremiumFractions)
-	if numFundingRates >= 48 {
-		fundingRates = pairMetadata.CumulativePremiumFractions[numFundingRates-48 : numFundingRates]
-	} else {
-		fundingRates = pairMetadata.CumulativePremiumFractions
+	pageReq := req.Pagination
+	if pageReq == nil {
+		pageReq = &query.PageRequest{Limit: 48, Reverse: true}
+	}
+
+	store := prefix.NewStore(
+		ctx.KVStore(q.k.storeKey),
+		append(fundingRatesNamespace.Prefix(), common.AssetPairKeyEncoder.Encode(assetPair)...),
+	)
+
+	var fundingRates []types.FundingRate
+	pageResp, err := query.FilteredPaginate(store, pageReq, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		var fundingRate types.FundingRate
+		if err := q.k.cdc.Unmarshal(value, &fundingRate); err != nil {
+			return false, err
+		}
+		if fundingRate.BlockTimeMs < req.StartTimeMs ||
+			(req.EndTimeMs != 0 && fundingRate.BlockTimeMs >= req.EndTimeMs) {
+			return false, nil
+		}
+		if accumulate {
+			fundingRates = append(fundingRates, fundingRate)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// the funding rates of a page are returned oldest first, in either direction
+	if pageReq.Reverse {
+		for i, j := 0, len(fundingRates)-1; i < j; i, j = i+1, j-1 {
+			fundingRates[i], fundingRates[j] = fundingRates[j], fundingRates[i]
+		}
+	}
+
+	cumulativeFundingRates := make([]sdk.Dec, len(fundingRates))
+	for i, fundingRate := range fundingRates {
+		cumulativeFundingRates[i] = fundingRate.CumulativePremiumFraction
 	}
 
 	return &types.QueryFundingRatesResponse{
-		CumulativeFundingRates: fundingRates,
+		CumulativeFundingRates: cumulativeFundingRates,
+		FundingRates:           fundingRates,
+		Pagination:             pageResp,
+	}, nil
+}
+
+func (q queryServer) QueryOrders(
+	goCtx context.Context, req *types.QueryOrdersRequest,
+) (*types.QueryOrdersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	traderAddr, err := sdk.AccAddressFromBech32(req.Trader)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid trader: %s", req.Trader)
+	}
+
+	var pair common.AssetPair
+	if req.Pair != "" {
+		pair, err = common.NewAssetPair(req.Pair)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid pair: %s", req.Pair)
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	orders := []types.Order{}
+	for _, id := range q.k.Orders.Indexes.Trader.ExactMatch(ctx, traderAddr).PrimaryKeys() {
+		order, err := q.k.Orders.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if req.Pair != "" && !order.Pair.Equal(pair) {
+			continue
+		}
+		orders = append(orders, order)
+	}
+
+	return &types.QueryOrdersResponse{Orders: orders}, nil
+}
+
+func (q queryServer) QueryCrossMarginAccount(
+	goCtx context.Context, req *types.QueryCrossMarginAccountRequest,
+) (*types.QueryCrossMarginAccountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	traderAddr, err := sdk.AccAddressFromBech32(req.Trader)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid trader: %s", req.Trader)
+	}
+	if err = sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid denom: %s", req.Denom)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	account, err := q.k.GetCrossMarginAccount(ctx, traderAddr)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "no cross margin account for trader: %s", req.Trader)
+	}
+
+	summary, err := q.k.GetCrossMarginSummary(ctx, account, req.Denom, types.MarginCalculationPriceOption_SPOT)
+	if err != nil {
+		return nil, err
+	}
+	freeCollateral, err := q.k.calcCrossMarginFreeCollateral(ctx, account, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &types.QueryCrossMarginAccountResponse{
+		Account:                account,
+		PositionNotional:       summary.PositionNotional,
+		UnrealizedPnl:          summary.UnrealizedPnl,
+		MarginRatioMark:        sdk.ZeroDec(),
+		MarginRatioIndex:       sdk.ZeroDec(),
+		MaintenanceMarginRatio: sdk.ZeroDec(),
+		FreeCollateral:         freeCollateral,
+		Leverage:               summary.Leverage(),
+		BlockNumber:            ctx.BlockHeight(),
+	}
+	if summary.PositionNotional.IsZero() {
+		// margin ratios are undefined without open positions
+		return resp, nil
+	}
+
+	if resp.MaintenanceMarginRatio, err = summary.MaintenanceMarginRatio(); err != nil {
+		return nil, err
+	}
+	if resp.MarginRatioMark, _, err = q.k.GetCrossMarginRatio(
+		ctx, traderAddr, req.Denom, types.MarginCalculationPriceOption_MAX_PNL); err != nil {
+		return nil, err
+	}
+	if resp.MarginRatioIndex, _, err = q.k.GetCrossMarginRatio(
+		ctx, traderAddr, req.Denom, types.MarginCalculationPriceOption_INDEX); err != nil {
+		// The index portion of the query fails silently as not to distrupt all
+		// account queries when oracles aren't posting prices.
+		q.k.Logger(ctx).Error(err.Error())
+		resp.MarginRatioIndex = sdk.Dec{}
+	}
+
+	return resp, nil
+}
+
+func (q queryServer) QueryInsuranceFund(
+	goCtx context.Context, req *types.QueryInsuranceFundRequest,
+) (*types.QueryInsuranceFundResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid denom: %s", req.Denom)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	balance := q.k.BankKeeper.GetBalance(
+		ctx, q.k.AccountKeeper.GetModuleAddress(types.InsuranceFundModuleAccount), req.Denom)
+
+	totalOpenNotional := sdk.ZeroDec()
+	for _, openInterest := range q.k.OpenInterests.Iterate(ctx, collections.Range[common.AssetPair]{}).Values() {
+		if openInterest.Pair.QuoteDenom() == req.Denom {
+			totalOpenNotional = totalOpenNotional.Add(openInterest.LongOpenNotional).Add(openInterest.ShortOpenNotional)
+		}
+	}
+
+	coverageRatio := sdk.ZeroDec()
+	if totalOpenNotional.IsPositive() {
+		coverageRatio = balance.Amount.ToDec().Quo(totalOpenNotional)
+	}
+
+	return &types.QueryInsuranceFundResponse{
+		InsuranceFund:     q.k.GetInsuranceFund(ctx, req.Denom),
+		Balance:           balance,
+		TotalOpenNotional: totalOpenNotional,
+		CoverageRatio:     coverageRatio,
+		BlockNumber:       ctx.BlockHeight(),
+	}, nil
+}
+
+func (q queryServer) QueryShortfalls(
+	goCtx context.Context, req *types.QueryShortfallsRequest,
+) (*types.QueryShortfallsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid denom: %s", req.Denom)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(
+		ctx.KVStore(q.k.storeKey),
+		append(shortfallsNamespace.Prefix(), collections.StringKeyEncoder.Encode(req.Denom)...),
+	)
+
+	var shortfalls []types.Shortfall
+	pageResp, err := query.Paginate(store, req.Pagination, func(_ []byte, value []byte) error {
+		var shortfall types.Shortfall
+		if err := q.k.cdc.Unmarshal(value, &shortfall); err != nil {
+			return err
+		}
+		shortfalls = append(shortfalls, shortfall)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryShortfallsResponse{
+		Shortfalls: shortfalls,
+		Pagination: pageResp,
+	}, nil
+}
+
+func (q queryServer) QueryADLRank(
+	goCtx context.Context, req *types.QueryADLRankRequest,
+) (*types.QueryADLRankResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	traderAddr, err := sdk.AccAddressFromBech32(req.Trader)
+	if err != nil {
+		return nil, err
+	}
+	pair, err := common.NewAssetPair(req.TokenPair)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	position, err := q.k.GetPosition(ctx, pair, traderAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	queue, err := q.k.GetADLQueue(ctx, pair, position.Size_.IsPositive())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &types.QueryADLRankResponse{
+		QueueLength:   uint64(len(queue)),
+		Score:         sdk.ZeroDec(),
+		UnrealizedPnl: sdk.ZeroDec(),
+		Leverage:      sdk.ZeroDec(),
+		BlockNumber:   ctx.BlockHeight(),
+	}
+	for idx, candidate := range queue {
+		if candidate.Position.TraderAddress == position.TraderAddress {
+			resp.Rank = uint64(idx + 1)
+			resp.Score = candidate.Score
+			resp.UnrealizedPnl = candidate.UnrealizedPnl
+			resp.Leverage = candidate.Leverage
+			break
+		}
+	}
+
+	return resp, nil
+}
+
+func (q queryServer) QueryLiquidatablePositions(
+	goCtx context.Context, req *types.QueryLiquidatablePositionsRequest,
+) (*types.QueryLiquidatablePositionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	pair, err := common.NewAssetPair(req.TokenPair)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err = q.k.requireVpool(ctx, pair); err != nil {
+		return nil, err
+	}
+
+	store := prefix.NewStore(
+		ctx.KVStore(q.k.storeKey),
+		append(positionsNamespace.Prefix(), common.AssetPairKeyEncoder.Encode(pair)...),
+	)
+
+	var positions []types.LiquidatablePosition
+	onResult := func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		var position types.Position
+		if err := q.k.cdc.Unmarshal(value, &position); err != nil {
+			return false, err
+		}
+		position = q.k.withSocializedLosses(ctx, position)
+		if position.Size_.IsZero() {
+			return false, nil
+		}
+		// a position locked by a liquidation auction cannot be liquidated
+		traderAddr, err := sdk.AccAddressFromBech32(position.TraderAddress)
+		if err != nil {
+			return false, err
+		}
+		if q.k.requireNotInAuction(ctx, pair, traderAddr) != nil {
+			return false, nil
+		}
+
+		check, err := q.k.checkLiquidation(ctx, position)
+		if err != nil {
+			return false, err
+		}
+		if !check.isLiquidatable {
+			return false, nil
+		}
+
+		if accumulate {
+			marginRatioMaxPnl, err := q.k.getLiquidationMarginRatio(ctx, position, types.MarginCalculationPriceOption_MAX_PNL)
+			if err != nil {
+				return false, err
+			}
+			marginRatioIndex, err := q.k.getLiquidationMarginRatio(ctx, position, types.MarginCalculationPriceOption_INDEX)
+			if err != nil {
+				// The index portion of the query fails silently as not to distrupt
+				// the query when oracles aren't posting prices.
+				q.k.Logger(ctx).Error(err.Error())
+				marginRatioIndex = sdk.Dec{}
+			}
+
+			positions = append(positions, types.LiquidatablePosition{
+				Position:               position,
+				MarginRatioSpot:        check.marginRatioSpot,
+				MarginRatioIndex:       marginRatioIndex,
+				MarginRatioMaxPnl:      marginRatioMaxPnl,
+				MaintenanceMarginRatio: check.maintenanceMarginRatio,
+				FullLiquidation:        check.isFullLiquidation,
+			})
+		}
+		return true, nil
+	}
+	pageResp, err := query.FilteredPaginate(store, req.Pagination, onResult)
+	if err != nil {
+		return nil, err
+	}
+	// FilteredPaginate returns the key following a full page even if none of
+	// the remaining positions is liquidatable, skip ahead to the next match so
+	// that the client isn't sent to an empty page.
+	if pageResp.NextKey != nil {
+		limit := req.Pagination.GetLimit()
+		if limit == 0 {
+			limit = query.DefaultLimit
+		}
+		pageResp.NextKey, err = nextFilteredKey(store, pageResp.NextKey, req.Pagination.GetReverse(), limit, onResult)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.QueryLiquidatablePositionsResponse{
+		Positions:   positions,
+		Pagination:  pageResp,
+		BlockNumber: ctx.BlockHeight(),
+	}, nil
+}
+
+// nextFilteredKey returns the first key from start onwards, in the iteration
+// order of the page, whose entry passes the filter. Nil if there is none. At
+// most limit entries are checked, the key following them is returned if none
+// of them passes so that the next page carries on from there.
+func nextFilteredKey(
+	store sdk.KVStore, start []byte, reverse bool, limit uint64,
+	onResult func(key []byte, value []byte, accumulate bool) (bool, error),
+) ([]byte, error) {
+	var iterator sdk.Iterator
+	if reverse {
+		iterator = store.ReverseIterator(nil, sdk.InclusiveEndBytes(start))
+	} else {
+		iterator = store.Iterator(start, nil)
+	}
+	defer iterator.Close()
+
+	for checked := uint64(0); iterator.Valid() && checked < limit; iterator.Next() {
+		hit, err := onResult(iterator.Key(), iterator.Value(), false)
+		if err != nil {
+			return nil, err
+		}
+		if hit {
+			return iterator.Key(), nil
+		}
+		checked++
+	}
+	if iterator.Valid() {
+		return iterator.Key(), nil
+	}
+	return nil, nil
+}
+
+func (q queryServer) QueryLiquidationPreview(
+	goCtx context.Context, req *types.QueryLiquidationPreviewRequest,
+) (*types.QueryLiquidationPreviewResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	traderAddr, err := sdk.AccAddressFromBech32(req.Trader)
+	if err != nil {
+		return nil, err
+	}
+	pair, err := common.NewAssetPair(req.TokenPair)
+	if err != nil {
+		return nil, err
+	}
+
+	var liquidatorAddr sdk.AccAddress
+	if req.Liquidator != "" {
+		if liquidatorAddr, err = sdk.AccAddressFromBech32(req.Liquidator); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	liquidationResp, isFullLiquidation, isAuction, err := q.k.PreviewLiquidation(ctx, pair, traderAddr, liquidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	denom := pair.QuoteDenom()
+	feeToInsuranceFund, feeToPerpEF := q.k.splitLiquidationFee(ctx, liquidationResp.FeeToPerpEcosystemFund)
+	position := *liquidationResp.PositionResp.Position
+	return &types.QueryLiquidationPreviewResponse{
+		FullLiquidation:       isFullLiquidation,
+		FeeToLiquidator:       sdk.NewCoin(denom, liquidationResp.FeeToLiquidator),
+		FeeToEcosystemFund:    sdk.NewCoin(denom, feeToPerpEF),
+		FeeToInsuranceFund:    sdk.NewCoin(denom, feeToInsuranceFund),
+		BadDebt:               sdk.NewCoin(denom, liquidationResp.BadDebt),
+		ExchangedPositionSize: liquidationResp.PositionResp.ExchangedPositionSize,
+		ExchangedQuoteAmount:  liquidationResp.PositionResp.ExchangedNotionalValue,
+		Position:              position,
+		BlockNumber:           ctx.BlockHeight(),
+		Auction:               isAuction,
+	}, nil
+}
+
+func (q queryServer) QueryLiquidationAuctions(
+	goCtx context.Context, req *types.QueryLiquidationAuctionsRequest,
+) (*types.QueryLiquidationAuctionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	rng := collections.PairRange[common.AssetPair, sdk.AccAddress]{}
+	if req.TokenPair != "" {
+		pair, err := common.NewAssetPair(req.TokenPair)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid pair: %s", req.TokenPair)
+		}
+		rng = rng.Prefix(pair)
+	}
+
+	return &types.QueryLiquidationAuctionsResponse{
+		Auctions:    q.k.LiquidationAuctions.Iterate(ctx, rng).Values(),
+		BlockNumber: ctx.BlockHeight(),
+	}, nil
+}
+
+func (q queryServer) EstimateOpenPosition(
+	goCtx context.Context, req *types.QueryEstimateOpenPositionRequest,
+) (*types.QueryEstimateOpenPositionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	traderAddr, err := sdk.AccAddressFromBech32(req.Trader)
+	if err != nil {
+		return nil, err
+	}
+	pair, err := common.NewAssetPair(req.TokenPair)
+	if err != nil {
+		return nil, err
+	}
+	if req.Side == types.Side_SIDE_UNSPECIFIED {
+		return nil, status.Error(codes.InvalidArgument, "unspecified side")
+	}
+	if req.QuoteAssetAmount.IsNil() || !req.QuoteAssetAmount.IsPositive() {
+		return nil, status.Error(codes.InvalidArgument, "quote asset amount must be positive")
+	}
+	if req.Leverage.IsNil() || !req.Leverage.IsPositive() {
+		return nil, status.Error(codes.InvalidArgument, "leverage must be positive")
+	}
+	baseAmtLimit := sdk.ZeroDec()
+	if !req.BaseAssetAmountLimit.IsNil() {
+		baseAmtLimit = req.BaseAssetAmountLimit.ToDec()
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return q.k.EstimateOpenPosition(
+		ctx, pair, req.Side, traderAddr, req.QuoteAssetAmount, req.Leverage, baseAmtLimit)
+}
+
+func (q queryServer) EstimateClosePosition(
+	goCtx context.Context, req *types.QueryEstimateClosePositionRequest,
+) (*types.QueryEstimateClosePositionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	traderAddr, err := sdk.AccAddressFromBech32(req.Trader)
+	if err != nil {
+		return nil, err
+	}
+	pair, err := common.NewAssetPair(req.TokenPair)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return q.k.EstimateClosePosition(ctx, pair, traderAddr)
+}
+
+func (q queryServer) EstimateRemoveMargin(
+	goCtx context.Context, req *types.QueryEstimateRemoveMarginRequest,
+) (*types.QueryEstimateRemoveMarginResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	traderAddr, err := sdk.AccAddressFromBech32(req.Trader)
+	if err != nil {
+		return nil, err
+	}
+	pair, err := common.NewAssetPair(req.TokenPair)
+	if err != nil {
+		return nil, err
+	}
+	if req.Margin.Amount.IsNil() || req.Margin.Validate() != nil || !req.Margin.IsPositive() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid margin: %s", req.Margin)
+	}
+	if req.Margin.Denom != pair.QuoteDenom() {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"invalid margin denom %s, expected %s", req.Margin.Denom, pair.QuoteDenom())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return q.k.EstimateRemoveMargin(ctx, pair, traderAddr, req.Margin)
+}
+
+func (q queryServer) QueryOpenInterest(
+	goCtx context.Context, req *types.QueryOpenInterestRequest,
+) (*types.QueryOpenInterestResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	pair, err := common.NewAssetPair(req.TokenPair)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid pair: %s", req.TokenPair)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := q.k.requireVpool(ctx, pair); err != nil {
+		return nil, err
+	}
+
+	maxOpenInterest, maxPositionSize := q.k.getOpenInterestCaps(ctx, pair)
+	return &types.QueryOpenInterestResponse{
+		OpenInterest:    q.k.GetOpenInterest(ctx, pair),
+		MaxOpenInterest: maxOpenInterest,
+		MaxPositionSize: maxPositionSize,
+		BlockNumber:     ctx.BlockHeight(),
+	}, nil
+}
+
+func (q queryServer) QueryTraderFeeTier(
+	goCtx context.Context, req *types.QueryTraderFeeTierRequest,
+) (*types.QueryTraderFeeTierResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	traderAddr, err := sdk.AccAddressFromBech32(req.Trader)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid trader address: %s", req.Trader)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	tier, volume, feeRatios := q.k.GetFeeTier(ctx, traderAddr)
+	resp := &types.QueryTraderFeeTierResponse{
+		Volume:      volume,
+		Tier:        tier,
+		FeeRatios:   feeRatios,
+		BlockNumber: ctx.BlockHeight(),
+	}
+
+	if req.TokenPair != "" {
+		pair, err := common.NewAssetPair(req.TokenPair)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid pair: %s", req.TokenPair)
+		}
+		resp.FeeRatios, resp.PairOverride = q.k.getFeeRatios(ctx, pair, traderAddr)
+	}
+
+	return resp, nil
+}
+
+func (q queryServer) QueryMarginPricePolicy(
+	goCtx context.Context, req *types.QueryMarginPricePolicyRequest,
+) (*types.QueryMarginPricePolicyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	pair, err := common.NewAssetPair(req.TokenPair)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid pair: %s", req.TokenPair)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !q.k.VpoolKeeper.ExistsPool(ctx, pair) {
+		return nil, status.Errorf(codes.NotFound, "pair: %s", pair)
+	}
+
+	policy, pairOverride := q.k.getMarginPricePolicy(ctx, pair)
+	return &types.QueryMarginPricePolicyResponse{
+		Policy:       policy,
+		PairOverride: pairOverride,
+		BlockNumber:  ctx.BlockHeight(),
 	}, nil
 }
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"bytes"
 	"testing"
 	"time"
 
@@ -8,10 +9,13 @@ import (
 
 	"github.com/NibiruChain/nibiru/simapp"
 
+	sdkSimapp "github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/NibiruChain/nibiru/collections"
 	"github.com/NibiruChain/nibiru/x/common"
 	"github.com/NibiruChain/nibiru/x/perp/keeper"
 	"github.com/NibiruChain/nibiru/x/perp/types"
@@ -108,10 +112,8 @@ func TestQueryPosition(t *testing.T) {
 				/* maxLeverage */ sdk.MustNewDecFromStr("15"),
 			)
 			setPairMetadata(nibiruApp.PerpKeeper, ctx, types.PairMetadata{
-				Pair: common.Pair_BTC_NUSD,
-				CumulativePremiumFractions: []sdk.Dec{
-					sdk.ZeroDec(),
-				},
+				Pair:                            common.Pair_BTC_NUSD,
+				LatestCumulativePremiumFraction: sdk.ZeroDec(),
 			})
 
 			t.Log("initialize position")
@@ -196,10 +198,8 @@ func TestQueryPositions(t *testing.T) {
 				/* maxLeverage */ sdk.MustNewDecFromStr("15"),
 			)
 			setPairMetadata(nibiruApp.PerpKeeper, ctx, types.PairMetadata{
-				Pair: common.Pair_BTC_NUSD,
-				CumulativePremiumFractions: []sdk.Dec{
-					sdk.ZeroDec(),
-				},
+				Pair:                            common.Pair_BTC_NUSD,
+				LatestCumulativePremiumFraction: sdk.ZeroDec(),
 			})
 			vpoolKeeper.CreatePool(
 				ctx,
@@ -213,10 +213,8 @@ func TestQueryPositions(t *testing.T) {
 				/* maxLeverage */ sdk.MustNewDecFromStr("15"),
 			)
 			setPairMetadata(nibiruApp.PerpKeeper, ctx, types.PairMetadata{
-				Pair: common.Pair_ETH_NUSD,
-				CumulativePremiumFractions: []sdk.Dec{
-					sdk.ZeroDec(),
-				},
+				Pair:                            common.Pair_ETH_NUSD,
+				LatestCumulativePremiumFraction: sdk.ZeroDec(),
 			})
 			vpoolKeeper.CreatePool(
 				ctx,
@@ -230,10 +228,8 @@ func TestQueryPositions(t *testing.T) {
 				/* maxLeverage */ sdk.MustNewDecFromStr("15"),
 			)
 			setPairMetadata(nibiruApp.PerpKeeper, ctx, types.PairMetadata{
-				Pair: common.Pair_NIBI_NUSD,
-				CumulativePremiumFractions: []sdk.Dec{
-					sdk.ZeroDec(),
-				},
+				Pair:                            common.Pair_NIBI_NUSD,
+				LatestCumulativePremiumFraction: sdk.ZeroDec(),
 			})
 
 			t.Log("initialize position")
@@ -260,9 +256,18 @@ func TestQueryPositions(t *testing.T) {
 }
 
 func TestQueryFundingRates(t *testing.T) {
+	// cumulativePremiumFractions returns 0, 1, ..., n-1
+	cumulativePremiumFractions := func(n int64) (fractions []sdk.Dec) {
+		for i := int64(0); i < n; i++ {
+			fractions = append(fractions, sdk.NewDec(i))
+		}
+		return fractions
+	}
+
 	tests := []struct {
-		name                string
-		initialPairMetadata *types.PairMetadata
+		name string
+		// the cumulative premium fractions of epochs 1, 2, ..., ending at 1s, 2s, ...
+		initialFundingRates []sdk.Dec
 
 		query *types.QueryFundingRatesRequest
 
@@ -270,39 +275,33 @@ func TestQueryFundingRates(t *testing.T) {
 		expectedFundingRates []sdk.Dec
 	}{
 		{
-			name: "empty string pair",
-			initialPairMetadata: &types.PairMetadata{
-				Pair: common.Pair_BTC_NUSD,
-				CumulativePremiumFractions: []sdk.Dec{
-					sdk.ZeroDec(),
-				},
-			},
+			name:                "empty string pair",
+			initialFundingRates: cumulativePremiumFractions(1),
 			query: &types.QueryFundingRatesRequest{
 				Pair: "",
 			},
 			expectErr: true,
 		},
 		{
-			name: "pair metadata not found",
-			initialPairMetadata: &types.PairMetadata{
-				Pair: common.Pair_BTC_NUSD,
-				CumulativePremiumFractions: []sdk.Dec{
-					sdk.ZeroDec(),
-				},
-			},
+			name:                "pair metadata not found",
+			initialFundingRates: cumulativePremiumFractions(1),
 			query: &types.QueryFundingRatesRequest{
 				Pair: "foo:bar",
 			},
 			expectErr: true,
 		},
 		{
-			name: "returns single funding payment",
-			initialPairMetadata: &types.PairMetadata{
-				Pair: common.Pair_BTC_NUSD,
-				CumulativePremiumFractions: []sdk.Dec{
-					sdk.ZeroDec(),
-				},
+			name:                "no funding payment yet",
+			initialFundingRates: nil,
+			query: &types.QueryFundingRatesRequest{
+				Pair: common.Pair_BTC_NUSD.String(),
 			},
+			expectErr:            false,
+			expectedFundingRates: []sdk.Dec{},
+		},
+		{
+			name:                "returns single funding payment",
+			initialFundingRates: cumulativePremiumFractions(1),
 			query: &types.QueryFundingRatesRequest{
 				Pair: common.Pair_BTC_NUSD.String(),
 			},
@@ -312,115 +311,44 @@ func TestQueryFundingRates(t *testing.T) {
 			},
 		},
 		{
-			name: "truncates to 48 funding payments",
-			initialPairMetadata: &types.PairMetadata{
-				Pair: common.Pair_BTC_NUSD,
-				CumulativePremiumFractions: []sdk.Dec{
-					sdk.ZeroDec(),
-					sdk.NewDec(1),
-					sdk.NewDec(2),
-					sdk.NewDec(3),
-					sdk.NewDec(4),
-					sdk.NewDec(5),
-					sdk.NewDec(6),
-					sdk.NewDec(7),
-					sdk.NewDec(8),
-					sdk.NewDec(9),
-					sdk.NewDec(10),
-					sdk.NewDec(11),
-					sdk.NewDec(12),
-					sdk.NewDec(13),
-					sdk.NewDec(14),
-					sdk.NewDec(15),
-					sdk.NewDec(16),
-					sdk.NewDec(17),
-					sdk.NewDec(18),
-					sdk.NewDec(19),
-					sdk.NewDec(20),
-					sdk.NewDec(21),
-					sdk.NewDec(22),
-					sdk.NewDec(23),
-					sdk.NewDec(24),
-					sdk.NewDec(25),
-					sdk.NewDec(26),
-					sdk.NewDec(27),
-					sdk.NewDec(28),
-					sdk.NewDec(29),
-					sdk.NewDec(30),
-					sdk.NewDec(31),
-					sdk.NewDec(32),
-					sdk.NewDec(33),
-					sdk.NewDec(34),
-					sdk.NewDec(35),
-					sdk.NewDec(36),
-					sdk.NewDec(37),
-					sdk.NewDec(38),
-					sdk.NewDec(39),
-					sdk.NewDec(40),
-					sdk.NewDec(41),
-					sdk.NewDec(42),
-					sdk.NewDec(43),
-					sdk.NewDec(44),
-					sdk.NewDec(45),
-					sdk.NewDec(46),
-					sdk.NewDec(47),
-					sdk.NewDec(48),
-				},
-			},
+			name:                "truncates to 48 funding payments",
+			initialFundingRates: cumulativePremiumFractions(49),
 			query: &types.QueryFundingRatesRequest{
 				Pair: common.Pair_BTC_NUSD.String(),
 			},
-			expectErr: false,
-			expectedFundingRates: []sdk.Dec{
-				sdk.NewDec(1),
-				sdk.NewDec(2),
-				sdk.NewDec(3),
-				sdk.NewDec(4),
-				sdk.NewDec(5),
-				sdk.NewDec(6),
-				sdk.NewDec(7),
-				sdk.NewDec(8),
-				sdk.NewDec(9),
-				sdk.NewDec(10),
-				sdk.NewDec(11),
-				sdk.NewDec(12),
-				sdk.NewDec(13),
-				sdk.NewDec(14),
-				sdk.NewDec(15),
-				sdk.NewDec(16),
-				sdk.NewDec(17),
-				sdk.NewDec(18),
-				sdk.NewDec(19),
-				sdk.NewDec(20),
-				sdk.NewDec(21),
-				sdk.NewDec(22),
-				sdk.NewDec(23),
-				sdk.NewDec(24),
-				sdk.NewDec(25),
-				sdk.NewDec(26),
-				sdk.NewDec(27),
-				sdk.NewDec(28),
-				sdk.NewDec(29),
-				sdk.NewDec(30),
-				sdk.NewDec(31),
-				sdk.NewDec(32),
-				sdk.NewDec(33),
-				sdk.NewDec(34),
-				sdk.NewDec(35),
-				sdk.NewDec(36),
-				sdk.NewDec(37),
-				sdk.NewDec(38),
-				sdk.NewDec(39),
-				sdk.NewDec(40),
-				sdk.NewDec(41),
-				sdk.NewDec(42),
-				sdk.NewDec(43),
-				sdk.NewDec(44),
-				sdk.NewDec(45),
-				sdk.NewDec(46),
-				sdk.NewDec(47),
-				sdk.NewDec(48),
+			expectErr:            false,
+			expectedFundingRates: cumulativePremiumFractions(49)[1:],
+		},
+		{
+			name:                "paginates from the oldest funding payment",
+			initialFundingRates: cumulativePremiumFractions(49),
+			query: &types.QueryFundingRatesRequest{
+				Pair:       common.Pair_BTC_NUSD.String(),
+				Pagination: &query.PageRequest{Offset: 2, Limit: 3},
+			},
+			expectErr:            false,
+			expectedFundingRates: []sdk.Dec{sdk.NewDec(2), sdk.NewDec(3), sdk.NewDec(4)},
+		},
+		{
+			name:                "filters by time range",
+			initialFundingRates: cumulativePremiumFractions(10),
+			query: &types.QueryFundingRatesRequest{
+				Pair:        common.Pair_BTC_NUSD.String(),
+				StartTimeMs: 2_000,
+				EndTimeMs:   4_000,
+			},
+			expectErr:            false,
+			expectedFundingRates: []sdk.Dec{sdk.NewDec(1), sdk.NewDec(2)},
+		},
+		{
+			name:                "end time before start time",
+			initialFundingRates: cumulativePremiumFractions(10),
+			query: &types.QueryFundingRatesRequest{
+				Pair:        common.Pair_BTC_NUSD.String(),
+				StartTimeMs: 4_000,
+				EndTimeMs:   2_000,
 			},
+			expectErr: true,
 		},
 	}
 
@@ -431,8 +359,26 @@ func TestQueryFundingRates(t *testing.T) {
 			nibiruApp, ctx := simapp.NewTestNibiruAppAndContext(true)
 			queryServer := keeper.NewQuerier(nibiruApp.PerpKeeper)
 
-			t.Log("initialize pair metadata")
-			setPairMetadata(nibiruApp.PerpKeeper, ctx, *tc.initialPairMetadata)
+			t.Log("initialize pair metadata and funding rates")
+			latestCumulativePremiumFraction := sdk.ZeroDec()
+			for i, cumulativePremiumFraction := range tc.initialFundingRates {
+				epoch := uint64(i + 1)
+				nibiruApp.PerpKeeper.FundingRates.Insert(ctx, collections.Join(common.Pair_BTC_NUSD, epoch), types.FundingRate{
+					Pair:                      common.Pair_BTC_NUSD,
+					Epoch:                     epoch,
+					PremiumFraction:           sdk.OneDec(),
+					CumulativePremiumFraction: cumulativePremiumFraction,
+					MarkPrice:                 sdk.OneDec(),
+					IndexPrice:                sdk.OneDec(),
+					BlockHeight:               int64(epoch),
+					BlockTimeMs:               int64(epoch) * 1_000,
+				})
+				latestCumulativePremiumFraction = cumulativePremiumFraction
+			}
+			setPairMetadata(nibiruApp.PerpKeeper, ctx, types.PairMetadata{
+				Pair:                            common.Pair_BTC_NUSD,
+				LatestCumulativePremiumFraction: latestCumulativePremiumFraction,
+			})
 
 			t.Log("query funding payments")
 			resp, err := queryServer.FundingRates(sdk.WrapSDKContext(ctx), tc.query)
@@ -444,7 +390,209 @@ func TestQueryFundingRates(t *testing.T) {
 
 				t.Log("assert response")
 				assert.EqualValues(t, tc.expectedFundingRates, resp.CumulativeFundingRates)
+				require.Len(t, resp.FundingRates, len(tc.expectedFundingRates))
+				for i, fundingRate := range resp.FundingRates {
+					assert.EqualValues(t, tc.expectedFundingRates[i], fundingRate.CumulativePremiumFraction)
+				}
 			}
 		})
 	}
 }
+
+func TestQueryCrossMarginAccount(t *testing.T) {
+	nibiruApp, ctx, traderAddr := initOrdersTest(t, sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 1_000)))
+	perpKeeper := nibiruApp.PerpKeeper
+
+	_, err := perpKeeper.DepositCrossMargin(ctx, traderAddr, sdk.NewInt64Coin(common.DenomNUSD, 1_000))
+	require.NoError(t, err)
+	setPosition(perpKeeper, ctx, types.Position{
+		TraderAddress:                   traderAddr.String(),
+		Pair:                            common.Pair_BTC_NUSD,
+		Size_:                           sdk.NewDec(10_000),
+		Margin:                          sdk.NewDec(1_000),
+		OpenNotional:                    sdk.NewDec(10_000),
+		LatestCumulativePremiumFraction: sdk.ZeroDec(),
+	})
+
+	queryServer := keeper.NewQuerier(perpKeeper)
+	resp, err := queryServer.QueryCrossMarginAccount(sdk.WrapSDKContext(ctx), &types.QueryCrossMarginAccountRequest{
+		Trader: traderAddr.String(),
+		Denom:  common.DenomNUSD,
+	})
+	require.NoError(t, err)
+
+	assert.EqualValues(t, sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 1_000)), resp.Account.Collateral)
+	assert.InDelta(t, 10_000, resp.PositionNotional.MustFloat64(), 1e-3)
+	// (collateral + margin) / notional = 2_000 / 10_000
+	assert.InDelta(t, 0.2, resp.MarginRatioMark.MustFloat64(), 1e-6)
+	assert.EqualValues(t, sdk.MustNewDecFromStr("0.0625"), resp.MaintenanceMarginRatio)
+	// capped at the collateral
+	assert.EqualValues(t, sdk.NewDec(1_000), resp.FreeCollateral)
+	assert.InDelta(t, 5, resp.Leverage.MustFloat64(), 1e-6)
+
+	t.Log("unknown account")
+	_, err = queryServer.QueryCrossMarginAccount(sdk.WrapSDKContext(ctx), &types.QueryCrossMarginAccountRequest{
+		Trader: testutil.AccAddress().String(),
+		Denom:  common.DenomNUSD,
+	})
+	require.Error(t, err)
+}
+
+func TestQueryLiquidatablePositions(t *testing.T) {
+	// the mark price of the pool is 1, the maintenance margin ratio 0.0625
+	nibiruApp, ctx, _ := initOrdersTest(t, sdk.NewCoins())
+	querier := keeper.NewQuerier(nibiruApp.PerpKeeper)
+	postIndexPrice(t, nibiruApp, ctx, sdk.OneDec())
+
+	// margin ratio (100 - 1_000) / 1_000 = -0.9
+	bankruptTrader := testutil.AccAddress()
+	// margin ratio (80 - 50) / 1_000 = 0.03
+	underwaterTrader := testutil.AccAddress()
+	// margin ratio 100 / 1_000 = 0.1
+	healthyTrader := testutil.AccAddress()
+	setPositions := func(positions ...types.Position) {
+		for _, position := range positions {
+			position.Pair = common.Pair_BTC_NUSD
+			position.Size_ = sdk.NewDec(1_000)
+			position.LatestCumulativePremiumFraction = sdk.ZeroDec()
+			setPosition(nibiruApp.PerpKeeper, ctx, position)
+		}
+	}
+	setPositions(
+		types.Position{TraderAddress: bankruptTrader.String(), Margin: sdk.NewDec(100), OpenNotional: sdk.NewDec(2_000)},
+		types.Position{TraderAddress: underwaterTrader.String(), Margin: sdk.NewDec(80), OpenNotional: sdk.NewDec(1_050)},
+		types.Position{TraderAddress: healthyTrader.String(), Margin: sdk.NewDec(100), OpenNotional: sdk.NewDec(1_000)},
+	)
+
+	t.Log("query the liquidatable positions one page at a time")
+	var liquidatable []types.LiquidatablePosition
+	var nextKey []byte
+	for {
+		resp, err := querier.QueryLiquidatablePositions(sdk.WrapSDKContext(ctx), &types.QueryLiquidatablePositionsRequest{
+			TokenPair:  common.Pair_BTC_NUSD.String(),
+			Pagination: &query.PageRequest{Key: nextKey, Limit: 1},
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Positions, 1)
+		liquidatable = append(liquidatable, resp.Positions...)
+		if nextKey = resp.Pagination.NextKey; nextKey == nil {
+			break
+		}
+	}
+	require.Len(t, liquidatable, 2)
+
+	t.Log("the last page isn't followed by an empty page when the trailing positions aren't liquidatable")
+	trailingTrader := sdk.AccAddress(bytes.Repeat([]byte{0xff}, 20))
+	setPositions(types.Position{TraderAddress: trailingTrader.String(), Margin: sdk.NewDec(100), OpenNotional: sdk.NewDec(1_000)})
+	resp, err := querier.QueryLiquidatablePositions(sdk.WrapSDKContext(ctx), &types.QueryLiquidatablePositionsRequest{
+		TokenPair:  common.Pair_BTC_NUSD.String(),
+		Pagination: &query.PageRequest{Limit: 2},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Positions, 2)
+	assert.Nil(t, resp.Pagination.NextKey)
+
+	byTrader := map[string]types.LiquidatablePosition{}
+	for _, position := range liquidatable {
+		byTrader[position.Position.TraderAddress] = position
+	}
+	assert.NotContains(t, byTrader, healthyTrader.String())
+
+	bankrupt := byTrader[bankruptTrader.String()]
+	assert.True(t, bankrupt.FullLiquidation)
+	assert.EqualValues(t, sdk.MustNewDecFromStr("0.0625"), bankrupt.MaintenanceMarginRatio)
+	assert.InDelta(t, -0.9, bankrupt.MarginRatioSpot.MustFloat64(), 0.0001)
+	assert.InDelta(t, -0.9, bankrupt.MarginRatioMaxPnl.MustFloat64(), 0.0001)
+	assert.InDelta(t, -0.9, bankrupt.MarginRatioIndex.MustFloat64(), 0.0001)
+
+	underwater := byTrader[underwaterTrader.String()]
+	assert.False(t, underwater.FullLiquidation)
+	assert.InDelta(t, 0.03, underwater.MarginRatioSpot.MustFloat64(), 0.0001)
+}
+
+func TestQueryLiquidationPreview(t *testing.T) {
+	nibiruApp, ctx, _ := initOrdersTest(t, sdk.NewCoins())
+	perpKeeper := nibiruApp.PerpKeeper
+	querier := keeper.NewQuerier(perpKeeper)
+	postIndexPrice(t, nibiruApp, ctx, sdk.OneDec())
+
+	underwaterTrader := testutil.AccAddress()
+	healthyTrader := testutil.AccAddress()
+	setPosition(perpKeeper, ctx, types.Position{
+		TraderAddress:                   underwaterTrader.String(),
+		Pair:                            common.Pair_BTC_NUSD,
+		Size_:                           sdk.NewDec(1_000),
+		Margin:                          sdk.NewDec(80),
+		OpenNotional:                    sdk.NewDec(1_050),
+		LatestCumulativePremiumFraction: sdk.ZeroDec(),
+	})
+	setPosition(perpKeeper, ctx, types.Position{
+		TraderAddress:                   healthyTrader.String(),
+		Pair:                            common.Pair_BTC_NUSD,
+		Size_:                           sdk.NewDec(1_000),
+		Margin:                          sdk.NewDec(100),
+		OpenNotional:                    sdk.NewDec(1_000),
+		LatestCumulativePremiumFraction: sdk.ZeroDec(),
+	})
+	require.NoError(t, sdkSimapp.FundModuleAccount(nibiruApp.BankKeeper, ctx, types.VaultModuleAccount,
+		sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 180))))
+
+	t.Log("a healthy position cannot be liquidated")
+	_, err := querier.QueryLiquidationPreview(sdk.WrapSDKContext(ctx), &types.QueryLiquidationPreviewRequest{
+		TokenPair: common.Pair_BTC_NUSD.String(),
+		Trader:    healthyTrader.String(),
+	})
+	require.ErrorIs(t, err, types.ErrMarginHighEnough)
+
+	t.Log("preview the partial liquidation of the underwater position")
+	resp, err := querier.QueryLiquidationPreview(sdk.WrapSDKContext(ctx), &types.QueryLiquidationPreviewRequest{
+		TokenPair: common.Pair_BTC_NUSD.String(),
+		Trader:    underwaterTrader.String(),
+	})
+	require.NoError(t, err)
+	assert.False(t, resp.FullLiquidation)
+	// a quarter of the position is sold, liquidation fee = 250 * 0.025 = 6.25
+	assert.EqualValues(t, sdk.NewDec(-250), resp.ExchangedPositionSize)
+	assert.EqualValues(t, sdk.NewInt64Coin(common.DenomNUSD, 3), resp.FeeToLiquidator)
+	assert.EqualValues(t, sdk.NewInt64Coin(common.DenomNUSD, 1), resp.FeeToInsuranceFund)
+	assert.EqualValues(t, sdk.NewInt64Coin(common.DenomNUSD, 2), resp.FeeToEcosystemFund)
+	assert.True(t, resp.BadDebt.IsZero())
+	assert.EqualValues(t, sdk.NewDec(750), resp.Position.Size_)
+
+	t.Log("the liquidator is only set when given, and must be whitelisted")
+	liquidationResp, _, _, err := perpKeeper.PreviewLiquidation(ctx, common.Pair_BTC_NUSD, underwaterTrader, nil)
+	require.NoError(t, err)
+	assert.Empty(t, liquidationResp.Liquidator)
+	liquidator := testutil.AccAddress()
+	_, err = querier.QueryLiquidationPreview(sdk.WrapSDKContext(ctx), &types.QueryLiquidationPreviewRequest{
+		TokenPair:  common.Pair_BTC_NUSD.String(),
+		Trader:     underwaterTrader.String(),
+		Liquidator: liquidator.String(),
+	})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	params := perpKeeper.GetParams(ctx)
+	params.WhitelistedLiquidators = []string{liquidator.String()}
+	perpKeeper.SetParams(ctx, params)
+	liquidationResp, _, _, err = perpKeeper.PreviewLiquidation(ctx, common.Pair_BTC_NUSD, underwaterTrader, liquidator)
+	require.NoError(t, err)
+	assert.EqualValues(t, liquidator.String(), liquidationResp.Liquidator)
+	assert.EqualValues(t, sdk.NewInt(3), liquidationResp.FeeToLiquidator)
+
+	t.Log("the state is unchanged")
+	position, err := perpKeeper.Positions.Get(ctx, collections.Join(common.Pair_BTC_NUSD, underwaterTrader))
+	require.NoError(t, err)
+	assert.EqualValues(t, sdk.NewDec(1_000), position.Size_)
+	assert.EqualValues(t, sdk.NewInt64Coin(common.DenomNUSD, 180), nibiruApp.BankKeeper.GetBalance(ctx,
+		nibiruApp.AccountKeeper.GetModuleAddress(types.VaultModuleAccount), common.DenomNUSD))
+	for _, event := range ctx.EventManager().Events() {
+		assert.NotEqual(t, "nibiru.perp.v1.PositionLiquidatedEvent", event.Type)
+	}
+}
+
+// postIndexPrice posts the BTC:NUSD index price from a whitelisted oracle.
+func postIndexPrice(t *testing.T, nibiruApp *simapp.NibiruTestApp, ctx sdk.Context, price sdk.Dec) {
+	oracle := testutil.AccAddress()
+	nibiruApp.PricefeedKeeper.WhitelistOracles(ctx, []sdk.AccAddress{oracle})
+	require.NoError(t, nibiruApp.PricefeedKeeper.PostRawPrice(ctx, oracle, common.Pair_BTC_NUSD.String(), price, ctx.BlockTime().Add(time.Hour)))
+	require.NoError(t, nibiruApp.PricefeedKeeper.GatherRawPrices(ctx, common.DenomBTC, common.DenomNUSD))
+}
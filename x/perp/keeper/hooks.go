@@ -16,9 +16,15 @@ import (
 func (k Keeper) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber uint64) {
 }
 
-func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, _ uint64) {
+func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber uint64) {
 	params := k.GetParams(ctx)
-	if epochIdentifier != params.FundingRateInterval || params.Stopped {
+	if params.Stopped {
+		return
+	}
+
+	k.adjustPoolDepths(ctx, epochIdentifier)
+
+	if epochIdentifier != params.FundingRateInterval {
 		return
 	}
 
@@ -51,16 +57,20 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, _ uint64)
 		epochInfo := k.EpochKeeper.GetEpochInfo(ctx, epochIdentifier)
 		intervalsPerDay := (24 * time.Hour) / epochInfo.Duration
 		// See https://www.notion.so/nibiru/Funding-Payments-5032d0f8ed164096808354296d43e1fa for an explanation of these terms.
-		premiumFraction := markTwap.Sub(indexTWAP).QuoInt64(int64(intervalsPerDay))
-
-		// If there is a previous cumulative funding rate, add onto that one. Otherwise, the funding rate is the first cumulative funding rate.
-		cumulativePremiumFraction := premiumFraction
-		if len(pairMetadata.CumulativePremiumFractions) > 0 {
-			cumulativePremiumFraction = pairMetadata.CumulativePremiumFractions[len(pairMetadata.CumulativePremiumFractions)-1].Add(premiumFraction)
-		}
-
-		pairMetadata.CumulativePremiumFractions = append(pairMetadata.CumulativePremiumFractions, cumulativePremiumFraction)
-		k.PairsMetadata.Insert(ctx, pairMetadata.Pair, pairMetadata)
+		rawPremiumFraction := markTwap.Sub(indexTWAP).QuoInt64(int64(intervalsPerDay))
+		premiumFraction, clampReason := k.clampPremiumFraction(ctx, params, pairMetadata.Pair, rawPremiumFraction, indexTWAP)
+
+		cumulativePremiumFraction := pairMetadata.LatestCumulativePremiumFraction.Add(premiumFraction)
+		k.recordFundingRate(ctx, pairMetadata, types.FundingRate{
+			Pair:                      pairMetadata.Pair,
+			Epoch:                     epochNumber,
+			PremiumFraction:           premiumFraction,
+			CumulativePremiumFraction: cumulativePremiumFraction,
+			MarkPrice:                 markTwap,
+			IndexPrice:                indexTWAP,
+			BlockHeight:               ctx.BlockHeight(),
+			BlockTimeMs:               ctx.BlockTime().UnixMilli(),
+		})
 
 		if err = ctx.EventManager().EmitTypedEvent(&types.FundingRateChangedEvent{
 			Pair:                      pairMetadata.Pair.String(),
@@ -71,6 +81,9 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, _ uint64)
 			CumulativePremiumFraction: cumulativePremiumFraction,
 			BlockHeight:               ctx.BlockHeight(),
 			BlockTimeMs:               ctx.BlockTime().UnixMilli(),
+			RawPremiumFraction:        rawPremiumFraction,
+			RawFundingRate:            rawPremiumFraction.Quo(indexTWAP),
+			ClampReason:               clampReason,
 		}); err != nil {
 			ctx.Logger().Error("failed to emit FundingRateChangedEvent", "pairMetadata.Pair", pairMetadata.Pair, "error", err)
 			continue
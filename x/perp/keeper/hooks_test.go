@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/NibiruChain/nibiru/collections"
 	"github.com/NibiruChain/nibiru/x/common"
 	epochtypes "github.com/NibiruChain/nibiru/x/epochs/types"
 	"github.com/NibiruChain/nibiru/x/perp/types"
@@ -17,38 +18,38 @@ import (
 
 func TestEndOfEpochTwapCalculation(t *testing.T) {
 	tests := []struct {
-		name                               string
-		indexPrice                         sdk.Dec
-		markPrice                          sdk.Dec
-		expectedCumulativePremiumFractions []sdk.Dec
-		expectedFundingRateChangedEvent    *types.FundingRateChangedEvent
+		name                                    string
+		indexPrice                              sdk.Dec
+		markPrice                               sdk.Dec
+		expectedLatestCumulativePremiumFraction sdk.Dec
+		expectedFundingRateChangedEvent         *types.FundingRateChangedEvent
 	}{
 		{
-			name:                               "check empty prices",
-			indexPrice:                         sdk.ZeroDec(),
-			markPrice:                          sdk.ZeroDec(),
-			expectedCumulativePremiumFractions: []sdk.Dec{sdk.ZeroDec()},
-			expectedFundingRateChangedEvent:    nil,
+			name:                                    "check empty prices",
+			indexPrice:                              sdk.ZeroDec(),
+			markPrice:                               sdk.ZeroDec(),
+			expectedLatestCumulativePremiumFraction: sdk.ZeroDec(),
+			expectedFundingRateChangedEvent:         nil,
 		},
 		{
-			name:                               "empty index price",
-			indexPrice:                         sdk.ZeroDec(),
-			markPrice:                          sdk.NewDec(10),
-			expectedCumulativePremiumFractions: []sdk.Dec{sdk.ZeroDec()},
-			expectedFundingRateChangedEvent:    nil,
+			name:                                    "empty index price",
+			indexPrice:                              sdk.ZeroDec(),
+			markPrice:                               sdk.NewDec(10),
+			expectedLatestCumulativePremiumFraction: sdk.ZeroDec(),
+			expectedFundingRateChangedEvent:         nil,
 		},
 		{
-			name:                               "empty mark price",
-			indexPrice:                         sdk.NewDec(10),
-			markPrice:                          sdk.ZeroDec(),
-			expectedCumulativePremiumFractions: []sdk.Dec{sdk.ZeroDec()},
-			expectedFundingRateChangedEvent:    nil,
+			name:                                    "empty mark price",
+			indexPrice:                              sdk.NewDec(10),
+			markPrice:                               sdk.ZeroDec(),
+			expectedLatestCumulativePremiumFraction: sdk.ZeroDec(),
+			expectedFundingRateChangedEvent:         nil,
 		},
 		{
-			name:                               "equal prices",
-			indexPrice:                         sdk.NewDec(10),
-			markPrice:                          sdk.NewDec(10),
-			expectedCumulativePremiumFractions: []sdk.Dec{sdk.ZeroDec(), sdk.ZeroDec()},
+			name:                                    "equal prices",
+			indexPrice:                              sdk.NewDec(10),
+			markPrice:                               sdk.NewDec(10),
+			expectedLatestCumulativePremiumFraction: sdk.ZeroDec(),
 			expectedFundingRateChangedEvent: &types.FundingRateChangedEvent{
 				Pair:                      common.Pair_BTC_NUSD.String(),
 				MarkPrice:                 sdk.NewDec(10),
@@ -58,13 +59,15 @@ func TestEndOfEpochTwapCalculation(t *testing.T) {
 				CumulativePremiumFraction: sdk.ZeroDec(),
 				BlockHeight:               1,
 				BlockTimeMs:               1,
+				RawPremiumFraction:        sdk.ZeroDec(),
+				RawFundingRate:            sdk.ZeroDec(),
 			},
 		},
 		{
-			name:                               "calculate funding rate with higher index price",
-			markPrice:                          sdk.NewDec(19),
-			indexPrice:                         sdk.NewDec(462),
-			expectedCumulativePremiumFractions: []sdk.Dec{sdk.ZeroDec(), sdk.MustNewDecFromStr("-9.229166666666666666")},
+			name:                                    "calculate funding rate with higher index price",
+			markPrice:                               sdk.NewDec(19),
+			indexPrice:                              sdk.NewDec(462),
+			expectedLatestCumulativePremiumFraction: sdk.MustNewDecFromStr("-9.229166666666666666"),
 			expectedFundingRateChangedEvent: &types.FundingRateChangedEvent{
 				Pair:                      common.Pair_BTC_NUSD.String(),
 				MarkPrice:                 sdk.NewDec(19),
@@ -74,13 +77,15 @@ func TestEndOfEpochTwapCalculation(t *testing.T) {
 				CumulativePremiumFraction: sdk.MustNewDecFromStr("-9.229166666666666666"),
 				BlockHeight:               1,
 				BlockTimeMs:               1,
+				RawPremiumFraction:        sdk.MustNewDecFromStr("-9.229166666666666666"),
+				RawFundingRate:            sdk.MustNewDecFromStr("-0.019976551226551227"),
 			},
 		},
 		{
-			name:                               "calculate funding rate with higher mark price",
-			markPrice:                          sdk.NewDec(745),
-			indexPrice:                         sdk.NewDec(64),
-			expectedCumulativePremiumFractions: []sdk.Dec{sdk.ZeroDec(), sdk.MustNewDecFromStr("14.1875")},
+			name:                                    "calculate funding rate with higher mark price",
+			markPrice:                               sdk.NewDec(745),
+			indexPrice:                              sdk.NewDec(64),
+			expectedLatestCumulativePremiumFraction: sdk.MustNewDecFromStr("14.1875"),
 			expectedFundingRateChangedEvent: &types.FundingRateChangedEvent{
 				Pair:                      common.Pair_BTC_NUSD.String(),
 				MarkPrice:                 sdk.NewDec(745),
@@ -90,6 +95,8 @@ func TestEndOfEpochTwapCalculation(t *testing.T) {
 				CumulativePremiumFraction: sdk.MustNewDecFromStr("14.1875"),
 				BlockHeight:               1,
 				BlockTimeMs:               1,
+				RawPremiumFraction:        sdk.MustNewDecFromStr("14.1875"),
+				RawFundingRate:            sdk.MustNewDecFromStr("0.2216796875"),
 			},
 		},
 	}
@@ -109,16 +116,170 @@ func TestEndOfEpochTwapCalculation(t *testing.T) {
 			t.Log("assert PairMetadataState")
 			pair, err := perpKeeper.PairsMetadata.Get(ctx, common.Pair_BTC_NUSD)
 			require.NoError(t, err)
-			assert.Equal(t, tc.expectedCumulativePremiumFractions, pair.CumulativePremiumFractions)
+			assert.Equal(t, tc.expectedLatestCumulativePremiumFraction, pair.LatestCumulativePremiumFraction)
 
-			if tc.expectedFundingRateChangedEvent != nil {
-				t.Log("assert FundingRateChangedEvent")
-				testutilevents.RequireContainsTypedEvent(t, ctx, tc.expectedFundingRateChangedEvent)
+			fundingRate, err := perpKeeper.FundingRates.Get(ctx, collections.Join(common.Pair_BTC_NUSD, uint64(1)))
+			if tc.expectedFundingRateChangedEvent == nil {
+				require.ErrorIs(t, err, collections.ErrNotFound)
+				return
 			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedLatestCumulativePremiumFraction, fundingRate.CumulativePremiumFraction)
+			assert.Equal(t, tc.markPrice, fundingRate.MarkPrice)
+			assert.Equal(t, tc.indexPrice, fundingRate.IndexPrice)
+			assert.EqualValues(t, 1, fundingRate.BlockTimeMs)
+
+			t.Log("assert FundingRateChangedEvent")
+			testutilevents.RequireContainsTypedEvent(t, ctx, tc.expectedFundingRateChangedEvent)
+		})
+	}
+}
+
+func TestFundingRateClamping(t *testing.T) {
+	tests := []struct {
+		name                   string
+		markPrice              sdk.Dec
+		indexPrice             sdk.Dec
+		maxFundingRate         sdk.Dec
+		premiumSmoothingFactor sdk.Dec
+		// the premium fraction of the previous epoch, nil if none
+		previousPremiumFraction sdk.Dec
+
+		expectedPremiumFraction sdk.Dec
+		expectedClampReason     types.FundingRateClampReason
+	}{
+		{
+			name:                    "within the max funding rate",
+			markPrice:               sdk.NewDec(11),
+			indexPrice:              sdk.NewDec(10),
+			maxFundingRate:          sdk.MustNewDecFromStr("0.01"),
+			premiumSmoothingFactor:  sdk.ZeroDec(),
+			expectedPremiumFraction: sdk.OneDec().QuoInt64(48),
+			expectedClampReason:     types.FundingRateClampReason_NOT_CLAMPED,
+		},
+		{
+			name:                    "positive funding rate capped",
+			markPrice:               sdk.NewDec(745),
+			indexPrice:              sdk.NewDec(64),
+			maxFundingRate:          sdk.MustNewDecFromStr("0.01"),
+			premiumSmoothingFactor:  sdk.ZeroDec(),
+			expectedPremiumFraction: sdk.MustNewDecFromStr("0.64"),
+			expectedClampReason:     types.FundingRateClampReason_MAX_FUNDING_RATE,
+		},
+		{
+			name:                    "negative funding rate capped",
+			markPrice:               sdk.NewDec(19),
+			indexPrice:              sdk.NewDec(462),
+			maxFundingRate:          sdk.MustNewDecFromStr("0.01"),
+			premiumSmoothingFactor:  sdk.ZeroDec(),
+			expectedPremiumFraction: sdk.MustNewDecFromStr("-4.62"),
+			expectedClampReason:     types.FundingRateClampReason_MAX_FUNDING_RATE,
+		},
+		{
+			name:                    "no smoothing without a previous premium fraction",
+			markPrice:               sdk.NewDec(11),
+			indexPrice:              sdk.NewDec(10),
+			maxFundingRate:          sdk.ZeroDec(),
+			premiumSmoothingFactor:  sdk.MustNewDecFromStr("0.5"),
+			expectedPremiumFraction: sdk.OneDec().QuoInt64(48),
+			expectedClampReason:     types.FundingRateClampReason_NOT_CLAMPED,
+		},
+		{
+			name:                    "premium smoothed with the previous one",
+			markPrice:               sdk.NewDec(11),
+			indexPrice:              sdk.NewDec(10),
+			maxFundingRate:          sdk.ZeroDec(),
+			premiumSmoothingFactor:  sdk.MustNewDecFromStr("0.75"),
+			previousPremiumFraction: sdk.MustNewDecFromStr("0.5"),
+			// 0.25 * 1 / 48 + 0.75 * 0.5
+			expectedPremiumFraction: sdk.OneDec().QuoInt64(48).Mul(sdk.MustNewDecFromStr("0.25")).Add(sdk.MustNewDecFromStr("0.375")),
+			expectedClampReason:     types.FundingRateClampReason_PREMIUM_SMOOTHING,
+		},
+		{
+			name:                    "smoothed premium capped",
+			markPrice:               sdk.NewDec(11),
+			indexPrice:              sdk.NewDec(10),
+			maxFundingRate:          sdk.MustNewDecFromStr("0.01"),
+			premiumSmoothingFactor:  sdk.MustNewDecFromStr("0.75"),
+			previousPremiumFraction: sdk.MustNewDecFromStr("0.5"),
+			expectedPremiumFraction: sdk.MustNewDecFromStr("0.1"),
+			expectedClampReason:     types.FundingRateClampReason_MAX_FUNDING_RATE,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			perpKeeper, mocks, ctx := getKeeper(t)
+			ctx = ctx.WithBlockHeight(1).WithBlockTime(time.UnixMilli(1))
+			initParams(ctx, perpKeeper)
+			params := perpKeeper.GetParams(ctx)
+			params.MaxFundingRate = tc.maxFundingRate
+			params.PremiumSmoothingFactor = tc.premiumSmoothingFactor
+			perpKeeper.SetParams(ctx, params)
+
+			if !tc.previousPremiumFraction.IsNil() {
+				perpKeeper.FundingRates.Insert(ctx, collections.Join(common.Pair_BTC_NUSD, uint64(1)), types.FundingRate{
+					Pair:                      common.Pair_BTC_NUSD,
+					Epoch:                     1,
+					PremiumFraction:           tc.previousPremiumFraction,
+					CumulativePremiumFraction: tc.previousPremiumFraction,
+					MarkPrice:                 sdk.OneDec(),
+					IndexPrice:                sdk.OneDec(),
+				})
+			}
+
+			setMocks(ctx, mocks, tc.indexPrice, tc.markPrice)
+			perpKeeper.AfterEpochEnd(ctx, "30 min", 2)
+
+			fundingRate, err := perpKeeper.FundingRates.Get(ctx, collections.Join(common.Pair_BTC_NUSD, uint64(2)))
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedPremiumFraction, fundingRate.PremiumFraction)
+
+			rawPremiumFraction := tc.markPrice.Sub(tc.indexPrice).QuoInt64(48)
+			testutilevents.RequireContainsTypedEvent(t, ctx, &types.FundingRateChangedEvent{
+				Pair:                      common.Pair_BTC_NUSD.String(),
+				MarkPrice:                 tc.markPrice,
+				IndexPrice:                tc.indexPrice,
+				LatestFundingRate:         tc.expectedPremiumFraction.Quo(tc.indexPrice),
+				LatestPremiumFraction:     tc.expectedPremiumFraction,
+				CumulativePremiumFraction: tc.expectedPremiumFraction,
+				BlockHeight:               1,
+				BlockTimeMs:               1,
+				RawPremiumFraction:        rawPremiumFraction,
+				RawFundingRate:            rawPremiumFraction.Quo(tc.indexPrice),
+				ClampReason:               tc.expectedClampReason,
+			})
 		})
 	}
 }
 
+func TestFundingRateHistoryIsBounded(t *testing.T) {
+	perpKeeper, mocks, ctx := getKeeper(t)
+	ctx = ctx.WithBlockHeight(1).WithBlockTime(time.UnixMilli(1))
+	initParams(ctx, perpKeeper)
+	params := perpKeeper.GetParams(ctx)
+	params.FundingRateHistoryLength = 2
+	perpKeeper.SetParams(ctx, params)
+
+	for epoch := uint64(1); epoch <= 3; epoch++ {
+		setMocks(ctx, mocks, sdk.NewDec(10), sdk.NewDec(11))
+		perpKeeper.AfterEpochEnd(ctx, "30 min", epoch)
+	}
+
+	t.Log("only the last two epochs are kept")
+	epochs := perpKeeper.FundingRates.Iterate(ctx, collections.PairRange[common.AssetPair, uint64]{}.
+		Prefix(common.Pair_BTC_NUSD)).Keys()
+	require.Len(t, epochs, 2)
+	assert.EqualValues(t, 2, epochs[0].K2())
+	assert.EqualValues(t, 3, epochs[1].K2())
+
+	t.Log("the cumulative premium fraction keeps adding up")
+	// (11 - 10) / 48 per epoch
+	pair, err := perpKeeper.PairsMetadata.Get(ctx, common.Pair_BTC_NUSD)
+	require.NoError(t, err)
+	assert.Equal(t, sdk.OneDec().QuoInt64(48).MulInt64(3), pair.LatestCumulativePremiumFraction)
+}
+
 func initParams(ctx sdk.Context, k Keeper) {
 	k.SetParams(ctx, types.Params{
 		Stopped:                 false,
@@ -128,11 +289,21 @@ func initParams(ctx sdk.Context, k Keeper) {
 		PartialLiquidationRatio: sdk.MustNewDecFromStr("0.00001"),
 		FundingRateInterval:     "30 min",
 		TwapLookbackWindow:      15 * time.Minute,
+		OrderExecutionReward:    sdk.ZeroInt(),
+		MaxOrderDuration:        24 * time.Hour,
+
+		InsuranceFundFeeShare:         sdk.MustNewDecFromStr("0.5"),
+		InsuranceFundLiquidationShare: sdk.MustNewDecFromStr("0.5"),
+		BadDebtPayoutOrder:            types.DefaultParams().BadDebtPayoutOrder,
+		MaxFundingRate:                sdk.ZeroDec(),
+		PremiumSmoothingFactor:        sdk.ZeroDec(),
+		LiquidationAuctionThreshold:   sdk.ZeroDec(),
+		LiquidationAuctionMaxDiscount: sdk.ZeroDec(),
+		LiquidationAuctionDuration:    time.Minute,
 	})
 	setPairMetadata(k, ctx, types.PairMetadata{
-		Pair: common.Pair_BTC_NUSD,
-		// start with one entry to ensure we append
-		CumulativePremiumFractions: []sdk.Dec{sdk.ZeroDec()},
+		Pair:                            common.Pair_BTC_NUSD,
+		LatestCumulativePremiumFraction: sdk.ZeroDec(),
 	})
 }
 
@@ -0,0 +1,244 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/collections"
+	"github.com/NibiruChain/nibiru/x/perp/types"
+)
+
+// GetInsuranceFund returns the lifetime statistics of the insurance fund of the given denom.
+func (k Keeper) GetInsuranceFund(ctx sdk.Context, denom string) types.InsuranceFund {
+	return k.InsuranceFunds.GetOr(ctx, denom, types.InsuranceFund{
+		Denom:               denom,
+		TotalContributions:  sdk.ZeroInt(),
+		TotalPayouts:        sdk.ZeroInt(),
+		TotalSocializedLoss: sdk.ZeroInt(),
+	})
+}
+
+// recordInsuranceFundContribution accounts for coins paid into the insurance fund.
+func (k Keeper) recordInsuranceFundContribution(ctx sdk.Context, coin sdk.Coin) {
+	fund := k.GetInsuranceFund(ctx, coin.Denom)
+	fund.TotalContributions = fund.TotalContributions.Add(coin.Amount)
+	k.InsuranceFunds.Insert(ctx, coin.Denom, fund)
+}
+
+/*
+coverBadDebt covers bad debt the vault cannot pay for by walking the bad debt
+payout order. Each payer transfers as much of the remaining bad debt to the
+vault as its balance allows. AUTO_DELEVERAGING reduces the profitable
+positions opposite to the liquidated position, if any. SOCIALIZED_LOSS takes
+the remainder from the margin of the open positions, see socializeLoss.
+
+args:
+  - ctx: cosmos-sdk context
+  - denom: the denom of the bad debt
+  - badDebt: the amount of bad debt to cover
+  - socialize: whether the remainder can be socialized. Withdrawals pass false
+    since a haircut on the margins doesn't put coins in the vault to pay the
+    receiver.
+  - liquidated: the liquidated position which left the bad debt, as it was
+    before the liquidation. Auto-deleveraging is skipped if nil.
+
+ret:
+  - shortfall: the record of how the bad debt was covered
+  - err: ErrVaultInsolvent if the payers cannot cover the bad debt and it
+    cannot be socialized
+*/
+func (k Keeper) coverBadDebt(
+	ctx sdk.Context, denom string, badDebt sdk.Int, socialize bool, liquidated *types.Position,
+) (shortfall types.Shortfall, err error) {
+	shortfall = types.Shortfall{
+		Id:                        k.ShortfallID.Next(ctx),
+		Denom:                     denom,
+		BadDebt:                   badDebt,
+		CoveredByInsuranceFund:    sdk.ZeroInt(),
+		CoveredByEcosystemFund:    sdk.ZeroInt(),
+		CoveredByAutoDeleveraging: sdk.ZeroInt(),
+		SocializedLoss:            sdk.ZeroInt(),
+		BlockHeight:               ctx.BlockHeight(),
+		BlockTimeMs:               ctx.BlockTime().UnixMilli(),
+	}
+
+	remaining := badDebt
+	for _, payer := range k.GetParams(ctx).BadDebtPayoutOrder {
+		if !remaining.IsPositive() {
+			break
+		}
+
+		var covered sdk.Int
+		switch payer {
+		case types.BadDebtPayer_INSURANCE_FUND:
+			covered, err = k.payBadDebtFrom(ctx, types.InsuranceFundModuleAccount, denom, remaining)
+			if err != nil {
+				return types.Shortfall{}, err
+			}
+			shortfall.CoveredByInsuranceFund = covered
+		case types.BadDebtPayer_ECOSYSTEM_FUND:
+			covered, err = k.payBadDebtFrom(ctx, types.PerpEFModuleAccount, denom, remaining)
+			if err != nil {
+				return types.Shortfall{}, err
+			}
+			shortfall.CoveredByEcosystemFund = covered
+		case types.BadDebtPayer_AUTO_DELEVERAGING:
+			if liquidated == nil {
+				continue
+			}
+			covered, err = k.autoDeleverage(ctx, shortfall.Id, *liquidated, remaining)
+			if err != nil {
+				return types.Shortfall{}, err
+			}
+			shortfall.CoveredByAutoDeleveraging = covered
+		case types.BadDebtPayer_SOCIALIZED_LOSS:
+			if !socialize {
+				return types.Shortfall{}, types.ErrVaultInsolvent.Wrapf(
+					"%s%s left uncovered", remaining, denom)
+			}
+			covered = k.socializeLoss(ctx, shortfall.Id, denom, remaining)
+			shortfall.SocializedLoss = covered
+		default:
+			continue
+		}
+
+		if !covered.IsPositive() {
+			continue
+		}
+		remaining = remaining.Sub(covered)
+
+		if err = ctx.EventManager().EmitTypedEvent(&types.BadDebtCoveredEvent{
+			ShortfallId:      shortfall.Id,
+			Payer:            payer,
+			Amount:           sdk.NewCoin(denom, covered),
+			RemainingBadDebt: remaining,
+			BlockHeight:      ctx.BlockHeight(),
+			BlockTimeMs:      ctx.BlockTime().UnixMilli(),
+		}); err != nil {
+			return types.Shortfall{}, err
+		}
+	}
+
+	if remaining.IsPositive() {
+		return types.Shortfall{}, types.ErrVaultInsolvent.Wrapf(
+			"%s%s left uncovered", remaining, denom)
+	}
+
+	fund := k.GetInsuranceFund(ctx, denom)
+	fund.TotalPayouts = fund.TotalPayouts.Add(shortfall.CoveredByInsuranceFund)
+	fund.TotalSocializedLoss = fund.TotalSocializedLoss.Add(shortfall.SocializedLoss)
+	k.InsuranceFunds.Insert(ctx, denom, fund)
+	k.Shortfalls.Insert(ctx, collections.Join(denom, shortfall.Id), shortfall)
+
+	return shortfall, nil
+}
+
+/*
+socializeLoss haircuts the margin of every open position quoted in denom and
+the cross margin collateral in denom, pro rata to their size, so that the
+claims of the traders on the vault shrink by the loss the payers could not
+cover. The margins and collateral cap the haircut.
+
+The haircut is recorded as a SocializedLoss rather than applied to every
+position in the transaction. Positions and cross margin accounts take it the
+next time they are read, see GetPosition and GetCrossMarginAccount.
+
+args:
+  - ctx: cosmos-sdk context
+  - shortfallID: the id of the shortfall the loss is socialized for
+  - denom: the denom of the bad debt
+  - loss: the bad debt left uncovered by the other payers
+
+ret:
+  - socialized: the amount taken from the margins
+*/
+func (k Keeper) socializeLoss(ctx sdk.Context, shortfallID uint64, denom string, loss sdk.Int) (socialized sdk.Int) {
+	totalMargin := k.TotalMargins.GetOr(ctx, denom, sdk.ZeroDec())
+	socialized = sdk.MinInt(loss, totalMargin.TruncateInt())
+	if !socialized.IsPositive() {
+		return sdk.ZeroInt()
+	}
+
+	id := k.SocializedLossID.Next(ctx)
+	k.SocializedLosses.Insert(ctx, collections.Join(denom, id), types.SocializedLoss{
+		Id:          id,
+		Denom:       denom,
+		Ratio:       socialized.ToDec().Quo(totalMargin),
+		ShortfallId: shortfallID,
+	})
+	k.addToTotalMargin(ctx, denom, socialized.ToDec().Neg())
+
+	return socialized
+}
+
+/*
+applySocializedLosses takes the losses of denom socialized after the cursor
+from a margin. Margins which are not positive have nothing to take from.
+
+args:
+  - ctx: cosmos-sdk context
+  - denom: the denom of the margin
+  - margin: the margin as it was when the cursor was set
+  - cursor: the id of the last loss taken from the margin
+
+ret:
+  - margin: the margin net of the losses
+*/
+func (k Keeper) applySocializedLosses(ctx sdk.Context, denom string, margin sdk.Dec, cursor uint64) sdk.Dec {
+	if !margin.IsPositive() {
+		return margin
+	}
+
+	iter := k.SocializedLosses.Iterate(
+		ctx,
+		collections.PairRange[string, uint64]{}.Prefix(denom).StartExclusive(cursor),
+	)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		margin = margin.Mul(sdk.OneDec().Sub(iter.Value().Ratio))
+	}
+
+	return margin
+}
+
+// lastSocializedLossID returns the id of the last socialized loss, zero if
+// there is none.
+func (k Keeper) lastSocializedLossID(ctx sdk.Context) uint64 {
+	return k.SocializedLossID.Peek(ctx) - 1
+}
+
+// addToTotalMargin adds 'amount' to the total margin of denom, which is kept
+// non-negative against rounding.
+func (k Keeper) addToTotalMargin(ctx sdk.Context, denom string, amount sdk.Dec) {
+	if amount.IsZero() {
+		return
+	}
+
+	total := k.TotalMargins.GetOr(ctx, denom, sdk.ZeroDec()).Add(amount)
+	if !total.IsPositive() {
+		_ = k.TotalMargins.Delete(ctx, denom)
+		return
+	}
+	k.TotalMargins.Insert(ctx, denom, total)
+}
+
+// payBadDebtFrom transfers up to 'amount' from the module account to the
+// vault and returns the amount transferred.
+func (k Keeper) payBadDebtFrom(
+	ctx sdk.Context, moduleAccount string, denom string, amount sdk.Int,
+) (paid sdk.Int, err error) {
+	balance := k.BankKeeper.GetBalance(ctx, k.AccountKeeper.GetModuleAddress(moduleAccount), denom)
+	paid = sdk.MinInt(balance.Amount, amount)
+	if !paid.IsPositive() {
+		return sdk.ZeroInt(), nil
+	}
+
+	if err = k.BankKeeper.SendCoinsFromModuleToModule(
+		ctx,
+		/* from */ moduleAccount,
+		/* to */ types.VaultModuleAccount,
+		sdk.NewCoins(sdk.NewCoin(denom, paid)),
+	); err != nil {
+		return sdk.Int{}, err
+	}
+	return paid, nil
+}
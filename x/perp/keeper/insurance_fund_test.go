@@ -0,0 +1,202 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/collections"
+	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/perp/keeper"
+	"github.com/NibiruChain/nibiru/x/perp/types"
+	"github.com/NibiruChain/nibiru/x/testutil"
+)
+
+func TestInsuranceFundFees(t *testing.T) {
+	// margin of 1000 and fees of 10 to the fee pool and 10 to the funds
+	nibiruApp, ctx, traderAddr := initOrdersTest(t, sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 1_020)))
+	perpKeeper := nibiruApp.PerpKeeper
+	insuranceFundAddr := nibiruApp.AccountKeeper.GetModuleAddress(types.InsuranceFundModuleAccount)
+	perpEFAddr := nibiruApp.AccountKeeper.GetModuleAddress(types.PerpEFModuleAccount)
+
+	_, err := perpKeeper.OpenPosition(ctx, common.Pair_BTC_NUSD, types.Side_BUY, traderAddr,
+		sdk.NewInt(1_000), sdk.NewDec(10), sdk.ZeroDec())
+	require.NoError(t, err)
+
+	t.Log("half of the ecosystem fund fee goes to the insurance fund")
+	assert.EqualValues(t, sdk.NewInt64Coin(common.DenomNUSD, 5), nibiruApp.BankKeeper.GetBalance(ctx, insuranceFundAddr, common.DenomNUSD))
+	assert.EqualValues(t, sdk.NewInt64Coin(common.DenomNUSD, 5), nibiruApp.BankKeeper.GetBalance(ctx, perpEFAddr, common.DenomNUSD))
+
+	resp, err := keeper.NewQuerier(perpKeeper).QueryInsuranceFund(sdk.WrapSDKContext(ctx), &types.QueryInsuranceFundRequest{
+		Denom: common.DenomNUSD,
+	})
+	require.NoError(t, err)
+	assert.EqualValues(t, types.InsuranceFund{
+		Denom:               common.DenomNUSD,
+		TotalContributions:  sdk.NewInt(5),
+		TotalPayouts:        sdk.ZeroInt(),
+		TotalSocializedLoss: sdk.ZeroInt(),
+	}, resp.InsuranceFund)
+	assert.EqualValues(t, sdk.NewInt64Coin(common.DenomNUSD, 5), resp.Balance)
+	assert.EqualValues(t, sdk.NewDec(10_000), resp.TotalOpenNotional)
+	assert.EqualValues(t, sdk.MustNewDecFromStr("0.0005"), resp.CoverageRatio)
+}
+
+func TestInsuranceFundCoversShortfalls(t *testing.T) {
+	nibiruApp, ctx, _ := initOrdersTest(t, sdk.NewCoins())
+	perpKeeper := nibiruApp.PerpKeeper
+	querier := keeper.NewQuerier(perpKeeper)
+	receiver := testutil.AccAddress()
+
+	require.NoError(t, simapp.FundModuleAccount(nibiruApp.BankKeeper, ctx, types.InsuranceFundModuleAccount,
+		sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 30))))
+	require.NoError(t, simapp.FundModuleAccount(nibiruApp.BankKeeper, ctx, types.PerpEFModuleAccount,
+		sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 15))))
+
+	t.Log("the insurance fund covers the first shortage of the empty vault")
+	require.NoError(t, perpKeeper.Withdraw(ctx, common.DenomNUSD, receiver, sdk.NewInt(20)))
+	testutil.RequireHasTypedEvent(t, ctx, &types.BadDebtCoveredEvent{
+		ShortfallId:      1,
+		Payer:            types.BadDebtPayer_INSURANCE_FUND,
+		Amount:           sdk.NewInt64Coin(common.DenomNUSD, 20),
+		RemainingBadDebt: sdk.ZeroInt(),
+		BlockHeight:      ctx.BlockHeight(),
+		BlockTimeMs:      ctx.BlockTime().UnixMilli(),
+	})
+
+	t.Log("the ecosystem fund covers what the insurance fund cannot")
+	require.NoError(t, perpKeeper.Withdraw(ctx, common.DenomNUSD, receiver, sdk.NewInt(20)))
+
+	t.Log("the remaining shortage cannot be socialized by a withdrawal")
+	err := perpKeeper.Withdraw(ctx, common.DenomNUSD, receiver, sdk.NewInt(20))
+	require.ErrorIs(t, err, types.ErrVaultInsolvent)
+
+	assert.EqualValues(t, sdk.NewInt64Coin(common.DenomNUSD, 40), nibiruApp.BankKeeper.GetBalance(ctx, receiver, common.DenomNUSD))
+	assert.EqualValues(t, sdk.NewInt(40), perpKeeper.PrepaidBadDebt.GetOr(ctx, common.DenomNUSD, types.PrepaidBadDebt{}).Amount)
+
+	t.Log("query the shortfalls one page at a time")
+	page, err := querier.QueryShortfalls(sdk.WrapSDKContext(ctx), &types.QueryShortfallsRequest{
+		Denom:      common.DenomNUSD,
+		Pagination: &query.PageRequest{Limit: 1},
+	})
+	require.NoError(t, err)
+	require.Len(t, page.Shortfalls, 1)
+	assert.EqualValues(t, types.Shortfall{
+		Id:                        1,
+		Denom:                     common.DenomNUSD,
+		BadDebt:                   sdk.NewInt(20),
+		CoveredByInsuranceFund:    sdk.NewInt(20),
+		CoveredByEcosystemFund:    sdk.ZeroInt(),
+		CoveredByAutoDeleveraging: sdk.ZeroInt(),
+		SocializedLoss:            sdk.ZeroInt(),
+		BlockHeight:               ctx.BlockHeight(),
+		BlockTimeMs:               ctx.BlockTime().UnixMilli(),
+	}, page.Shortfalls[0])
+
+	page, err = querier.QueryShortfalls(sdk.WrapSDKContext(ctx), &types.QueryShortfallsRequest{
+		Denom:      common.DenomNUSD,
+		Pagination: &query.PageRequest{Key: page.Pagination.NextKey, Limit: 1},
+	})
+	require.NoError(t, err)
+	require.Len(t, page.Shortfalls, 1)
+	assert.EqualValues(t, sdk.NewInt(10), page.Shortfalls[0].CoveredByInsuranceFund)
+	assert.EqualValues(t, sdk.NewInt(10), page.Shortfalls[0].CoveredByEcosystemFund)
+	assert.Nil(t, page.Pagination.NextKey)
+
+	resp, err := querier.QueryInsuranceFund(sdk.WrapSDKContext(ctx), &types.QueryInsuranceFundRequest{
+		Denom: common.DenomNUSD,
+	})
+	require.NoError(t, err)
+	assert.EqualValues(t, sdk.NewInt(30), resp.InsuranceFund.TotalPayouts)
+	assert.True(t, resp.Balance.IsZero())
+	assert.True(t, resp.CoverageRatio.IsZero())
+}
+
+func TestSocializedLoss(t *testing.T) {
+	// the mark price of the pool is 1, the insurance fund and ecosystem fund are empty
+	nibiruApp, ctx, _ := initOrdersTest(t, sdk.NewCoins())
+	perpKeeper := nibiruApp.PerpKeeper
+
+	// a long bought at 2, bankrupt at (2_000 - 100) / 1_000 = 1.9
+	bankruptTrader := testutil.AccAddress()
+	setPosition(perpKeeper, ctx, types.Position{
+		TraderAddress:                   bankruptTrader.String(),
+		Pair:                            common.Pair_BTC_NUSD,
+		Size_:                           sdk.NewDec(1_000),
+		Margin:                          sdk.NewDec(100),
+		OpenNotional:                    sdk.NewDec(2_000),
+		LatestCumulativePremiumFraction: sdk.ZeroDec(),
+	})
+
+	// no short is profitable, so there is nothing to auto-deleverage
+	short := testutil.AccAddress()
+	setPosition(perpKeeper, ctx, types.Position{
+		TraderAddress:                   short.String(),
+		Pair:                            common.Pair_BTC_NUSD,
+		Size_:                           sdk.NewDec(-1_000),
+		Margin:                          sdk.NewDec(1_000),
+		OpenNotional:                    sdk.NewDec(500),
+		LatestCumulativePremiumFraction: sdk.ZeroDec(),
+	})
+	long := testutil.AccAddress()
+	setPosition(perpKeeper, ctx, types.Position{
+		TraderAddress:                   long.String(),
+		Pair:                            common.Pair_BTC_NUSD,
+		Size_:                           sdk.NewDec(500),
+		Margin:                          sdk.NewDec(500),
+		OpenNotional:                    sdk.NewDec(500),
+		LatestCumulativePremiumFraction: sdk.ZeroDec(),
+	})
+	crossMarginTrader := testutil.AccAddress()
+	perpKeeper.SetCrossMarginAccount(ctx, types.CrossMarginAccount{
+		TraderAddress: crossMarginTrader.String(),
+		Collateral:    sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 500)),
+	})
+	require.NoError(t, simapp.FundModuleAccount(nibiruApp.BankKeeper, ctx, types.VaultModuleAccount,
+		sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 2_100))))
+
+	t.Log("liquidate the bankrupt long")
+	position, err := perpKeeper.Positions.Get(ctx, collections.Join(common.Pair_BTC_NUSD, bankruptTrader))
+	require.NoError(t, err)
+	resp, err := perpKeeper.ExecuteFullLiquidation(ctx, testutil.AccAddress(), &position)
+	require.NoError(t, err)
+	badDebt := resp.BadDebt
+	require.True(t, badDebt.IsPositive())
+
+	t.Log("the bad debt is socialized")
+	shortfall, err := perpKeeper.Shortfalls.Get(ctx, collections.Join(common.DenomNUSD, uint64(1)))
+	require.NoError(t, err)
+	assert.EqualValues(t, badDebt, shortfall.SocializedLoss)
+	assert.EqualValues(t, badDebt, perpKeeper.GetInsuranceFund(ctx, common.DenomNUSD).TotalSocializedLoss)
+
+	t.Log("the margins of the open positions and the cross margin collateral are cut pro rata")
+	keptRatio := sdk.OneDec().Sub(badDebt.ToDec().Quo(sdk.NewDec(2_000)))
+	shortPosition, err := perpKeeper.GetPosition(ctx, common.Pair_BTC_NUSD, short)
+	require.NoError(t, err)
+	assert.EqualValues(t, sdk.NewDec(1_000).Mul(keptRatio), shortPosition.Margin)
+	longPosition, err := perpKeeper.GetPosition(ctx, common.Pair_BTC_NUSD, long)
+	require.NoError(t, err)
+	assert.EqualValues(t, sdk.NewDec(500).Mul(keptRatio), longPosition.Margin)
+	account, err := perpKeeper.GetCrossMarginAccount(ctx, crossMarginTrader)
+	require.NoError(t, err)
+	assert.EqualValues(t, sdk.NewDec(500).Mul(keptRatio).TruncateInt(), account.Collateral.AmountOf(common.DenomNUSD))
+	assert.EqualValues(t, sdk.NewDec(2_000).Sub(badDebt.ToDec()), perpKeeper.TotalMargins.GetOr(ctx, common.DenomNUSD, sdk.ZeroDec()))
+
+	t.Log("the cut is only written when the position is next updated")
+	storedShort, err := perpKeeper.Positions.Get(ctx, collections.Join(common.Pair_BTC_NUSD, short))
+	require.NoError(t, err)
+	assert.EqualValues(t, sdk.NewDec(1_000), storedShort.Margin)
+	perpKeeper.SetPosition(ctx, shortPosition)
+	storedShort, err = perpKeeper.Positions.Get(ctx, collections.Join(common.Pair_BTC_NUSD, short))
+	require.NoError(t, err)
+	assert.EqualValues(t, shortPosition.Margin, storedShort.Margin)
+	assert.EqualValues(t, 1, storedShort.SocializedLossCursor)
+	shortPosition, err = perpKeeper.GetPosition(ctx, common.Pair_BTC_NUSD, short)
+	require.NoError(t, err)
+	assert.EqualValues(t, storedShort.Margin, shortPosition.Margin)
+	assert.EqualValues(t, sdk.NewDec(2_000).Sub(badDebt.ToDec()), perpKeeper.TotalMargins.GetOr(ctx, common.DenomNUSD, sdk.ZeroDec()))
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/NibiruChain/nibiru/collections"
 
@@ -15,6 +16,13 @@ import (
 	"github.com/NibiruChain/nibiru/x/perp/types"
 )
 
+// The namespaces of the collections which the queries also paginate over directly.
+const (
+	positionsNamespace    collections.Namespace = 0
+	shortfallsNamespace   collections.Namespace = 12
+	fundingRatesNamespace collections.Namespace = 16
+)
+
 type Keeper struct {
 	cdc           codec.BinaryCodec
 	storeKey      sdk.StoreKey
@@ -26,9 +34,60 @@ type Keeper struct {
 	VpoolKeeper     types.VpoolKeeper
 	EpochKeeper     types.EpochKeeper
 
+	hooks types.PerpHooks
+
 	Positions      collections.Map[collections.Pair[common.AssetPair, sdk.AccAddress], types.Position]
 	PairsMetadata  collections.Map[common.AssetPair, types.PairMetadata]
 	PrepaidBadDebt collections.Map[string, types.PrepaidBadDebt]
+	Orders         collections.IndexedMap[uint64, types.Order, OrdersIndexes]
+	OrderID        collections.Sequence
+
+	CrossMarginAccounts collections.Map[sdk.AccAddress, types.CrossMarginAccount]
+	// SettledPairs holds the markets of settled vpools whose positions have all been settled.
+	SettledPairs collections.KeySet[common.AssetPair]
+	// SettlementCursors holds the last order and position visited by the settlement sweep of each settled market.
+	SettlementCursors collections.Map[common.AssetPair, types.SettlementCursor]
+
+	InsuranceFunds collections.Map[string, types.InsuranceFund]
+	// Shortfalls records the bad debts covered by the payout waterfall, keyed by denom and shortfall id.
+	Shortfalls  collections.Map[collections.Pair[string, uint64], types.Shortfall]
+	ShortfallID collections.Sequence
+
+	// OpenInterests tracks the aggregate long and short exposure of each pair.
+	// It is derived from the positions, so it is rebuilt at genesis rather than exported.
+	OpenInterests collections.Map[common.AssetPair, types.OpenInterest]
+	// TraderVolumes holds the notional traded by each trader, keyed by trader and day.
+	TraderVolumes collections.Map[collections.Pair[sdk.AccAddress, uint64], sdk.Dec]
+	// FundingRates holds the funding history of each pair, keyed by pair and funding epoch.
+	FundingRates collections.Map[collections.Pair[common.AssetPair, uint64], types.FundingRate]
+	// LiquidationAuctions holds the running liquidation auctions, keyed by the pair and trader of the auctioned position.
+	LiquidationAuctions collections.Map[collections.Pair[common.AssetPair, sdk.AccAddress], types.LiquidationAuction]
+	// SocializedLosses holds the losses taken from the margins of each denom, keyed by denom and loss id.
+	// The positions and cross margin accounts take the losses lazily, see GetPosition.
+	SocializedLosses collections.Map[collections.Pair[string, uint64], types.SocializedLoss]
+	SocializedLossID collections.Sequence
+	// TotalMargins holds the sum of the margins of the positions and of the cross margin collateral of each denom,
+	// net of the socialized losses. It is derived from the positions and accounts, so it is rebuilt at genesis.
+	TotalMargins collections.Map[string, sdk.Dec]
+	// ADLLongs and ADLShorts index the long and short positions of each pair by entry price, see GetADLQueue.
+	// They are derived from the positions, so they are rebuilt at genesis.
+	ADLLongs  collections.KeySet[collections.Pair[common.AssetPair, collections.Pair[sdk.Dec, sdk.AccAddress]]]
+	ADLShorts collections.KeySet[collections.Pair[common.AssetPair, collections.Pair[sdk.Dec, sdk.AccAddress]]]
+}
+
+type OrdersIndexes struct {
+	// Trader is the index that maps orders to the trader who placed them.
+	Trader collections.MultiIndex[sdk.AccAddress, uint64, types.Order]
+	// Expiry is the index that maps orders to the time they expire at.
+	Expiry collections.MultiIndex[time.Time, uint64, types.Order]
+	// TriggerPrice is the order book, which maps orders to their pair and trigger price.
+	TriggerPrice TriggerPriceIndex
+	// Pair is the index that maps orders to their pair, in the order they were placed.
+	Pair collections.MultiIndex[common.AssetPair, uint64, types.Order]
+}
+
+func (o OrdersIndexes) IndexerList() []collections.Indexer[uint64, types.Order] {
+	return []collections.Indexer[uint64, types.Order]{o.Trader, o.Expiry, o.TriggerPrice, o.Pair}
 }
 
 // NewKeeper Creates a new x/perp Keeper instance.
@@ -63,13 +122,96 @@ func NewKeeper(
 		VpoolKeeper:     vpoolKeeper,
 		EpochKeeper:     epochKeeper,
 		Positions: collections.NewMap(
-			storeKey, 0,
+			storeKey, positionsNamespace,
 			collections.PairKeyEncoder(common.AssetPairKeyEncoder, collections.AccAddressKeyEncoder),
 			collections.ProtoValueEncoder[types.Position](cdc),
 		),
 		PairsMetadata:  collections.NewMap(storeKey, 1, common.AssetPairKeyEncoder, collections.ProtoValueEncoder[types.PairMetadata](cdc)),
 		PrepaidBadDebt: collections.NewMap(storeKey, 2, collections.StringKeyEncoder, collections.ProtoValueEncoder[types.PrepaidBadDebt](cdc)),
+		Orders: collections.NewIndexedMap(
+			storeKey, 3,
+			collections.Uint64KeyEncoder, collections.ProtoValueEncoder[types.Order](cdc),
+			OrdersIndexes{
+				Trader: collections.NewMultiIndex(storeKey, 5, collections.AccAddressKeyEncoder, collections.Uint64KeyEncoder, func(o types.Order) sdk.AccAddress {
+					return sdk.MustAccAddressFromBech32(o.TraderAddress)
+				}),
+				Expiry: collections.NewMultiIndex(storeKey, 6, collections.TimeKeyEncoder, collections.Uint64KeyEncoder, func(o types.Order) time.Time {
+					return o.Expiry
+				}),
+				TriggerPrice: NewTriggerPriceIndex(storeKey, 7, 8),
+				Pair: collections.NewMultiIndex(storeKey, 22, common.AssetPairKeyEncoder, collections.Uint64KeyEncoder, func(o types.Order) common.AssetPair {
+					return o.Pair
+				}),
+			}),
+		OrderID: collections.NewSequence(storeKey, 4),
+		CrossMarginAccounts: collections.NewMap(
+			storeKey, 9,
+			collections.AccAddressKeyEncoder, collections.ProtoValueEncoder[types.CrossMarginAccount](cdc),
+		),
+		SettledPairs: collections.NewKeySet(storeKey, 10, common.AssetPairKeyEncoder),
+		InsuranceFunds: collections.NewMap(
+			storeKey, 11,
+			collections.StringKeyEncoder, collections.ProtoValueEncoder[types.InsuranceFund](cdc),
+		),
+		Shortfalls: collections.NewMap(
+			storeKey, shortfallsNamespace,
+			collections.PairKeyEncoder(collections.StringKeyEncoder, collections.Uint64KeyEncoder),
+			collections.ProtoValueEncoder[types.Shortfall](cdc),
+		),
+		ShortfallID: collections.NewSequence(storeKey, 13),
+		OpenInterests: collections.NewMap(
+			storeKey, 14,
+			common.AssetPairKeyEncoder, collections.ProtoValueEncoder[types.OpenInterest](cdc),
+		),
+		TraderVolumes: collections.NewMap(
+			storeKey, 15,
+			collections.PairKeyEncoder(collections.AccAddressKeyEncoder, collections.Uint64KeyEncoder),
+			collections.DecValueEncoder,
+		),
+		FundingRates: collections.NewMap(
+			storeKey, fundingRatesNamespace,
+			collections.PairKeyEncoder(common.AssetPairKeyEncoder, collections.Uint64KeyEncoder),
+			collections.ProtoValueEncoder[types.FundingRate](cdc),
+		),
+		LiquidationAuctions: collections.NewMap(
+			storeKey, 17,
+			collections.PairKeyEncoder(common.AssetPairKeyEncoder, collections.AccAddressKeyEncoder),
+			collections.ProtoValueEncoder[types.LiquidationAuction](cdc),
+		),
+		SettlementCursors: collections.NewMap(
+			storeKey, 18,
+			common.AssetPairKeyEncoder, collections.ProtoValueEncoder[types.SettlementCursor](cdc),
+		),
+		SocializedLosses: collections.NewMap(
+			storeKey, 19,
+			collections.PairKeyEncoder(collections.StringKeyEncoder, collections.Uint64KeyEncoder),
+			collections.ProtoValueEncoder[types.SocializedLoss](cdc),
+		),
+		SocializedLossID: collections.NewSequence(storeKey, 20),
+		TotalMargins:     collections.NewMap(storeKey, 21, collections.StringKeyEncoder, collections.DecValueEncoder),
+		ADLLongs: collections.NewKeySet(
+			storeKey, 23,
+			collections.PairKeyEncoder(common.AssetPairKeyEncoder,
+				collections.PairKeyEncoder(collections.DecKeyEncoder, collections.AccAddressKeyEncoder)),
+		),
+		ADLShorts: collections.NewKeySet(
+			storeKey, 24,
+			collections.PairKeyEncoder(common.AssetPairKeyEncoder,
+				collections.PairKeyEncoder(collections.DecKeyEncoder, collections.AccAddressKeyEncoder)),
+		),
+	}
+}
+
+// SetHooks sets the hooks run on the lifecycle of the positions. The keeper is
+// copied into the other modules, so the hooks must be set right after it is created.
+func (k *Keeper) SetHooks(hooks types.PerpHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set perp hooks twice")
 	}
+
+	k.hooks = hooks
+
+	return k
 }
 
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
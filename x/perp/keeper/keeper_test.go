@@ -35,6 +35,17 @@ func TestGetAndSetParams(t *testing.T) {
 					LiquidationFeeRatio:     sdk.OneDec(),
 					PartialLiquidationRatio: sdk.OneDec(),
 					TwapLookbackWindow:      15 * time.Minute,
+					OrderExecutionReward:    sdk.ZeroInt(),
+					MaxOrderDuration:        24 * time.Hour,
+
+					InsuranceFundFeeShare:         sdk.OneDec(),
+					InsuranceFundLiquidationShare: sdk.OneDec(),
+					BadDebtPayoutOrder:            []types.BadDebtPayer{types.BadDebtPayer_SOCIALIZED_LOSS},
+					MaxFundingRate:                sdk.ZeroDec(),
+					PremiumSmoothingFactor:        sdk.ZeroDec(),
+					LiquidationAuctionThreshold:   sdk.ZeroDec(),
+					LiquidationAuctionMaxDiscount: sdk.ZeroDec(),
+					LiquidationAuctionDuration:    time.Minute,
 				}
 				return params
 			},
@@ -3,8 +3,6 @@ package keeper
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
-	"github.com/NibiruChain/nibiru/collections"
-
 	"github.com/NibiruChain/nibiru/x/common"
 	"github.com/NibiruChain/nibiru/x/perp/types"
 	vpooltypes "github.com/NibiruChain/nibiru/x/vpool/types"
@@ -15,6 +13,10 @@ import (
 
 required margin maintenance ratio.
 
+A position fully liquidated whose notional is above the liquidation auction
+threshold is put up for a liquidation auction instead of being closed on the
+vpool, and the fees are paid when the auction ends.
+
 args:
   - liquidatorAddr: the liquidator who is executing the liquidation
   - pair: the asset pair
@@ -39,49 +41,42 @@ func (k Keeper) Liquidate(
 		return sdk.Coin{}, sdk.Coin{}, err
 	}
 
-	position, err := k.Positions.Get(ctx, collections.Join(pair, traderAddr))
-	if err != nil {
+	if err = k.requireNotInAuction(ctx, pair, traderAddr); err != nil {
 		return sdk.Coin{}, sdk.Coin{}, err
 	}
 
-	marginRatio, err := k.GetMarginRatio(
-		ctx,
-		position,
-		types.MarginCalculationPriceOption_MAX_PNL,
-	)
+	position, err := k.GetPosition(ctx, pair, traderAddr)
 	if err != nil {
 		return sdk.Coin{}, sdk.Coin{}, err
 	}
 
-	if k.VpoolKeeper.IsOverSpreadLimit(ctx, pair) {
-		marginRatioBasedOnOracle, err := k.GetMarginRatio(
-			ctx, position, types.MarginCalculationPriceOption_INDEX)
-		if err != nil {
-			return sdk.Coin{}, sdk.Coin{}, err
-		}
-
-		marginRatio = sdk.MaxDec(marginRatio, marginRatioBasedOnOracle)
-	}
-
-	params := k.GetParams(ctx)
-
-	maintenanceMarginRatio := k.VpoolKeeper.GetMaintenanceMarginRatio(ctx, pair)
-	err = requireMoreMarginRatio(marginRatio, maintenanceMarginRatio, false)
+	check, err := k.checkLiquidation(ctx, position)
 	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+	if !check.isLiquidatable {
 		return sdk.Coin{}, sdk.Coin{}, types.ErrMarginHighEnough
 	}
 
-	marginRatioBasedOnSpot, err := k.GetMarginRatio(
-		ctx, position, types.MarginCalculationPriceOption_SPOT)
-	if err != nil {
-		return sdk.Coin{}, sdk.Coin{}, err
+	if check.isFullLiquidation {
+		shouldAuction, err := k.shouldAuctionLiquidation(ctx, position)
+		if err != nil {
+			return sdk.Coin{}, sdk.Coin{}, err
+		}
+		if shouldAuction {
+			// the fees are paid when the auction ends
+			if _, err = k.startLiquidationAuction(ctx, liquidatorAddr, position); err != nil {
+				return sdk.Coin{}, sdk.Coin{}, err
+			}
+			return sdk.NewCoin(pair.QuoteDenom(), sdk.ZeroInt()), sdk.NewCoin(pair.QuoteDenom(), sdk.ZeroInt()), nil
+		}
 	}
 
 	var liquidationResponse types.LiquidateResp
-	if marginRatioBasedOnSpot.GTE(params.LiquidationFeeRatio) {
-		liquidationResponse, err = k.ExecutePartialLiquidation(ctx, liquidatorAddr, &position)
-	} else {
+	if check.isFullLiquidation {
 		liquidationResponse, err = k.ExecuteFullLiquidation(ctx, liquidatorAddr, &position)
+	} else {
+		liquidationResponse, err = k.ExecutePartialLiquidation(ctx, liquidatorAddr, &position)
 	}
 	if err != nil {
 		return sdk.Coin{}, sdk.Coin{}, err
@@ -100,6 +95,111 @@ func (k Keeper) Liquidate(
 	return feeToLiquidator, feeToFund, nil
 }
 
+// liquidationCheck is the outcome of checking whether a position can be liquidated.
+type liquidationCheck struct {
+	isLiquidatable    bool
+	isFullLiquidation bool
+	// marginRatio is the margin ratio compared against the maintenance margin ratio
+	marginRatio            sdk.Dec
+	maintenanceMarginRatio sdk.Dec
+	// marginRatioSpot decides between a partial and a full liquidation, only set if the position is liquidatable.
+	// It is measured at the full liquidation source of the margin price policy.
+	marginRatioSpot sdk.Dec
+}
+
+/*
+checkLiquidation checks whether a position is below the maintenance margin
+ratio and whether it would be liquidated partially or entirely.
+
+The margin ratio of an isolated margin position is measured with the margin
+price policy of its pair. By default, it is the MAX_PNL margin ratio, or the
+higher of the MAX_PNL and INDEX margin ratios when the mark price is too far
+from the index price, and the SPOT margin ratio decides between a partial and a
+full liquidation. Cross margin accounts are always measured with the default
+policy, account-wide.
+
+args:
+  - ctx: cosmos-sdk context
+  - position: the position to check
+
+ret:
+  - check: whether and how the position can be liquidated
+  - err: error
+*/
+func (k Keeper) checkLiquidation(ctx sdk.Context, position types.Position) (check liquidationCheck, err error) {
+	traderAddr, err := sdk.AccAddressFromBech32(position.TraderAddress)
+	if err != nil {
+		return liquidationCheck{}, err
+	}
+	isCrossMargin := k.isCrossMarginAccount(ctx, traderAddr)
+
+	policy := types.DefaultMarginPricePolicy()
+	if !isCrossMargin {
+		policy, _ = k.getMarginPricePolicy(ctx, position.Pair)
+	}
+
+	if isCrossMargin {
+		check.marginRatio, err = k.getLiquidationMarginRatio(ctx, position, types.MarginCalculationPriceOption_MAX_PNL)
+	} else {
+		check.marginRatio, err = k.getSourcesMarginRatio(ctx, position, policy.Sources, policy.Preference)
+	}
+	if err != nil {
+		return liquidationCheck{}, err
+	}
+
+	if policy.IndexOverSpreadLimit && k.VpoolKeeper.IsOverSpreadLimit(ctx, position.Pair) {
+		marginRatioBasedOnOracle, err := k.getLiquidationMarginRatio(ctx, position, types.MarginCalculationPriceOption_INDEX)
+		if err != nil {
+			return liquidationCheck{}, err
+		}
+
+		check.marginRatio = sdk.MaxDec(check.marginRatio, marginRatioBasedOnOracle)
+	}
+
+	if isCrossMargin {
+		_, check.maintenanceMarginRatio, err = k.GetCrossMarginRatio(
+			ctx, traderAddr, position.Pair.QuoteDenom(), types.MarginCalculationPriceOption_SPOT)
+		if err != nil {
+			return liquidationCheck{}, err
+		}
+	} else {
+		check.maintenanceMarginRatio = k.VpoolKeeper.GetMaintenanceMarginRatio(ctx, position.Pair)
+	}
+	if requireMoreMarginRatio(check.marginRatio, check.maintenanceMarginRatio, false) != nil {
+		return check, nil
+	}
+	check.isLiquidatable = true
+
+	if isCrossMargin {
+		check.marginRatioSpot, err = k.getLiquidationMarginRatio(ctx, position, types.MarginCalculationPriceOption_SPOT)
+	} else {
+		check.marginRatioSpot, err = k.getSourcesMarginRatio(
+			ctx, position, []types.MarginPriceSource{policy.FullLiquidationSource}, types.PnLPreferenceOption_MAX)
+	}
+	if err != nil {
+		return liquidationCheck{}, err
+	}
+	check.isFullLiquidation = check.marginRatioSpot.LT(k.GetParams(ctx).LiquidationFeeRatio)
+
+	return check, nil
+}
+
+// getLiquidationMarginRatio returns the margin ratio a position is liquidated
+// on, which is the account-wide margin ratio for cross margin accounts.
+func (k Keeper) getLiquidationMarginRatio(
+	ctx sdk.Context, position types.Position, priceOption types.MarginCalculationPriceOption,
+) (marginRatio sdk.Dec, err error) {
+	traderAddr, err := sdk.AccAddressFromBech32(position.TraderAddress)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	if k.isCrossMarginAccount(ctx, traderAddr) {
+		marginRatio, _, err = k.GetCrossMarginRatio(ctx, traderAddr, position.Pair.QuoteDenom(), priceOption)
+		return marginRatio, err
+	}
+	return k.GetMarginRatio(ctx, position, priceOption)
+}
+
 /*
 Fully liquidates a position. It is assumed that the margin ratio has already been
 checked prior to calling this method.
@@ -149,12 +249,21 @@ func (k Keeper) ExecuteFullLiquidation(
 		remainMargin = remainMargin.Sub(feeToLiquidator)
 	}
 
+	// Bad debt of a cross margin account is first paid for by its collateral
+	if totalBadDebt.IsPositive() {
+		totalBadDebt, err = k.coverBadDebtWithCrossMargin(ctx, traderAddr, position.Pair.QuoteDenom(), totalBadDebt)
+		if err != nil {
+			return types.LiquidateResp{}, err
+		}
+	}
+
 	// Realize bad debt
 	if totalBadDebt.IsPositive() {
 		if err = k.realizeBadDebt(
 			ctx,
 			position.Pair.QuoteDenom(),
 			totalBadDebt.RoundInt(),
+			position,
 		); err != nil {
 			return types.LiquidateResp{}, err
 		}
@@ -182,7 +291,7 @@ func (k Keeper) ExecuteFullLiquidation(
 		return types.LiquidateResp{}, err
 	}
 
-	err = ctx.EventManager().EmitTypedEvent(&types.PositionLiquidatedEvent{
+	err = k.emitPositionLiquidated(ctx, &types.PositionLiquidatedEvent{
 		Pair:                  position.Pair.String(),
 		TraderAddress:         traderAddr.String(),
 		ExchangedQuoteAmount:  positionResp.ExchangedNotionalValue,
@@ -231,8 +340,22 @@ func (k Keeper) distributeLiquidateRewards(
 	// Distribution of rewards
 	// --------------------------------------------------------------
 
-	// Transfer fee from vault to PerpEF
-	feeToPerpEF := liquidateResp.FeeToPerpEcosystemFund
+	// Transfer the insurance fund share of the PerpEF fee from vault to the insurance fund
+	feeToInsuranceFund, feeToPerpEF := k.splitLiquidationFee(ctx, liquidateResp.FeeToPerpEcosystemFund)
+	if feeToInsuranceFund.IsPositive() {
+		coinToInsuranceFund := sdk.NewCoin(pair.QuoteDenom(), feeToInsuranceFund)
+		if err = k.BankKeeper.SendCoinsFromModuleToModule(
+			ctx,
+			/* from */ types.VaultModuleAccount,
+			/* to */ types.InsuranceFundModuleAccount,
+			sdk.NewCoins(coinToInsuranceFund),
+		); err != nil {
+			return err
+		}
+		k.recordInsuranceFundContribution(ctx, coinToInsuranceFund)
+	}
+
+	// Transfer the rest of the fee from vault to PerpEF
 	if feeToPerpEF.IsPositive() {
 		coinToPerpEF := sdk.NewCoin(
 			pair.QuoteDenom(), feeToPerpEF)
@@ -258,6 +381,105 @@ func (k Keeper) distributeLiquidateRewards(
 	return nil
 }
 
+// splitLiquidationFee splits the liquidation fee owed to the funds between the insurance fund and the PerpEF.
+func (k Keeper) splitLiquidationFee(ctx sdk.Context, fee sdk.Int) (feeToInsuranceFund sdk.Int, feeToPerpEF sdk.Int) {
+	feeToInsuranceFund = k.GetParams(ctx).InsuranceFundLiquidationShare.MulInt(fee).TruncateInt()
+	return feeToInsuranceFund, fee.Sub(feeToInsuranceFund)
+}
+
+/*
+PreviewLiquidation simulates the liquidation of a position without changing
+state, by liquidating it on a cached context that is never written. A position
+which would be put up for a liquidation auction isn't simulated, its fees and
+bad debt are zero since they are only known when the auction ends.
+
+args:
+  - ctx: cosmos-sdk context
+  - pair: the asset pair
+  - traderAddr: the trader who owns the position
+  - liquidatorAddr: the liquidator, optional. If empty, the fee to the
+    liquidator is previewed without a liquidator and the liquidator of the
+    response is empty.
+
+ret:
+  - liquidationResp: the outcome of the liquidation
+  - isFullLiquidation: whether the position would be liquidated entirely
+  - isAuction: whether the position would be put up for a liquidation auction
+  - err: ErrMarginHighEnough if the position cannot be liquidated
+*/
+func (k Keeper) PreviewLiquidation(
+	ctx sdk.Context, pair common.AssetPair, traderAddr sdk.AccAddress, liquidatorAddr sdk.AccAddress,
+) (liquidationResp types.LiquidateResp, isFullLiquidation bool, isAuction bool, err error) {
+	if !liquidatorAddr.Empty() && !k.canLiquidate(ctx, liquidatorAddr) {
+		return types.LiquidateResp{}, false, false, types.ErrUnauthorized.Wrapf("not allowed to liquidate: %s", traderAddr)
+	}
+	if err = k.requireVpool(ctx, pair); err != nil {
+		return types.LiquidateResp{}, false, false, err
+	}
+	if err = k.requireNotInAuction(ctx, pair, traderAddr); err != nil {
+		return types.LiquidateResp{}, false, false, err
+	}
+
+	position, err := k.GetPosition(ctx, pair, traderAddr)
+	if err != nil {
+		return types.LiquidateResp{}, false, false, err
+	}
+
+	check, err := k.checkLiquidation(ctx, position)
+	if err != nil {
+		return types.LiquidateResp{}, false, false, err
+	}
+	if !check.isLiquidatable {
+		return types.LiquidateResp{}, false, false, types.ErrMarginHighEnough
+	}
+
+	if check.isFullLiquidation {
+		shouldAuction, err := k.shouldAuctionLiquidation(ctx, position)
+		if err != nil {
+			return types.LiquidateResp{}, false, false, err
+		}
+		if shouldAuction {
+			return types.LiquidateResp{
+				BadDebt:                sdk.ZeroInt(),
+				FeeToLiquidator:        sdk.ZeroInt(),
+				FeeToPerpEcosystemFund: sdk.ZeroInt(),
+				Liquidator:             liquidatorAddr.String(),
+				PositionResp: &types.PositionResp{
+					Position:               &position,
+					ExchangedNotionalValue: sdk.ZeroDec(),
+					ExchangedPositionSize:  sdk.ZeroDec(),
+					BadDebt:                sdk.ZeroDec(),
+					FundingPayment:         sdk.ZeroDec(),
+					RealizedPnl:            sdk.ZeroDec(),
+					UnrealizedPnlAfter:     sdk.ZeroDec(),
+					MarginToVault:          sdk.ZeroDec(),
+					PositionNotional:       sdk.ZeroDec(),
+				},
+			}, true, true, nil
+		}
+	}
+
+	// without a liquidator, the trader receives the fee to the liquidator in
+	// the cached context
+	feeReceiver := liquidatorAddr
+	if feeReceiver.Empty() {
+		feeReceiver = traderAddr
+	}
+	cachedCtx, _ := ctx.CacheContext()
+	cachedCtx = cachedCtx.WithEventManager(sdk.NewEventManager())
+	if check.isFullLiquidation {
+		liquidationResp, err = k.ExecuteFullLiquidation(cachedCtx, feeReceiver, &position)
+	} else {
+		liquidationResp, err = k.ExecutePartialLiquidation(cachedCtx, feeReceiver, &position)
+	}
+	if err != nil {
+		return types.LiquidateResp{}, false, false, err
+	}
+	liquidationResp.Liquidator = liquidatorAddr.String()
+
+	return liquidationResp, check.isFullLiquidation, false, nil
+}
+
 // ExecutePartialLiquidation partially liquidates a position
 func (k Keeper) ExecutePartialLiquidation(
 	ctx sdk.Context, liquidator sdk.AccAddress, currentPosition *types.Position,
@@ -302,7 +524,7 @@ func (k Keeper) ExecutePartialLiquidation(
 		Mul(params.LiquidationFeeRatio)
 	positionResp.Position.Margin = positionResp.Position.Margin.
 		Sub(liquidationFeeAmount)
-	k.Positions.Insert(ctx, collections.Join(positionResp.Position.Pair, traderAddr), *positionResp.Position)
+	k.SetPosition(ctx, *positionResp.Position)
 
 	// Compute splits for the liquidation fee
 	feeToLiquidator := liquidationFeeAmount.QuoInt64(2)
@@ -325,7 +547,7 @@ func (k Keeper) ExecutePartialLiquidation(
 		return types.LiquidateResp{}, err
 	}
 
-	err = ctx.EventManager().EmitTypedEvent(&types.PositionLiquidatedEvent{
+	err = k.emitPositionLiquidated(ctx, &types.PositionLiquidatedEvent{
 		Pair:                  currentPosition.Pair.String(),
 		TraderAddress:         traderAddr.String(),
 		ExchangedQuoteAmount:  positionResp.ExchangedNotionalValue,
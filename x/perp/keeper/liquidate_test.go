@@ -110,10 +110,21 @@ func TestExecuteFullLiquidation(t *testing.T) {
 				params.PartialLiquidationRatio,
 				"hour",
 				15*time.Minute,
+				params.OrderExecutionReward,
+				params.MaxOrderDuration,
+				/* insuranceFundFeeShare */ sdk.ZeroDec(),
+				/* insuranceFundLiquidationShare */ sdk.ZeroDec(),
+				params.BadDebtPayoutOrder,
+				params.FundingRateHistoryLength,
+				params.MaxFundingRate,
+				params.PremiumSmoothingFactor,
+				params.LiquidationAuctionThreshold,
+				params.LiquidationAuctionMaxDiscount,
+				params.LiquidationAuctionDuration,
 			))
 			setPairMetadata(nibiruApp.PerpKeeper, ctx, types.PairMetadata{
-				Pair:                       tokenPair,
-				CumulativePremiumFractions: []sdk.Dec{sdk.OneDec()},
+				Pair:                            tokenPair,
+				LatestCumulativePremiumFraction: sdk.OneDec(),
 			})
 
 			t.Log("Fund trader account with sufficient quote")
@@ -287,11 +298,22 @@ func TestExecutePartialLiquidation(t *testing.T) {
 				partialLiquidationRatio,
 				"hour",
 				15*time.Minute,
+				params.OrderExecutionReward,
+				params.MaxOrderDuration,
+				/* insuranceFundFeeShare */ sdk.ZeroDec(),
+				/* insuranceFundLiquidationShare */ sdk.ZeroDec(),
+				params.BadDebtPayoutOrder,
+				params.FundingRateHistoryLength,
+				params.MaxFundingRate,
+				params.PremiumSmoothingFactor,
+				params.LiquidationAuctionThreshold,
+				params.LiquidationAuctionMaxDiscount,
+				params.LiquidationAuctionDuration,
 			))
 
 			setPairMetadata(nibiruApp.PerpKeeper, ctx, types.PairMetadata{
-				Pair:                       tokenPair,
-				CumulativePremiumFractions: []sdk.Dec{sdk.OneDec()},
+				Pair:                            tokenPair,
+				LatestCumulativePremiumFraction: sdk.OneDec(),
 			})
 
 			t.Log("Fund trader account with sufficient quote")
@@ -376,7 +398,7 @@ func TestExecutePartialLiquidation(t *testing.T) {
 }
 
 func setPosition(k perpkeeper.Keeper, ctx sdk.Context, pos types.Position) {
-	k.Positions.Insert(ctx, collections.Join(pos.Pair, sdk.MustAccAddressFromBech32(pos.TraderAddress)), pos)
+	k.SetPosition(ctx, pos)
 }
 
 func setPairMetadata(k perpkeeper.Keeper, ctx sdk.Context, pm types.PairMetadata) {
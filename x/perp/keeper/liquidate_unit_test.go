@@ -103,10 +103,8 @@ func TestLiquidateIntoPartialLiquidation(t *testing.T) {
 
 			t.Log("set pair metadata")
 			setPairMetadata(perpKeeper, ctx, types.PairMetadata{
-				Pair: common.Pair_BTC_NUSD,
-				CumulativePremiumFractions: []sdk.Dec{
-					sdk.ZeroDec(),
-				},
+				Pair:                            common.Pair_BTC_NUSD,
+				LatestCumulativePremiumFraction: sdk.ZeroDec(),
 			})
 
 			t.Log("mock vpool keeper")
@@ -168,12 +166,7 @@ func TestLiquidateIntoPartialLiquidation(t *testing.T) {
 					sdk.NewCoins(tc.expectedLiquidatorFee),
 				).
 				Return(nil)
-			mocks.mockBankKeeper.EXPECT().
-				SendCoinsFromModuleToModule(
-					ctx, types.VaultModuleAccount, types.PerpEFModuleAccount,
-					sdk.NewCoins(tc.expectedPerpEFFee),
-				).
-				Return(nil)
+			expectLiquidationFeeToFunds(mocks, ctx, tc.expectedPerpEFFee)
 
 			t.Log("execute liquidation")
 			setLiquidator(ctx, perpKeeper, liquidatorAddr)
@@ -277,10 +270,8 @@ func TestLiquidateIntoFullLiquidation(t *testing.T) {
 
 			t.Log("set pair metadata")
 			setPairMetadata(perpKeeper, ctx, types.PairMetadata{
-				Pair: common.Pair_BTC_NUSD,
-				CumulativePremiumFractions: []sdk.Dec{
-					sdk.ZeroDec(),
-				},
+				Pair:                            common.Pair_BTC_NUSD,
+				LatestCumulativePremiumFraction: sdk.ZeroDec(),
 			})
 
 			t.Log("mock vpool keeper")
@@ -334,12 +325,7 @@ func TestLiquidateIntoFullLiquidation(t *testing.T) {
 				).
 				Return(nil)
 			if tc.expectedPerpEFFee.Amount.IsPositive() {
-				mocks.mockBankKeeper.EXPECT().
-					SendCoinsFromModuleToModule(
-						ctx, types.VaultModuleAccount, types.PerpEFModuleAccount,
-						sdk.NewCoins(tc.expectedPerpEFFee),
-					).
-					Return(nil)
+				expectLiquidationFeeToFunds(mocks, ctx, tc.expectedPerpEFFee)
 			}
 
 			t.Log("execute liquidation")
@@ -447,10 +433,8 @@ func TestLiquidateIntoFullLiquidationWithBadDebt(t *testing.T) {
 
 			t.Log("set pair metadata")
 			setPairMetadata(perpKeeper, ctx, types.PairMetadata{
-				Pair: common.Pair_BTC_NUSD,
-				CumulativePremiumFractions: []sdk.Dec{
-					sdk.ZeroDec(),
-				},
+				Pair:                            common.Pair_BTC_NUSD,
+				LatestCumulativePremiumFraction: sdk.ZeroDec(),
 			})
 
 			t.Log("mock vpool keeper")
@@ -503,17 +487,10 @@ func TestLiquidateIntoFullLiquidationWithBadDebt(t *testing.T) {
 					sdk.NewCoins(tc.expectedLiquidatorFee),
 				).
 				Return(nil)
-			mocks.mockBankKeeper.EXPECT().
-				SendCoinsFromModuleToModule(
-					ctx, types.PerpEFModuleAccount, types.VaultModuleAccount,
-					sdk.NewCoins(
-						sdk.NewCoin(
-							common.DenomNUSD,
-							tc.expectedLiquidationBadDebt.Add(tc.expectedPositionBadDebt).RoundInt(),
-						),
-					),
-				).
-				Return(nil)
+			t.Log("the empty insurance fund leaves the bad debt to the perpEF")
+			expectBadDebtPayout(mocks, ctx, types.InsuranceFundModuleAccount, common.DenomNUSD, 0, 0)
+			expectBadDebtPayout(mocks, ctx, types.PerpEFModuleAccount, common.DenomNUSD, math.MaxInt64,
+				tc.expectedLiquidationBadDebt.Add(tc.expectedPositionBadDebt).RoundInt().Int64())
 
 			t.Log("execute liquidation")
 			setLiquidator(ctx, perpKeeper, liquidatorAddr)
@@ -618,10 +595,7 @@ func TestDistributeLiquidateRewards(t *testing.T) {
 				mocks.mockBankKeeper.
 					EXPECT().GetBalance(ctx, authtypes.NewModuleAddress(types.VaultModuleAccount), "unusd").
 					Return(sdk.NewCoin("unusd", sdk.NewInt(math.MaxInt64)))
-				mocks.mockBankKeeper.EXPECT().SendCoinsFromModuleToModule(
-					ctx, types.VaultModuleAccount, types.PerpEFModuleAccount,
-					sdk.NewCoins(sdk.NewCoin("unusd", sdk.OneInt())),
-				).Return(nil)
+				expectLiquidationFeeToFunds(mocks, ctx, sdk.NewCoin("unusd", sdk.OneInt()))
 				mocks.mockBankKeeper.EXPECT().SendCoinsFromModuleToAccount(
 					ctx, types.VaultModuleAccount, liquidator,
 					sdk.NewCoins(sdk.NewCoin("unusd", sdk.OneInt())),
@@ -896,10 +870,7 @@ func TestKeeper_ExecuteFullLiquidation(t *testing.T) {
 
 			t.Log("mock bank keeper")
 			if tc.expectedFundsToPerpEF.IsPositive() {
-				mocks.mockBankKeeper.EXPECT().SendCoinsFromModuleToModule(
-					ctx, types.VaultModuleAccount, types.PerpEFModuleAccount,
-					sdk.NewCoins(sdk.NewCoin("unusd", tc.expectedFundsToPerpEF)),
-				).Return(nil)
+				expectLiquidationFeeToFunds(mocks, ctx, sdk.NewCoin("unusd", tc.expectedFundsToPerpEF))
 			}
 			if tc.expectedFundsToLiquidator.IsPositive() {
 				mocks.mockAccountKeeper.
@@ -914,10 +885,9 @@ func TestKeeper_ExecuteFullLiquidation(t *testing.T) {
 				).Return(nil)
 			}
 			if tc.expectedLiquidationBadDebt.IsPositive() {
-				mocks.mockBankKeeper.EXPECT().SendCoinsFromModuleToModule(
-					ctx, types.PerpEFModuleAccount, types.VaultModuleAccount,
-					sdk.NewCoins(sdk.NewCoin("unusd", tc.expectedLiquidationBadDebt)),
-				)
+				expectBadDebtPayout(mocks, ctx, types.InsuranceFundModuleAccount, "unusd", 0, 0)
+				expectBadDebtPayout(mocks, ctx, types.PerpEFModuleAccount, "unusd", math.MaxInt64,
+					tc.expectedLiquidationBadDebt.Int64())
 			}
 
 			t.Log("setup perp keeper params")
@@ -925,10 +895,8 @@ func TestKeeper_ExecuteFullLiquidation(t *testing.T) {
 			newParams.LiquidationFeeRatio = tc.liquidationFee
 			perpKeeper.SetParams(ctx, newParams)
 			setPairMetadata(perpKeeper, ctx, types.PairMetadata{
-				Pair: common.Pair_BTC_NUSD,
-				CumulativePremiumFractions: []sdk.Dec{
-					sdk.ZeroDec(), // zero funding payment for this test case
-				},
+				Pair:                            common.Pair_BTC_NUSD,
+				LatestCumulativePremiumFraction: sdk.ZeroDec(), // zero funding payment for this test case
 			})
 
 			t.Log("mock vpool")
@@ -1193,10 +1161,7 @@ func TestKeeper_ExecutePartialLiquidation(t *testing.T) {
 
 			t.Log("mock bank keeper")
 			if tc.expectedFundsToPerpEF.IsPositive() {
-				mocks.mockBankKeeper.EXPECT().SendCoinsFromModuleToModule(
-					ctx, types.VaultModuleAccount, types.PerpEFModuleAccount,
-					sdk.NewCoins(sdk.NewCoin("unusd", tc.expectedFundsToPerpEF)),
-				).Return(nil)
+				expectLiquidationFeeToFunds(mocks, ctx, sdk.NewCoin("unusd", tc.expectedFundsToPerpEF))
 			}
 
 			if tc.expectedFundsToLiquidator.IsPositive() {
@@ -1218,10 +1183,8 @@ func TestKeeper_ExecutePartialLiquidation(t *testing.T) {
 			newParams.PartialLiquidationRatio = tc.partialLiquidationRatio
 			perpKeeper.SetParams(ctx, newParams)
 			setPairMetadata(perpKeeper, ctx, types.PairMetadata{
-				Pair: common.Pair_BTC_NUSD,
-				CumulativePremiumFractions: []sdk.Dec{
-					sdk.ZeroDec(), // zero funding payment for this test case
-				},
+				Pair:                            common.Pair_BTC_NUSD,
+				LatestCumulativePremiumFraction: sdk.ZeroDec(), // zero funding payment for this test case
 			})
 
 			t.Log("mock vpool")
@@ -1344,3 +1307,22 @@ func setLiquidator(ctx sdk.Context, k Keeper, addr sdk.AccAddress) {
 	p.WhitelistedLiquidators = []string{addr.String()}
 	k.SetParams(ctx, p)
 }
+
+// expectLiquidationFeeToFunds mocks the transfer of the ecosystem fund
+// liquidation penalty from the vault, split between the insurance fund and
+// the perpEF with the default insurance fund liquidation share.
+func expectLiquidationFeeToFunds(mocks mockedDependencies, ctx sdk.Context, fee sdk.Coin) {
+	feeToInsuranceFund := types.DefaultParams().InsuranceFundLiquidationShare.MulInt(fee.Amount).TruncateInt()
+	if feeToInsuranceFund.IsPositive() {
+		mocks.mockBankKeeper.EXPECT().SendCoinsFromModuleToModule(
+			ctx, types.VaultModuleAccount, types.InsuranceFundModuleAccount,
+			sdk.NewCoins(sdk.NewCoin(fee.Denom, feeToInsuranceFund)),
+		).Return(nil)
+	}
+	if feeToPerpEF := fee.Amount.Sub(feeToInsuranceFund); feeToPerpEF.IsPositive() {
+		mocks.mockBankKeeper.EXPECT().SendCoinsFromModuleToModule(
+			ctx, types.VaultModuleAccount, types.PerpEFModuleAccount,
+			sdk.NewCoins(sdk.NewCoin(fee.Denom, feeToPerpEF)),
+		).Return(nil)
+	}
+}
@@ -0,0 +1,144 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/collections"
+	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/perp/types"
+)
+
+/*
+SetLiquidityDepthPolicy sets the liquidity depth policy of a pair, or removes it
+when policy is nil.
+
+args:
+  - ctx: cosmos-sdk context
+  - pair: the pair
+  - policy: the liquidity depth policy of the pair, nil to fix the depth of its vpool
+
+ret:
+  - err: error if the pair has no metadata
+*/
+func (k Keeper) SetLiquidityDepthPolicy(ctx sdk.Context, pair common.AssetPair, policy *types.LiquidityDepthPolicy) error {
+	metadata, err := k.PairsMetadata.Get(ctx, pair)
+	if err != nil {
+		return types.ErrPairMetadataNotFound.Wrap(pair.String())
+	}
+
+	metadata.LiquidityDepthPolicy = policy
+	k.PairsMetadata.Insert(ctx, pair, metadata)
+	return nil
+}
+
+// adjustPoolDepths adjusts the depth of the vpools of the pairs whose liquidity
+// depth policy follows the epoch that ended. A pair that fails to adjust is
+// logged and left untouched.
+func (k Keeper) adjustPoolDepths(ctx sdk.Context, epochIdentifier string) {
+	for _, metadata := range k.PairsMetadata.Iterate(ctx, collections.Range[common.AssetPair]{}).Values() {
+		policy := metadata.LiquidityDepthPolicy
+		if policy == nil || policy.EpochIdentifier != epochIdentifier {
+			continue
+		}
+		if !k.VpoolKeeper.ExistsPool(ctx, metadata.Pair) || k.VpoolKeeper.IsPoolSettled(ctx, metadata.Pair) {
+			continue
+		}
+
+		cachedCtx, commit := ctx.CacheContext()
+		cachedCtx = cachedCtx.WithEventManager(sdk.NewEventManager())
+		window := k.EpochKeeper.GetEpochInfo(ctx, epochIdentifier).Duration
+		if _, err := k.AdjustPoolDepth(cachedCtx, metadata.Pair, *policy, window); err != nil {
+			k.Logger(ctx).Error("failed to adjust pool depth", "pair", metadata.Pair, "error", err)
+			continue
+		}
+		commit()
+		ctx.EventManager().EmitEvents(cachedCtx.EventManager().Events())
+	}
+}
+
+/*
+AdjustPoolDepth moves the depth of the vpool of a pair towards the target of a
+liquidity depth policy, keeping its mark price unchanged, and settles the cost
+of the adjustment between the ecosystem fund and the vault.
+
+The target quote reserve grows with the notional of the open interest and the
+quote volume traded over the window, within the bounds of the policy. The
+reserves move towards it by at most the max change ratio of the policy.
+
+Like a repeg, changing the depth changes what closing the net position of the
+pair is worth: the ecosystem fund pays the change to the vault when positive,
+and receives it from the vault when negative.
+
+args:
+  - ctx: cosmos-sdk context
+  - pair: the pair whose vpool is adjusted
+  - policy: the liquidity depth policy of the pair
+  - window: how far back the traded quote volume is measured
+
+ret:
+  - cost: the change of the value of the net position, paid by the ecosystem fund
+  - err: error if the vpool cannot be adjusted or the ecosystem fund cannot pay
+*/
+func (k Keeper) AdjustPoolDepth(
+	ctx sdk.Context, pair common.AssetPair, policy types.LiquidityDepthPolicy, window time.Duration,
+) (cost sdk.Dec, err error) {
+	pool, err := k.VpoolKeeper.GetPool(ctx, pair)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	markPrice := pool.GetMarkPrice()
+
+	openInterest := k.GetOpenInterest(ctx, pair)
+	netSize := openInterest.LongSize.Sub(openInterest.ShortSize)
+	openInterestNotional := openInterest.LongSize.Add(openInterest.ShortSize).Mul(markPrice)
+	quoteVolume := k.VpoolKeeper.GetQuoteVolume(ctx, pair, ctx.BlockTime().Add(-window))
+
+	target := sdk.MaxDec(
+		policy.OpenInterestDepthRatio.Mul(openInterestNotional).Add(policy.VolumeDepthRatio.Mul(quoteVolume)),
+		policy.MinQuoteReserve,
+	)
+	if policy.MaxQuoteReserve.IsPositive() {
+		target = sdk.MinDec(target, policy.MaxQuoteReserve)
+	}
+
+	multiplier := target.Quo(pool.QuoteAssetReserve)
+	multiplier = sdk.MaxDec(multiplier, sdk.OneDec().Sub(policy.MaxChangeRatio))
+	multiplier = sdk.MinDec(multiplier, sdk.OneDec().Add(policy.MaxChangeRatio))
+	if multiplier.Equal(sdk.OneDec()) {
+		return sdk.ZeroDec(), nil
+	}
+
+	valueBefore, err := k.getNetPositionValue(ctx, pair, netSize)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	adjustedPool, err := k.VpoolKeeper.AdjustPoolDepth(ctx, pair, multiplier)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	valueAfter, err := k.getNetPositionValue(ctx, pair, netSize)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	cost = valueAfter.Sub(valueBefore)
+
+	if err = k.settleNetPositionValueChange(ctx, pair, cost); err != nil {
+		return sdk.Dec{}, err
+	}
+
+	return cost, ctx.EventManager().EmitTypedEvent(&types.PoolDepthAdjustedEvent{
+		Pair:               pair.String(),
+		Multiplier:         multiplier,
+		NetSize:            netSize,
+		Cost:               cost,
+		OldQuoteReserve:    pool.QuoteAssetReserve,
+		NewQuoteReserve:    adjustedPool.QuoteAssetReserve,
+		TargetQuoteReserve: target,
+		BlockHeight:        ctx.BlockHeight(),
+		BlockTimeMs:        ctx.BlockTime().UnixMilli(),
+	})
+}
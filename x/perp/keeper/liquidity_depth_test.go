@@ -0,0 +1,203 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	nibisimapp "github.com/NibiruChain/nibiru/simapp"
+	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/perp/types"
+	"github.com/NibiruChain/nibiru/x/testutil"
+)
+
+func TestAdjustPoolDepth(t *testing.T) {
+	// the pool starts with reserves of about 1e12 and a mark price of about 1
+	quoteReserve := sdk.NewDec(1_000_000_000_000)
+
+	setup := func(t *testing.T, efFunds int64) (*nibisimapp.NibiruTestApp, sdk.Context) {
+		nibiruApp, ctx, trader := initOrdersTest(t, sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 1_100)))
+
+		// a long of 10_000 NUSD of notional at the mark price of 1
+		_, err := nibiruApp.PerpKeeper.OpenPosition(ctx, common.Pair_BTC_NUSD, types.Side_BUY, trader,
+			sdk.NewInt(1_000), sdk.NewDec(10), sdk.ZeroDec())
+		require.NoError(t, err)
+
+		// leave the ecosystem fund with efFunds only, without the fees of the position
+		efAddr := nibiruApp.AccountKeeper.GetModuleAddress(types.PerpEFModuleAccount)
+		require.NoError(t, nibiruApp.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.PerpEFModuleAccount,
+			testutil.AccAddress(), nibiruApp.BankKeeper.GetAllBalances(ctx, efAddr)))
+		require.NoError(t, simapp.FundModuleAccount(nibiruApp.BankKeeper, ctx, types.PerpEFModuleAccount,
+			sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, efFunds))))
+		return nibiruApp, ctx
+	}
+
+	newPolicy := func(oiRatio, volumeRatio, minQuoteReserve sdk.Dec) types.LiquidityDepthPolicy {
+		return types.LiquidityDepthPolicy{
+			EpochIdentifier:        "15 min",
+			OpenInterestDepthRatio: oiRatio,
+			VolumeDepthRatio:       volumeRatio,
+			MaxChangeRatio:         sdk.MustNewDecFromStr("0.1"),
+			MinQuoteReserve:        minQuoteReserve,
+			MaxQuoteReserve:        sdk.ZeroDec(),
+		}
+	}
+
+	getQuoteReserve := func(nibiruApp *nibisimapp.NibiruTestApp, ctx sdk.Context) sdk.Dec {
+		pool, err := nibiruApp.VpoolKeeper.GetPool(ctx, common.Pair_BTC_NUSD)
+		require.NoError(t, err)
+		return pool.QuoteAssetReserve
+	}
+
+	getBalance := func(nibiruApp *nibisimapp.NibiruTestApp, ctx sdk.Context, moduleAccount string) sdk.Int {
+		return nibiruApp.BankKeeper.GetBalance(
+			ctx, nibiruApp.AccountKeeper.GetModuleAddress(moduleAccount), common.DenomNUSD).Amount
+	}
+
+	t.Run("deepening the pool is bounded and paid by the ecosystem fund", func(t *testing.T) {
+		nibiruApp, ctx := setup(t, 20_000)
+		oldQuoteReserve := getQuoteReserve(nibiruApp, ctx)
+		oldMarkPrice, err := nibiruApp.VpoolKeeper.GetMarkPrice(ctx, common.Pair_BTC_NUSD)
+		require.NoError(t, err)
+		efBefore := getBalance(nibiruApp, ctx, types.PerpEFModuleAccount)
+		vaultBefore := getBalance(nibiruApp, ctx, types.VaultModuleAccount)
+
+		policy := newPolicy(sdk.ZeroDec(), sdk.ZeroDec(), quoteReserve.MulInt64(2))
+		cost, err := nibiruApp.PerpKeeper.AdjustPoolDepth(ctx, common.Pair_BTC_NUSD, policy, time.Hour)
+		require.NoError(t, err)
+
+		// the target of 2e12 is cut to the max change of 10%
+		assert.EqualValues(t, oldQuoteReserve.Mul(sdk.MustNewDecFromStr("1.1")), getQuoteReserve(nibiruApp, ctx))
+		markPrice, err := nibiruApp.VpoolKeeper.GetMarkPrice(ctx, common.Pair_BTC_NUSD)
+		require.NoError(t, err)
+		assert.EqualValues(t, oldMarkPrice, markPrice)
+
+		// closing the net long on a deeper pool slips less
+		require.True(t, cost.IsPositive(), cost.String())
+		paid := cost.Ceil().TruncateInt()
+		assert.EqualValues(t, efBefore.Sub(paid), getBalance(nibiruApp, ctx, types.PerpEFModuleAccount))
+		assert.EqualValues(t, vaultBefore.Add(paid), getBalance(nibiruApp, ctx, types.VaultModuleAccount))
+
+		openInterest := nibiruApp.PerpKeeper.GetOpenInterest(ctx, common.Pair_BTC_NUSD)
+		testutil.RequireHasTypedEvent(t, ctx, &types.PoolDepthAdjustedEvent{
+			Pair:               common.Pair_BTC_NUSD.String(),
+			Multiplier:         sdk.MustNewDecFromStr("1.1"),
+			NetSize:            openInterest.LongSize,
+			Cost:               cost,
+			OldQuoteReserve:    oldQuoteReserve,
+			NewQuoteReserve:    oldQuoteReserve.Mul(sdk.MustNewDecFromStr("1.1")),
+			TargetQuoteReserve: quoteReserve.MulInt64(2),
+			BlockHeight:        ctx.BlockHeight(),
+			BlockTimeMs:        ctx.BlockTime().UnixMilli(),
+		})
+	})
+
+	t.Run("the target follows the open interest and the vault pays for a shallower pool", func(t *testing.T) {
+		nibiruApp, ctx := setup(t, 0)
+		oldQuoteReserve := getQuoteReserve(nibiruApp, ctx)
+		efBefore := getBalance(nibiruApp, ctx, types.PerpEFModuleAccount)
+
+		// a target of about 0.95e12 for the open interest of about 10_000
+		policy := newPolicy(sdk.NewDec(95_000_000), sdk.ZeroDec(), sdk.OneDec())
+		cost, err := nibiruApp.PerpKeeper.AdjustPoolDepth(ctx, common.Pair_BTC_NUSD, policy, time.Hour)
+		require.NoError(t, err)
+
+		ratio := getQuoteReserve(nibiruApp, ctx).Quo(oldQuoteReserve)
+		assert.True(t, ratio.GT(sdk.MustNewDecFromStr("0.949")) && ratio.LT(sdk.MustNewDecFromStr("0.951")), ratio.String())
+
+		require.True(t, cost.IsNegative(), cost.String())
+		assert.EqualValues(t, efBefore.Add(cost.Abs().TruncateInt()), getBalance(nibiruApp, ctx, types.PerpEFModuleAccount))
+	})
+
+	t.Run("the target follows the quote volume of the window", func(t *testing.T) {
+		nibiruApp, ctx := setup(t, 20_000)
+		oldQuoteReserve := getQuoteReserve(nibiruApp, ctx)
+
+		// the position traded 10_000 NUSD, for a target of 1.02e12
+		policy := newPolicy(sdk.ZeroDec(), sdk.NewDec(102_000_000), sdk.OneDec())
+		_, err := nibiruApp.PerpKeeper.AdjustPoolDepth(ctx, common.Pair_BTC_NUSD, policy, time.Hour)
+		require.NoError(t, err)
+		assert.True(t, getQuoteReserve(nibiruApp, ctx).Sub(quoteReserve.Mul(sdk.MustNewDecFromStr("1.02"))).
+			Abs().LT(sdk.OneDec()))
+
+		// the volume has left the window
+		ctx = ctx.WithBlockTime(ctx.BlockTime().Add(2 * time.Hour))
+		oldQuoteReserve = getQuoteReserve(nibiruApp, ctx)
+		_, err = nibiruApp.PerpKeeper.AdjustPoolDepth(ctx, common.Pair_BTC_NUSD, policy, time.Hour)
+		require.NoError(t, err)
+		assert.EqualValues(t, oldQuoteReserve.Mul(sdk.MustNewDecFromStr("0.9")), getQuoteReserve(nibiruApp, ctx))
+	})
+
+	t.Run("a pool at its target is left untouched", func(t *testing.T) {
+		nibiruApp, ctx := setup(t, 0)
+		oldQuoteReserve := getQuoteReserve(nibiruApp, ctx)
+		policy := newPolicy(sdk.ZeroDec(), sdk.ZeroDec(), oldQuoteReserve)
+		policy.MaxQuoteReserve = policy.MinQuoteReserve
+
+		cost, err := nibiruApp.PerpKeeper.AdjustPoolDepth(ctx, common.Pair_BTC_NUSD, policy, time.Hour)
+		require.NoError(t, err)
+		assert.True(t, cost.IsZero())
+		assert.EqualValues(t, oldQuoteReserve, getQuoteReserve(nibiruApp, ctx))
+	})
+
+	t.Run("the adjustment fails when the ecosystem fund cannot pay", func(t *testing.T) {
+		nibiruApp, ctx := setup(t, 0)
+		policy := newPolicy(sdk.ZeroDec(), sdk.ZeroDec(), quoteReserve.MulInt64(2))
+
+		_, err := nibiruApp.PerpKeeper.AdjustPoolDepth(ctx, common.Pair_BTC_NUSD, policy, time.Hour)
+		require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+	})
+
+	t.Run("the epoch hook adjusts the pairs following the epoch", func(t *testing.T) {
+		nibiruApp, ctx := setup(t, 20_000)
+		oldQuoteReserve := getQuoteReserve(nibiruApp, ctx)
+		policy := newPolicy(sdk.ZeroDec(), sdk.ZeroDec(), quoteReserve.MulInt64(2))
+		require.NoError(t, nibiruApp.PerpKeeper.SetLiquidityDepthPolicy(ctx, common.Pair_BTC_NUSD, &policy))
+
+		nibiruApp.PerpKeeper.AfterEpochEnd(ctx, "1 min", 1)
+		assert.EqualValues(t, oldQuoteReserve, getQuoteReserve(nibiruApp, ctx))
+
+		nibiruApp.PerpKeeper.AfterEpochEnd(ctx, "15 min", 1)
+		assert.EqualValues(t, oldQuoteReserve.Mul(sdk.MustNewDecFromStr("1.1")), getQuoteReserve(nibiruApp, ctx))
+	})
+
+	t.Run("the epoch hook leaves the pool untouched when the adjustment fails", func(t *testing.T) {
+		nibiruApp, ctx := setup(t, 0)
+		oldQuoteReserve := getQuoteReserve(nibiruApp, ctx)
+		policy := newPolicy(sdk.ZeroDec(), sdk.ZeroDec(), quoteReserve.MulInt64(2))
+		require.NoError(t, nibiruApp.PerpKeeper.SetLiquidityDepthPolicy(ctx, common.Pair_BTC_NUSD, &policy))
+
+		nibiruApp.PerpKeeper.AfterEpochEnd(ctx, "15 min", 1)
+		assert.EqualValues(t, oldQuoteReserve, getQuoteReserve(nibiruApp, ctx))
+	})
+}
+
+func TestSetLiquidityDepthPolicy(t *testing.T) {
+	nibiruApp, ctx, _ := initOrdersTest(t, sdk.NewCoins())
+	policy := types.LiquidityDepthPolicy{
+		EpochIdentifier:        "15 min",
+		OpenInterestDepthRatio: sdk.OneDec(),
+		VolumeDepthRatio:       sdk.ZeroDec(),
+		MaxChangeRatio:         sdk.MustNewDecFromStr("0.1"),
+		MinQuoteReserve:        sdk.OneDec(),
+		MaxQuoteReserve:        sdk.ZeroDec(),
+	}
+
+	require.NoError(t, nibiruApp.PerpKeeper.SetLiquidityDepthPolicy(ctx, common.Pair_BTC_NUSD, &policy))
+	metadata, err := nibiruApp.PerpKeeper.PairsMetadata.Get(ctx, common.Pair_BTC_NUSD)
+	require.NoError(t, err)
+	assert.EqualValues(t, &policy, metadata.LiquidityDepthPolicy)
+
+	require.NoError(t, nibiruApp.PerpKeeper.SetLiquidityDepthPolicy(ctx, common.Pair_BTC_NUSD, nil))
+	metadata, err = nibiruApp.PerpKeeper.PairsMetadata.Get(ctx, common.Pair_BTC_NUSD)
+	require.NoError(t, err)
+	assert.Nil(t, metadata.LiquidityDepthPolicy)
+
+	require.ErrorIs(t, nibiruApp.PerpKeeper.SetLiquidityDepthPolicy(ctx, common.Pair_ETH_NUSD, &policy),
+		types.ErrPairMetadataNotFound)
+}
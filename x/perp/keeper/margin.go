@@ -3,8 +3,6 @@ package keeper
 import (
 	"fmt"
 
-	"github.com/NibiruChain/nibiru/collections"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/NibiruChain/nibiru/x/common"
@@ -16,6 +14,8 @@ import (
 	AddMargin deleverages an existing position by adding margin (collateral)
 
 to it. Adding margin increases the margin ratio of the corresponding position.
+The margin of a cross margin position is taken from the account collateral,
+and from the trader's wallet for the part the collateral can't cover.
 */
 func (k Keeper) AddMargin(
 	ctx sdk.Context, pair common.AssetPair, traderAddr sdk.AccAddress, margin sdk.Coin,
@@ -24,9 +24,15 @@ func (k Keeper) AddMargin(
 	if err = k.requireVpool(ctx, pair); err != nil {
 		return nil, err
 	}
+	if err = k.requirePoolNotSettled(ctx, pair); err != nil {
+		return nil, err
+	}
+	if err = k.requireNotInAuction(ctx, pair, traderAddr); err != nil {
+		return nil, err
+	}
 
 	// ------------- AddMargin -------------
-	position, err := k.Positions.Get(ctx, collections.Join(pair, traderAddr))
+	position, err := k.GetPosition(ctx, pair, traderAddr)
 	if err != nil {
 		return nil, err
 	}
@@ -40,19 +46,24 @@ func (k Keeper) AddMargin(
 		return nil, fmt.Errorf("failed to add margin; position has bad debt; consider adding more margin")
 	}
 
-	if err = k.BankKeeper.SendCoinsFromAccountToModule(
-		ctx,
-		/* from */ traderAddr,
-		/* to */ types.VaultModuleAccount,
-		/* amount */ sdk.NewCoins(margin),
-	); err != nil {
+	if k.isCrossMarginAccount(ctx, traderAddr) {
+		err = k.allocateCrossMargin(ctx, traderAddr, pair.QuoteDenom(), margin.Amount)
+	} else {
+		err = k.BankKeeper.SendCoinsFromAccountToModule(
+			ctx,
+			/* from */ traderAddr,
+			/* to */ types.VaultModuleAccount,
+			/* amount */ sdk.NewCoins(margin),
+		)
+	}
+	if err != nil {
 		return nil, err
 	}
 
 	position.Margin = remainingMargin.Margin
 	position.LatestCumulativePremiumFraction = remainingMargin.LatestCumulativePremiumFraction
 	position.BlockNumber = ctx.BlockHeight()
-	k.Positions.Insert(ctx, collections.Join(position.Pair, traderAddr), position)
+	k.SetPosition(ctx, position)
 
 	positionNotional, unrealizedPnl, err := k.getPositionNotionalAndUnrealizedPnL(ctx, position, types.PnLCalcOption_SPOT_PRICE)
 	if err != nil {
@@ -64,7 +75,9 @@ func (k Keeper) AddMargin(
 		return nil, err
 	}
 
-	if err = ctx.EventManager().EmitTypedEvent(
+	if err = k.emitPositionChanged(
+		ctx,
+		/* previousSize */ position.Size_,
 		&types.PositionChangedEvent{
 			Pair:                  pair.String(),
 			TraderAddress:         traderAddr.String(),
@@ -100,6 +113,11 @@ margin ratio of the position.
 
 Fails if the position goes underwater.
 
+The margin removed from a cross margin position goes back to the account
+collateral instead of the trader's wallet. The account equity doesn't change,
+the account-wide free collateral check applies when the collateral is
+withdrawn from the account.
+
 args:
   - ctx: the cosmos-sdk context
   - pair: the asset pair
@@ -118,9 +136,15 @@ func (k Keeper) RemoveMargin(
 	if err = k.requireVpool(ctx, pair); err != nil {
 		return sdk.Coin{}, sdk.Dec{}, types.Position{}, err
 	}
+	if err = k.requirePoolNotSettled(ctx, pair); err != nil {
+		return sdk.Coin{}, sdk.Dec{}, types.Position{}, err
+	}
+	if err = k.requireNotInAuction(ctx, pair, traderAddr); err != nil {
+		return sdk.Coin{}, sdk.Dec{}, types.Position{}, err
+	}
 
 	// ------------- RemoveMargin -------------
-	position, err = k.Positions.Get(ctx, collections.Join(pair, traderAddr))
+	position, err = k.GetPosition(ctx, pair, traderAddr)
 	if err != nil {
 		return sdk.Coin{}, sdk.Dec{}, types.Position{}, err
 	}
@@ -137,14 +161,17 @@ func (k Keeper) RemoveMargin(
 	position.Margin = remainingMargin.Margin
 	position.LatestCumulativePremiumFraction = remainingMargin.LatestCumulativePremiumFraction
 
-	freeCollateral, err := k.calcFreeCollateral(ctx, position)
-	if err != nil {
-		return sdk.Coin{}, sdk.Dec{}, types.Position{}, err
-	} else if !freeCollateral.IsPositive() {
-		return sdk.Coin{}, sdk.Dec{}, types.Position{}, fmt.Errorf("not enough free collateral")
+	isCrossMargin := k.isCrossMarginAccount(ctx, traderAddr)
+	if !isCrossMargin {
+		freeCollateral, err := k.calcFreeCollateral(ctx, position)
+		if err != nil {
+			return sdk.Coin{}, sdk.Dec{}, types.Position{}, err
+		} else if !freeCollateral.IsPositive() {
+			return sdk.Coin{}, sdk.Dec{}, types.Position{}, fmt.Errorf("not enough free collateral")
+		}
 	}
 
-	k.Positions.Insert(ctx, collections.Join(position.Pair, traderAddr), position)
+	k.SetPosition(ctx, position)
 
 	positionNotional, unrealizedPnl, err := k.getPositionNotionalAndUnrealizedPnL(ctx, position, types.PnLCalcOption_SPOT_PRICE)
 	if err != nil {
@@ -156,11 +183,18 @@ func (k Keeper) RemoveMargin(
 		return sdk.Coin{}, sdk.Dec{}, types.Position{}, err
 	}
 
-	if err = k.Withdraw(ctx, pair.QuoteDenom(), traderAddr, margin.Amount); err != nil {
+	if isCrossMargin {
+		err = k.allocateCrossMargin(ctx, traderAddr, pair.QuoteDenom(), margin.Amount.Neg())
+	} else {
+		err = k.Withdraw(ctx, pair.QuoteDenom(), traderAddr, margin.Amount)
+	}
+	if err != nil {
 		return sdk.Coin{}, sdk.Dec{}, types.Position{}, err
 	}
 
-	if err = ctx.EventManager().EmitTypedEvent(
+	if err = k.emitPositionChanged(
+		ctx,
+		/* previousSize */ position.Size_,
 		&types.PositionChangedEvent{
 			Pair:                  pair.String(),
 			TraderAddress:         traderAddr.String(),
@@ -193,35 +227,18 @@ func (k Keeper) GetMarginRatio(
 		return sdk.Dec{}, types.ErrPositionZero
 	}
 
-	var (
-		unrealizedPnL    sdk.Dec
-		positionNotional sdk.Dec
-	)
-
-	switch priceOption {
-	case types.MarginCalculationPriceOption_MAX_PNL:
-		positionNotional, unrealizedPnL, err = k.GetPreferencePositionNotionalAndUnrealizedPnL(
-			ctx,
-			position,
-			types.PnLPreferenceOption_MAX,
-		)
-	case types.MarginCalculationPriceOption_INDEX:
-		positionNotional, unrealizedPnL, err = k.getPositionNotionalAndUnrealizedPnL(
-			ctx,
-			position,
-			types.PnLCalcOption_ORACLE,
-		)
-	case types.MarginCalculationPriceOption_SPOT:
-		positionNotional, unrealizedPnL, err = k.getPositionNotionalAndUnrealizedPnL(
-			ctx,
-			position,
-			types.PnLCalcOption_SPOT_PRICE,
-		)
-	}
-
+	positionNotional, unrealizedPnL, err := k.getMarginCalculationNotionalAndUnrealizedPnL(ctx, position, priceOption)
 	if err != nil {
 		return sdk.Dec{}, err
 	}
+	return k.marginRatioAt(ctx, position, positionNotional, unrealizedPnL)
+}
+
+// marginRatioAt returns the margin ratio of a position given its position
+// notional and unrealized PnL, after the pending funding payment.
+func (k Keeper) marginRatioAt(
+	ctx sdk.Context, position types.Position, positionNotional sdk.Dec, unrealizedPnL sdk.Dec,
+) (marginRatio sdk.Dec, err error) {
 	if positionNotional.IsZero() {
 		// NOTE causes division by zero in margin ratio calculation
 		return sdk.Dec{},
@@ -242,6 +259,35 @@ func (k Keeper) GetMarginRatio(
 	return marginRatio, nil
 }
 
+// getMarginCalculationNotionalAndUnrealizedPnL returns the position notional
+// and unrealized PnL priced according to the margin calculation price option.
+func (k Keeper) getMarginCalculationNotionalAndUnrealizedPnL(
+	ctx sdk.Context, position types.Position, priceOption types.MarginCalculationPriceOption,
+) (positionNotional sdk.Dec, unrealizedPnL sdk.Dec, err error) {
+	switch priceOption {
+	case types.MarginCalculationPriceOption_MAX_PNL:
+		return k.GetPreferencePositionNotionalAndUnrealizedPnL(
+			ctx,
+			position,
+			types.PnLPreferenceOption_MAX,
+		)
+	case types.MarginCalculationPriceOption_INDEX:
+		return k.getPositionNotionalAndUnrealizedPnL(
+			ctx,
+			position,
+			types.PnLCalcOption_ORACLE,
+		)
+	case types.MarginCalculationPriceOption_SPOT:
+		return k.getPositionNotionalAndUnrealizedPnL(
+			ctx,
+			position,
+			types.PnLCalcOption_SPOT_PRICE,
+		)
+	default:
+		return sdk.Dec{}, sdk.Dec{}, fmt.Errorf("unrecognized margin calculation price option: %s", priceOption)
+	}
+}
+
 func (k Keeper) requireVpool(ctx sdk.Context, pair common.AssetPair) (err error) {
 	if !k.VpoolKeeper.ExistsPool(ctx, pair) {
 		return types.ErrPairNotFound.Wrap(pair.String())
@@ -249,6 +295,15 @@ func (k Keeper) requireVpool(ctx sdk.Context, pair common.AssetPair) (err error)
 	return nil
 }
 
+// requirePoolNotSettled returns an error if the vpool of the pair was settled,
+// after which its positions can only be settled.
+func (k Keeper) requirePoolNotSettled(ctx sdk.Context, pair common.AssetPair) (err error) {
+	if k.VpoolKeeper.IsPoolSettled(ctx, pair) {
+		return types.ErrPairSettled.Wrap(pair.String())
+	}
+	return nil
+}
+
 /*
 requireMoreMarginRatio checks if the marginRatio corresponding to the margin
 backing a position is above or below the 'baseMarginRatio'.
@@ -0,0 +1,208 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/perp/types"
+	vpooltypes "github.com/NibiruChain/nibiru/x/vpool/types"
+)
+
+// getMarginPricePolicy returns the margin price policy of a pair: the override
+// of the pair if it has one, otherwise the default policy.
+func (k Keeper) getMarginPricePolicy(
+	ctx sdk.Context, pair common.AssetPair,
+) (policy types.MarginPricePolicy, pairOverride bool) {
+	if metadata, err := k.PairsMetadata.Get(ctx, pair); err == nil && metadata.MarginPricePolicy != nil {
+		return *metadata.MarginPricePolicy, true
+	}
+	return types.DefaultMarginPricePolicy(), false
+}
+
+/*
+GetTwapLookbacks returns the longest lookbacks of the vpool TWAPs the perp
+module reads over a pair, for the vpool to keep the reserve snapshots they
+need: the TWAP lookback window of the params, read by the funding rates and the
+TWAP margin checks, and the MARK_TWAP sources of the margin price policy of the
+pair, priced as swaps.
+
+args:
+  - ctx: cosmos-sdk context
+  - pair: the pair
+
+ret:
+  - markPriceLookback: the longest lookback of the mark price TWAPs
+  - swapLookback: the longest lookback of the swap TWAPs
+*/
+func (k Keeper) GetTwapLookbacks(
+	ctx sdk.Context, pair common.AssetPair,
+) (markPriceLookback time.Duration, swapLookback time.Duration) {
+	markPriceLookback = k.GetParams(ctx).TwapLookbackWindow
+	swapLookback = markPriceLookback
+
+	policy, _ := k.getMarginPricePolicy(ctx, pair)
+	for _, source := range append(policy.Sources, policy.FullLiquidationSource) {
+		if source.Type == types.MarginPriceSourceType_MARGIN_PRICE_SOURCE_MARK_TWAP &&
+			source.LookbackWindow > swapLookback {
+			swapLookback = source.LookbackWindow
+		}
+	}
+	return markPriceLookback, swapLookback
+}
+
+/*
+SetMarginPricePolicy overrides the margin price policy of a pair, or removes
+the override when policy is nil.
+
+args:
+  - ctx: cosmos-sdk context
+  - pair: the pair
+  - policy: the margin price policy of the pair, nil to use the default policy
+
+ret:
+  - err: error if the pair has no metadata
+*/
+func (k Keeper) SetMarginPricePolicy(ctx sdk.Context, pair common.AssetPair, policy *types.MarginPricePolicy) error {
+	metadata, err := k.PairsMetadata.Get(ctx, pair)
+	if err != nil {
+		return types.ErrPairMetadataNotFound.Wrap(pair.String())
+	}
+
+	metadata.MarginPricePolicy = policy
+	k.PairsMetadata.Insert(ctx, pair, metadata)
+	return nil
+}
+
+// getMarginCheckRatio returns the margin ratio the margin checks compare
+// against the maintenance margin ratio: the margin ratio measured with the
+// margin price policy of the pair, or the account-wide MAX_PNL margin ratio for
+// cross margin accounts.
+func (k Keeper) getMarginCheckRatio(ctx sdk.Context, position types.Position) (marginRatio sdk.Dec, err error) {
+	traderAddr, err := sdk.AccAddressFromBech32(position.TraderAddress)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	if k.isCrossMarginAccount(ctx, traderAddr) {
+		marginRatio, _, err = k.GetCrossMarginRatio(
+			ctx, traderAddr, position.Pair.QuoteDenom(), types.MarginCalculationPriceOption_MAX_PNL)
+		return marginRatio, err
+	}
+
+	policy, _ := k.getMarginPricePolicy(ctx, position.Pair)
+	return k.getSourcesMarginRatio(ctx, position, policy.Sources, policy.Preference)
+}
+
+// getSourcesMarginRatio returns the margin ratio of an isolated margin position
+// measured at the given price sources, keeping the highest or the lowest
+// position notional and unrealized PnL.
+func (k Keeper) getSourcesMarginRatio(
+	ctx sdk.Context,
+	position types.Position,
+	sources []types.MarginPriceSource,
+	preference types.PnLPreferenceOption,
+) (marginRatio sdk.Dec, err error) {
+	if position.Size_.IsZero() {
+		return sdk.Dec{}, types.ErrPositionZero
+	}
+
+	positionNotional, unrealizedPnL, err := k.getSourcesNotionalAndUnrealizedPnL(ctx, position, sources, preference)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	return k.marginRatioAt(ctx, position, positionNotional, unrealizedPnL)
+}
+
+/*
+getSourcesNotionalAndUnrealizedPnL prices a position at each of the price
+sources and keeps the highest (MAX) or the lowest (MIN) position notional and
+unrealized PnL, in the same way as GetPreferencePositionNotionalAndUnrealizedPnL.
+
+args:
+  - ctx: cosmos-sdk context
+  - position: the trader's position
+  - sources: the price sources, at least one
+  - preference: MAX or MIN
+
+ret:
+  - positionNotional: the position's notional value
+  - unrealizedPnL: the position's unrealized profits and losses
+  - err: error
+*/
+func (k Keeper) getSourcesNotionalAndUnrealizedPnL(
+	ctx sdk.Context,
+	position types.Position,
+	sources []types.MarginPriceSource,
+	preference types.PnLPreferenceOption,
+) (positionNotional sdk.Dec, unrealizedPnL sdk.Dec, err error) {
+	if len(sources) == 0 {
+		return sdk.Dec{}, sdk.Dec{}, fmt.Errorf("no margin price source")
+	}
+
+	for i, source := range sources {
+		sourceNotional, sourcePnL, err := k.getSourceNotionalAndUnrealizedPnL(ctx, position, source)
+		if err != nil {
+			return sdk.Dec{}, sdk.Dec{}, err
+		}
+		if i == 0 {
+			positionNotional, unrealizedPnL = sourceNotional, sourcePnL
+			continue
+		}
+
+		switch preference {
+		case types.PnLPreferenceOption_MAX:
+			positionNotional = sdk.MaxDec(positionNotional, sourceNotional)
+			unrealizedPnL = sdk.MaxDec(unrealizedPnL, sourcePnL)
+		case types.PnLPreferenceOption_MIN:
+			positionNotional = sdk.MinDec(positionNotional, sourceNotional)
+			unrealizedPnL = sdk.MinDec(unrealizedPnL, sourcePnL)
+		default:
+			return sdk.Dec{}, sdk.Dec{}, fmt.Errorf("invalid pnl preference option: %s", preference)
+		}
+	}
+
+	return positionNotional, unrealizedPnL, nil
+}
+
+// getSourceNotionalAndUnrealizedPnL returns the position notional and the
+// unrealized PnL of a position priced at a single price source.
+func (k Keeper) getSourceNotionalAndUnrealizedPnL(
+	ctx sdk.Context, position types.Position, source types.MarginPriceSource,
+) (positionNotional sdk.Dec, unrealizedPnL sdk.Dec, err error) {
+	switch source.Type {
+	case types.MarginPriceSourceType_MARGIN_PRICE_SOURCE_SPOT:
+		return k.getPositionNotionalAndUnrealizedPnL(ctx, position, types.PnLCalcOption_SPOT_PRICE)
+	case types.MarginPriceSourceType_MARGIN_PRICE_SOURCE_INDEX:
+		return k.getPositionNotionalAndUnrealizedPnL(ctx, position, types.PnLCalcOption_ORACLE)
+	case types.MarginPriceSourceType_MARGIN_PRICE_SOURCE_MARK_TWAP:
+		if source.LookbackWindow == 0 {
+			return k.getPositionNotionalAndUnrealizedPnL(ctx, position, types.PnLCalcOption_TWAP)
+		}
+		dir := vpooltypes.Direction_ADD_TO_POOL
+		if position.Size_.IsNegative() {
+			dir = vpooltypes.Direction_REMOVE_FROM_POOL
+		}
+		positionNotional, err = k.VpoolKeeper.GetBaseAssetTWAP(
+			ctx, position.Pair, dir, position.Size_.Abs(), source.LookbackWindow)
+		if err != nil {
+			return sdk.Dec{}, sdk.Dec{}, err
+		}
+	case types.MarginPriceSourceType_MARGIN_PRICE_SOURCE_INDEX_TWAP:
+		indexTwap, err := k.PricefeedKeeper.GetCurrentTWAP(ctx, position.Pair.Token0, position.Pair.Token1)
+		if err != nil {
+			return sdk.Dec{}, sdk.Dec{}, err
+		}
+		positionNotional = indexTwap.Mul(position.Size_.Abs())
+	default:
+		return sdk.Dec{}, sdk.Dec{}, fmt.Errorf("unrecognized margin price source: %s", source.Type)
+	}
+
+	if position.Size_.IsPositive() {
+		unrealizedPnL = positionNotional.Sub(position.OpenNotional)
+	} else {
+		unrealizedPnL = position.OpenNotional.Sub(positionNotional)
+	}
+	return positionNotional, unrealizedPnL, nil
+}
@@ -0,0 +1,91 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/perp/keeper"
+	"github.com/NibiruChain/nibiru/x/perp/types"
+	"github.com/NibiruChain/nibiru/x/testutil"
+)
+
+func TestMarginPricePolicy(t *testing.T) {
+	nibiruApp, ctx, trader := initOrdersTest(t, sdk.NewCoins())
+	perpKeeper := nibiruApp.PerpKeeper
+	querier := keeper.NewQuerier(perpKeeper)
+
+	require.NoError(t, simapp.FundModuleAccount(nibiruApp.BankKeeper, ctx, types.VaultModuleAccount,
+		sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 1_000))))
+
+	postIndexPrice := func(price sdk.Dec) {
+		oracle := testutil.AccAddress()
+		nibiruApp.PricefeedKeeper.WhitelistOracles(ctx, []sdk.AccAddress{oracle})
+		require.NoError(t, nibiruApp.PricefeedKeeper.PostRawPrice(
+			ctx, oracle, common.Pair_BTC_NUSD.String(), price, time.Now().Add(time.Hour)))
+		require.NoError(t, nibiruApp.PricefeedKeeper.GatherRawPrices(ctx, common.DenomBTC, common.DenomNUSD))
+	}
+	// a long of 1_000 BTC opened at a price of 1, whose margin ratio at the
+	// mark price of 1 is 0.1, above the maintenance margin ratio of 0.0625
+	setPosition(perpKeeper, ctx, types.Position{
+		TraderAddress:                   trader.String(),
+		Pair:                            common.Pair_BTC_NUSD,
+		Size_:                           sdk.NewDec(1_000),
+		Margin:                          sdk.NewDec(100),
+		OpenNotional:                    sdk.NewDec(1_000),
+		LatestCumulativePremiumFraction: sdk.ZeroDec(),
+	})
+
+	t.Log("the pair starts with the default policy")
+	resp, err := querier.QueryMarginPricePolicy(sdk.WrapSDKContext(ctx), &types.QueryMarginPricePolicyRequest{
+		TokenPair: common.Pair_BTC_NUSD.String(),
+	})
+	require.NoError(t, err)
+	assert.EqualValues(t, types.DefaultMarginPricePolicy(), resp.Policy)
+	assert.False(t, resp.PairOverride)
+
+	t.Log("the index price is ignored by the default policy")
+	postIndexPrice(sdk.MustNewDecFromStr("0.5"))
+	_, _, _, err = perpKeeper.PreviewLiquidation(ctx, common.Pair_BTC_NUSD, trader, nil)
+	require.ErrorIs(t, err, types.ErrMarginHighEnough)
+
+	t.Log("a conservative policy measures the position at the lowest PnL of the mark and index prices")
+	policy := types.MarginPricePolicy{
+		Sources: []types.MarginPriceSource{
+			{Type: types.MarginPriceSourceType_MARGIN_PRICE_SOURCE_SPOT},
+			{Type: types.MarginPriceSourceType_MARGIN_PRICE_SOURCE_MARK_TWAP, LookbackWindow: time.Hour},
+			{Type: types.MarginPriceSourceType_MARGIN_PRICE_SOURCE_INDEX},
+		},
+		Preference:            types.PnLPreferenceOption_MIN,
+		FullLiquidationSource: types.MarginPriceSource{Type: types.MarginPriceSourceType_MARGIN_PRICE_SOURCE_SPOT},
+	}
+	require.NoError(t, perpKeeper.SetMarginPricePolicy(ctx, common.Pair_BTC_NUSD, &policy))
+	resp, err = querier.QueryMarginPricePolicy(sdk.WrapSDKContext(ctx), &types.QueryMarginPricePolicyRequest{
+		TokenPair: common.Pair_BTC_NUSD.String(),
+	})
+	require.NoError(t, err)
+	assert.EqualValues(t, policy, resp.Policy)
+	assert.True(t, resp.PairOverride)
+
+	_, isFullLiquidation, _, err := perpKeeper.PreviewLiquidation(ctx, common.Pair_BTC_NUSD, trader, nil)
+	require.NoError(t, err)
+	// the SPOT margin ratio of 0.1 is above the liquidation fee ratio
+	assert.False(t, isFullLiquidation)
+
+	t.Log("the margin checks use the policy too")
+	_, err = perpKeeper.TransferPosition(ctx, common.Pair_BTC_NUSD, trader, testutil.AccAddress())
+	require.ErrorIs(t, err, types.ErrMarginRatioTooLow)
+
+	t.Log("removing the override goes back to the default policy")
+	require.NoError(t, perpKeeper.SetMarginPricePolicy(ctx, common.Pair_BTC_NUSD, nil))
+	_, _, _, err = perpKeeper.PreviewLiquidation(ctx, common.Pair_BTC_NUSD, trader, nil)
+	require.ErrorIs(t, err, types.ErrMarginHighEnough)
+
+	t.Log("a pair without metadata cannot be overridden")
+	require.ErrorIs(t, perpKeeper.SetMarginPricePolicy(ctx, common.Pair_ETH_NUSD, &policy), types.ErrPairMetadataNotFound)
+}
@@ -164,8 +164,8 @@ func TestGetMarginRatio(t *testing.T) {
 				Return(tc.newPrice, nil)
 
 			setPairMetadata(perpKeeper, ctx, types.PairMetadata{
-				Pair:                       common.Pair_BTC_NUSD,
-				CumulativePremiumFractions: []sdk.Dec{sdk.OneDec()},
+				Pair:                            common.Pair_BTC_NUSD,
+				LatestCumulativePremiumFraction: sdk.OneDec(),
 			})
 
 			marginRatio, err := perpKeeper.GetMarginRatio(
@@ -195,15 +195,13 @@ func TestRemoveMargin(t *testing.T) {
 				}
 
 				mocks.mockVpoolKeeper.EXPECT().ExistsPool(ctx, pair).Return(true)
+				mocks.mockVpoolKeeper.EXPECT().IsPoolSettled(ctx, pair).Return(false)
 				mocks.mockPricefeedKeeper.EXPECT().IsActivePair(gomock.Any(), gomock.Any()).Return(true).AnyTimes()
 
 				t.Log("Set vpool defined by pair on PerpKeeper")
 				setPairMetadata(perpKeeper, ctx, types.PairMetadata{
-					Pair: pair,
-					CumulativePremiumFractions: []sdk.Dec{
-						sdk.ZeroDec(),
-						sdk.MustNewDecFromStr("0.1")},
-				})
+					Pair:                            pair,
+					LatestCumulativePremiumFraction: sdk.MustNewDecFromStr("0.1")})
 
 				t.Log("Set an underwater position, positive bad debt due to excessive margin request")
 				setPosition(perpKeeper, ctx, types.Position{
@@ -233,6 +231,7 @@ func TestRemoveMargin(t *testing.T) {
 
 				t.Log("mock vpool keeper")
 				mocks.mockVpoolKeeper.EXPECT().ExistsPool(ctx, pair).AnyTimes().Return(true)
+				mocks.mockVpoolKeeper.EXPECT().IsPoolSettled(ctx, pair).Return(false)
 				mocks.mockVpoolKeeper.EXPECT().GetMaintenanceMarginRatio(ctx, pair).Return(sdk.MustNewDecFromStr("0.0625"))
 				mocks.mockVpoolKeeper.EXPECT().GetMarkPrice(ctx, pair).Return(sdk.OneDec(), nil)
 				mocks.mockVpoolKeeper.EXPECT().GetBaseAssetPrice(
@@ -256,6 +255,15 @@ func TestRemoveMargin(t *testing.T) {
 
 				t.Log("mock bank keeper")
 				expectedError := fmt.Errorf("not enough funds in vault module account")
+				expectBadDebtPayout(mocks, ctx, types.InsuranceFundModuleAccount, pair.QuoteDenom(), 0, 0)
+				mocks.mockAccountKeeper.
+					EXPECT().GetModuleAddress(types.PerpEFModuleAccount).
+					Return(authtypes.NewModuleAddress(types.PerpEFModuleAccount))
+				mocks.mockBankKeeper.EXPECT().GetBalance(
+					ctx,
+					authtypes.NewModuleAddress(types.PerpEFModuleAccount),
+					pair.QuoteDenom(),
+				).Return(marginToWithdraw)
 				mocks.mockBankKeeper.EXPECT().SendCoinsFromModuleToModule(
 					ctx, types.PerpEFModuleAccount, types.VaultModuleAccount, sdk.NewCoins(marginToWithdraw),
 				).Return(expectedError)
@@ -267,10 +275,8 @@ func TestRemoveMargin(t *testing.T) {
 
 				t.Log("set pair metadata")
 				setPairMetadata(perpKeeper, ctx, types.PairMetadata{
-					Pair: pair,
-					CumulativePremiumFractions: []sdk.Dec{
-						sdk.ZeroDec(),
-					},
+					Pair:                            pair,
+					LatestCumulativePremiumFraction: sdk.ZeroDec(),
 				})
 
 				t.Log("Set position a healthy position that has 0 unrealized funding")
@@ -302,6 +308,7 @@ func TestRemoveMargin(t *testing.T) {
 
 				t.Log("mock vpool keeper")
 				mocks.mockVpoolKeeper.EXPECT().ExistsPool(ctx, pair).Return(true)
+				mocks.mockVpoolKeeper.EXPECT().IsPoolSettled(ctx, pair).Return(false)
 				mocks.mockVpoolKeeper.EXPECT().GetMaintenanceMarginRatio(ctx, pair).Return(sdk.MustNewDecFromStr("0.0625"))
 				mocks.mockVpoolKeeper.EXPECT().ExistsPool(ctx, pair).Return(true)
 
@@ -329,10 +336,8 @@ func TestRemoveMargin(t *testing.T) {
 
 				t.Log("set pair metadata")
 				setPairMetadata(perpKeeper, ctx, types.PairMetadata{
-					Pair: pair,
-					CumulativePremiumFractions: []sdk.Dec{
-						sdk.ZeroDec(),
-					},
+					Pair:                            pair,
+					LatestCumulativePremiumFraction: sdk.ZeroDec(),
 				})
 
 				t.Log("Set position a healthy position that has 0 unrealized funding")
@@ -399,13 +404,12 @@ func TestRemoveMargin(t *testing.T) {
 
 				t.Log("mock vpool keeper")
 				mocks.mockVpoolKeeper.EXPECT().ExistsPool(ctx, pair).Return(true)
+				mocks.mockVpoolKeeper.EXPECT().IsPoolSettled(ctx, pair).Return(false)
 
 				t.Log("set pair metadata")
 				setPairMetadata(perpKeeper, ctx, types.PairMetadata{
-					Pair: pair,
-					CumulativePremiumFractions: []sdk.Dec{
-						sdk.OneDec(),
-					},
+					Pair:                            pair,
+					LatestCumulativePremiumFraction: sdk.OneDec(),
 				})
 
 				t.Log("Set position a healthy position that has 0 unrealized funding")
@@ -446,6 +450,21 @@ func TestAddMargin(t *testing.T) {
 		name string
 		test func()
 	}{
+		{
+			name: "fail - pool frozen for settlement",
+			test: func() {
+				perpKeeper, mocks, ctx := getKeeper(t)
+
+				traderAddr := testutilevents.AccAddress()
+				pair := common.MustNewAssetPair("uosmo:unusd")
+
+				mocks.mockVpoolKeeper.EXPECT().ExistsPool(ctx, pair).Return(true)
+				mocks.mockVpoolKeeper.EXPECT().IsPoolSettled(ctx, pair).Return(true)
+
+				_, err := perpKeeper.AddMargin(ctx, pair, traderAddr, sdk.NewInt64Coin(pair.QuoteDenom(), 600))
+				require.ErrorIs(t, err, types.ErrPairSettled)
+			},
+		},
 		{
 			name: "fail - user doesn't have enough funds",
 			test: func() {
@@ -460,10 +479,11 @@ func TestAddMargin(t *testing.T) {
 
 				t.Log("set pair metadata")
 				setPairMetadata(perpKeeper, ctx, types.PairMetadata{
-					Pair:                       pair,
-					CumulativePremiumFractions: []sdk.Dec{sdk.ZeroDec()},
+					Pair:                            pair,
+					LatestCumulativePremiumFraction: sdk.ZeroDec(),
 				})
 				mocks.mockVpoolKeeper.EXPECT().ExistsPool(ctx, pair).Return(true)
+				mocks.mockVpoolKeeper.EXPECT().IsPoolSettled(ctx, pair).Return(false)
 
 				t.Log("set a position")
 				setPosition(perpKeeper, ctx, types.Position{
@@ -497,13 +517,14 @@ func TestAddMargin(t *testing.T) {
 				margin := sdk.NewInt64Coin("unusd", 100)
 
 				mocks.mockVpoolKeeper.EXPECT().ExistsPool(ctx, pair).Return(true)
+				mocks.mockVpoolKeeper.EXPECT().IsPoolSettled(ctx, pair).Return(false)
 				mocks.mockVpoolKeeper.EXPECT().GetBaseAssetPrice(ctx, pair, vpooltypes.Direction_ADD_TO_POOL, sdk.NewDec(1000)).Return(sdk.NewDec(1000), nil)
 				mocks.mockVpoolKeeper.EXPECT().GetMarkPrice(ctx, pair).Return(sdk.OneDec(), nil)
 
 				t.Log("set pair metadata")
 				setPairMetadata(perpKeeper, ctx, types.PairMetadata{
-					Pair:                       pair,
-					CumulativePremiumFractions: []sdk.Dec{sdk.ZeroDec()},
+					Pair:                            pair,
+					LatestCumulativePremiumFraction: sdk.ZeroDec(),
 				})
 
 				t.Log("set position")
@@ -568,13 +589,14 @@ func TestAddMargin(t *testing.T) {
 				margin := sdk.NewInt64Coin("unusd", 100)
 
 				mocks.mockVpoolKeeper.EXPECT().ExistsPool(ctx, pair).Return(true)
+				mocks.mockVpoolKeeper.EXPECT().IsPoolSettled(ctx, pair).Return(false)
 				mocks.mockVpoolKeeper.EXPECT().GetBaseAssetPrice(ctx, pair, vpooltypes.Direction_ADD_TO_POOL, sdk.NewDec(1000)).Return(sdk.NewDec(1000), nil)
 				mocks.mockVpoolKeeper.EXPECT().GetMarkPrice(ctx, pair).Return(sdk.OneDec(), nil)
 
 				t.Log("set pair metadata")
 				setPairMetadata(perpKeeper, ctx, types.PairMetadata{
-					Pair:                       pair,
-					CumulativePremiumFractions: []sdk.Dec{sdk.MustNewDecFromStr("0.001")},
+					Pair:                            pair,
+					LatestCumulativePremiumFraction: sdk.MustNewDecFromStr("0.001"),
 				})
 
 				t.Log("set position")
@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/collections"
+	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/perp/types"
+)
+
+// Migrator handles the in-place store migrations of the x/perp module.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+/*
+Migrate2to3 migrates the x/perp store from consensus version 2 to 3.
+
+  - The params added since version 2 are set to their defaults, the params
+    already set are kept.
+  - The funding history of the pair metadata is moved to FundingRate records,
+    see MigratePairMetadata.
+  - The open interests, the total margins and the ADL index, which are
+    derived from the positions, are built from the existing positions.
+*/
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	k := m.keeper
+
+	params := types.DefaultParams()
+	k.ParamSubspace.GetParamSetIfExists(ctx, &params)
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	k.SetParams(ctx, params)
+
+	for _, pairMetadata := range k.PairsMetadata.Iterate(ctx, collections.Range[common.AssetPair]{}).Values() {
+		pairMetadata = k.MigratePairMetadata(ctx, pairMetadata)
+		k.PairsMetadata.Insert(ctx, pairMetadata.Pair, pairMetadata)
+	}
+
+	for _, position := range k.Positions.Iterate(ctx, collections.PairRange[common.AssetPair, sdk.AccAddress]{}).Values() {
+		k.updateOpenInterest(ctx, types.Position{Pair: position.Pair, Size_: sdk.ZeroDec(), OpenNotional: sdk.ZeroDec()}, position)
+		k.addToTotalMargin(ctx, position.Pair.QuoteDenom(), positiveMargin(position))
+		k.indexADL(ctx, position)
+	}
+
+	return nil
+}
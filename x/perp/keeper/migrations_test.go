@@ -0,0 +1,56 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/collections"
+	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/perp/keeper"
+	"github.com/NibiruChain/nibiru/x/perp/types"
+	"github.com/NibiruChain/nibiru/x/testutil"
+)
+
+func TestMigrate2to3(t *testing.T) {
+	nibiruApp, ctx, _ := initOrdersTest(t, sdk.NewCoins())
+	perpKeeper := nibiruApp.PerpKeeper
+	params := perpKeeper.GetParams(ctx)
+
+	t.Log("the pair metadata and the positions are stored the way version 2 did")
+	perpKeeper.PairsMetadata.Insert(ctx, common.Pair_BTC_NUSD, types.PairMetadata{
+		Pair:                       common.Pair_BTC_NUSD,
+		CumulativePremiumFractions: []sdk.Dec{sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.3")},
+	})
+	long, short := testutil.AccAddress(), testutil.AccAddress()
+	for _, position := range []types.Position{
+		{TraderAddress: long.String(), Size_: sdk.NewDec(10), Margin: sdk.NewDec(5), OpenNotional: sdk.NewDec(20)},
+		{TraderAddress: short.String(), Size_: sdk.NewDec(-4), Margin: sdk.NewDec(3), OpenNotional: sdk.NewDec(8)},
+	} {
+		position.Pair = common.Pair_BTC_NUSD
+		position.LatestCumulativePremiumFraction = sdk.ZeroDec()
+		perpKeeper.Positions.Insert(ctx, collections.Join(common.Pair_BTC_NUSD, sdk.MustAccAddressFromBech32(position.TraderAddress)), position)
+	}
+
+	require.NoError(t, keeper.NewMigrator(perpKeeper).Migrate2to3(ctx))
+
+	t.Log("the params already set are kept")
+	assert.Equal(t, params, perpKeeper.GetParams(ctx))
+
+	t.Log("the funding history is moved off the pair metadata")
+	pairMetadata, err := perpKeeper.PairsMetadata.Get(ctx, common.Pair_BTC_NUSD)
+	require.NoError(t, err)
+	assert.Empty(t, pairMetadata.CumulativePremiumFractions)
+
+	t.Log("the open interest, the total margin and the ADL index are built from the positions")
+	openInterest := perpKeeper.GetOpenInterest(ctx, common.Pair_BTC_NUSD)
+	assert.EqualValues(t, sdk.NewDec(10), openInterest.LongSize)
+	assert.EqualValues(t, sdk.NewDec(4), openInterest.ShortSize)
+	assert.EqualValues(t, sdk.NewDec(20), openInterest.LongOpenNotional)
+	assert.EqualValues(t, sdk.NewDec(8), openInterest.ShortOpenNotional)
+	assert.EqualValues(t, sdk.NewDec(8), perpKeeper.TotalMargins.GetOr(ctx, common.DenomNUSD, sdk.ZeroDec()))
+	assert.True(t, perpKeeper.ADLLongs.Has(ctx, collections.Join(common.Pair_BTC_NUSD, collections.Join(sdk.NewDec(2), long))))
+	assert.True(t, perpKeeper.ADLShorts.Has(ctx, collections.Join(common.Pair_BTC_NUSD, collections.Join(sdk.NewDec(2), short))))
+}
@@ -96,6 +96,24 @@ func (m msgServer) ClosePosition(goCtx context.Context, position *types.MsgClose
 	}, nil
 }
 
+func (m msgServer) SettlePosition(goCtx context.Context, msg *types.MsgSettlePosition) (*types.MsgSettlePositionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	traderAddr := sdk.MustAccAddressFromBech32(msg.Sender)
+	pair := common.MustNewAssetPair(msg.TokenPair)
+
+	position, err := m.k.GetPosition(ctx, pair, traderAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	settledCoins, err := m.k.SettlePosition(ctx, position)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSettlePositionResponse{SettledCoins: settledCoins}, nil
+}
+
 func (m msgServer) Liquidate(goCtx context.Context, msg *types.MsgLiquidate,
 ) (*types.MsgLiquidateResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -148,3 +166,135 @@ func (m msgServer) DonateToEcosystemFund(ctx context.Context, msg *types.MsgDona
 
 	return &types.MsgDonateToEcosystemFundResponse{}, nil
 }
+
+func (m msgServer) PlaceOrder(goCtx context.Context, msg *types.MsgPlaceOrder) (*types.MsgPlaceOrderResponse, error) {
+	order, err := m.k.PlaceOrder(
+		sdk.UnwrapSDKContext(goCtx),
+		common.MustNewAssetPair(msg.TokenPair),
+		sdk.MustAccAddressFromBech32(msg.Sender),
+		msg.OrderType,
+		msg.Side,
+		msg.TriggerPrice,
+		msg.QuoteAssetAmount,
+		msg.Leverage,
+		msg.BaseAssetAmountLimit,
+		msg.Expiry,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgPlaceOrderResponse{OrderId: order.Id}, nil
+}
+
+func (m msgServer) CancelOrder(goCtx context.Context, msg *types.MsgCancelOrder) (*types.MsgCancelOrderResponse, error) {
+	refund, err := m.k.CancelOrder(
+		sdk.UnwrapSDKContext(goCtx),
+		sdk.MustAccAddressFromBech32(msg.Sender),
+		msg.OrderId,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelOrderResponse{RefundedReward: refund}, nil
+}
+
+func (m msgServer) DepositCrossMargin(goCtx context.Context, msg *types.MsgDepositCrossMargin) (*types.MsgDepositCrossMarginResponse, error) {
+	account, err := m.k.DepositCrossMargin(
+		sdk.UnwrapSDKContext(goCtx),
+		sdk.MustAccAddressFromBech32(msg.Sender),
+		msg.Collateral,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDepositCrossMarginResponse{Account: account}, nil
+}
+
+func (m msgServer) WithdrawCrossMargin(goCtx context.Context, msg *types.MsgWithdrawCrossMargin) (*types.MsgWithdrawCrossMarginResponse, error) {
+	account, err := m.k.WithdrawCrossMargin(
+		sdk.UnwrapSDKContext(goCtx),
+		sdk.MustAccAddressFromBech32(msg.Sender),
+		msg.Collateral,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWithdrawCrossMarginResponse{Account: account}, nil
+}
+
+func (m msgServer) TransferPosition(goCtx context.Context, msg *types.MsgTransferPosition) (*types.MsgTransferPositionResponse, error) {
+	pair, err := common.NewAssetPair(msg.TokenPair)
+	if err != nil {
+		return nil, err
+	}
+
+	position, err := m.k.TransferPosition(
+		sdk.UnwrapSDKContext(goCtx),
+		pair,
+		sdk.MustAccAddressFromBech32(msg.Sender),
+		sdk.MustAccAddressFromBech32(msg.Receiver),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTransferPositionResponse{Position: position}, nil
+}
+
+func (m msgServer) PartialClose(goCtx context.Context, msg *types.MsgPartialClose) (*types.MsgPartialCloseResponse, error) {
+	size, quoteAssetAmount, limit := msg.Size_, msg.QuoteAssetAmount, msg.Limit
+	if size.IsNil() {
+		size = sdk.ZeroDec()
+	}
+	if quoteAssetAmount.IsNil() {
+		quoteAssetAmount = sdk.ZeroDec()
+	}
+	if limit.IsNil() {
+		limit = sdk.ZeroDec()
+	}
+
+	resp, err := m.k.PartialClose(
+		sdk.UnwrapSDKContext(goCtx),
+		common.MustNewAssetPair(msg.TokenPair),
+		sdk.MustAccAddressFromBech32(msg.Sender),
+		size,
+		quoteAssetAmount,
+		limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgPartialCloseResponse{
+		ExchangedNotionalValue: resp.ExchangedNotionalValue,
+		ExchangedPositionSize:  resp.ExchangedPositionSize,
+		FundingPayment:         resp.FundingPayment,
+		RealizedPnl:            resp.RealizedPnl,
+		Position:               *resp.Position,
+	}, nil
+}
+
+func (m msgServer) BidLiquidationAuction(goCtx context.Context, msg *types.MsgBidLiquidationAuction) (*types.MsgBidLiquidationAuctionResponse, error) {
+	pair, err := common.NewAssetPair(msg.TokenPair)
+	if err != nil {
+		return nil, err
+	}
+
+	auction, err := m.k.BidLiquidationAuction(
+		sdk.UnwrapSDKContext(goCtx),
+		sdk.MustAccAddressFromBech32(msg.Sender),
+		pair,
+		sdk.MustAccAddressFromBech32(msg.Trader),
+		msg.Price,
+		msg.Margin,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgBidLiquidationAuctionResponse{Auction: auction}, nil
+}
@@ -86,8 +86,8 @@ func TestMsgServerAddMargin(t *testing.T) {
 				/* maxLeverage */ sdk.MustNewDecFromStr("15"),
 			)
 			setPairMetadata(app.PerpKeeper, ctx, types.PairMetadata{
-				Pair:                       common.Pair_BTC_NUSD,
-				CumulativePremiumFractions: []sdk.Dec{sdk.ZeroDec()},
+				Pair:                            common.Pair_BTC_NUSD,
+				LatestCumulativePremiumFraction: sdk.ZeroDec(),
 			})
 
 			t.Log("fund trader")
@@ -167,7 +167,7 @@ func TestMsgServerRemoveMargin(t *testing.T) {
 				BlockNumber:                     1,
 			},
 			marginToRemove: sdk.NewInt64Coin(common.DenomNUSD, 1000),
-			expectedErr:    sdkerrors.ErrInsufficientFunds,
+			expectedErr:    types.ErrVaultInsolvent,
 		},
 		{
 			name:       "success",
@@ -205,8 +205,8 @@ func TestMsgServerRemoveMargin(t *testing.T) {
 				/* maxLeverage */ sdk.MustNewDecFromStr("15"),
 			)
 			setPairMetadata(app.PerpKeeper, ctx, types.PairMetadata{
-				Pair:                       common.Pair_BTC_NUSD,
-				CumulativePremiumFractions: []sdk.Dec{sdk.ZeroDec()},
+				Pair:                            common.Pair_BTC_NUSD,
+				LatestCumulativePremiumFraction: sdk.ZeroDec(),
 			})
 
 			t.Log("fund vault")
@@ -292,8 +292,8 @@ func TestMsgServerOpenPosition(t *testing.T) {
 				/* maxLeverage */ sdk.MustNewDecFromStr("15"),
 			)
 			setPairMetadata(app.PerpKeeper, ctx, types.PairMetadata{
-				Pair:                       common.Pair_BTC_NUSD,
-				CumulativePremiumFractions: []sdk.Dec{sdk.ZeroDec()},
+				Pair:                            common.Pair_BTC_NUSD,
+				LatestCumulativePremiumFraction: sdk.ZeroDec(),
 			})
 
 			traderAddr, err := sdk.AccAddressFromBech32(tc.sender)
@@ -377,8 +377,8 @@ func TestMsgServerClosePosition(t *testing.T) {
 				/* maxLeverage */ sdk.MustNewDecFromStr("15"),
 			)
 			setPairMetadata(app.PerpKeeper, ctx, types.PairMetadata{
-				Pair:                       common.Pair_BTC_NUSD,
-				CumulativePremiumFractions: []sdk.Dec{sdk.ZeroDec()},
+				Pair:                            common.Pair_BTC_NUSD,
+				LatestCumulativePremiumFraction: sdk.ZeroDec(),
 			})
 
 			t.Log("create position")
@@ -454,8 +454,8 @@ func TestMsgServerLiquidate(t *testing.T) {
 				/* maxLeverage */ sdk.MustNewDecFromStr("15"),
 			)
 			setPairMetadata(app.PerpKeeper, ctx, types.PairMetadata{
-				Pair:                       common.Pair_BTC_NUSD,
-				CumulativePremiumFractions: []sdk.Dec{sdk.ZeroDec()},
+				Pair:                            common.Pair_BTC_NUSD,
+				LatestCumulativePremiumFraction: sdk.ZeroDec(),
 			})
 			ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(time.Now().Add(time.Minute))
 
@@ -529,8 +529,8 @@ func TestMsgServerMultiLiquidate(t *testing.T) {
 		/* maxLeverage */ sdk.MustNewDecFromStr("15"),
 	)
 	setPairMetadata(app.PerpKeeper, ctx, types.PairMetadata{
-		Pair:                       pair,
-		CumulativePremiumFractions: []sdk.Dec{sdk.ZeroDec()},
+		Pair:                            pair,
+		LatestCumulativePremiumFraction: sdk.ZeroDec(),
 	})
 	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(time.Now().Add(time.Minute))
 
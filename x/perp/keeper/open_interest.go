@@ -0,0 +1,258 @@
+package keeper
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/collections"
+	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/perp/types"
+	vpooltypes "github.com/NibiruChain/nibiru/x/vpool/types"
+)
+
+/*
+GetPosition returns a position with the socialized losses it has not yet
+taken deducted from its margin. Every read of the Positions map which may lead to a
+write must go through GetPosition, or the position would escape the losses.
+
+args:
+  - ctx: cosmos-sdk context
+  - pair: the pair of the position
+  - traderAddr: the owner of the position
+
+ret:
+  - position: the position net of the socialized losses
+  - err: error if the position doesn't exist
+*/
+func (k Keeper) GetPosition(ctx sdk.Context, pair common.AssetPair, traderAddr sdk.AccAddress) (types.Position, error) {
+	position, err := k.Positions.Get(ctx, collections.Join(pair, traderAddr))
+	if err != nil {
+		return types.Position{}, err
+	}
+
+	return k.withSocializedLosses(ctx, position), nil
+}
+
+// withSocializedLosses takes the socialized losses past the cursor of a
+// position from its margin and moves the cursor past them.
+func (k Keeper) withSocializedLosses(ctx sdk.Context, position types.Position) types.Position {
+	position.Margin = k.applySocializedLosses(ctx, position.Pair.QuoteDenom(), position.Margin, position.SocializedLossCursor)
+	position.SocializedLossCursor = k.lastSocializedLossID(ctx)
+	return position
+}
+
+/*
+SetPosition saves a position to state and updates the open interest of its
+pair and the total margin of its quote denom with the difference between the
+previous and the new position. The margin of the position is taken to be net
+of all the socialized losses so far.
+Every write to the Positions map must go through SetPosition or
+DeletePosition to keep the open interest, the total margin and the ADL index
+in sync.
+
+args:
+  - ctx: cosmos-sdk context
+  - position: the position to save
+*/
+func (k Keeper) SetPosition(ctx sdk.Context, position types.Position) {
+	key := collections.Join(position.Pair, sdk.MustAccAddressFromBech32(position.TraderAddress))
+	previous, err := k.Positions.Get(ctx, key)
+	if err != nil {
+		previous = types.Position{Pair: position.Pair, Size_: sdk.ZeroDec(), Margin: sdk.ZeroDec(), OpenNotional: sdk.ZeroDec()}
+	}
+	previous = k.withSocializedLosses(ctx, previous)
+	position.SocializedLossCursor = previous.SocializedLossCursor
+
+	k.updateOpenInterest(ctx, previous, position)
+	k.addToTotalMargin(ctx, position.Pair.QuoteDenom(), positiveMargin(position).Sub(positiveMargin(previous)))
+	k.unindexADL(ctx, previous)
+	k.indexADL(ctx, position)
+	k.Positions.Insert(ctx, key, position)
+}
+
+/*
+DeletePosition removes a position from state and removes it from the open
+interest of its pair, from the total margin of its quote denom and from the
+ADL index.
+
+args:
+  - ctx: cosmos-sdk context
+  - pair: the pair of the position
+  - traderAddr: the owner of the position
+
+ret:
+  - err: error if the position doesn't exist
+*/
+func (k Keeper) DeletePosition(ctx sdk.Context, pair common.AssetPair, traderAddr sdk.AccAddress) error {
+	previous, err := k.GetPosition(ctx, pair, traderAddr)
+	if err != nil {
+		return err
+	}
+
+	k.updateOpenInterest(ctx, previous, types.Position{Pair: pair, Size_: sdk.ZeroDec(), OpenNotional: sdk.ZeroDec()})
+	k.addToTotalMargin(ctx, pair.QuoteDenom(), positiveMargin(previous).Neg())
+	k.unindexADL(ctx, previous)
+	return k.Positions.Delete(ctx, collections.Join(pair, traderAddr))
+}
+
+// positiveMargin returns the margin of a position which counts towards the
+// total margin: underwater positions have nothing to take a loss from.
+func positiveMargin(position types.Position) sdk.Dec {
+	if position.Margin.IsNil() || !position.Margin.IsPositive() {
+		return sdk.ZeroDec()
+	}
+	return position.Margin
+}
+
+// GetOpenInterest returns the open interest of a pair, zero if it has no positions.
+func (k Keeper) GetOpenInterest(ctx sdk.Context, pair common.AssetPair) types.OpenInterest {
+	return k.OpenInterests.GetOr(ctx, pair, types.OpenInterest{
+		Pair:              pair,
+		LongSize:          sdk.ZeroDec(),
+		ShortSize:         sdk.ZeroDec(),
+		LongOpenNotional:  sdk.ZeroDec(),
+		ShortOpenNotional: sdk.ZeroDec(),
+	})
+}
+
+// updateOpenInterest replaces the contribution of the previous position to the
+// open interest of its pair with the contribution of the new position.
+func (k Keeper) updateOpenInterest(ctx sdk.Context, previous types.Position, position types.Position) {
+	openInterest := k.GetOpenInterest(ctx, position.Pair)
+	addToOpenInterest(&openInterest, previous, sdk.OneDec().Neg())
+	addToOpenInterest(&openInterest, position, sdk.OneDec())
+	k.OpenInterests.Insert(ctx, position.Pair, openInterest)
+}
+
+// addToOpenInterest adds the size and the open notional of a position, times
+// 'sign', to the side of the open interest the position is on.
+func addToOpenInterest(openInterest *types.OpenInterest, position types.Position, sign sdk.Dec) {
+	switch {
+	case position.Size_.IsPositive():
+		openInterest.LongSize = openInterest.LongSize.Add(position.Size_.Mul(sign))
+		openInterest.LongOpenNotional = openInterest.LongOpenNotional.Add(position.OpenNotional.Mul(sign))
+	case position.Size_.IsNegative():
+		openInterest.ShortSize = openInterest.ShortSize.Add(position.Size_.Abs().Mul(sign))
+		openInterest.ShortOpenNotional = openInterest.ShortOpenNotional.Add(position.OpenNotional.Mul(sign))
+	}
+}
+
+// getOpenInterestCaps returns the caps on the open interest and on the position
+// size of a single trader of a pair, zero meaning no cap.
+func (k Keeper) getOpenInterestCaps(ctx sdk.Context, pair common.AssetPair) (maxOpenInterest sdk.Dec, maxPositionSize sdk.Dec) {
+	maxOpenInterest, maxPositionSize = sdk.ZeroDec(), sdk.ZeroDec()
+	metadata, err := k.PairsMetadata.Get(ctx, pair)
+	if err != nil {
+		return maxOpenInterest, maxPositionSize
+	}
+
+	if !metadata.MaxOpenInterest.IsNil() {
+		maxOpenInterest = metadata.MaxOpenInterest
+	}
+	if !metadata.MaxPositionSize.IsNil() {
+		maxPositionSize = metadata.MaxPositionSize
+	}
+	return maxOpenInterest, maxPositionSize
+}
+
+/*
+SetOpenInterestCaps sets the caps on the open interest and on the position size
+of a single trader of a pair. A zero cap means no cap.
+
+args:
+  - ctx: cosmos-sdk context
+  - pair: the pair
+  - maxOpenInterest: the cap on the total size of each side, in base asset units
+  - maxPositionSize: the cap on the size of a single position, in base asset units
+
+ret:
+  - err: error if the pair has no metadata or the caps are invalid
+*/
+func (k Keeper) SetOpenInterestCaps(
+	ctx sdk.Context, pair common.AssetPair, maxOpenInterest sdk.Dec, maxPositionSize sdk.Dec,
+) error {
+	metadata, err := k.PairsMetadata.Get(ctx, pair)
+	if err != nil {
+		return types.ErrPairMetadataNotFound.Wrap(pair.String())
+	}
+
+	if err = types.ValidateOpenInterestCaps(maxOpenInterest, maxPositionSize); err != nil {
+		return err
+	}
+
+	metadata.MaxOpenInterest = maxOpenInterest
+	metadata.MaxPositionSize = maxPositionSize
+	k.PairsMetadata.Insert(ctx, pair, metadata)
+	return nil
+}
+
+/*
+checkOpenInterestCaps checks that trading 'notional' on a position keeps the
+open interest of the pair and the size of the position within the caps set by
+governance. The size traded is estimated at the current reserves of the vpool.
+Trades that reduce the exposure are always allowed, even if the caps are
+already exceeded.
+
+args:
+  - ctx: cosmos-sdk context
+  - pair: the pair of the position
+  - side: whether the trade is in the BUY or SELL direction
+  - traderAddr: the owner of the position
+  - notional: the notional value of the trade, in quote asset units
+
+ret:
+  - err: ErrOpenInterestCapExceeded if a cap would be exceeded
+*/
+func (k Keeper) checkOpenInterestCaps(
+	ctx sdk.Context, pair common.AssetPair, side types.Side, traderAddr sdk.AccAddress, notional sdk.Dec,
+) error {
+	maxOpenInterest, maxPositionSize := k.getOpenInterestCaps(ctx, pair)
+	if maxOpenInterest.IsZero() && maxPositionSize.IsZero() {
+		return nil
+	}
+
+	var sizeDelta sdk.Dec
+	var err error
+	if side == types.Side_BUY {
+		sizeDelta, err = k.VpoolKeeper.GetQuoteAssetPrice(ctx, pair, vpooltypes.Direction_ADD_TO_POOL, notional)
+	} else {
+		sizeDelta, err = k.VpoolKeeper.GetQuoteAssetPrice(ctx, pair, vpooltypes.Direction_REMOVE_FROM_POOL, notional)
+		sizeDelta = sizeDelta.Neg()
+	}
+	if err != nil {
+		return err
+	}
+
+	previousSize := sdk.ZeroDec()
+	position, err := k.GetPosition(ctx, pair, traderAddr)
+	if err == nil {
+		previousSize = position.Size_
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	newSize := previousSize.Add(sizeDelta)
+
+	if maxPositionSize.IsPositive() && newSize.Abs().GT(maxPositionSize) && newSize.Abs().GT(previousSize.Abs()) {
+		return types.ErrOpenInterestCapExceeded.Wrapf(
+			"position size %s is over the cap of %s", newSize.Abs(), maxPositionSize)
+	}
+
+	if maxOpenInterest.IsPositive() {
+		openInterest := k.GetOpenInterest(ctx, pair)
+		longSize := openInterest.LongSize.Sub(sdk.MaxDec(previousSize, sdk.ZeroDec())).
+			Add(sdk.MaxDec(newSize, sdk.ZeroDec()))
+		shortSize := openInterest.ShortSize.Add(sdk.MinDec(previousSize, sdk.ZeroDec())).
+			Sub(sdk.MinDec(newSize, sdk.ZeroDec()))
+		if longSize.GT(maxOpenInterest) && longSize.GT(openInterest.LongSize) {
+			return types.ErrOpenInterestCapExceeded.Wrapf(
+				"long open interest %s is over the cap of %s", longSize, maxOpenInterest)
+		}
+		if shortSize.GT(maxOpenInterest) && shortSize.GT(openInterest.ShortSize) {
+			return types.ErrOpenInterestCapExceeded.Wrapf(
+				"short open interest %s is over the cap of %s", shortSize, maxOpenInterest)
+		}
+	}
+
+	return nil
+}
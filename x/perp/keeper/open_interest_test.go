@@ -0,0 +1,105 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/perp/keeper"
+	"github.com/NibiruChain/nibiru/x/perp/types"
+	"github.com/NibiruChain/nibiru/x/testutil"
+)
+
+func TestOpenInterest(t *testing.T) {
+	nibiruApp, ctx, longTrader := initOrdersTest(t, sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 10_000)))
+	perpKeeper := nibiruApp.PerpKeeper
+	shortTrader := testutil.AccAddress()
+	require.NoError(t, simapp.FundAccount(nibiruApp.BankKeeper, ctx, shortTrader,
+		sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 10_000))))
+
+	t.Log("open a long and a short")
+	longResp, err := perpKeeper.OpenPosition(ctx, common.Pair_BTC_NUSD, types.Side_BUY, longTrader,
+		sdk.NewInt(1_000), sdk.NewDec(10), sdk.ZeroDec())
+	require.NoError(t, err)
+	shortResp, err := perpKeeper.OpenPosition(ctx, common.Pair_BTC_NUSD, types.Side_SELL, shortTrader,
+		sdk.NewInt(500), sdk.NewDec(4), sdk.ZeroDec())
+	require.NoError(t, err)
+
+	openInterest := perpKeeper.GetOpenInterest(ctx, common.Pair_BTC_NUSD)
+	assert.EqualValues(t, longResp.Position.Size_, openInterest.LongSize)
+	assert.EqualValues(t, shortResp.Position.Size_.Abs(), openInterest.ShortSize)
+	assert.EqualValues(t, sdk.NewDec(10_000), openInterest.LongOpenNotional)
+	assert.EqualValues(t, sdk.NewDec(2_000), openInterest.ShortOpenNotional)
+
+	t.Log("reduce the long")
+	reduceResp, err := perpKeeper.OpenPosition(ctx, common.Pair_BTC_NUSD, types.Side_SELL, longTrader,
+		sdk.NewInt(100), sdk.NewDec(10), sdk.ZeroDec())
+	require.NoError(t, err)
+	openInterest = perpKeeper.GetOpenInterest(ctx, common.Pair_BTC_NUSD)
+	assert.EqualValues(t, reduceResp.Position.Size_, openInterest.LongSize)
+	assert.EqualValues(t, reduceResp.Position.OpenNotional, openInterest.LongOpenNotional)
+
+	t.Log("close the short")
+	_, err = perpKeeper.ClosePosition(ctx, common.Pair_BTC_NUSD, shortTrader)
+	require.NoError(t, err)
+	openInterest = perpKeeper.GetOpenInterest(ctx, common.Pair_BTC_NUSD)
+	assert.True(t, openInterest.ShortSize.IsZero())
+	assert.True(t, openInterest.ShortOpenNotional.IsZero())
+	assert.EqualValues(t, reduceResp.Position.Size_, openInterest.LongSize)
+
+	t.Log("query the open interest")
+	resp, err := keeper.NewQuerier(perpKeeper).QueryOpenInterest(sdk.WrapSDKContext(ctx), &types.QueryOpenInterestRequest{
+		TokenPair: common.Pair_BTC_NUSD.String(),
+	})
+	require.NoError(t, err)
+	assert.EqualValues(t, openInterest, resp.OpenInterest)
+	assert.True(t, resp.MaxOpenInterest.IsZero())
+	assert.True(t, resp.MaxPositionSize.IsZero())
+}
+
+func TestOpenInterestCaps(t *testing.T) {
+	nibiruApp, ctx, trader := initOrdersTest(t, sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 10_000)))
+	perpKeeper := nibiruApp.PerpKeeper
+	otherTrader := testutil.AccAddress()
+	require.NoError(t, simapp.FundAccount(nibiruApp.BankKeeper, ctx, otherTrader,
+		sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 10_000))))
+
+	// the mark price is 1, so the notional is about the size
+	require.NoError(t, perpKeeper.SetOpenInterestCaps(
+		ctx, common.Pair_BTC_NUSD, sdk.NewDec(15_000), sdk.NewDec(10_000)))
+
+	t.Log("a position over the position size cap is rejected")
+	_, err := perpKeeper.OpenPosition(ctx, common.Pair_BTC_NUSD, types.Side_BUY, trader,
+		sdk.NewInt(1_100), sdk.NewDec(10), sdk.ZeroDec())
+	require.ErrorIs(t, err, types.ErrOpenInterestCapExceeded)
+
+	t.Log("a position within the caps is opened")
+	_, err = perpKeeper.OpenPosition(ctx, common.Pair_BTC_NUSD, types.Side_BUY, trader,
+		sdk.NewInt(900), sdk.NewDec(10), sdk.ZeroDec())
+	require.NoError(t, err)
+
+	t.Log("a position over the open interest cap is rejected")
+	_, err = perpKeeper.OpenPosition(ctx, common.Pair_BTC_NUSD, types.Side_BUY, otherTrader,
+		sdk.NewInt(700), sdk.NewDec(10), sdk.ZeroDec())
+	require.ErrorIs(t, err, types.ErrOpenInterestCapExceeded)
+
+	t.Log("the other side is capped separately")
+	_, err = perpKeeper.OpenPosition(ctx, common.Pair_BTC_NUSD, types.Side_SELL, otherTrader,
+		sdk.NewInt(700), sdk.NewDec(10), sdk.ZeroDec())
+	require.NoError(t, err)
+
+	t.Log("reducing a position is allowed when the caps are lowered")
+	require.NoError(t, perpKeeper.SetOpenInterestCaps(
+		ctx, common.Pair_BTC_NUSD, sdk.NewDec(1_000), sdk.NewDec(1_000)))
+	_, err = perpKeeper.OpenPosition(ctx, common.Pair_BTC_NUSD, types.Side_SELL, trader,
+		sdk.NewInt(100), sdk.NewDec(10), sdk.ZeroDec())
+	require.NoError(t, err)
+
+	t.Log("the caps of a pair without metadata cannot be set")
+	require.ErrorIs(t, perpKeeper.SetOpenInterestCaps(
+		ctx, common.Pair_ETH_NUSD, sdk.NewDec(1_000), sdk.NewDec(1_000)), types.ErrPairMetadataNotFound)
+}
@@ -0,0 +1,440 @@
+package keeper
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/collections"
+	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/perp/types"
+)
+
+type orderBookKey = collections.Pair[common.AssetPair, collections.Pair[sdk.Dec, uint64]]
+
+// TriggerPriceIndex indexes orders by pair and trigger price.
+// The orders triggered by a price rise are kept apart from the ones triggered
+// by a price fall, so that all the triggered orders of a pair can be found
+// with a single range over each side of the book.
+type TriggerPriceIndex struct {
+	rise collections.KeySet[orderBookKey]
+	fall collections.KeySet[orderBookKey]
+}
+
+// NewTriggerPriceIndex instantiates a new TriggerPriceIndex, which uses one
+// storage namespace for each side of the order book.
+func NewTriggerPriceIndex(sk sdk.StoreKey, riseNamespace, fallNamespace collections.Namespace) TriggerPriceIndex {
+	keyEncoder := collections.PairKeyEncoder(
+		common.AssetPairKeyEncoder,
+		collections.PairKeyEncoder(collections.DecKeyEncoder, collections.Uint64KeyEncoder),
+	)
+	return TriggerPriceIndex{
+		rise: collections.NewKeySet[orderBookKey](sk, riseNamespace, keyEncoder),
+		fall: collections.NewKeySet[orderBookKey](sk, fallNamespace, keyEncoder),
+	}
+}
+
+// Insert implements the collections.Indexer interface.
+func (i TriggerPriceIndex) Insert(ctx sdk.Context, id uint64, order types.Order) {
+	i.side(order).Insert(ctx, collections.Join(order.Pair, collections.Join(order.TriggerPrice, id)))
+}
+
+// Delete implements the collections.Indexer interface.
+func (i TriggerPriceIndex) Delete(ctx sdk.Context, id uint64, order types.Order) {
+	i.side(order).Delete(ctx, collections.Join(order.Pair, collections.Join(order.TriggerPrice, id)))
+}
+
+// Triggered returns the ids, in ascending order, of at most 'limit' orders of
+// the pair which are triggered by any price within [low, high]. The orders
+// whose trigger price was crossed the furthest are kept, the lower id first on
+// equal distances, so that a deeply crossed order is not displaced by newer
+// orders which are barely crossed.
+func (i TriggerPriceIndex) Triggered(ctx sdk.Context, pair common.AssetPair, low, high sdk.Dec, limit int) []uint64 {
+	// orders triggered by a price rise have a trigger price <= high
+	riseKeys := firstOrderBookKeys(i.rise.Iterate(ctx, collections.PairRange[common.AssetPair, collections.Pair[sdk.Dec, uint64]]{}.
+		Prefix(pair).
+		EndInclusive(collections.Join(high, uint64(math.MaxUint64))),
+	), limit)
+	// orders triggered by a price fall have a trigger price >= low
+	fallKeys := firstOrderBookKeys(i.fall.Iterate(ctx, collections.PairRange[common.AssetPair, collections.Pair[sdk.Dec, uint64]]{}.
+		Prefix(pair).
+		StartInclusive(collections.Join(low, uint64(0))).
+		Descending(),
+	), limit)
+
+	// both sides come the furthest crossed first, merge them on the distance
+	// from the trigger price
+	ids := make([]uint64, 0, limit)
+	for len(ids) < limit && (len(riseKeys) > 0 || len(fallKeys) > 0) {
+		takeRise := len(fallKeys) == 0
+		if len(riseKeys) > 0 && len(fallKeys) > 0 {
+			riseDistance := high.Sub(riseKeys[0].K2().K1())
+			fallDistance := fallKeys[0].K2().K1().Sub(low)
+			takeRise = riseDistance.GT(fallDistance) ||
+				(riseDistance.Equal(fallDistance) && riseKeys[0].K2().K2() < fallKeys[0].K2().K2())
+		}
+		if takeRise {
+			ids = append(ids, riseKeys[0].K2().K2())
+			riseKeys = riseKeys[1:]
+		} else {
+			ids = append(ids, fallKeys[0].K2().K2())
+			fallKeys = fallKeys[1:]
+		}
+	}
+	sort.Slice(ids, func(a, b int) bool { return ids[a] < ids[b] })
+	return ids
+}
+
+// firstOrderBookKeys returns at most the first 'limit' keys of the iterator and closes it.
+func firstOrderBookKeys(iter collections.KeySetIterator[orderBookKey], limit int) (keys []orderBookKey) {
+	defer iter.Close()
+	for ; iter.Valid() && len(keys) < limit; iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	return keys
+}
+
+func (i TriggerPriceIndex) side(order types.Order) collections.KeySet[orderBookKey] {
+	if order.IsTriggeredByPriceRise() {
+		return i.rise
+	}
+	return i.fall
+}
+
+/*
+PlaceOrder rests a conditional order in the order book of the pair.
+The order execution reward is escrowed from the trader until the order is executed,
+cancelled or expired.
+
+args:
+  - ctx: cosmos-sdk context
+  - pair: the pair of the order
+  - traderAddr: the address of the trader who places the order
+  - orderType: LIMIT, STOP_LOSS or TAKE_PROFIT, the latter two closing the whole position
+  - side: the direction of the trade executed by the order
+  - triggerPrice: the price which triggers the order, not yet crossed by the mark or index price
+  - quoteAssetAmount: the amount of quote asset, only used by LIMIT orders
+  - leverage: the amount of leverage to take, only used by LIMIT orders
+  - baseAmtLimit: the limit on the base asset amount, only used by LIMIT orders
+  - expiry: the time after which the order expires, zero for the max order duration
+
+ret:
+  - order: the order as stored in the order book
+  - err: error
+*/
+func (k Keeper) PlaceOrder(
+	ctx sdk.Context,
+	pair common.AssetPair,
+	traderAddr sdk.AccAddress,
+	orderType types.OrderType,
+	side types.Side,
+	triggerPrice sdk.Dec,
+	quoteAssetAmount sdk.Int,
+	leverage sdk.Dec,
+	baseAmtLimit sdk.Int,
+	expiry time.Time,
+) (order types.Order, err error) {
+	params := k.GetParams(ctx)
+
+	if err = k.requirePoolNotSettled(ctx, pair); err != nil {
+		return types.Order{}, err
+	}
+
+	if orderType == types.OrderType_LIMIT {
+		if err = k.checkOpenPositionRequirements(ctx, pair, side, traderAddr, quoteAssetAmount, leverage); err != nil {
+			return types.Order{}, err
+		}
+	} else {
+		if err = k.requireVpool(ctx, pair); err != nil {
+			return types.Order{}, err
+		}
+
+		position, err := k.GetPosition(ctx, pair, traderAddr)
+		if err != nil {
+			return types.Order{}, err
+		}
+		if !orderClosesPosition(side, position) {
+			return types.Order{}, types.ErrInvalidOrder.Wrapf(
+				"a %s order must close the position of size %s", side, position.Size_)
+		}
+
+		// only limit orders open positions
+		quoteAssetAmount, leverage, baseAmtLimit = sdk.ZeroInt(), sdk.ZeroDec(), sdk.ZeroInt()
+	}
+
+	// an order must wait for the price to cross its trigger price, not fire on
+	// the next block because the price is already past it
+	_, _, low, high, err := k.getTriggerPriceRange(ctx, pair)
+	if err != nil {
+		return types.Order{}, err
+	}
+	triggerCheck := types.Order{OrderType: orderType, Side: side, TriggerPrice: triggerPrice}
+	if triggerCheck.IsTriggered(low) || triggerCheck.IsTriggered(high) {
+		return types.Order{}, types.ErrInvalidOrder.Wrapf(
+			"trigger price %s is already crossed by the prices in [%s, %s]", triggerPrice, low, high)
+	}
+
+	maxExpiry := ctx.BlockTime().Add(params.MaxOrderDuration)
+	if expiry.IsZero() {
+		expiry = maxExpiry
+	}
+	if !expiry.After(ctx.BlockTime()) {
+		return types.Order{}, types.ErrInvalidOrder.Wrapf("expiry %s is not after the block time", expiry)
+	}
+	if expiry.After(maxExpiry) {
+		return types.Order{}, types.ErrInvalidOrder.Wrapf(
+			"expiry %s is beyond the max order duration of %s", expiry, params.MaxOrderDuration)
+	}
+
+	executionReward := sdk.NewCoin(pair.QuoteDenom(), params.OrderExecutionReward)
+	if executionReward.IsPositive() {
+		if err = k.BankKeeper.SendCoinsFromAccountToModule(
+			ctx, traderAddr, types.ModuleName, sdk.NewCoins(executionReward),
+		); err != nil {
+			return types.Order{}, err
+		}
+	}
+
+	order = types.Order{
+		Id:                   k.OrderID.Next(ctx),
+		TraderAddress:        traderAddr.String(),
+		Pair:                 pair,
+		OrderType:            orderType,
+		Side:                 side,
+		TriggerPrice:         triggerPrice,
+		QuoteAssetAmount:     quoteAssetAmount,
+		Leverage:             leverage,
+		BaseAssetAmountLimit: baseAmtLimit,
+		ExecutionReward:      executionReward,
+		Expiry:               expiry,
+		BlockNumber:          ctx.BlockHeight(),
+	}
+	k.Orders.Insert(ctx, order.Id, order)
+
+	return order, ctx.EventManager().EmitTypedEvent(&types.OrderPlacedEvent{
+		Order:       order,
+		BlockHeight: ctx.BlockHeight(),
+		BlockTimeMs: ctx.BlockTime().UnixMilli(),
+	})
+}
+
+// CancelOrder removes an order of the trader from the order book and refunds its execution reward.
+func (k Keeper) CancelOrder(ctx sdk.Context, traderAddr sdk.AccAddress, orderID uint64) (refund sdk.Coin, err error) {
+	order, err := k.Orders.Get(ctx, orderID)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if order.TraderAddress != traderAddr.String() {
+		return sdk.Coin{}, types.ErrUnauthorized.Wrapf("order %d does not belong to %s", orderID, traderAddr)
+	}
+
+	if err = k.removeOrder(ctx, order, "cancelled by trader"); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return order.ExecutionReward, nil
+}
+
+// cancelTraderPairOrders removes the orders of the trader on the pair from the
+// order book and refunds their execution reward.
+func (k Keeper) cancelTraderPairOrders(
+	ctx sdk.Context, pair common.AssetPair, traderAddr sdk.AccAddress, reason string,
+) error {
+	for _, id := range k.Orders.Indexes.Trader.ExactMatch(ctx, traderAddr).PrimaryKeys() {
+		order, err := k.Orders.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+		if order.Pair != pair {
+			continue
+		}
+		if err = k.removeOrder(ctx, order, reason); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+/*
+RemoveExpiredOrders removes the orders whose expiry is not after the block
+time and refunds their execution reward, at most
+types.MaxExpiredOrdersRemovedPerBlock per block, the oldest expiry first. The
+expired orders left are removed in the next blocks.
+
+Each order is removed in its own cache context. Orders which fail to be
+removed are logged and left in place.
+*/
+func (k Keeper) RemoveExpiredOrders(ctx sdk.Context) {
+	iter := k.Orders.Indexes.Expiry.Iterate(ctx, collections.Range[collections.Pair[time.Time, uint64]]{}.
+		EndInclusive(collections.Join(ctx.BlockTime(), uint64(math.MaxUint64))),
+	)
+	var ids []uint64
+	for ; iter.Valid() && len(ids) < types.MaxExpiredOrdersRemovedPerBlock; iter.Next() {
+		ids = append(ids, iter.PrimaryKey())
+	}
+	iter.Close()
+
+	for _, id := range ids {
+		order, err := k.Orders.Get(ctx, id)
+		if err != nil {
+			k.Logger(ctx).Error("failed to get expired order", "order_id", id, "error", err.Error())
+			continue
+		}
+
+		cachedCtx, commit := ctx.CacheContext()
+		cachedCtx = cachedCtx.WithEventManager(sdk.NewEventManager())
+		if err = k.removeOrder(cachedCtx, order, "expired"); err != nil {
+			k.Logger(ctx).Error("failed to remove expired order", "order_id", id, "error", err.Error())
+			continue
+		}
+		commit()
+		ctx.EventManager().EmitEvents(cachedCtx.EventManager().Events())
+	}
+}
+
+/*
+ExecuteTriggeredOrders executes at most 'limit' orders of the pair whose trigger
+price has been crossed by the mark price or the index price, see
+TriggerPriceIndex.Triggered. The triggered orders left are executed in the next
+blocks if their trigger price is still crossed.
+
+Each order is executed in its own cache context. Orders which fail to execute
+are removed from the order book and their execution reward is refunded.
+
+ret:
+  - processed: the number of triggered orders visited, counting the ones which failed
+*/
+func (k Keeper) ExecuteTriggeredOrders(ctx sdk.Context, pair common.AssetPair, limit int) (processed int) {
+	markPrice, indexPrice, low, high, err := k.getTriggerPriceRange(ctx, pair)
+	if err != nil {
+		k.Logger(ctx).Error(err.Error(), "pair", pair.String())
+		return 0
+	}
+
+	ids := k.Orders.Indexes.TriggerPrice.Triggered(ctx, pair, low, high, limit)
+	for _, id := range ids {
+		order, err := k.Orders.Get(ctx, id)
+		if err != nil {
+			k.Logger(ctx).Error("failed to get triggered order", "order_id", id, "error", err.Error())
+			continue
+		}
+
+		cachedCtx, commit := ctx.CacheContext()
+		cachedCtx = cachedCtx.WithEventManager(sdk.NewEventManager())
+		if err = k.executeOrder(cachedCtx, order); err == nil {
+			err = k.Orders.Delete(cachedCtx, id)
+		}
+		if err != nil {
+			if err = k.removeOrder(ctx, order, fmt.Sprintf("failed to execute: %s", err)); err != nil {
+				k.Logger(ctx).Error("failed to remove order", "order_id", id, "error", err.Error())
+			}
+			continue
+		}
+		commit()
+		ctx.EventManager().EmitEvents(cachedCtx.EventManager().Events())
+
+		_ = ctx.EventManager().EmitTypedEvent(&types.OrderExecutedEvent{
+			Order:       order,
+			MarkPrice:   markPrice,
+			IndexPrice:  indexPrice,
+			BlockHeight: ctx.BlockHeight(),
+			BlockTimeMs: ctx.BlockTime().UnixMilli(),
+		})
+	}
+	return len(ids)
+}
+
+// getTriggerPriceRange returns the mark and index prices of the pair and the
+// range between them, the prices which trigger the orders of the pair. The
+// index price is zero, and the range only the mark price, when the pair has no
+// index price.
+func (k Keeper) getTriggerPriceRange(ctx sdk.Context, pair common.AssetPair) (
+	markPrice, indexPrice, low, high sdk.Dec, err error,
+) {
+	markPrice, err = k.VpoolKeeper.GetMarkPrice(ctx, pair)
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, sdk.Dec{}, sdk.Dec{}, err
+	}
+
+	// the index price fails silently as not to halt orders when oracles aren't posting prices.
+	indexPrice = sdk.ZeroDec()
+	low, high = markPrice, markPrice
+	if currentPrice, err := k.PricefeedKeeper.GetCurrentPrice(ctx, pair.Token0, pair.Token1); err == nil {
+		indexPrice = currentPrice.Price
+		low, high = sdk.MinDec(markPrice, indexPrice), sdk.MaxDec(markPrice, indexPrice)
+	}
+	return markPrice, indexPrice, low, high, nil
+}
+
+// executeOrder trades the order against the vpool and pays its execution reward to the fee pool.
+func (k Keeper) executeOrder(ctx sdk.Context, order types.Order) (err error) {
+	traderAddr := sdk.MustAccAddressFromBech32(order.TraderAddress)
+
+	if order.OrderType == types.OrderType_LIMIT {
+		_, err = k.OpenPosition(
+			ctx,
+			order.Pair,
+			order.Side,
+			traderAddr,
+			order.QuoteAssetAmount,
+			order.Leverage,
+			order.BaseAssetAmountLimit.ToDec(),
+		)
+	} else {
+		var position types.Position
+		position, err = k.GetPosition(ctx, order.Pair, traderAddr)
+		if err != nil {
+			return err
+		}
+		if !orderClosesPosition(order.Side, position) {
+			return types.ErrInvalidOrder.Wrapf(
+				"a %s order must close the position of size %s", order.Side, position.Size_)
+		}
+		_, err = k.ClosePosition(ctx, order.Pair, traderAddr)
+	}
+	if err != nil {
+		return err
+	}
+
+	if order.ExecutionReward.IsPositive() {
+		return k.BankKeeper.SendCoinsFromModuleToModule(
+			ctx, types.ModuleName, types.FeePoolModuleAccount, sdk.NewCoins(order.ExecutionReward))
+	}
+
+	return nil
+}
+
+// removeOrder deletes the order from the order book and refunds its execution reward to the trader.
+func (k Keeper) removeOrder(ctx sdk.Context, order types.Order, reason string) error {
+	if err := k.Orders.Delete(ctx, order.Id); err != nil {
+		return err
+	}
+
+	if order.ExecutionReward.IsPositive() {
+		if err := k.BankKeeper.SendCoinsFromModuleToAccount(
+			ctx,
+			types.ModuleName,
+			sdk.MustAccAddressFromBech32(order.TraderAddress),
+			sdk.NewCoins(order.ExecutionReward),
+		); err != nil {
+			return err
+		}
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.OrderCancelledEvent{
+		Order:       order,
+		Reason:      reason,
+		BlockHeight: ctx.BlockHeight(),
+		BlockTimeMs: ctx.BlockTime().UnixMilli(),
+	})
+}
+
+// orderClosesPosition returns true if a trade on the given side reduces the position.
+func orderClosesPosition(side types.Side, position types.Position) bool {
+	return (position.Size_.IsPositive() && side == types.Side_SELL) ||
+		(position.Size_.IsNegative() && side == types.Side_BUY)
+}
@@ -0,0 +1,444 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/collections"
+	nibisimapp "github.com/NibiruChain/nibiru/simapp"
+	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/perp/types"
+	"github.com/NibiruChain/nibiru/x/testutil"
+)
+
+// initOrdersTest creates a BTC:NUSD vpool with a mark price of 1 and a funded trader.
+func initOrdersTest(t *testing.T, traderFunds sdk.Coins) (*nibisimapp.NibiruTestApp, sdk.Context, sdk.AccAddress) {
+	nibiruApp, ctx := nibisimapp.NewTestNibiruAppAndContext(true)
+	ctx = ctx.WithBlockHeight(1).WithBlockTime(time.Now())
+	traderAddr := testutil.AccAddress()
+
+	nibiruApp.VpoolKeeper.CreatePool(
+		ctx,
+		common.Pair_BTC_NUSD,
+		/* tradeLimitRatio */ sdk.OneDec(),
+		/* quoteReserve */ sdk.NewDec(1_000_000_000_000),
+		/* baseReserve */ sdk.NewDec(1_000_000_000_000),
+		/* fluctuationLimit */ sdk.OneDec(),
+		/* maxOracleSpreadRatio */ sdk.OneDec(),
+		/* maintenanceMarginRatio */ sdk.MustNewDecFromStr("0.0625"),
+		/* maxLeverage */ sdk.MustNewDecFromStr("15"),
+	)
+	setPairMetadata(nibiruApp.PerpKeeper, ctx, types.PairMetadata{
+		Pair:                            common.Pair_BTC_NUSD,
+		LatestCumulativePremiumFraction: sdk.ZeroDec(),
+	})
+	require.NoError(t, simapp.FundAccount(nibiruApp.BankKeeper, ctx, traderAddr, traderFunds))
+
+	return nibiruApp, ctx, traderAddr
+}
+
+func TestPlaceOrder(t *testing.T) {
+	testCases := []struct {
+		name            string
+		traderFunds     sdk.Coins
+		initialPosition *types.Position
+
+		orderType types.OrderType
+		side      types.Side
+		expiry    time.Duration
+
+		expectedErr error
+	}{
+		{
+			name:        "limit order",
+			traderFunds: sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 1_000)),
+			orderType:   types.OrderType_LIMIT,
+			side:        types.Side_BUY,
+		},
+		{
+			name:        "stop loss order on a long position",
+			traderFunds: sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 1_000)),
+			initialPosition: &types.Position{
+				Pair:                            common.Pair_BTC_NUSD,
+				Size_:                           sdk.NewDec(10_000),
+				Margin:                          sdk.NewDec(1_000),
+				OpenNotional:                    sdk.NewDec(10_000),
+				LatestCumulativePremiumFraction: sdk.ZeroDec(),
+			},
+			orderType: types.OrderType_STOP_LOSS,
+			side:      types.Side_SELL,
+			expiry:    time.Hour,
+		},
+		{
+			name:        "stop loss order without position",
+			traderFunds: sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 1_000)),
+			orderType:   types.OrderType_STOP_LOSS,
+			side:        types.Side_SELL,
+			expectedErr: collections.ErrNotFound,
+		},
+		{
+			name:        "take profit order on the side of the position",
+			traderFunds: sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 1_000)),
+			initialPosition: &types.Position{
+				Pair:                            common.Pair_BTC_NUSD,
+				Size_:                           sdk.NewDec(-10_000),
+				Margin:                          sdk.NewDec(1_000),
+				OpenNotional:                    sdk.NewDec(10_000),
+				LatestCumulativePremiumFraction: sdk.ZeroDec(),
+			},
+			orderType:   types.OrderType_TAKE_PROFIT,
+			side:        types.Side_SELL,
+			expectedErr: types.ErrInvalidOrder,
+		},
+		{
+			name:        "expiry beyond max order duration",
+			traderFunds: sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 1_000)),
+			orderType:   types.OrderType_LIMIT,
+			side:        types.Side_BUY,
+			expiry:      types.DefaultParams().MaxOrderDuration + time.Second,
+			expectedErr: types.ErrInvalidOrder,
+		},
+		{
+			name:        "expiry in the past",
+			traderFunds: sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 1_000)),
+			orderType:   types.OrderType_LIMIT,
+			side:        types.Side_BUY,
+			expiry:      -time.Second,
+			expectedErr: types.ErrInvalidOrder,
+		},
+		{
+			name:        "not enough funds for the execution reward",
+			traderFunds: sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 999)),
+			orderType:   types.OrderType_LIMIT,
+			side:        types.Side_BUY,
+			expectedErr: sdkerrors.ErrInsufficientFunds,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			nibiruApp, ctx, traderAddr := initOrdersTest(t, tc.traderFunds)
+
+			if tc.initialPosition != nil {
+				tc.initialPosition.TraderAddress = traderAddr.String()
+				setPosition(nibiruApp.PerpKeeper, ctx, *tc.initialPosition)
+			}
+
+			var expiry time.Time
+			if tc.expiry != 0 {
+				expiry = ctx.BlockTime().Add(tc.expiry)
+			}
+
+			order, err := nibiruApp.PerpKeeper.PlaceOrder(
+				ctx,
+				common.Pair_BTC_NUSD,
+				traderAddr,
+				tc.orderType,
+				tc.side,
+				/* triggerPrice */ sdk.MustNewDecFromStr("0.9"),
+				/* quoteAssetAmount */ sdk.NewInt(100),
+				/* leverage */ sdk.NewDec(10),
+				/* baseAmtLimit */ sdk.ZeroInt(),
+				expiry,
+			)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			t.Log("assert order in state")
+			stored, err := nibiruApp.PerpKeeper.Orders.Get(ctx, order.Id)
+			require.NoError(t, err)
+			assert.Equal(t, order, stored)
+			assert.EqualValues(t, sdk.NewInt64Coin(common.DenomNUSD, 1_000), order.ExecutionReward)
+			if tc.expiry == 0 {
+				assert.Equal(t, ctx.BlockTime().Add(types.DefaultParams().MaxOrderDuration).UTC(), order.Expiry.UTC())
+			}
+			if tc.orderType != types.OrderType_LIMIT {
+				assert.EqualValues(t, sdk.ZeroInt(), order.QuoteAssetAmount)
+				assert.EqualValues(t, sdk.ZeroDec(), order.Leverage)
+			}
+
+			t.Log("assert execution reward is escrowed")
+			assert.True(t, nibiruApp.BankKeeper.GetBalance(ctx, traderAddr, common.DenomNUSD).IsZero())
+			testutil.RequireHasTypedEvent(t, ctx, &types.OrderPlacedEvent{
+				Order:       order,
+				BlockHeight: ctx.BlockHeight(),
+				BlockTimeMs: ctx.BlockTime().UnixMilli(),
+			})
+		})
+	}
+}
+
+func TestCancelOrder(t *testing.T) {
+	t.Run("refunds the execution reward", func(t *testing.T) {
+		nibiruApp, ctx, traderAddr := initOrdersTest(t, sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 1_000)))
+		order, err := nibiruApp.PerpKeeper.PlaceOrder(ctx, common.Pair_BTC_NUSD, traderAddr, types.OrderType_LIMIT,
+			types.Side_BUY, sdk.MustNewDecFromStr("0.9"), sdk.NewInt(100), sdk.NewDec(10), sdk.ZeroInt(), time.Time{})
+		require.NoError(t, err)
+
+		refund, err := nibiruApp.PerpKeeper.CancelOrder(ctx, traderAddr, order.Id)
+		require.NoError(t, err)
+		assert.EqualValues(t, order.ExecutionReward, refund)
+		assert.EqualValues(t, refund, nibiruApp.BankKeeper.GetBalance(ctx, traderAddr, common.DenomNUSD))
+
+		_, err = nibiruApp.PerpKeeper.Orders.Get(ctx, order.Id)
+		require.ErrorIs(t, err, collections.ErrNotFound)
+		assert.Empty(t, nibiruApp.PerpKeeper.Orders.Indexes.Trader.ExactMatch(ctx, traderAddr).PrimaryKeys())
+		testutil.RequireHasTypedEvent(t, ctx, &types.OrderCancelledEvent{
+			Order:       order,
+			Reason:      "cancelled by trader",
+			BlockHeight: ctx.BlockHeight(),
+			BlockTimeMs: ctx.BlockTime().UnixMilli(),
+		})
+	})
+
+	t.Run("order of another trader", func(t *testing.T) {
+		nibiruApp, ctx, traderAddr := initOrdersTest(t, sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 1_000)))
+		order, err := nibiruApp.PerpKeeper.PlaceOrder(ctx, common.Pair_BTC_NUSD, traderAddr, types.OrderType_LIMIT,
+			types.Side_BUY, sdk.MustNewDecFromStr("0.9"), sdk.NewInt(100), sdk.NewDec(10), sdk.ZeroInt(), time.Time{})
+		require.NoError(t, err)
+
+		_, err = nibiruApp.PerpKeeper.CancelOrder(ctx, testutil.AccAddress(), order.Id)
+		require.ErrorIs(t, err, types.ErrUnauthorized)
+	})
+
+	t.Run("order not found", func(t *testing.T) {
+		nibiruApp, ctx, traderAddr := initOrdersTest(t, sdk.NewCoins())
+		_, err := nibiruApp.PerpKeeper.CancelOrder(ctx, traderAddr, 1)
+		require.ErrorIs(t, err, collections.ErrNotFound)
+	})
+}
+
+func TestExecuteTriggeredOrders(t *testing.T) {
+	t.Run("limit orders", func(t *testing.T) {
+		nibiruApp, ctx, traderAddr := initOrdersTest(t, sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 10_000)))
+		perpKeeper := nibiruApp.PerpKeeper
+
+		t.Log("place two buy orders below the mark price")
+		triggered, err := perpKeeper.PlaceOrder(ctx, common.Pair_BTC_NUSD, traderAddr, types.OrderType_LIMIT,
+			types.Side_BUY, sdk.MustNewDecFromStr("0.9"), sdk.NewInt(1_000), sdk.NewDec(10), sdk.ZeroInt(), time.Time{})
+		require.NoError(t, err)
+		resting, err := perpKeeper.PlaceOrder(ctx, common.Pair_BTC_NUSD, traderAddr, types.OrderType_LIMIT,
+			types.Side_BUY, sdk.MustNewDecFromStr("0.8"), sdk.NewInt(1_000), sdk.NewDec(10), sdk.ZeroInt(), time.Time{})
+		require.NoError(t, err)
+
+		t.Log("the index price falls between the two trigger prices")
+		postIndexPrice(t, nibiruApp, ctx, sdk.MustNewDecFromStr("0.85"))
+		perpKeeper.ExecuteTriggeredOrders(ctx, common.Pair_BTC_NUSD, types.MaxTriggeredOrdersExecutedPerBlock)
+
+		t.Log("assert the triggered order opened a position")
+		position, err := perpKeeper.Positions.Get(ctx, collections.Join(common.Pair_BTC_NUSD, traderAddr))
+		require.NoError(t, err)
+		assert.EqualValues(t, sdk.NewDec(1_000), position.Margin)
+		assert.EqualValues(t, sdk.NewDec(10_000), position.OpenNotional)
+
+		_, err = perpKeeper.Orders.Get(ctx, triggered.Id)
+		require.ErrorIs(t, err, collections.ErrNotFound)
+		_, err = perpKeeper.Orders.Get(ctx, resting.Id)
+		require.NoError(t, err)
+
+		t.Log("assert execution reward paid to the fee pool")
+		feePool := nibiruApp.AccountKeeper.GetModuleAddress(types.FeePoolModuleAccount)
+		assert.EqualValues(t,
+			// 10 bps of the notional plus the execution reward
+			sdk.NewInt64Coin(common.DenomNUSD, 10+1_000),
+			nibiruApp.BankKeeper.GetBalance(ctx, feePool, common.DenomNUSD))
+		testutil.RequireHasTypedEvent(t, ctx, &types.OrderExecutedEvent{
+			Order:       triggered,
+			MarkPrice:   sdk.OneDec(),
+			IndexPrice:  sdk.MustNewDecFromStr("0.85"),
+			BlockHeight: ctx.BlockHeight(),
+			BlockTimeMs: ctx.BlockTime().UnixMilli(),
+		})
+	})
+
+	t.Run("stop loss order closes the position", func(t *testing.T) {
+		nibiruApp, ctx, traderAddr := initOrdersTest(t, sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 10_000)))
+		perpKeeper := nibiruApp.PerpKeeper
+
+		_, err := perpKeeper.OpenPosition(ctx, common.Pair_BTC_NUSD, types.Side_SELL, traderAddr,
+			sdk.NewInt(1_000), sdk.NewDec(10), sdk.ZeroDec())
+		require.NoError(t, err)
+
+		t.Log("the short position pushed the mark price below 1, which does not trigger the stop loss")
+		order, err := perpKeeper.PlaceOrder(ctx, common.Pair_BTC_NUSD, traderAddr, types.OrderType_STOP_LOSS,
+			types.Side_BUY, sdk.OneDec(), sdk.ZeroInt(), sdk.ZeroDec(), sdk.ZeroInt(), time.Time{})
+		require.NoError(t, err)
+		perpKeeper.ExecuteTriggeredOrders(ctx, common.Pair_BTC_NUSD, types.MaxTriggeredOrdersExecutedPerBlock)
+		_, err = perpKeeper.Orders.Get(ctx, order.Id)
+		require.NoError(t, err)
+
+		t.Log("another trader pushes the mark price above 1")
+		otherTrader := testutil.AccAddress()
+		require.NoError(t, simapp.FundAccount(nibiruApp.BankKeeper, ctx, otherTrader,
+			sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 10_000))))
+		_, err = perpKeeper.OpenPosition(ctx, common.Pair_BTC_NUSD, types.Side_BUY, otherTrader,
+			sdk.NewInt(2_000), sdk.NewDec(10), sdk.ZeroDec())
+		require.NoError(t, err)
+
+		perpKeeper.ExecuteTriggeredOrders(ctx, common.Pair_BTC_NUSD, types.MaxTriggeredOrdersExecutedPerBlock)
+		_, err = perpKeeper.Positions.Get(ctx, collections.Join(common.Pair_BTC_NUSD, traderAddr))
+		require.ErrorIs(t, err, collections.ErrNotFound)
+		_, err = perpKeeper.Orders.Get(ctx, order.Id)
+		require.ErrorIs(t, err, collections.ErrNotFound)
+	})
+
+	t.Run("order failing to execute is cancelled", func(t *testing.T) {
+		nibiruApp, ctx, traderAddr := initOrdersTest(t, sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 1_000)))
+		perpKeeper := nibiruApp.PerpKeeper
+		setPosition(perpKeeper, ctx, types.Position{
+			TraderAddress:                   traderAddr.String(),
+			Pair:                            common.Pair_BTC_NUSD,
+			Size_:                           sdk.NewDec(10_000),
+			Margin:                          sdk.NewDec(1_000),
+			OpenNotional:                    sdk.NewDec(10_000),
+			LatestCumulativePremiumFraction: sdk.ZeroDec(),
+		})
+		order, err := perpKeeper.PlaceOrder(ctx, common.Pair_BTC_NUSD, traderAddr, types.OrderType_TAKE_PROFIT,
+			types.Side_SELL, sdk.MustNewDecFromStr("1.5"), sdk.ZeroInt(), sdk.ZeroDec(), sdk.ZeroInt(), time.Time{})
+		require.NoError(t, err)
+
+		t.Log("the position is gone by the time the order is triggered")
+		require.NoError(t, perpKeeper.DeletePosition(ctx, common.Pair_BTC_NUSD, traderAddr))
+		postIndexPrice(t, nibiruApp, ctx, sdk.NewDec(2))
+		perpKeeper.ExecuteTriggeredOrders(ctx, common.Pair_BTC_NUSD, types.MaxTriggeredOrdersExecutedPerBlock)
+
+		_, err = perpKeeper.Orders.Get(ctx, order.Id)
+		require.ErrorIs(t, err, collections.ErrNotFound)
+		assert.EqualValues(t, order.ExecutionReward, nibiruApp.BankKeeper.GetBalance(ctx, traderAddr, common.DenomNUSD))
+	})
+}
+
+func TestTriggerPriceIndexTriggered(t *testing.T) {
+	nibiruApp, ctx, traderAddr := initOrdersTest(t, sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 100_000)))
+	perpKeeper := nibiruApp.PerpKeeper
+	placeLimitOrder := func(side types.Side, triggerPrice string) uint64 {
+		order, err := perpKeeper.PlaceOrder(ctx, common.Pair_BTC_NUSD, traderAddr, types.OrderType_LIMIT,
+			side, sdk.MustNewDecFromStr(triggerPrice), sdk.NewInt(1_000), sdk.NewDec(10), sdk.ZeroInt(), time.Time{})
+		require.NoError(t, err)
+		return order.Id
+	}
+
+	t.Log("place barely crossed orders before a deeply crossed one")
+	sell11 := placeLimitOrder(types.Side_SELL, "1.1")
+	buy055 := placeLimitOrder(types.Side_BUY, "0.55")
+	buy06 := placeLimitOrder(types.Side_BUY, "0.6")
+	buy09 := placeLimitOrder(types.Side_BUY, "0.9")
+	index := perpKeeper.Orders.Indexes.TriggerPrice
+	low, high := sdk.MustNewDecFromStr("0.5"), sdk.MustNewDecFromStr("1.2")
+
+	t.Log("the orders crossed the furthest are kept, whatever their id")
+	assert.EqualValues(t, []uint64{buy09}, index.Triggered(ctx, common.Pair_BTC_NUSD, low, high, 1))
+
+	t.Log("equal distances on both sides keep the lower id")
+	assert.EqualValues(t, []uint64{sell11, buy09}, index.Triggered(ctx, common.Pair_BTC_NUSD, low, high, 2))
+	assert.EqualValues(t, []uint64{sell11, buy06, buy09}, index.Triggered(ctx, common.Pair_BTC_NUSD, low, high, 3))
+
+	t.Log("the ids are returned in ascending order")
+	assert.EqualValues(t, []uint64{sell11, buy055, buy06, buy09}, index.Triggered(ctx, common.Pair_BTC_NUSD, low, high, 10))
+
+	t.Log("orders whose trigger price is not crossed are left out")
+	assert.EqualValues(t, []uint64{buy09}, index.Triggered(ctx, common.Pair_BTC_NUSD, sdk.MustNewDecFromStr("0.7"), sdk.OneDec(), 10))
+}
+
+func TestPlaceOrderAlreadyTriggered(t *testing.T) {
+	nibiruApp, ctx, traderAddr := initOrdersTest(t, sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 10_000)))
+	perpKeeper := nibiruApp.PerpKeeper
+
+	t.Log("a buy order above the mark price of 1 would fire on the next block")
+	_, err := perpKeeper.PlaceOrder(ctx, common.Pair_BTC_NUSD, traderAddr, types.OrderType_LIMIT,
+		types.Side_BUY, sdk.MustNewDecFromStr("1.1"), sdk.NewInt(1_000), sdk.NewDec(10), sdk.ZeroInt(), time.Time{})
+	require.ErrorIs(t, err, types.ErrInvalidOrder)
+
+	t.Log("a trigger price crossed by the index price only is rejected too")
+	postIndexPrice(t, nibiruApp, ctx, sdk.MustNewDecFromStr("0.8"))
+	_, err = perpKeeper.PlaceOrder(ctx, common.Pair_BTC_NUSD, traderAddr, types.OrderType_LIMIT,
+		types.Side_BUY, sdk.MustNewDecFromStr("0.9"), sdk.NewInt(1_000), sdk.NewDec(10), sdk.ZeroInt(), time.Time{})
+	require.ErrorIs(t, err, types.ErrInvalidOrder)
+
+	_, err = perpKeeper.PlaceOrder(ctx, common.Pair_BTC_NUSD, traderAddr, types.OrderType_LIMIT,
+		types.Side_BUY, sdk.MustNewDecFromStr("0.7"), sdk.NewInt(1_000), sdk.NewDec(10), sdk.ZeroInt(), time.Time{})
+	require.NoError(t, err)
+}
+
+func TestRemoveExpiredOrders(t *testing.T) {
+	nibiruApp, ctx, traderAddr := initOrdersTest(t, sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 2_000)))
+	perpKeeper := nibiruApp.PerpKeeper
+
+	expiring, err := perpKeeper.PlaceOrder(ctx, common.Pair_BTC_NUSD, traderAddr, types.OrderType_LIMIT,
+		types.Side_BUY, sdk.MustNewDecFromStr("0.9"), sdk.NewInt(100), sdk.NewDec(10), sdk.ZeroInt(), ctx.BlockTime().Add(time.Minute))
+	require.NoError(t, err)
+	remaining, err := perpKeeper.PlaceOrder(ctx, common.Pair_BTC_NUSD, traderAddr, types.OrderType_LIMIT,
+		types.Side_BUY, sdk.MustNewDecFromStr("0.9"), sdk.NewInt(100), sdk.NewDec(10), sdk.ZeroInt(), ctx.BlockTime().Add(time.Hour))
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Minute))
+	perpKeeper.RemoveExpiredOrders(ctx)
+
+	_, err = perpKeeper.Orders.Get(ctx, expiring.Id)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+	_, err = perpKeeper.Orders.Get(ctx, remaining.Id)
+	require.NoError(t, err)
+	assert.EqualValues(t, expiring.ExecutionReward, nibiruApp.BankKeeper.GetBalance(ctx, traderAddr, common.DenomNUSD))
+}
+
+func TestEndBlockerOrderCaps(t *testing.T) {
+	nibiruApp, ctx, traderAddr := initOrdersTest(t, sdk.NewCoins())
+	perpKeeper := nibiruApp.PerpKeeper
+	insertOrder := func(orderType types.OrderType, side types.Side, triggerPrice sdk.Dec, expiry time.Time) types.Order {
+		order := types.Order{
+			Id:                   perpKeeper.OrderID.Next(ctx),
+			TraderAddress:        traderAddr.String(),
+			Pair:                 common.Pair_BTC_NUSD,
+			OrderType:            orderType,
+			Side:                 side,
+			TriggerPrice:         triggerPrice,
+			QuoteAssetAmount:     sdk.ZeroInt(),
+			Leverage:             sdk.ZeroDec(),
+			BaseAssetAmountLimit: sdk.ZeroInt(),
+			ExecutionReward:      sdk.NewInt64Coin(common.DenomNUSD, 0),
+			Expiry:               expiry,
+		}
+		perpKeeper.Orders.Insert(ctx, order.Id, order)
+		return order
+	}
+
+	t.Run("expired orders past the cap are removed in the next block", func(t *testing.T) {
+		var last types.Order
+		for i := 0; i <= types.MaxExpiredOrdersRemovedPerBlock; i++ {
+			last = insertOrder(types.OrderType_LIMIT, types.Side_BUY, sdk.MustNewDecFromStr("0.5"),
+				ctx.BlockTime().Add(time.Duration(i+1)*time.Second))
+		}
+		ctx := ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+
+		perpKeeper.RemoveExpiredOrders(ctx)
+		assert.Len(t, perpKeeper.Orders.Iterate(ctx, collections.Range[uint64]{}).Keys(), 1)
+		_, err := perpKeeper.Orders.Get(ctx, last.Id)
+		require.NoError(t, err, "the latest expiry is left for the next block")
+
+		perpKeeper.RemoveExpiredOrders(ctx)
+		assert.Empty(t, perpKeeper.Orders.Iterate(ctx, collections.Range[uint64]{}).Keys())
+	})
+
+	t.Run("triggered orders past the limit are left in the book", func(t *testing.T) {
+		// take profit orders without a position are triggered by the mark price of 1 and fail to execute
+		for i := 0; i < 3; i++ {
+			insertOrder(types.OrderType_TAKE_PROFIT, types.Side_SELL, sdk.MustNewDecFromStr("0.5"), ctx.BlockTime().Add(time.Hour))
+		}
+
+		assert.EqualValues(t, 2, perpKeeper.ExecuteTriggeredOrders(ctx, common.Pair_BTC_NUSD, 2))
+		assert.Len(t, perpKeeper.Orders.Iterate(ctx, collections.Range[uint64]{}).Keys(), 1)
+
+		assert.EqualValues(t, 1, perpKeeper.ExecuteTriggeredOrders(ctx, common.Pair_BTC_NUSD, 2))
+		assert.Empty(t, perpKeeper.Orders.Iterate(ctx, collections.Range[uint64]{}).Keys())
+	})
+}
@@ -0,0 +1,113 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/collections"
+	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/perp/types"
+)
+
+func TestPartialClose(t *testing.T) {
+	testCases := []struct {
+		name             string
+		side             types.Side
+		size             sdk.Dec
+		quoteAssetAmount sdk.Dec
+		limit            sdk.Dec
+
+		expectedErr error
+	}{
+		{
+			name:             "long closed by size",
+			side:             types.Side_BUY,
+			size:             sdk.NewDec(400),
+			quoteAssetAmount: sdk.ZeroDec(),
+			limit:            sdk.ZeroDec(),
+		},
+		{
+			name:             "short closed by size",
+			side:             types.Side_SELL,
+			size:             sdk.NewDec(400),
+			quoteAssetAmount: sdk.ZeroDec(),
+			limit:            sdk.ZeroDec(),
+		},
+		{
+			name:             "long closed by notional",
+			side:             types.Side_BUY,
+			size:             sdk.ZeroDec(),
+			quoteAssetAmount: sdk.NewDec(400),
+			limit:            sdk.ZeroDec(),
+		},
+		{
+			name:             "size not smaller than the position size",
+			side:             types.Side_BUY,
+			size:             sdk.NewDec(1_000),
+			quoteAssetAmount: sdk.ZeroDec(),
+			limit:            sdk.ZeroDec(),
+			expectedErr:      types.ErrInvalidPartialClose,
+		},
+		{
+			name:             "notional not smaller than the position notional",
+			side:             types.Side_SELL,
+			size:             sdk.ZeroDec(),
+			quoteAssetAmount: sdk.NewDec(1_000),
+			limit:            sdk.ZeroDec(),
+			expectedErr:      types.ErrInvalidPartialClose,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			nibiruApp, ctx, traderAddr := initOrdersTest(t, sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 1_000)))
+			perpKeeper := nibiruApp.PerpKeeper
+
+			_, err := perpKeeper.OpenPosition(ctx, common.Pair_BTC_NUSD, tc.side, traderAddr,
+				sdk.NewInt(100), sdk.NewDec(10), sdk.ZeroDec())
+			require.NoError(t, err)
+			position, err := perpKeeper.Positions.Get(ctx, collections.Join(common.Pair_BTC_NUSD, traderAddr))
+			require.NoError(t, err)
+
+			resp, err := perpKeeper.PartialClose(ctx, common.Pair_BTC_NUSD, traderAddr, tc.size, tc.quoteAssetAmount, tc.limit)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			if tc.size.IsPositive() {
+				assert.EqualValues(t, tc.size, resp.ExchangedPositionSize.Abs())
+			} else {
+				assert.EqualValues(t, tc.quoteAssetAmount, resp.ExchangedNotionalValue)
+			}
+
+			newPosition, err := perpKeeper.Positions.Get(ctx, collections.Join(common.Pair_BTC_NUSD, traderAddr))
+			require.NoError(t, err)
+			assert.EqualValues(t, position.Size_.Add(resp.ExchangedPositionSize), newPosition.Size_)
+			assert.Equal(t, position.Size_.IsPositive(), newPosition.Size_.IsPositive())
+			assert.True(t, newPosition.Size_.Abs().LT(position.Size_.Abs()))
+			assert.True(t, newPosition.OpenNotional.LT(position.OpenNotional))
+		})
+	}
+}
+
+func TestPartialCloseLimit(t *testing.T) {
+	nibiruApp, ctx, traderAddr := initOrdersTest(t, sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 1_000)))
+	perpKeeper := nibiruApp.PerpKeeper
+
+	_, err := perpKeeper.OpenPosition(ctx, common.Pair_BTC_NUSD, types.Side_BUY, traderAddr,
+		sdk.NewInt(100), sdk.NewDec(10), sdk.ZeroDec())
+	require.NoError(t, err)
+
+	t.Log("a long cannot receive less than the limit")
+	_, err = perpKeeper.PartialClose(ctx, common.Pair_BTC_NUSD, traderAddr, sdk.NewDec(400), sdk.ZeroDec(), sdk.NewDec(401))
+	require.Error(t, err)
+
+	_, err = perpKeeper.PartialClose(ctx, common.Pair_BTC_NUSD, traderAddr, sdk.NewDec(400), sdk.ZeroDec(), sdk.NewDec(399))
+	require.NoError(t, err)
+}
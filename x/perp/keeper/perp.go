@@ -1,14 +1,29 @@
 package keeper
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/NibiruChain/nibiru/collections"
-
+	"github.com/NibiruChain/nibiru/x/common"
 	"github.com/NibiruChain/nibiru/x/perp/types"
 )
 
-// SettlePosition settles a trader position
+/*
+SettlePosition settles a trader position of a market whose vpool was settled by
+governance. The position is closed at the settlement price of the vpool, its
+bad debt, if any, is realized and then its margin, PnL and pending funding
+payment are paid out of the vault.
+
+args:
+  - ctx: the cosmos-sdk context
+  - currentPosition: the position to settle
+
+ret:
+  - transferredCoins: the coins paid out to the trader
+  - err: error if any
+*/
 func (k Keeper) SettlePosition(
 	ctx sdk.Context,
 	currentPosition types.Position,
@@ -16,62 +31,319 @@ func (k Keeper) SettlePosition(
 	// Validate trader address
 	traderAddr, err := sdk.AccAddressFromBech32(currentPosition.TraderAddress)
 	if err != nil {
-		return sdk.NewCoins(), nil
+		return nil, err
 	}
 
 	if currentPosition.Size_.IsZero() {
-		return sdk.NewCoins(), nil
-	}
-
-	err = k.Positions.Delete(ctx, collections.Join(currentPosition.Pair, traderAddr))
-	if err != nil {
-		return sdk.NewCoins(), nil
+		return sdk.NewCoins(), k.DeletePosition(ctx, currentPosition.Pair, traderAddr)
 	}
 
 	// run calculations on settled values
 	settlementPrice, err := k.VpoolKeeper.GetSettlementPrice(ctx, currentPosition.Pair)
 	if err != nil {
-		return sdk.NewCoins(), nil
+		return nil, err
+	}
+
+	if err = k.DeletePosition(ctx, currentPosition.Pair, traderAddr); err != nil {
+		return nil, err
 	}
 
-	settledValue := sdk.ZeroDec()
-	if settlementPrice.IsZero() {
-		settledValue = currentPosition.Margin
-	} else {
+	realizedPnL := sdk.ZeroDec()
+	if !settlementPrice.IsZero() {
 		// openPrice = positionOpenNotional / abs(positionSize)
 		openPrice := currentPosition.OpenNotional.Quo(currentPosition.Size_.Abs())
-		// returnedFund := positionSize * (settlementPrice - openPrice) + positionMargin
-		returnedFund := currentPosition.Size_.Mul(
-			settlementPrice.Sub(openPrice)).Add(currentPosition.Margin)
-		if returnedFund.IsPositive() {
-			settledValue = returnedFund
+		// realizedPnL = positionSize * (settlementPrice - openPrice)
+		realizedPnL = currentPosition.Size_.Mul(settlementPrice.Sub(openPrice))
+	}
+	remaining, err := k.CalcRemainMarginWithFundingPayment(ctx, currentPosition, realizedPnL)
+	if err != nil {
+		return nil, err
+	}
+	settledValue := remaining.Margin
+	badDebt := remaining.BadDebt
+
+	denom := currentPosition.Pair.QuoteDenom()
+	isCrossMargin := k.isCrossMarginAccount(ctx, traderAddr)
+	if badDebt.IsPositive() && isCrossMargin {
+		// the collateral of a cross margin account pays for the bad debt first
+		if badDebt, err = k.coverBadDebtWithCrossMargin(ctx, traderAddr, denom, badDebt); err != nil {
+			return nil, err
+		}
+	}
+	if badDebt.IsPositive() {
+		if err = k.realizeBadDebt(ctx, denom, badDebt.RoundInt(), nil); err != nil {
+			return nil, err
 		}
 	}
 
-	transferredCoins = sdk.NewCoins(sdk.NewInt64Coin(currentPosition.Pair.QuoteDenom(), 0))
+	transferredCoins = sdk.NewCoins()
 	settledValueInt := settledValue.RoundInt()
-	if settledValueInt.IsPositive() {
-		toTransfer := sdk.NewCoin(currentPosition.Pair.QuoteDenom(), settledValueInt)
-		transferredCoins = sdk.NewCoins(toTransfer)
-		if err != nil {
-			panic(err) // NOTE(mercilex): must never happen
+	if isCrossMargin {
+		// the settled value goes back to the collateral of a cross margin account
+		if err = k.allocateCrossMargin(ctx, traderAddr, denom, settledValueInt.Neg()); err != nil {
+			return nil, err
 		}
-		err = k.BankKeeper.SendCoinsFromModuleToAccount( // NOTE(mercilex): withdraw is not applied here
-			ctx,
-			types.VaultModuleAccount,
-			traderAddr,
-			transferredCoins,
-		)
-		if err != nil {
-			panic(err) // NOTE(mercilex): must never happen
+	} else if settledValueInt.IsPositive() {
+		if err = k.Withdraw(ctx, denom, traderAddr, settledValueInt); err != nil {
+			return nil, err
 		}
+		transferredCoins = sdk.NewCoins(sdk.NewCoin(denom, settledValueInt))
 	}
 
-	err = ctx.EventManager().EmitTypedEvent(&types.PositionSettledEvent{
-		Pair:          currentPosition.Pair.String(),
-		TraderAddress: traderAddr.String(),
-		SettledCoins:  transferredCoins,
+	err = k.emitPositionSettled(ctx, &types.PositionSettledEvent{
+		Pair:            currentPosition.Pair.String(),
+		TraderAddress:   traderAddr.String(),
+		SettledCoins:    transferredCoins,
+		SettlementPrice: settlementPrice,
+		PositionSize:    currentPosition.Size_,
+		BadDebt:         sdk.NewCoin(denom, badDebt.RoundInt()),
+		BlockHeight:     ctx.BlockHeight(),
+		BlockTimeMs:     ctx.BlockTime().UnixMilli(),
 	})
 
 	return transferredCoins, err
 }
+
+/*
+SettleSettledMarkets sweeps the markets whose vpool was settled by governance.
+The conditional orders of a settled market are cancelled and then its
+positions are settled, at most types.MaxPositionsSettledPerBlock orders and
+positions per block across all the markets. The sweep resumes after the last
+order and position it visited, so orders and positions which fail to be
+cancelled or settled don't hold back the ones behind them, and starts over
+once it reaches the last one of the market. Once a market has no orders nor
+positions left, it is marked as settled, and the vault is reconciled if it was
+the last live market of its quote denom.
+*/
+func (k Keeper) SettleSettledMarkets(ctx sdk.Context) {
+	remaining := types.MaxPositionsSettledPerBlock
+	for _, pool := range k.VpoolKeeper.GetAllPools(ctx) {
+		if remaining == 0 {
+			return
+		}
+
+		pair := pool.Pair
+		if k.SettledPairs.Has(ctx, pair) || !k.VpoolKeeper.IsPoolSettled(ctx, pair) {
+			continue
+		}
+
+		cursor := k.SettlementCursors.GetOr(ctx, pair, types.SettlementCursor{Pair: pair})
+
+		limit := remaining
+		orderIDs := k.pagePairOrders(ctx, pair, cursor.LastOrderId, limit)
+		k.cancelOrders(ctx, orderIDs, "pair settled")
+		remaining -= len(orderIDs)
+		if len(orderIDs) < limit {
+			// the sweep reached the last order of the market
+			cursor.LastOrderId = 0
+		} else {
+			cursor.LastOrderId = orderIDs[len(orderIDs)-1]
+		}
+
+		if remaining > 0 {
+			var after sdk.AccAddress
+			if cursor.TraderAddress != "" {
+				after = sdk.MustAccAddressFromBech32(cursor.TraderAddress)
+			}
+			limit = remaining
+			positions, last := k.pagePositions(ctx, pair, after, limit)
+			for _, position := range positions {
+				cachedCtx, commit := ctx.CacheContext()
+				cachedCtx = cachedCtx.WithEventManager(sdk.NewEventManager())
+				if _, err := k.SettlePosition(cachedCtx, position); err != nil {
+					// a position which can't be settled is left in place, and is
+					// settled by the trader or in a later sweep.
+					k.Logger(ctx).Error("failed to settle position",
+						"pair", pair.String(), "trader", position.TraderAddress, "error", err.Error())
+					continue
+				}
+				commit()
+				ctx.EventManager().EmitEvents(cachedCtx.EventManager().Events())
+			}
+			remaining -= len(positions)
+
+			if len(positions) < limit {
+				// the sweep reached the last position of the market
+				cursor.TraderAddress = ""
+			} else {
+				cursor.TraderAddress = last.String()
+			}
+		}
+
+		if cursor.LastOrderId == 0 && cursor.TraderAddress == "" {
+			_ = k.SettlementCursors.Delete(ctx, pair)
+		} else {
+			k.SettlementCursors.Insert(ctx, pair, cursor)
+		}
+
+		positions, _ := k.pagePositions(ctx, pair, nil, 1)
+		if len(positions) == 0 && len(k.pagePairOrders(ctx, pair, 0, 1)) == 0 {
+			cachedCtx, commit := ctx.CacheContext()
+			cachedCtx = cachedCtx.WithEventManager(sdk.NewEventManager())
+			if err := k.completeMarketSettlement(cachedCtx, pair); err != nil {
+				k.Logger(ctx).Error("failed to complete the market settlement", "pair", pair.String(), "error", err.Error())
+				continue
+			}
+			commit()
+			ctx.EventManager().EmitEvents(cachedCtx.EventManager().Events())
+		}
+	}
+}
+
+// pagePositions returns at most 'limit' positions of the pair, starting after
+// the position of the trader 'after' if it isn't nil, and the trader of the last one.
+func (k Keeper) pagePositions(
+	ctx sdk.Context, pair common.AssetPair, after sdk.AccAddress, limit int,
+) (positions []types.Position, last sdk.AccAddress) {
+	rng := collections.PairRange[common.AssetPair, sdk.AccAddress]{}.Prefix(pair)
+	if after != nil {
+		rng = rng.StartExclusive(after)
+	}
+	iter := k.Positions.Iterate(ctx, rng)
+	defer iter.Close()
+
+	for ; iter.Valid() && len(positions) < limit; iter.Next() {
+		positions = append(positions, k.withSocializedLosses(ctx, iter.Value()))
+		last = iter.Key().K2()
+	}
+	return positions, last
+}
+
+// pagePairOrders returns the ids of at most 'limit' conditional orders of the
+// pair, in the order they were placed, starting after the order 'after'.
+func (k Keeper) pagePairOrders(ctx sdk.Context, pair common.AssetPair, after uint64, limit int) (ids []uint64) {
+	iter := k.Orders.Indexes.Pair.Iterate(ctx, collections.PairRange[common.AssetPair, uint64]{}.
+		Prefix(pair).
+		StartExclusive(after),
+	)
+	defer iter.Close()
+
+	for ; iter.Valid() && len(ids) < limit; iter.Next() {
+		ids = append(ids, iter.PrimaryKey())
+	}
+	return ids
+}
+
+// cancelOrders removes the conditional orders, refunding their execution
+// reward. Orders which fail to be removed are logged and left in place.
+func (k Keeper) cancelOrders(ctx sdk.Context, ids []uint64, reason string) {
+	for _, id := range ids {
+		order, err := k.Orders.Get(ctx, id)
+		if err != nil {
+			k.Logger(ctx).Error("failed to get order", "order_id", id, "error", err.Error())
+			continue
+		}
+
+		cachedCtx, commit := ctx.CacheContext()
+		cachedCtx = cachedCtx.WithEventManager(sdk.NewEventManager())
+		if err = k.removeOrder(cachedCtx, order, reason); err != nil {
+			k.Logger(ctx).Error("failed to remove order", "order_id", id, "error", err.Error())
+			continue
+		}
+		commit()
+		ctx.EventManager().EmitEvents(cachedCtx.EventManager().Events())
+	}
+}
+
+/*
+completeMarketSettlement marks the market of a settled vpool as settled once
+every position has been settled, and reports the state of the vault in its
+quote denom.
+
+The vault is shared by the markets of a quote denom, so it is only reconciled
+when the last of them is settled: the prepaid bad debt of the denom is cleared
+since no position is left to realize it, and the surplus of the vault, net of
+the collateral of the cross margin accounts and the auction bids it holds for
+traders, is moved to the ecosystem fund.
+*/
+func (k Keeper) completeMarketSettlement(ctx sdk.Context, pair common.AssetPair) error {
+	settlementPrice, err := k.VpoolKeeper.GetSettlementPrice(ctx, pair)
+	if err != nil {
+		return err
+	}
+
+	k.SettledPairs.Insert(ctx, pair)
+
+	denom := pair.QuoteDenom()
+	clearedPrepaidBadDebt := sdk.NewCoin(denom, sdk.ZeroInt())
+	vaultSurplus := sdk.NewCoin(denom, sdk.ZeroInt())
+	if !k.hasLiveMarket(ctx, denom) {
+		if clearedPrepaidBadDebt, vaultSurplus, err = k.reconcileVault(ctx, denom); err != nil {
+			return err
+		}
+	}
+
+	prepaidBadDebt := k.PrepaidBadDebt.GetOr(ctx, denom, types.PrepaidBadDebt{
+		Denom:  denom,
+		Amount: sdk.ZeroInt(),
+	})
+	vaultBalance := k.BankKeeper.GetBalance(ctx, k.AccountKeeper.GetModuleAddress(types.VaultModuleAccount), denom)
+
+	if err = ctx.EventManager().EmitTypedEvent(&types.MarketSettledEvent{
+		Pair:                  pair.String(),
+		SettlementPrice:       settlementPrice,
+		VaultBalance:          vaultBalance,
+		PrepaidBadDebt:        sdk.NewCoin(denom, prepaidBadDebt.Amount),
+		BlockHeight:           ctx.BlockHeight(),
+		BlockTimeMs:           ctx.BlockTime().UnixMilli(),
+		VaultSurplus:          vaultSurplus,
+		ClearedPrepaidBadDebt: clearedPrepaidBadDebt,
+	}); err != nil {
+		return fmt.Errorf("failed to emit MarketSettledEvent: %w", err)
+	}
+
+	return nil
+}
+
+// hasLiveMarket returns true if a market quoted in the denom isn't settled yet.
+func (k Keeper) hasLiveMarket(ctx sdk.Context, denom string) bool {
+	for _, pool := range k.VpoolKeeper.GetAllPools(ctx) {
+		if pool.Pair.QuoteDenom() == denom && !k.SettledPairs.Has(ctx, pool.Pair) {
+			return true
+		}
+	}
+	return false
+}
+
+// reconcileVault clears the prepaid bad debt of the denom and moves the surplus
+// of the vault, what it holds beyond the cross margin collateral and the
+// escrowed auction bids, to the ecosystem fund.
+func (k Keeper) reconcileVault(ctx sdk.Context, denom string) (
+	clearedPrepaidBadDebt sdk.Coin, surplus sdk.Coin, err error,
+) {
+	prepaidBadDebt := k.PrepaidBadDebt.GetOr(ctx, denom, types.PrepaidBadDebt{
+		Denom:  denom,
+		Amount: sdk.ZeroInt(),
+	})
+	clearedPrepaidBadDebt = sdk.NewCoin(denom, prepaidBadDebt.Amount)
+	k.PrepaidBadDebt.Insert(ctx, denom, types.PrepaidBadDebt{
+		Denom:  denom,
+		Amount: sdk.ZeroInt(),
+	})
+
+	held := sdk.ZeroInt()
+	for _, account := range k.CrossMarginAccounts.Iterate(ctx, collections.Range[sdk.AccAddress]{}).Values() {
+		held = held.Add(k.crossMarginWithSocializedLosses(ctx, account).Collateral.AmountOf(denom))
+	}
+	auctions := k.LiquidationAuctions.Iterate(ctx, collections.PairRange[common.AssetPair, sdk.AccAddress]{}).Values()
+	for _, auction := range auctions {
+		if auction.BestBidder != "" && auction.BestBidMargin.Denom == denom {
+			held = held.Add(auction.BestBidMargin.Amount)
+		}
+	}
+
+	vaultBalance := k.BankKeeper.GetBalance(ctx, k.AccountKeeper.GetModuleAddress(types.VaultModuleAccount), denom)
+	surplus = sdk.NewCoin(denom, sdk.MaxInt(vaultBalance.Amount.Sub(held), sdk.ZeroInt()))
+	if surplus.IsPositive() {
+		if err = k.BankKeeper.SendCoinsFromModuleToModule(
+			ctx,
+			/* from */ types.VaultModuleAccount,
+			/* to */ types.PerpEFModuleAccount,
+			sdk.NewCoins(surplus),
+		); err != nil {
+			return sdk.Coin{}, sdk.Coin{}, err
+		}
+	}
+
+	return clearedPrepaidBadDebt, surplus, nil
+}
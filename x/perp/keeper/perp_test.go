@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"sort"
 	"testing"
 	"time"
 
@@ -8,12 +9,15 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	nibisimapp "github.com/NibiruChain/nibiru/simapp"
 	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/perp/keeper"
 	"github.com/NibiruChain/nibiru/x/perp/types"
 	"github.com/NibiruChain/nibiru/x/testutil"
+	vpooltypes "github.com/NibiruChain/nibiru/x/vpool/types"
 )
 
 func TestKeeperClosePosition(t *testing.T) {
@@ -42,9 +46,8 @@ func TestKeeperClosePosition(t *testing.T) {
 
 		t.Log("Set vpool defined by pair on PerpKeeper")
 		setPairMetadata(nibiruApp.PerpKeeper, ctx, types.PairMetadata{
-			Pair: pair,
-			CumulativePremiumFractions: []sdk.Dec{
-				sdk.MustNewDecFromStr("0.2")},
+			Pair:                            pair,
+			LatestCumulativePremiumFraction: sdk.MustNewDecFromStr("0.2"),
 		},
 		)
 
@@ -67,9 +70,8 @@ func TestKeeperClosePosition(t *testing.T) {
 		t.Log("open position for bob - long")
 		// force funding payments
 		setPairMetadata(nibiruApp.PerpKeeper, ctx, types.PairMetadata{
-			Pair: pair,
-			CumulativePremiumFractions: []sdk.Dec{
-				sdk.MustNewDecFromStr("0.3")},
+			Pair:                            pair,
+			LatestCumulativePremiumFraction: sdk.MustNewDecFromStr("0.3"),
 		})
 		bob := testutil.AccAddress()
 		err = simapp.FundAccount(nibiruApp.BankKeeper, ctx, bob,
@@ -105,3 +107,231 @@ func TestKeeperClosePosition(t *testing.T) {
 		require.NoError(t, err)
 	})
 }
+
+func TestSettleSettledMarket(t *testing.T) {
+	nibiruApp, ctx, alice := initOrdersTest(t, sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 1_000)))
+	perpKeeper := nibiruApp.PerpKeeper
+	nibiruApp.PricefeedKeeper.ActivePairsStore().Set(ctx, common.Pair_BTC_NUSD, true)
+	bob := testutil.AccAddress()
+	require.NoError(t, simapp.FundAccount(nibiruApp.BankKeeper, ctx, bob,
+		sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 2_000))))
+
+	t.Log("alice goes long and bob goes short")
+	_, err := perpKeeper.OpenPosition(ctx, common.Pair_BTC_NUSD, types.Side_BUY, alice,
+		sdk.NewInt(100), sdk.NewDec(10), sdk.ZeroDec())
+	require.NoError(t, err)
+	_, err = perpKeeper.OpenPosition(ctx, common.Pair_BTC_NUSD, types.Side_SELL, bob,
+		sdk.NewInt(100), sdk.NewDec(10), sdk.ZeroDec())
+	require.NoError(t, err)
+	order, err := perpKeeper.PlaceOrder(ctx, common.Pair_BTC_NUSD, bob, types.OrderType_STOP_LOSS, types.Side_BUY,
+		sdk.MustNewDecFromStr("1.1"), sdk.ZeroInt(), sdk.ZeroDec(), sdk.ZeroInt(), time.Time{})
+	require.NoError(t, err)
+
+	t.Log("governance freezes the vpool")
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(ctx.BlockTime().Add(time.Minute))
+	settlement, err := nibiruApp.VpoolKeeper.SettlePool(ctx, common.Pair_BTC_NUSD, vpooltypes.SettlementPriceSource_MARK_TWAP, time.Hour)
+	require.NoError(t, err)
+
+	t.Log("only settlement is allowed")
+	_, err = perpKeeper.OpenPosition(ctx, common.Pair_BTC_NUSD, types.Side_BUY, alice,
+		sdk.NewInt(100), sdk.NewDec(10), sdk.ZeroDec())
+	require.ErrorIs(t, err, vpooltypes.ErrPoolSettled)
+	_, err = perpKeeper.AddMargin(ctx, common.Pair_BTC_NUSD, alice, sdk.NewInt64Coin(common.DenomNUSD, 10))
+	require.ErrorIs(t, err, types.ErrPairSettled)
+	_, err = perpKeeper.PlaceOrder(ctx, common.Pair_BTC_NUSD, alice, types.OrderType_STOP_LOSS, types.Side_SELL,
+		sdk.MustNewDecFromStr("0.9"), sdk.ZeroInt(), sdk.ZeroDec(), sdk.ZeroInt(), time.Time{})
+	require.ErrorIs(t, err, types.ErrPairSettled)
+
+	t.Log("alice settles her position")
+	msgServer := keeper.NewMsgServerImpl(perpKeeper)
+	resp, err := msgServer.SettlePosition(sdk.WrapSDKContext(ctx), &types.MsgSettlePosition{
+		Sender:    alice.String(),
+		TokenPair: common.Pair_BTC_NUSD.String(),
+	})
+	require.NoError(t, err)
+	require.True(t, resp.SettledCoins.AmountOf(common.DenomNUSD).IsPositive())
+	_, err = perpKeeper.Positions.Get(ctx, collections.Join(common.Pair_BTC_NUSD, alice))
+	require.ErrorIs(t, err, collections.ErrNotFound)
+
+	t.Log("the end blocker sweep settles bob and completes the market settlement")
+	require.False(t, perpKeeper.SettledPairs.Has(ctx, common.Pair_BTC_NUSD))
+	perpKeeper.SettleSettledMarkets(ctx)
+	_, err = perpKeeper.Positions.Get(ctx, collections.Join(common.Pair_BTC_NUSD, bob))
+	require.ErrorIs(t, err, collections.ErrNotFound)
+	_, err = perpKeeper.Orders.Get(ctx, order.Id)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+	require.True(t, perpKeeper.SettledPairs.Has(ctx, common.Pair_BTC_NUSD))
+
+	t.Log("both traders were paid out of the vault, which has no surplus left")
+	vaultAddr := nibiruApp.AccountKeeper.GetModuleAddress(types.VaultModuleAccount)
+	assert.True(t, nibiruApp.BankKeeper.GetBalance(ctx, vaultAddr, common.DenomNUSD).IsZero())
+	testutil.RequireHasTypedEvent(t, ctx, &types.MarketSettledEvent{
+		Pair:                  common.Pair_BTC_NUSD.String(),
+		SettlementPrice:       settlement.SettlementPrice,
+		VaultBalance:          sdk.NewInt64Coin(common.DenomNUSD, 0),
+		PrepaidBadDebt:        sdk.NewInt64Coin(common.DenomNUSD, 0),
+		BlockHeight:           ctx.BlockHeight(),
+		BlockTimeMs:           ctx.BlockTime().UnixMilli(),
+		VaultSurplus:          sdk.NewInt64Coin(common.DenomNUSD, 0),
+		ClearedPrepaidBadDebt: sdk.NewInt64Coin(common.DenomNUSD, 0),
+	})
+}
+
+func TestSettleSettledMarketReconcilesVault(t *testing.T) {
+	nibiruApp, ctx, trader := initOrdersTest(t, sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 100)))
+	perpKeeper := nibiruApp.PerpKeeper
+	nibiruApp.VpoolKeeper.CreatePool(
+		ctx,
+		common.Pair_ETH_NUSD,
+		/* tradeLimitRatio */ sdk.OneDec(),
+		/* quoteReserve */ sdk.NewDec(1_000_000_000_000),
+		/* baseReserve */ sdk.NewDec(1_000_000_000_000),
+		/* fluctuationLimit */ sdk.OneDec(),
+		/* maxOracleSpreadRatio */ sdk.OneDec(),
+		/* maintenanceMarginRatio */ sdk.MustNewDecFromStr("0.0625"),
+		/* maxLeverage */ sdk.MustNewDecFromStr("15"),
+	)
+	vaultAddr := nibiruApp.AccountKeeper.GetModuleAddress(types.VaultModuleAccount)
+	efAddr := nibiruApp.AccountKeeper.GetModuleAddress(types.PerpEFModuleAccount)
+
+	t.Log("the vault holds cross margin collateral and a surplus, with some prepaid bad debt")
+	_, err := perpKeeper.DepositCrossMargin(ctx, trader, sdk.NewInt64Coin(common.DenomNUSD, 100))
+	require.NoError(t, err)
+	require.NoError(t, simapp.FundModuleAccount(nibiruApp.BankKeeper, ctx, types.VaultModuleAccount,
+		sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 500))))
+	perpKeeper.IncrementPrepaidBadDebt(ctx, common.DenomNUSD, sdk.NewInt(50))
+	efBalanceBefore := nibiruApp.BankKeeper.GetBalance(ctx, efAddr, common.DenomNUSD)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(ctx.BlockTime().Add(time.Minute))
+	_, err = nibiruApp.VpoolKeeper.SettlePool(ctx, common.Pair_BTC_NUSD, vpooltypes.SettlementPriceSource_MARK_TWAP, time.Hour)
+	require.NoError(t, err)
+
+	t.Log("the vault is left alone while another market of the denom is live")
+	perpKeeper.SettleSettledMarkets(ctx)
+	require.True(t, perpKeeper.SettledPairs.Has(ctx, common.Pair_BTC_NUSD))
+	assert.EqualValues(t, sdk.NewInt64Coin(common.DenomNUSD, 600), nibiruApp.BankKeeper.GetBalance(ctx, vaultAddr, common.DenomNUSD))
+	prepaidBadDebt, err := perpKeeper.PrepaidBadDebt.Get(ctx, common.DenomNUSD)
+	require.NoError(t, err)
+	assert.EqualValues(t, sdk.NewInt(50), prepaidBadDebt.Amount)
+
+	t.Log("settling the last market of the denom reconciles the vault")
+	_, err = nibiruApp.VpoolKeeper.SettlePool(ctx, common.Pair_ETH_NUSD, vpooltypes.SettlementPriceSource_MARK_TWAP, time.Hour)
+	require.NoError(t, err)
+	perpKeeper.SettleSettledMarkets(ctx)
+	require.True(t, perpKeeper.SettledPairs.Has(ctx, common.Pair_ETH_NUSD))
+	// the cross margin collateral stays in the vault
+	assert.EqualValues(t, sdk.NewInt64Coin(common.DenomNUSD, 100), nibiruApp.BankKeeper.GetBalance(ctx, vaultAddr, common.DenomNUSD))
+	assert.EqualValues(t, efBalanceBefore.AddAmount(sdk.NewInt(500)), nibiruApp.BankKeeper.GetBalance(ctx, efAddr, common.DenomNUSD))
+	prepaidBadDebt, err = perpKeeper.PrepaidBadDebt.Get(ctx, common.DenomNUSD)
+	require.NoError(t, err)
+	assert.True(t, prepaidBadDebt.Amount.IsZero())
+	testutil.RequireContainsTypedEvent(t, ctx, &types.MarketSettledEvent{
+		Pair:                  common.Pair_ETH_NUSD.String(),
+		SettlementPrice:       sdk.OneDec(),
+		VaultBalance:          sdk.NewInt64Coin(common.DenomNUSD, 100),
+		PrepaidBadDebt:        sdk.NewInt64Coin(common.DenomNUSD, 0),
+		BlockHeight:           ctx.BlockHeight(),
+		BlockTimeMs:           ctx.BlockTime().UnixMilli(),
+		VaultSurplus:          sdk.NewInt64Coin(common.DenomNUSD, 500),
+		ClearedPrepaidBadDebt: sdk.NewInt64Coin(common.DenomNUSD, 50),
+	})
+}
+
+func TestSettleSettledMarketSkipsFailingPositions(t *testing.T) {
+	nibiruApp, ctx, _ := initOrdersTest(t, sdk.NewCoins())
+	perpKeeper := nibiruApp.PerpKeeper
+	nibiruApp.PricefeedKeeper.ActivePairsStore().Set(ctx, common.Pair_BTC_NUSD, true)
+
+	t.Log("a page of positions which can't be settled sorts before the trader")
+	addrs := make([]sdk.AccAddress, types.MaxPositionsSettledPerBlock+1)
+	for i := range addrs {
+		addrs[i] = testutil.AccAddress()
+	}
+	sort.Slice(addrs, func(i, j int) bool { return addrs[i].String() < addrs[j].String() })
+	trader := addrs[len(addrs)-1]
+	for _, addr := range addrs[:len(addrs)-1] {
+		// the trader address of the position is missing, so settling it fails
+		perpKeeper.Positions.Insert(ctx, collections.Join(common.Pair_BTC_NUSD, addr), types.Position{
+			Pair:                            common.Pair_BTC_NUSD,
+			Size_:                           sdk.OneDec(),
+			Margin:                          sdk.OneDec(),
+			OpenNotional:                    sdk.OneDec(),
+			LatestCumulativePremiumFraction: sdk.ZeroDec(),
+		})
+	}
+
+	require.NoError(t, simapp.FundAccount(nibiruApp.BankKeeper, ctx, trader,
+		sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 1_000))))
+	_, err := perpKeeper.OpenPosition(ctx, common.Pair_BTC_NUSD, types.Side_BUY, trader,
+		sdk.NewInt(100), sdk.NewDec(10), sdk.ZeroDec())
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(ctx.BlockTime().Add(time.Minute))
+	_, err = nibiruApp.VpoolKeeper.SettlePool(ctx, common.Pair_BTC_NUSD, vpooltypes.SettlementPriceSource_MARK_TWAP, time.Hour)
+	require.NoError(t, err)
+
+	t.Log("the first sweep only visits the failing positions")
+	perpKeeper.SettleSettledMarkets(ctx)
+	_, err = perpKeeper.Positions.Get(ctx, collections.Join(common.Pair_BTC_NUSD, trader))
+	require.NoError(t, err)
+
+	t.Log("the next sweep resumes after them and settles the trader")
+	perpKeeper.SettleSettledMarkets(ctx)
+	_, err = perpKeeper.Positions.Get(ctx, collections.Join(common.Pair_BTC_NUSD, trader))
+	require.ErrorIs(t, err, collections.ErrNotFound)
+	_, err = perpKeeper.SettlementCursors.Get(ctx, common.Pair_BTC_NUSD)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+	require.False(t, perpKeeper.SettledPairs.Has(ctx, common.Pair_BTC_NUSD))
+}
+
+func TestSettleSettledMarketPagesOrders(t *testing.T) {
+	nibiruApp, ctx, trader := initOrdersTest(t, sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 1_000)))
+	perpKeeper := nibiruApp.PerpKeeper
+	nibiruApp.PricefeedKeeper.ActivePairsStore().Set(ctx, common.Pair_BTC_NUSD, true)
+
+	_, err := perpKeeper.OpenPosition(ctx, common.Pair_BTC_NUSD, types.Side_BUY, trader,
+		sdk.NewInt(100), sdk.NewDec(10), sdk.ZeroDec())
+	require.NoError(t, err)
+
+	t.Log("the market has more orders than the sweep handles in a block")
+	var ids []uint64
+	for i := 0; i <= types.MaxPositionsSettledPerBlock; i++ {
+		id := perpKeeper.OrderID.Next(ctx)
+		perpKeeper.Orders.Insert(ctx, id, types.Order{
+			Id:                   id,
+			TraderAddress:        trader.String(),
+			Pair:                 common.Pair_BTC_NUSD,
+			OrderType:            types.OrderType_LIMIT,
+			Side:                 types.Side_BUY,
+			TriggerPrice:         sdk.MustNewDecFromStr("0.5"),
+			QuoteAssetAmount:     sdk.OneInt(),
+			Leverage:             sdk.OneDec(),
+			BaseAssetAmountLimit: sdk.ZeroInt(),
+			ExecutionReward:      sdk.NewInt64Coin(common.DenomNUSD, 0),
+			Expiry:               ctx.BlockTime().Add(time.Hour),
+		})
+		ids = append(ids, id)
+	}
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(ctx.BlockTime().Add(time.Minute))
+	_, err = nibiruApp.VpoolKeeper.SettlePool(ctx, common.Pair_BTC_NUSD, vpooltypes.SettlementPriceSource_MARK_TWAP, time.Hour)
+	require.NoError(t, err)
+
+	t.Log("the first sweep only cancels a page of orders")
+	perpKeeper.SettleSettledMarkets(ctx)
+	assert.Len(t, perpKeeper.Orders.Iterate(ctx, collections.Range[uint64]{}).Keys(), 1)
+	cursor, err := perpKeeper.SettlementCursors.Get(ctx, common.Pair_BTC_NUSD)
+	require.NoError(t, err)
+	assert.EqualValues(t, ids[len(ids)-2], cursor.LastOrderId)
+	_, err = perpKeeper.Positions.Get(ctx, collections.Join(common.Pair_BTC_NUSD, trader))
+	require.NoError(t, err)
+
+	t.Log("the next sweep cancels the last order, settles the position and completes the market settlement")
+	perpKeeper.SettleSettledMarkets(ctx)
+	assert.Empty(t, perpKeeper.Orders.Iterate(ctx, collections.Range[uint64]{}).Keys())
+	_, err = perpKeeper.Positions.Get(ctx, collections.Join(common.Pair_BTC_NUSD, trader))
+	require.ErrorIs(t, err, collections.ErrNotFound)
+	_, err = perpKeeper.SettlementCursors.Get(ctx, common.Pair_BTC_NUSD)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+	require.True(t, perpKeeper.SettledPairs.Has(ctx, common.Pair_BTC_NUSD))
+}
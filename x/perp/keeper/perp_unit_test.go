@@ -9,8 +9,10 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/NibiruChain/nibiru/collections"
 	"github.com/NibiruChain/nibiru/x/common"
 	"github.com/NibiruChain/nibiru/x/perp/types"
+	vpooltypes "github.com/NibiruChain/nibiru/x/vpool/types"
 )
 
 func TestSettlePosition(t *testing.T) {
@@ -24,6 +26,9 @@ func TestSettlePosition(t *testing.T) {
 			GetSettlementPrice(gomock.Eq(ctx), gomock.Eq(pair)).
 			Return(sdk.ZeroDec(), error(nil))
 
+		vaultAddr := testutil.AccAddress()
+		dep.mockAccountKeeper.EXPECT().GetModuleAddress(types.VaultModuleAccount).Return(vaultAddr)
+		dep.mockBankKeeper.EXPECT().GetBalance(ctx, vaultAddr, "UST").Return(sdk.NewInt64Coin("UST", 1_000))
 		dep.mockBankKeeper.EXPECT().
 			SendCoinsFromModuleToAccount(
 				ctx, types.VaultModuleAccount, traderAddr,
@@ -32,13 +37,15 @@ func TestSettlePosition(t *testing.T) {
 			Return(error(nil))
 
 		pos := types.Position{
-			TraderAddress: traderAddr.String(),
-			Pair:          pair,
-			Size_:         sdk.NewDec(10),
-			Margin:        sdk.NewDec(100),
-			OpenNotional:  sdk.NewDec(1000),
+			TraderAddress:                   traderAddr.String(),
+			Pair:                            pair,
+			Size_:                           sdk.NewDec(10),
+			Margin:                          sdk.NewDec(100),
+			OpenNotional:                    sdk.NewDec(1000),
+			LatestCumulativePremiumFraction: sdk.ZeroDec(),
 		}
 		setPosition(k, ctx, pos)
+		setPairMetadata(k, ctx, types.PairMetadata{Pair: pair, LatestCumulativePremiumFraction: sdk.ZeroDec()})
 
 		coins, err := k.SettlePosition(ctx, pos)
 		require.NoError(t, err)
@@ -58,6 +65,12 @@ func TestSettlePosition(t *testing.T) {
 			GetSettlementPrice(ctx, pair).
 			Return(sdk.NewDec(1000), error(nil))
 
+		t.Log("the vault is short of the profits, which are prepaid by the ecosystem fund since the insurance fund is empty")
+		vaultAddr := testutil.AccAddress()
+		dep.mockAccountKeeper.EXPECT().GetModuleAddress(types.VaultModuleAccount).Return(vaultAddr)
+		dep.mockBankKeeper.EXPECT().GetBalance(ctx, vaultAddr, "UST").Return(sdk.NewInt64Coin("UST", 100))
+		expectBadDebtPayout(dep, ctx, types.InsuranceFundModuleAccount, "UST", 0, 0)
+		expectBadDebtPayout(dep, ctx, types.PerpEFModuleAccount, "UST", 100_000, 99_000)
 		dep.mockBankKeeper.EXPECT().
 			SendCoinsFromModuleToAccount(
 				ctx, types.VaultModuleAccount, traderAddr, sdk.NewCoins(sdk.NewCoin("UST", sdk.NewInt(99_100)))).
@@ -73,6 +86,164 @@ func TestSettlePosition(t *testing.T) {
 		// which are 99000 coins
 		// we also need to return margin which is 100coin
 		// so total is 99_100 coin
+		pos := types.Position{
+			TraderAddress:                   traderAddr.String(),
+			Pair:                            pair,
+			Size_:                           sdk.NewDec(100),
+			Margin:                          sdk.NewDec(100),
+			OpenNotional:                    sdk.NewDec(1000),
+			LatestCumulativePremiumFraction: sdk.ZeroDec(),
+		}
+		setPosition(k, ctx, pos)
+		setPairMetadata(k, ctx, types.PairMetadata{Pair: pair, LatestCumulativePremiumFraction: sdk.ZeroDec()})
+
+		coins, err := k.SettlePosition(ctx, pos)
+		require.NoError(t, err)
+		require.Equal(t, coins, sdk.NewCoins(
+			sdk.NewInt64Coin(pair.QuoteDenom(), 99100))) // todo(mercilex): modify denom once transfer is impl
+		require.EqualValues(t, sdk.NewInt(99_000), k.PrepaidBadDebt.GetOr(ctx, "UST", types.PrepaidBadDebt{}).Amount)
+	})
+
+	t.Run("bad debt at the settlement price", func(t *testing.T) {
+		k, dep, ctx := getKeeper(t)
+		traderAddr := testutil.AccAddress()
+		pair := common.MustNewAssetPair("LUNA:UST")
+
+		dep.mockVpoolKeeper.
+			EXPECT().
+			GetSettlementPrice(ctx, pair).
+			Return(sdk.NewDec(5), error(nil))
+
+		// the long position lost 100 * (10 - 5) = 500 for a margin of 100
+		expectBadDebtPayout(dep, ctx, types.InsuranceFundModuleAccount, "UST", 300, 300)
+		expectBadDebtPayout(dep, ctx, types.PerpEFModuleAccount, "UST", 1_000, 100)
+
+		pos := types.Position{
+			TraderAddress:                   traderAddr.String(),
+			Pair:                            pair,
+			Size_:                           sdk.NewDec(100),
+			Margin:                          sdk.NewDec(100),
+			OpenNotional:                    sdk.NewDec(1000),
+			LatestCumulativePremiumFraction: sdk.ZeroDec(),
+		}
+		setPosition(k, ctx, pos)
+		setPairMetadata(k, ctx, types.PairMetadata{Pair: pair, LatestCumulativePremiumFraction: sdk.ZeroDec()})
+
+		coins, err := k.SettlePosition(ctx, pos)
+		require.NoError(t, err)
+		require.Len(t, coins, 0)
+		testutil.RequireHasTypedEvent(t, ctx, &types.PositionSettledEvent{
+			Pair:            pair.String(),
+			TraderAddress:   traderAddr.String(),
+			SettledCoins:    sdk.NewCoins(),
+			SettlementPrice: sdk.NewDec(5),
+			PositionSize:    sdk.NewDec(100),
+			BadDebt:         sdk.NewInt64Coin("UST", 400),
+			BlockHeight:     ctx.BlockHeight(),
+			BlockTimeMs:     ctx.BlockTime().UnixMilli(),
+		})
+		testutil.RequireContainsTypedEvent(t, ctx, &types.BadDebtCoveredEvent{
+			ShortfallId:      1,
+			Payer:            types.BadDebtPayer_INSURANCE_FUND,
+			Amount:           sdk.NewInt64Coin("UST", 300),
+			RemainingBadDebt: sdk.NewInt(100),
+			BlockHeight:      ctx.BlockHeight(),
+			BlockTimeMs:      ctx.BlockTime().UnixMilli(),
+		})
+		testutil.RequireContainsTypedEvent(t, ctx, &types.BadDebtCoveredEvent{
+			ShortfallId:      1,
+			Payer:            types.BadDebtPayer_ECOSYSTEM_FUND,
+			Amount:           sdk.NewInt64Coin("UST", 100),
+			RemainingBadDebt: sdk.ZeroInt(),
+			BlockHeight:      ctx.BlockHeight(),
+			BlockTimeMs:      ctx.BlockTime().UnixMilli(),
+		})
+	})
+
+	t.Run("pending funding payment at the settlement price", func(t *testing.T) {
+		k, dep, ctx := getKeeper(t)
+		traderAddr := testutil.AccAddress()
+		pair := common.MustNewAssetPair("LUNA:UST")
+
+		dep.mockVpoolKeeper.
+			EXPECT().
+			GetSettlementPrice(ctx, pair).
+			Return(sdk.NewDec(11), error(nil))
+
+		// the long position made 100 * (11 - 10) = 100 and owes 100 * 0.5 = 50 of funding
+		vaultAddr := testutil.AccAddress()
+		dep.mockAccountKeeper.EXPECT().GetModuleAddress(types.VaultModuleAccount).Return(vaultAddr)
+		dep.mockBankKeeper.EXPECT().GetBalance(ctx, vaultAddr, "UST").Return(sdk.NewInt64Coin("UST", 1_000))
+		dep.mockBankKeeper.EXPECT().
+			SendCoinsFromModuleToAccount(
+				ctx, types.VaultModuleAccount, traderAddr, sdk.NewCoins(sdk.NewInt64Coin("UST", 150))).
+			Return(error(nil))
+
+		pos := types.Position{
+			TraderAddress:                   traderAddr.String(),
+			Pair:                            pair,
+			Size_:                           sdk.NewDec(100),
+			Margin:                          sdk.NewDec(100),
+			OpenNotional:                    sdk.NewDec(1000),
+			LatestCumulativePremiumFraction: sdk.ZeroDec(),
+		}
+		setPosition(k, ctx, pos)
+		setPairMetadata(k, ctx, types.PairMetadata{Pair: pair, LatestCumulativePremiumFraction: sdk.MustNewDecFromStr("0.5")})
+
+		coins, err := k.SettlePosition(ctx, pos)
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("UST", 150)), coins)
+	})
+
+	t.Run("bad debt from the pending funding payment", func(t *testing.T) {
+		k, dep, ctx := getKeeper(t)
+		traderAddr := testutil.AccAddress()
+		pair := common.MustNewAssetPair("LUNA:UST")
+
+		dep.mockVpoolKeeper.
+			EXPECT().
+			GetSettlementPrice(ctx, pair).
+			Return(sdk.NewDec(10), error(nil))
+
+		// the long position broke even but owes 100 * 1.5 = 150 of funding for a margin of 100
+		expectBadDebtPayout(dep, ctx, types.InsuranceFundModuleAccount, "UST", 300, 50)
+
+		pos := types.Position{
+			TraderAddress:                   traderAddr.String(),
+			Pair:                            pair,
+			Size_:                           sdk.NewDec(100),
+			Margin:                          sdk.NewDec(100),
+			OpenNotional:                    sdk.NewDec(1000),
+			LatestCumulativePremiumFraction: sdk.ZeroDec(),
+		}
+		setPosition(k, ctx, pos)
+		setPairMetadata(k, ctx, types.PairMetadata{Pair: pair, LatestCumulativePremiumFraction: sdk.MustNewDecFromStr("1.5")})
+
+		coins, err := k.SettlePosition(ctx, pos)
+		require.NoError(t, err)
+		require.Len(t, coins, 0)
+		testutil.RequireHasTypedEvent(t, ctx, &types.PositionSettledEvent{
+			Pair:            pair.String(),
+			TraderAddress:   traderAddr.String(),
+			SettledCoins:    sdk.NewCoins(),
+			SettlementPrice: sdk.NewDec(10),
+			PositionSize:    sdk.NewDec(100),
+			BadDebt:         sdk.NewInt64Coin("UST", 50),
+			BlockHeight:     ctx.BlockHeight(),
+			BlockTimeMs:     ctx.BlockTime().UnixMilli(),
+		})
+	})
+
+	t.Run("fail - pool not settled", func(t *testing.T) {
+		k, dep, ctx := getKeeper(t)
+		traderAddr := testutil.AccAddress()
+		pair := common.MustNewAssetPair("LUNA:UST")
+
+		dep.mockVpoolKeeper.
+			EXPECT().
+			GetSettlementPrice(ctx, pair).
+			Return(sdk.Dec{}, vpooltypes.ErrPoolNotSettled)
+
 		pos := types.Position{
 			TraderAddress: traderAddr.String(),
 			Pair:          pair,
@@ -82,10 +253,10 @@ func TestSettlePosition(t *testing.T) {
 		}
 		setPosition(k, ctx, pos)
 
-		coins, err := k.SettlePosition(ctx, pos)
+		_, err := k.SettlePosition(ctx, pos)
+		require.ErrorIs(t, err, vpooltypes.ErrPoolNotSettled)
+		_, err = k.Positions.Get(ctx, collections.Join(pair, traderAddr))
 		require.NoError(t, err)
-		require.Equal(t, coins, sdk.NewCoins(
-			sdk.NewInt64Coin(pair.QuoteDenom(), 99100))) // todo(mercilex): modify denom once transfer is impl
 	})
 
 	t.Run("position size is zero", func(t *testing.T) {
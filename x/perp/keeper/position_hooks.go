@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/perp/types"
+)
+
+/*
+emitPositionChanged emits the PositionChangedEvent of a position and runs the
+hook matching the change: opened if the trader had no position, closed if the
+position is gone, changed otherwise.
+
+args:
+  - ctx: cosmos-sdk context
+  - previousSize: the size of the position before the change
+  - event: the event of the change
+*/
+func (k Keeper) emitPositionChanged(ctx sdk.Context, previousSize sdk.Dec, event *types.PositionChangedEvent) error {
+	if err := ctx.EventManager().EmitTypedEvent(event); err != nil {
+		return err
+	}
+
+	switch {
+	case previousSize.IsZero() && event.PositionSize.IsZero():
+		// nothing was opened, e.g. a trade that reverted to zero size
+	case previousSize.IsZero():
+		k.runHook(ctx, "AfterPositionOpened", func(ctx sdk.Context) error {
+			return k.hooks.AfterPositionOpened(ctx, *event)
+		})
+	case event.PositionSize.IsZero():
+		k.runHook(ctx, "AfterPositionClosed", func(ctx sdk.Context) error {
+			return k.hooks.AfterPositionClosed(ctx, *event)
+		})
+	default:
+		k.runHook(ctx, "AfterPositionChanged", func(ctx sdk.Context) error {
+			return k.hooks.AfterPositionChanged(ctx, *event)
+		})
+	}
+	return nil
+}
+
+// emitPositionLiquidated emits the PositionLiquidatedEvent of a position and runs the AfterPositionLiquidated hook.
+func (k Keeper) emitPositionLiquidated(ctx sdk.Context, event *types.PositionLiquidatedEvent) error {
+	if err := ctx.EventManager().EmitTypedEvent(event); err != nil {
+		return err
+	}
+
+	k.runHook(ctx, "AfterPositionLiquidated", func(ctx sdk.Context) error {
+		return k.hooks.AfterPositionLiquidated(ctx, *event)
+	})
+	return nil
+}
+
+// emitPositionSettled emits the PositionSettledEvent of a position and runs the AfterPositionSettled hook.
+func (k Keeper) emitPositionSettled(ctx sdk.Context, event *types.PositionSettledEvent) error {
+	if err := ctx.EventManager().EmitTypedEvent(event); err != nil {
+		return err
+	}
+
+	k.runHook(ctx, "AfterPositionSettled", func(ctx sdk.Context) error {
+		return k.hooks.AfterPositionSettled(ctx, *event)
+	})
+	return nil
+}
+
+// runHook runs a hook in a cache context that is only written if the hook
+// succeeds, so that a faulty hook cannot break trading. Running out of gas
+// still aborts the transaction.
+func (k Keeper) runHook(ctx sdk.Context, name string, hook func(ctx sdk.Context) error) {
+	if k.hooks == nil {
+		return
+	}
+
+	cachedCtx, commit := ctx.CacheContext()
+	defer func() {
+		if r := recover(); r != nil {
+			if _, isOutOfGas := r.(sdk.ErrorOutOfGas); isOutOfGas {
+				panic(r)
+			}
+			k.Logger(ctx).Error("perp hook panicked", "hook", name, "panic", fmt.Sprintf("%v", r))
+		}
+	}()
+
+	if err := hook(cachedCtx); err != nil {
+		k.Logger(ctx).Error("perp hook failed", "hook", name, "error", err.Error())
+		return
+	}
+
+	commit()
+	ctx.EventManager().EmitEvents(cachedCtx.EventManager().Events())
+}
@@ -0,0 +1,168 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/collections"
+	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/perp/types"
+	"github.com/NibiruChain/nibiru/x/testutil"
+	vpooltypes "github.com/NibiruChain/nibiru/x/vpool/types"
+)
+
+// recordingHooks records the hooks called, and fails or panics on demand.
+type recordingHooks struct {
+	calls []string
+	// write is called with the hook context before the hook returns
+	write   func(ctx sdk.Context)
+	err     error
+	doPanic bool
+}
+
+func (h *recordingHooks) record(ctx sdk.Context, call string) error {
+	h.calls = append(h.calls, call)
+	if h.write != nil {
+		h.write(ctx)
+	}
+	if h.doPanic {
+		panic("faulty hook")
+	}
+	return h.err
+}
+
+func (h *recordingHooks) AfterPositionOpened(ctx sdk.Context, event types.PositionChangedEvent) error {
+	return h.record(ctx, "opened "+event.TraderAddress)
+}
+
+func (h *recordingHooks) AfterPositionChanged(ctx sdk.Context, event types.PositionChangedEvent) error {
+	return h.record(ctx, "changed "+event.TraderAddress)
+}
+
+func (h *recordingHooks) AfterPositionClosed(ctx sdk.Context, event types.PositionChangedEvent) error {
+	return h.record(ctx, "closed "+event.TraderAddress)
+}
+
+func (h *recordingHooks) AfterPositionLiquidated(ctx sdk.Context, event types.PositionLiquidatedEvent) error {
+	return h.record(ctx, "liquidated "+event.TraderAddress)
+}
+
+func (h *recordingHooks) AfterPositionSettled(ctx sdk.Context, event types.PositionSettledEvent) error {
+	return h.record(ctx, "settled "+event.TraderAddress)
+}
+
+func TestPerpHooks(t *testing.T) {
+	nibiruApp, ctx, alice := initOrdersTest(t, sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 1_000)))
+	hooks := &recordingHooks{}
+	nibiruApp.PerpKeeper.SetHooks(types.NewMultiPerpHooks(hooks))
+	perpKeeper := nibiruApp.PerpKeeper
+	bob := testutil.AccAddress()
+	require.NoError(t, simapp.FundAccount(nibiruApp.BankKeeper, ctx, bob,
+		sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 1_000))))
+
+	t.Log("alice opens, adds margin to, reduces and closes a position")
+	_, err := perpKeeper.OpenPosition(ctx, common.Pair_BTC_NUSD, types.Side_BUY, alice,
+		sdk.NewInt(100), sdk.NewDec(10), sdk.ZeroDec())
+	require.NoError(t, err)
+	_, err = perpKeeper.AddMargin(ctx, common.Pair_BTC_NUSD, alice, sdk.NewInt64Coin(common.DenomNUSD, 10))
+	require.NoError(t, err)
+	_, err = perpKeeper.OpenPosition(ctx, common.Pair_BTC_NUSD, types.Side_SELL, alice,
+		sdk.NewInt(10), sdk.NewDec(10), sdk.ZeroDec())
+	require.NoError(t, err)
+	_, err = perpKeeper.ClosePosition(ctx, common.Pair_BTC_NUSD, alice)
+	require.NoError(t, err)
+
+	t.Log("bob's position is liquidated")
+	setPosition(perpKeeper, ctx, types.Position{
+		TraderAddress:                   bob.String(),
+		Pair:                            common.Pair_BTC_NUSD,
+		Size_:                           sdk.NewDec(1_000),
+		Margin:                          sdk.NewDec(10),
+		OpenNotional:                    sdk.NewDec(1_100),
+		LatestCumulativePremiumFraction: sdk.ZeroDec(),
+	})
+	require.NoError(t, simapp.FundModuleAccount(nibiruApp.BankKeeper, ctx, types.VaultModuleAccount,
+		sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 1_000))))
+	// no other position is left to socialize the bad debt of bob
+	require.NoError(t, simapp.FundModuleAccount(nibiruApp.BankKeeper, ctx, types.InsuranceFundModuleAccount,
+		sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 1_000))))
+	position, err := perpKeeper.Positions.Get(ctx, collections.Join(common.Pair_BTC_NUSD, bob))
+	require.NoError(t, err)
+	_, err = perpKeeper.ExecuteFullLiquidation(ctx, testutil.AccAddress(), &position)
+	require.NoError(t, err)
+
+	assert.EqualValues(t, []string{
+		"opened " + alice.String(),
+		"changed " + alice.String(),
+		"changed " + alice.String(),
+		"closed " + alice.String(),
+		"liquidated " + bob.String(),
+	}, hooks.calls)
+
+	t.Log("bob opens a position that is settled when the vpool is frozen")
+	hooks.calls = nil
+	_, err = perpKeeper.OpenPosition(ctx, common.Pair_BTC_NUSD, types.Side_SELL, bob,
+		sdk.NewInt(100), sdk.NewDec(10), sdk.ZeroDec())
+	require.NoError(t, err)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(ctx.BlockTime().Add(time.Minute))
+	_, err = nibiruApp.VpoolKeeper.SettlePool(ctx, common.Pair_BTC_NUSD, vpooltypes.SettlementPriceSource_MARK_TWAP, time.Hour)
+	require.NoError(t, err)
+	perpKeeper.SettleSettledMarkets(ctx)
+
+	assert.EqualValues(t, []string{
+		"opened " + bob.String(),
+		"settled " + bob.String(),
+	}, hooks.calls)
+}
+
+func TestPerpHooksStateChanges(t *testing.T) {
+	testCases := []struct {
+		name  string
+		hooks *recordingHooks
+
+		expectWritten bool
+	}{
+		{
+			name:          "hook succeeds",
+			hooks:         &recordingHooks{},
+			expectWritten: true,
+		},
+		{
+			name:          "hook returns an error",
+			hooks:         &recordingHooks{err: fmt.Errorf("faulty hook")},
+			expectWritten: false,
+		},
+		{
+			name:          "hook panics",
+			hooks:         &recordingHooks{doPanic: true},
+			expectWritten: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			nibiruApp, ctx, alice := initOrdersTest(t, sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 1_000)))
+			nibiruApp.PerpKeeper.SetHooks(tc.hooks)
+			perpKeeper := nibiruApp.PerpKeeper
+			tc.hooks.write = func(ctx sdk.Context) {
+				perpKeeper.SettledPairs.Insert(ctx, common.Pair_ETH_NUSD)
+			}
+
+			t.Log("the trade goes through")
+			_, err := perpKeeper.OpenPosition(ctx, common.Pair_BTC_NUSD, types.Side_BUY, alice,
+				sdk.NewInt(100), sdk.NewDec(10), sdk.ZeroDec())
+			require.NoError(t, err)
+			assert.EqualValues(t, []string{"opened " + alice.String()}, tc.hooks.calls)
+
+			t.Log("the state changes of the hook are only written if it succeeds")
+			assert.Equal(t, tc.expectWritten, perpKeeper.SettledPairs.Has(ctx, common.Pair_ETH_NUSD))
+		})
+	}
+}
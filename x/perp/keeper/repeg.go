@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/perp/types"
+	vpooltypes "github.com/NibiruChain/nibiru/x/vpool/types"
+)
+
+/*
+RepegPool repegs the vpool of a pair to the pricefeed price and settles the cost
+of the repeg between the ecosystem fund and the vault.
+
+Moving the reserves changes what closing the net position of the pair, the
+longs minus the shorts, is worth. The vault owes that change to the traders:
+the ecosystem fund pays it to the vault when positive, and receives it from the
+vault when negative.
+
+args:
+  - ctx: cosmos-sdk context
+  - pair: the pair to repeg
+
+ret:
+  - cost: the change of the value of the net position, paid by the ecosystem fund
+  - err: error if the vpool cannot be repegged or the ecosystem fund cannot pay
+*/
+func (k Keeper) RepegPool(ctx sdk.Context, pair common.AssetPair) (cost sdk.Dec, err error) {
+	openInterest := k.GetOpenInterest(ctx, pair)
+	netSize := openInterest.LongSize.Sub(openInterest.ShortSize)
+
+	oldMarkPrice, err := k.VpoolKeeper.GetMarkPrice(ctx, pair)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	valueBefore, err := k.getNetPositionValue(ctx, pair, netSize)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	pool, err := k.VpoolKeeper.RepegPool(ctx, pair)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	valueAfter, err := k.getNetPositionValue(ctx, pair, netSize)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	cost = valueAfter.Sub(valueBefore)
+
+	if err = k.settleNetPositionValueChange(ctx, pair, cost); err != nil {
+		return sdk.Dec{}, err
+	}
+
+	return cost, ctx.EventManager().EmitTypedEvent(&types.PoolRepeggedEvent{
+		Pair:         pair.String(),
+		NetSize:      netSize,
+		Cost:         cost,
+		OldMarkPrice: oldMarkPrice,
+		NewMarkPrice: pool.GetMarkPrice(),
+		BlockHeight:  ctx.BlockHeight(),
+		BlockTimeMs:  ctx.BlockTime().UnixMilli(),
+	})
+}
+
+// settleNetPositionValueChange books a change of the value of the net position
+// of a pair between the ecosystem fund and the vault: the ecosystem fund pays it
+// to the vault when positive, and receives it from the vault when negative.
+func (k Keeper) settleNetPositionValueChange(ctx sdk.Context, pair common.AssetPair, change sdk.Dec) error {
+	switch {
+	case change.IsPositive():
+		// rounded up so that the vault is never short of what it owes
+		return k.BankKeeper.SendCoinsFromModuleToModule(
+			ctx,
+			/* from */ types.PerpEFModuleAccount,
+			/* to */ types.VaultModuleAccount,
+			sdk.NewCoins(sdk.NewCoin(pair.QuoteDenom(), change.Ceil().TruncateInt())),
+		)
+	case change.IsNegative():
+		return k.BankKeeper.SendCoinsFromModuleToModule(
+			ctx,
+			/* from */ types.VaultModuleAccount,
+			/* to */ types.PerpEFModuleAccount,
+			sdk.NewCoins(sdk.NewCoin(pair.QuoteDenom(), change.Abs().TruncateInt())),
+		)
+	default:
+		return nil
+	}
+}
+
+// getNetPositionValue returns what closing a net position of size netSize on
+// the vpool of a pair is worth to its holders: the quote received for a net
+// long, minus the quote paid for a net short.
+func (k Keeper) getNetPositionValue(ctx sdk.Context, pair common.AssetPair, netSize sdk.Dec) (sdk.Dec, error) {
+	switch {
+	case netSize.IsPositive():
+		return k.VpoolKeeper.GetBaseAssetPrice(ctx, pair, vpooltypes.Direction_ADD_TO_POOL, netSize)
+	case netSize.IsNegative():
+		quoteAmount, err := k.VpoolKeeper.GetBaseAssetPrice(ctx, pair, vpooltypes.Direction_REMOVE_FROM_POOL, netSize.Abs())
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		return quoteAmount.Neg(), nil
+	default:
+		return sdk.ZeroDec(), nil
+	}
+}
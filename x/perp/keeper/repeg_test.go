@@ -0,0 +1,95 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	nibisimapp "github.com/NibiruChain/nibiru/simapp"
+	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/perp/types"
+	"github.com/NibiruChain/nibiru/x/testutil"
+)
+
+func TestRepegPool(t *testing.T) {
+	setup := func(t *testing.T, side types.Side, price sdk.Dec, efFunds int64) (
+		*nibisimapp.NibiruTestApp, sdk.Context,
+	) {
+		nibiruApp, ctx, trader := initOrdersTest(t, sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 1_100)))
+		require.NoError(t, simapp.FundModuleAccount(nibiruApp.BankKeeper, ctx, types.PerpEFModuleAccount,
+			sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, efFunds))))
+
+		// a position of 10_000 NUSD of notional at the mark price of 1
+		_, err := nibiruApp.PerpKeeper.OpenPosition(ctx, common.Pair_BTC_NUSD, side, trader,
+			sdk.NewInt(1_000), sdk.NewDec(10), sdk.ZeroDec())
+		require.NoError(t, err)
+
+		postIndexPrice(t, nibiruApp, ctx, price)
+		return nibiruApp, ctx
+	}
+
+	getBalance := func(nibiruApp *nibisimapp.NibiruTestApp, ctx sdk.Context, moduleAccount string) sdk.Int {
+		return nibiruApp.BankKeeper.GetBalance(
+			ctx, nibiruApp.AccountKeeper.GetModuleAddress(moduleAccount), common.DenomNUSD).Amount
+	}
+
+	t.Run("the ecosystem fund pays the gains of a net long", func(t *testing.T) {
+		nibiruApp, ctx := setup(t, types.Side_BUY, sdk.NewDec(2), 20_000)
+		efBefore := getBalance(nibiruApp, ctx, types.PerpEFModuleAccount)
+		vaultBefore := getBalance(nibiruApp, ctx, types.VaultModuleAccount)
+		oldMarkPrice, err := nibiruApp.VpoolKeeper.GetMarkPrice(ctx, common.Pair_BTC_NUSD)
+		require.NoError(t, err)
+
+		cost, err := nibiruApp.PerpKeeper.RepegPool(ctx, common.Pair_BTC_NUSD)
+		require.NoError(t, err)
+
+		markPrice, err := nibiruApp.VpoolKeeper.GetMarkPrice(ctx, common.Pair_BTC_NUSD)
+		require.NoError(t, err)
+		assert.EqualValues(t, sdk.NewDec(2), markPrice)
+
+		// the net long of ~10_000 BTC doubled its value
+		assert.True(t, cost.GT(sdk.NewDec(9_999)) && cost.LT(sdk.NewDec(10_001)), cost.String())
+		paid := cost.Ceil().TruncateInt()
+		assert.EqualValues(t, efBefore.Sub(paid), getBalance(nibiruApp, ctx, types.PerpEFModuleAccount))
+		assert.EqualValues(t, vaultBefore.Add(paid), getBalance(nibiruApp, ctx, types.VaultModuleAccount))
+
+		openInterest := nibiruApp.PerpKeeper.GetOpenInterest(ctx, common.Pair_BTC_NUSD)
+		testutil.RequireHasTypedEvent(t, ctx, &types.PoolRepeggedEvent{
+			Pair:         common.Pair_BTC_NUSD.String(),
+			NetSize:      openInterest.LongSize,
+			Cost:         cost,
+			OldMarkPrice: oldMarkPrice,
+			NewMarkPrice: sdk.NewDec(2),
+			BlockHeight:  ctx.BlockHeight(),
+			BlockTimeMs:  ctx.BlockTime().UnixMilli(),
+		})
+	})
+
+	t.Run("the vault pays the losses of a net short to the ecosystem fund", func(t *testing.T) {
+		nibiruApp, ctx := setup(t, types.Side_SELL, sdk.NewDec(2), 0)
+		// the vault also holds the margin of other pairs
+		require.NoError(t, simapp.FundModuleAccount(nibiruApp.BankKeeper, ctx, types.VaultModuleAccount,
+			sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 20_000))))
+		efBefore := getBalance(nibiruApp, ctx, types.PerpEFModuleAccount)
+		vaultBefore := getBalance(nibiruApp, ctx, types.VaultModuleAccount)
+
+		cost, err := nibiruApp.PerpKeeper.RepegPool(ctx, common.Pair_BTC_NUSD)
+		require.NoError(t, err)
+		require.True(t, cost.IsNegative())
+
+		received := cost.Abs().TruncateInt()
+		assert.EqualValues(t, efBefore.Add(received), getBalance(nibiruApp, ctx, types.PerpEFModuleAccount))
+		assert.EqualValues(t, vaultBefore.Sub(received), getBalance(nibiruApp, ctx, types.VaultModuleAccount))
+	})
+
+	t.Run("the repeg fails when the ecosystem fund cannot pay", func(t *testing.T) {
+		nibiruApp, ctx := setup(t, types.Side_BUY, sdk.NewDec(2), 1_000)
+
+		_, err := nibiruApp.PerpKeeper.RepegPool(ctx, common.Pair_BTC_NUSD)
+		require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+	})
+}
@@ -0,0 +1,169 @@
+package keeper
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/collections"
+	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/perp/types"
+)
+
+/*
+TransferPosition moves the position of the sender on a pair, along with its
+margin, to the receiver. The margin stays in the vault, only its owner changes.
+
+The funding payments of the transferred position are settled first. The
+transfer fails if the receiver already has a position on the pair, since
+merging into it would change the receiver's position without their consent.
+
+The transferred position must stay within the position size cap of the pair
+and above the maintenance margin ratio. The orders of the sender on the pair
+are cancelled, since they were placed against the transferred position.
+
+args:
+  - ctx: cosmos-sdk context
+  - pair: the pair of the position
+  - senderAddr: the owner of the position
+  - receiverAddr: the new owner of the position
+
+ret:
+  - position: the position of the receiver after the transfer
+  - err: error
+*/
+func (k Keeper) TransferPosition(
+	ctx sdk.Context, pair common.AssetPair, senderAddr sdk.AccAddress, receiverAddr sdk.AccAddress,
+) (position types.Position, err error) {
+	if err = k.requireVpool(ctx, pair); err != nil {
+		return types.Position{}, err
+	}
+	if err = k.requirePoolNotSettled(ctx, pair); err != nil {
+		return types.Position{}, err
+	}
+	if err = k.requireNotInAuction(ctx, pair, senderAddr); err != nil {
+		return types.Position{}, err
+	}
+	if err = k.requireNotInAuction(ctx, pair, receiverAddr); err != nil {
+		return types.Position{}, err
+	}
+	if senderAddr.Equals(receiverAddr) {
+		return types.Position{}, types.ErrInvalidPositionTransfer.Wrap("sender and receiver are the same")
+	}
+	if k.isCrossMarginAccount(ctx, senderAddr) || k.isCrossMarginAccount(ctx, receiverAddr) {
+		// the margin of cross margin positions belongs to the account collateral
+		return types.Position{}, types.ErrInvalidPositionTransfer.Wrap(
+			"positions of cross margin accounts cannot be transferred")
+	}
+
+	sent, err := k.GetPosition(ctx, pair, senderAddr)
+	if err != nil {
+		return types.Position{}, err
+	}
+	if sent.Size_.IsZero() {
+		return types.Position{}, types.ErrPositionZero
+	}
+
+	sentMargin, err := k.CalcRemainMarginWithFundingPayment(ctx, sent, sdk.ZeroDec())
+	if err != nil {
+		return types.Position{}, err
+	}
+	if !sentMargin.BadDebt.IsZero() {
+		return types.Position{}, types.ErrInvalidPositionTransfer.Wrap("position has bad debt")
+	}
+
+	position = types.Position{
+		TraderAddress:                   receiverAddr.String(),
+		Pair:                            pair,
+		Size_:                           sent.Size_,
+		Margin:                          sentMargin.Margin,
+		OpenNotional:                    sent.OpenNotional,
+		LatestCumulativePremiumFraction: sentMargin.LatestCumulativePremiumFraction,
+		BlockNumber:                     ctx.BlockHeight(),
+	}
+
+	received, err := k.GetPosition(ctx, pair, receiverAddr)
+	switch {
+	case err == nil && !received.Size_.IsZero():
+		return types.Position{}, types.ErrInvalidPositionTransfer.Wrap(
+			"the receiver already has a position on the pair")
+	case err != nil && !errors.Is(err, collections.ErrNotFound):
+		return types.Position{}, err
+	}
+
+	_, maxPositionSize := k.getOpenInterestCaps(ctx, pair)
+	if maxPositionSize.IsPositive() && position.Size_.Abs().GT(maxPositionSize) {
+		return types.Position{}, types.ErrOpenInterestCapExceeded.Wrapf(
+			"position size %s is over the cap of %s", position.Size_.Abs(), maxPositionSize)
+	}
+
+	marginRatio, err := k.getMarginCheckRatio(ctx, position)
+	if err != nil {
+		return types.Position{}, err
+	}
+	if err = requireMoreMarginRatio(
+		marginRatio, k.VpoolKeeper.GetMaintenanceMarginRatio(ctx, pair), true); err != nil {
+		return types.Position{}, types.ErrMarginRatioTooLow
+	}
+
+	if err = k.DeletePosition(ctx, pair, senderAddr); err != nil {
+		return types.Position{}, err
+	}
+	k.SetPosition(ctx, position)
+	if err = k.cancelTraderPairOrders(ctx, pair, senderAddr, "position transferred"); err != nil {
+		return types.Position{}, err
+	}
+
+	markPrice, err := k.VpoolKeeper.GetMarkPrice(ctx, pair)
+	if err != nil {
+		return types.Position{}, err
+	}
+	positionNotional, unrealizedPnl, err := k.getPositionNotionalAndUnrealizedPnL(
+		ctx, position, types.PnLCalcOption_SPOT_PRICE)
+	if err != nil {
+		return types.Position{}, err
+	}
+
+	denom := pair.QuoteDenom()
+	if err = k.emitPositionChanged(ctx, sent.Size_, &types.PositionChangedEvent{
+		Pair:                  pair.String(),
+		TraderAddress:         senderAddr.String(),
+		Margin:                sdk.NewCoin(denom, sdk.ZeroInt()),
+		PositionNotional:      sdk.ZeroDec(),
+		ExchangedPositionSize: sent.Size_.Neg(),
+		TransactionFee:        sdk.NewCoin(denom, sdk.ZeroInt()), // always zero when transferring
+		PositionSize:          sdk.ZeroDec(),
+		RealizedPnl:           sdk.ZeroDec(), // always zero when transferring
+		UnrealizedPnlAfter:    sdk.ZeroDec(),
+		BadDebt:               sdk.NewCoin(denom, sdk.ZeroInt()),
+		FundingPayment:        sentMargin.FundingPayment,
+		MarkPrice:             markPrice,
+		BlockHeight:           ctx.BlockHeight(),
+		BlockTimeMs:           ctx.BlockTime().UnixMilli(),
+		LiquidationPenalty:    sdk.ZeroDec(),
+	}); err != nil {
+		return types.Position{}, err
+	}
+
+	if err = k.emitPositionChanged(ctx, sdk.ZeroDec(), &types.PositionChangedEvent{
+		Pair:                  pair.String(),
+		TraderAddress:         receiverAddr.String(),
+		Margin:                sdk.NewCoin(denom, position.Margin.RoundInt()),
+		PositionNotional:      positionNotional,
+		ExchangedPositionSize: sent.Size_,
+		TransactionFee:        sdk.NewCoin(denom, sdk.ZeroInt()), // always zero when transferring
+		PositionSize:          position.Size_,
+		RealizedPnl:           sdk.ZeroDec(), // always zero when transferring
+		UnrealizedPnlAfter:    unrealizedPnl,
+		BadDebt:               sdk.NewCoin(denom, sdk.ZeroInt()),
+		FundingPayment:        sdk.ZeroDec(), // the position is new to the receiver
+		MarkPrice:             markPrice,
+		BlockHeight:           ctx.BlockHeight(),
+		BlockTimeMs:           ctx.BlockTime().UnixMilli(),
+		LiquidationPenalty:    sdk.ZeroDec(),
+	}); err != nil {
+		return types.Position{}, err
+	}
+
+	return position, nil
+}
@@ -0,0 +1,248 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/collections"
+	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/perp/types"
+	"github.com/NibiruChain/nibiru/x/testutil"
+	vpooltypes "github.com/NibiruChain/nibiru/x/vpool/types"
+)
+
+func TestTransferPosition(t *testing.T) {
+	nibiruApp, ctx, sender := initOrdersTest(t, sdk.NewCoins())
+	perpKeeper := nibiruApp.PerpKeeper
+	receiver := testutil.AccAddress()
+
+	// the cumulative premium fraction moved by 0.001 since the position was opened
+	setPairMetadata(perpKeeper, ctx, types.PairMetadata{
+		Pair:                            common.Pair_BTC_NUSD,
+		LatestCumulativePremiumFraction: sdk.MustNewDecFromStr("0.001"),
+	})
+	setPosition(perpKeeper, ctx, types.Position{
+		TraderAddress:                   sender.String(),
+		Pair:                            common.Pair_BTC_NUSD,
+		Size_:                           sdk.NewDec(1_000),
+		Margin:                          sdk.NewDec(100),
+		OpenNotional:                    sdk.NewDec(1_000),
+		LatestCumulativePremiumFraction: sdk.ZeroDec(),
+	})
+	openInterestBefore := perpKeeper.GetOpenInterest(ctx, common.Pair_BTC_NUSD)
+
+	position, err := perpKeeper.TransferPosition(ctx, common.Pair_BTC_NUSD, sender, receiver)
+	require.NoError(t, err)
+
+	t.Log("the position is moved with its margin net of the funding payment")
+	assert.EqualValues(t, types.Position{
+		TraderAddress:                   receiver.String(),
+		Pair:                            common.Pair_BTC_NUSD,
+		Size_:                           sdk.NewDec(1_000),
+		Margin:                          sdk.NewDec(99),
+		OpenNotional:                    sdk.NewDec(1_000),
+		LatestCumulativePremiumFraction: sdk.MustNewDecFromStr("0.001"),
+		BlockNumber:                     ctx.BlockHeight(),
+	}, position)
+	_, err = perpKeeper.Positions.Get(ctx, collections.Join(common.Pair_BTC_NUSD, sender))
+	require.ErrorIs(t, err, collections.ErrNotFound)
+	stored, err := perpKeeper.Positions.Get(ctx, collections.Join(common.Pair_BTC_NUSD, receiver))
+	require.NoError(t, err)
+	assert.EqualValues(t, position, stored)
+
+	t.Log("the open interest is unchanged")
+	assert.EqualValues(t, openInterestBefore, perpKeeper.GetOpenInterest(ctx, common.Pair_BTC_NUSD))
+
+	t.Log("both sides emit a position changed event")
+	testutil.RequireContainsTypedEvent(t, ctx, &types.PositionChangedEvent{
+		Pair:                  common.Pair_BTC_NUSD.String(),
+		TraderAddress:         sender.String(),
+		Margin:                sdk.NewInt64Coin(common.DenomNUSD, 0),
+		PositionNotional:      sdk.ZeroDec(),
+		ExchangedPositionSize: sdk.NewDec(-1_000),
+		TransactionFee:        sdk.NewInt64Coin(common.DenomNUSD, 0),
+		PositionSize:          sdk.ZeroDec(),
+		RealizedPnl:           sdk.ZeroDec(),
+		UnrealizedPnlAfter:    sdk.ZeroDec(),
+		BadDebt:               sdk.NewInt64Coin(common.DenomNUSD, 0),
+		FundingPayment:        sdk.NewDec(1),
+		MarkPrice:             sdk.OneDec(),
+		BlockHeight:           ctx.BlockHeight(),
+		BlockTimeMs:           ctx.BlockTime().UnixMilli(),
+		LiquidationPenalty:    sdk.ZeroDec(),
+	})
+	// a long is valued at the price of selling its size to the vpool
+	positionNotional, err := nibiruApp.VpoolKeeper.GetBaseAssetPrice(
+		ctx, common.Pair_BTC_NUSD, vpooltypes.Direction_ADD_TO_POOL, sdk.NewDec(1_000))
+	require.NoError(t, err)
+	testutil.RequireContainsTypedEvent(t, ctx, &types.PositionChangedEvent{
+		Pair:                  common.Pair_BTC_NUSD.String(),
+		TraderAddress:         receiver.String(),
+		Margin:                sdk.NewInt64Coin(common.DenomNUSD, 99),
+		PositionNotional:      positionNotional,
+		ExchangedPositionSize: sdk.NewDec(1_000),
+		TransactionFee:        sdk.NewInt64Coin(common.DenomNUSD, 0),
+		PositionSize:          sdk.NewDec(1_000),
+		RealizedPnl:           sdk.ZeroDec(),
+		UnrealizedPnlAfter:    positionNotional.Sub(sdk.NewDec(1_000)),
+		BadDebt:               sdk.NewInt64Coin(common.DenomNUSD, 0),
+		FundingPayment:        sdk.ZeroDec(),
+		MarkPrice:             sdk.OneDec(),
+		BlockHeight:           ctx.BlockHeight(),
+		BlockTimeMs:           ctx.BlockTime().UnixMilli(),
+		LiquidationPenalty:    sdk.ZeroDec(),
+	})
+}
+
+func TestTransferPositionRejected(t *testing.T) {
+	testCases := []struct {
+		name             string
+		receiverPosition *types.Position
+		maxPositionSize  sdk.Dec
+
+		expectedErr error
+	}{
+		{
+			name: "receiver has a position on the same side",
+			receiverPosition: &types.Position{
+				Size_:                           sdk.NewDec(-500),
+				Margin:                          sdk.NewDec(50),
+				OpenNotional:                    sdk.NewDec(500),
+				LatestCumulativePremiumFraction: sdk.ZeroDec(),
+			},
+			maxPositionSize: sdk.ZeroDec(),
+			expectedErr:     types.ErrInvalidPositionTransfer,
+		},
+		{
+			name: "receiver has a position on the opposite side",
+			receiverPosition: &types.Position{
+				Size_:                           sdk.NewDec(500),
+				Margin:                          sdk.NewDec(50),
+				OpenNotional:                    sdk.NewDec(500),
+				LatestCumulativePremiumFraction: sdk.ZeroDec(),
+			},
+			maxPositionSize: sdk.ZeroDec(),
+			expectedErr:     types.ErrInvalidPositionTransfer,
+		},
+		{
+			name:            "position over the position size cap",
+			maxPositionSize: sdk.NewDec(900),
+			expectedErr:     types.ErrOpenInterestCapExceeded,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			nibiruApp, ctx, sender := initOrdersTest(t, sdk.NewCoins())
+			perpKeeper := nibiruApp.PerpKeeper
+			receiver := testutil.AccAddress()
+			require.NoError(t, perpKeeper.SetOpenInterestCaps(
+				ctx, common.Pair_BTC_NUSD, sdk.ZeroDec(), tc.maxPositionSize))
+
+			sent := types.Position{
+				TraderAddress:                   sender.String(),
+				Pair:                            common.Pair_BTC_NUSD,
+				Size_:                           sdk.NewDec(-1_000),
+				Margin:                          sdk.NewDec(100),
+				OpenNotional:                    sdk.NewDec(1_000),
+				LatestCumulativePremiumFraction: sdk.ZeroDec(),
+			}
+			setPosition(perpKeeper, ctx, sent)
+			if tc.receiverPosition != nil {
+				received := *tc.receiverPosition
+				received.TraderAddress = receiver.String()
+				received.Pair = common.Pair_BTC_NUSD
+				setPosition(perpKeeper, ctx, received)
+			}
+
+			_, err := perpKeeper.TransferPosition(ctx, common.Pair_BTC_NUSD, sender, receiver)
+			require.ErrorIs(t, err, tc.expectedErr)
+
+			t.Log("the positions are unchanged")
+			stored, err := perpKeeper.Positions.Get(ctx, collections.Join(common.Pair_BTC_NUSD, sender))
+			require.NoError(t, err)
+			assert.EqualValues(t, sent, stored)
+			if tc.receiverPosition != nil {
+				stored, err = perpKeeper.Positions.Get(ctx, collections.Join(common.Pair_BTC_NUSD, receiver))
+				require.NoError(t, err)
+				assert.EqualValues(t, tc.receiverPosition.Size_, stored.Size_)
+			}
+		})
+	}
+}
+
+func TestTransferPositionCrossMargin(t *testing.T) {
+	nibiruApp, ctx, sender := initOrdersTest(t, sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 100)))
+	perpKeeper := nibiruApp.PerpKeeper
+	receiver := testutil.AccAddress()
+
+	setPosition(perpKeeper, ctx, types.Position{
+		TraderAddress:                   sender.String(),
+		Pair:                            common.Pair_BTC_NUSD,
+		Size_:                           sdk.NewDec(1_000),
+		Margin:                          sdk.NewDec(100),
+		OpenNotional:                    sdk.NewDec(1_000),
+		LatestCumulativePremiumFraction: sdk.ZeroDec(),
+	})
+	_, err := perpKeeper.DepositCrossMargin(ctx, sender, sdk.NewInt64Coin(common.DenomNUSD, 100))
+	require.NoError(t, err)
+
+	_, err = perpKeeper.TransferPosition(ctx, common.Pair_BTC_NUSD, sender, receiver)
+	require.ErrorIs(t, err, types.ErrInvalidPositionTransfer)
+}
+
+func TestTransferPositionCancelsOrders(t *testing.T) {
+	// enough for the execution reward of two orders
+	nibiruApp, ctx, sender := initOrdersTest(t, sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 2_000)))
+	perpKeeper := nibiruApp.PerpKeeper
+	receiver := testutil.AccAddress()
+	otherTrader := testutil.AccAddress()
+	require.NoError(t, simapp.FundAccount(nibiruApp.BankKeeper, ctx, otherTrader,
+		sdk.NewCoins(sdk.NewInt64Coin(common.DenomNUSD, 1_000))))
+
+	setPosition(perpKeeper, ctx, types.Position{
+		TraderAddress:                   sender.String(),
+		Pair:                            common.Pair_BTC_NUSD,
+		Size_:                           sdk.NewDec(1_000),
+		Margin:                          sdk.NewDec(100),
+		OpenNotional:                    sdk.NewDec(1_000),
+		LatestCumulativePremiumFraction: sdk.ZeroDec(),
+	})
+	stopLoss, err := perpKeeper.PlaceOrder(ctx, common.Pair_BTC_NUSD, sender, types.OrderType_STOP_LOSS,
+		types.Side_SELL, sdk.MustNewDecFromStr("0.9"), sdk.ZeroInt(), sdk.ZeroDec(), sdk.ZeroInt(), time.Time{})
+	require.NoError(t, err)
+	limit, err := perpKeeper.PlaceOrder(ctx, common.Pair_BTC_NUSD, sender, types.OrderType_LIMIT,
+		types.Side_BUY, sdk.MustNewDecFromStr("0.9"), sdk.NewInt(100), sdk.NewDec(10), sdk.ZeroInt(), time.Time{})
+	require.NoError(t, err)
+	otherOrder, err := perpKeeper.PlaceOrder(ctx, common.Pair_BTC_NUSD, otherTrader, types.OrderType_LIMIT,
+		types.Side_BUY, sdk.MustNewDecFromStr("0.9"), sdk.NewInt(100), sdk.NewDec(10), sdk.ZeroInt(), time.Time{})
+	require.NoError(t, err)
+	balanceAfterOrders := nibiruApp.BankKeeper.GetBalance(ctx, sender, common.DenomNUSD)
+
+	_, err = perpKeeper.TransferPosition(ctx, common.Pair_BTC_NUSD, sender, receiver)
+	require.NoError(t, err)
+
+	t.Log("the orders of the sender on the pair are cancelled and refunded")
+	assert.Empty(t, perpKeeper.Orders.Indexes.Trader.ExactMatch(ctx, sender).PrimaryKeys())
+	assert.EqualValues(t,
+		balanceAfterOrders.Add(stopLoss.ExecutionReward).Add(limit.ExecutionReward),
+		nibiruApp.BankKeeper.GetBalance(ctx, sender, common.DenomNUSD))
+	for _, order := range []types.Order{stopLoss, limit} {
+		testutil.RequireContainsTypedEvent(t, ctx, &types.OrderCancelledEvent{
+			Order:       order,
+			Reason:      "position transferred",
+			BlockHeight: ctx.BlockHeight(),
+			BlockTimeMs: ctx.BlockTime().UnixMilli(),
+		})
+	}
+
+	t.Log("the orders of other traders are untouched")
+	_, err = perpKeeper.Orders.Get(ctx, otherOrder.Id)
+	require.NoError(t, err)
+}
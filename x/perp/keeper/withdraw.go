@@ -9,7 +9,7 @@ import (
 /*
 Withdraws coins from the vault to the receiver.
 If the total amount of coins to withdraw is greater than the vault's amount, then
-withdraw the shortage from the PerpEF and mark it as prepaid bad debt.
+the shortage is covered by the bad debt payout waterfall and marked as prepaid bad debt.
 
 Prepaid bad debt will count towards realized bad debt from negative PnL positions
 when those are closed/liquidated.
@@ -18,9 +18,12 @@ An example of this happening is when a long position has really high PnL and
 closes their position, realizing their profits.
 There is a counter party short position with really negative PnL, but
 their position hasn't been closed/liquidated yet.
-We must pay the long trader first, which results in funds being taken from the EF.
-When the short position is closed, it also realizes some bad debt but because
-we have already withdrawn from the EF, we don't need to withdraw more from the EF.
+We must pay the long trader first, which results in funds being taken from the
+insurance fund or the EF. When the short position is closed, it also realizes
+some bad debt but because it has already been covered, we don't need to cover it again.
+
+The shortage cannot be socialized since the receiver must be paid in full,
+so the withdrawal fails with ErrVaultInsolvent when the payers run dry.
 */
 func (k Keeper) Withdraw(
 	ctx sdk.Context,
@@ -41,19 +44,12 @@ func (k Keeper) Withdraw(
 		// if withdraw amount is larger than entire balance of vault
 		// means this trader's profit comes from other under collateral position's future loss
 		// and the balance of entire vault is not enough
-		// need money from PerpEF to pay first, and record this prepaidBadDebt
+		// need money from the payers to pay first, and record this prepaidBadDebt
 		shortage := amountToWithdraw.Sub(vaultQuoteBalance.Amount)
-		k.IncrementPrepaidBadDebt(ctx, denom, shortage)
-		if err := k.BankKeeper.SendCoinsFromModuleToModule(
-			ctx,
-			types.PerpEFModuleAccount,
-			types.VaultModuleAccount,
-			sdk.NewCoins(
-				sdk.NewCoin(denom, shortage),
-			),
-		); err != nil {
+		if _, err = k.coverBadDebt(ctx, denom, shortage, false, nil); err != nil {
 			return err
 		}
+		k.IncrementPrepaidBadDebt(ctx, denom, shortage)
 	}
 
 	// Transfer from Vault to receiver
@@ -73,9 +69,13 @@ Prepaid bad debt accrues when more coins are withdrawn from the vault than the
 vault contains, so we "credit" ourselves with prepaid bad debt.
 
 Then, when bad debt is actually realized (by closing underwater positions), we
-can consume the credit we have built before withdrawing more from the ecosystem fund.
+can consume the credit we have built before covering the rest with the bad debt
+payout waterfall. The liquidated position, if any, is the position the rest can
+be auto-deleveraged against.
 */
-func (k Keeper) realizeBadDebt(ctx sdk.Context, denom string, badDebtToRealize sdk.Int) (
+func (k Keeper) realizeBadDebt(
+	ctx sdk.Context, denom string, badDebtToRealize sdk.Int, liquidated *types.Position,
+) (
 	err error,
 ) {
 	prepaidBadDebtBalance := k.PrepaidBadDebt.GetOr(ctx, denom, types.PrepaidBadDebt{
@@ -94,16 +94,8 @@ func (k Keeper) realizeBadDebt(ctx sdk.Context, denom string, badDebtToRealize s
 			Amount: sdk.ZeroInt(),
 		})
 
-		return k.BankKeeper.SendCoinsFromModuleToModule(ctx,
-			/*from=*/ types.PerpEFModuleAccount,
-			/*to=*/ types.VaultModuleAccount,
-			sdk.NewCoins(
-				sdk.NewCoin(
-					denom,
-					badDebtToRealize.Sub(prepaidBadDebtBalance),
-				),
-			),
-		)
+		_, err = k.coverBadDebt(ctx, denom, badDebtToRealize.Sub(prepaidBadDebtBalance), true, liquidated)
+		return err
 	}
 
 	return nil
@@ -18,10 +18,14 @@ func TestWithdraw(t *testing.T) {
 		name                  string
 		initialVaultBalance   int64
 		initialPrepaidBadDebt int64
+		insuranceFundBalance  int64
+		perpEFBalance         int64
 		amountToWithdraw      int64
 
-		expectedPerpEFWithdrawal    int64
-		expectedFinalPrepaidBadDebt int64
+		expectedInsuranceFundWithdrawal int64
+		expectedPerpEFWithdrawal        int64
+		expectedFinalPrepaidBadDebt     int64
+		expectedErr                     error
 	}{
 		{
 			name:                  "no bad debt",
@@ -37,6 +41,7 @@ func TestWithdraw(t *testing.T) {
 			name:                  "creates prepaid bad debt",
 			initialVaultBalance:   9,
 			initialPrepaidBadDebt: 0,
+			perpEFBalance:         100,
 
 			amountToWithdraw: 10,
 
@@ -47,12 +52,39 @@ func TestWithdraw(t *testing.T) {
 			name:                  "increases existing prepaid bad debt",
 			initialVaultBalance:   9,
 			initialPrepaidBadDebt: 1,
+			perpEFBalance:         100,
 
 			amountToWithdraw: 10,
 
 			expectedPerpEFWithdrawal:    1,
 			expectedFinalPrepaidBadDebt: 2,
 		},
+		{
+			name:                  "insurance fund pays before the perpEF",
+			initialVaultBalance:   5,
+			initialPrepaidBadDebt: 0,
+			insuranceFundBalance:  3,
+			perpEFBalance:         100,
+
+			amountToWithdraw: 10,
+
+			expectedInsuranceFundWithdrawal: 3,
+			expectedPerpEFWithdrawal:        2,
+			expectedFinalPrepaidBadDebt:     5,
+		},
+		{
+			name:                  "shortage cannot be socialized",
+			initialVaultBalance:   5,
+			initialPrepaidBadDebt: 0,
+			insuranceFundBalance:  1,
+			perpEFBalance:         1,
+
+			amountToWithdraw: 10,
+
+			expectedInsuranceFundWithdrawal: 1,
+			expectedPerpEFWithdrawal:        1,
+			expectedErr:                     types.ErrVaultInsolvent,
+		},
 	}
 
 	for _, tc := range tests {
@@ -72,22 +104,28 @@ func TestWithdraw(t *testing.T) {
 			t.Log("mock bank keeper")
 			mocks.mockBankKeeper.EXPECT().GetBalance(ctx, vaultAddr, denom).
 				Return(sdk.NewInt64Coin(denom, tc.initialVaultBalance))
-			mocks.mockBankKeeper.EXPECT().SendCoinsFromModuleToAccount(
-				ctx, types.VaultModuleAccount, receiver,
-				sdk.NewCoins(sdk.NewInt64Coin(denom, tc.amountToWithdraw)),
-			).Return(nil)
-			if tc.expectedPerpEFWithdrawal > 0 {
-				mocks.mockBankKeeper.EXPECT().SendCoinsFromModuleToModule(
-					ctx, types.PerpEFModuleAccount, types.VaultModuleAccount,
-					sdk.NewCoins(sdk.NewInt64Coin(denom, tc.expectedPerpEFWithdrawal)),
+			if tc.expectedErr == nil {
+				mocks.mockBankKeeper.EXPECT().SendCoinsFromModuleToAccount(
+					ctx, types.VaultModuleAccount, receiver,
+					sdk.NewCoins(sdk.NewInt64Coin(denom, tc.amountToWithdraw)),
 				).Return(nil)
 			}
+			if tc.initialVaultBalance < tc.amountToWithdraw {
+				expectBadDebtPayout(mocks, ctx, types.InsuranceFundModuleAccount, denom,
+					tc.insuranceFundBalance, tc.expectedInsuranceFundWithdrawal)
+				expectBadDebtPayout(mocks, ctx, types.PerpEFModuleAccount, denom,
+					tc.perpEFBalance, tc.expectedPerpEFWithdrawal)
+			}
 
 			t.Log("initial prepaid bad debt")
 			perpKeeper.PrepaidBadDebt.Insert(ctx, denom, types.PrepaidBadDebt{Denom: denom, Amount: sdk.NewInt(tc.initialPrepaidBadDebt)})
 
 			t.Log("execute withdrawal")
 			err := perpKeeper.Withdraw(ctx, denom, receiver, sdk.NewInt(tc.amountToWithdraw))
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
 			require.NoError(t, err)
 
 			t.Log("assert new prepaid bad debt")
@@ -102,11 +140,15 @@ func TestRealizeBadDebt(t *testing.T) {
 	tests := []struct {
 		name                  string
 		initialPrepaidBadDebt int64
+		insuranceFundBalance  int64
+		perpEFBalance         int64
 
 		badDebtToRealize int64
 
-		expectedPerpEFWithdrawal    int64
-		expectedFinalPrepaidBadDebt int64
+		expectedInsuranceFundWithdrawal int64
+		expectedPerpEFWithdrawal        int64
+		expectedSocializedLoss          int64
+		expectedFinalPrepaidBadDebt     int64
 	}{
 		{
 			name:                  "prepaid bad debt completely covers bad debt to realize",
@@ -129,12 +171,36 @@ func TestRealizeBadDebt(t *testing.T) {
 		{
 			name:                  "requires perpEF withdrawal",
 			initialPrepaidBadDebt: 5,
+			perpEFBalance:         100,
 
 			badDebtToRealize: 10,
 
 			expectedPerpEFWithdrawal:    5,
 			expectedFinalPrepaidBadDebt: 0,
 		},
+		{
+			name:                  "insurance fund covers everything",
+			initialPrepaidBadDebt: 0,
+			insuranceFundBalance:  100,
+			perpEFBalance:         100,
+
+			badDebtToRealize: 10,
+
+			expectedInsuranceFundWithdrawal: 10,
+			expectedFinalPrepaidBadDebt:     0,
+		},
+		{
+			name:                  "insurance fund and perpEF cover the bad debt together",
+			initialPrepaidBadDebt: 2,
+			insuranceFundBalance:  3,
+			perpEFBalance:         100,
+
+			badDebtToRealize: 10,
+
+			expectedInsuranceFundWithdrawal: 3,
+			expectedPerpEFWithdrawal:        5,
+			expectedFinalPrepaidBadDebt:     0,
+		},
 	}
 
 	for _, tc := range tests {
@@ -144,12 +210,14 @@ func TestRealizeBadDebt(t *testing.T) {
 			perpKeeper, mocks, ctx := getKeeper(t)
 			denom := "NUSD"
 
-			if tc.expectedPerpEFWithdrawal > 0 {
+			if tc.badDebtToRealize > tc.initialPrepaidBadDebt {
 				t.Log("mock bank keeper")
-				mocks.mockBankKeeper.EXPECT().SendCoinsFromModuleToModule(
-					ctx, types.PerpEFModuleAccount, types.VaultModuleAccount,
-					sdk.NewCoins(sdk.NewInt64Coin(denom, tc.expectedPerpEFWithdrawal)),
-				).Return(nil)
+				expectBadDebtPayout(mocks, ctx, types.InsuranceFundModuleAccount, denom,
+					tc.insuranceFundBalance, tc.expectedInsuranceFundWithdrawal)
+				if tc.expectedInsuranceFundWithdrawal < tc.badDebtToRealize-tc.initialPrepaidBadDebt {
+					expectBadDebtPayout(mocks, ctx, types.PerpEFModuleAccount, denom,
+						tc.perpEFBalance, tc.expectedPerpEFWithdrawal)
+				}
 			}
 
 			t.Log("initial prepaid bad debt")
@@ -159,17 +227,39 @@ func TestRealizeBadDebt(t *testing.T) {
 			})
 
 			t.Log("execute withdrawal")
-			err := perpKeeper.realizeBadDebt(ctx, denom, sdk.NewInt(tc.badDebtToRealize))
+			err := perpKeeper.realizeBadDebt(ctx, denom, sdk.NewInt(tc.badDebtToRealize), nil)
 			require.NoError(t, err)
 
 			t.Log("assert new prepaid bad debt")
 			prepaidBadDebt, err := perpKeeper.PrepaidBadDebt.Get(ctx, denom)
 			require.NoError(t, err)
 			assert.EqualValues(t, tc.expectedFinalPrepaidBadDebt, prepaidBadDebt.Amount.Int64())
+
+			t.Log("assert the insurance fund statistics")
+			fund := perpKeeper.GetInsuranceFund(ctx, denom)
+			assert.EqualValues(t, tc.expectedInsuranceFundWithdrawal, fund.TotalPayouts.Int64())
+			assert.EqualValues(t, tc.expectedSocializedLoss, fund.TotalSocializedLoss.Int64())
 		})
 	}
 }
 
+// expectBadDebtPayout mocks a step of the bad debt payout waterfall, in which
+// the module account is asked for its balance and pays 'paid' to the vault.
+func expectBadDebtPayout(
+	mocks mockedDependencies, ctx sdk.Context, moduleAccount string, denom string, balance int64, paid int64,
+) {
+	moduleAddr := authtypes.NewModuleAddress(moduleAccount)
+	mocks.mockAccountKeeper.EXPECT().GetModuleAddress(moduleAccount).Return(moduleAddr)
+	mocks.mockBankKeeper.EXPECT().GetBalance(ctx, moduleAddr, denom).
+		Return(sdk.NewInt64Coin(denom, balance))
+	if paid > 0 {
+		mocks.mockBankKeeper.EXPECT().SendCoinsFromModuleToModule(
+			ctx, moduleAccount, types.VaultModuleAccount,
+			sdk.NewCoins(sdk.NewInt64Coin(denom, paid)),
+		).Return(nil)
+	}
+}
+
 func TestIncrementDecrementBadDebt(t *testing.T) {
 	k, _, ctx := getKeeper(t)
 	// increment on non-existing prepaid bad debt
@@ -0,0 +1,144 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	"github.com/NibiruChain/nibiru/x/common"
+)
+
+var _ authz.Authorization = &TradingAuthorization{}
+
+// TradingMsgTypeURLs returns the type URLs of the msgs a TradingAuthorization
+// can be granted for.
+func TradingMsgTypeURLs() []string {
+	return []string{
+		sdk.MsgTypeURL(&MsgOpenPosition{}),
+		sdk.MsgTypeURL(&MsgClosePosition{}),
+		sdk.MsgTypeURL(&MsgPartialClose{}),
+		sdk.MsgTypeURL(&MsgAddMargin{}),
+		sdk.MsgTypeURL(&MsgRemoveMargin{}),
+	}
+}
+
+// NewTradingAuthorization creates the authorization of an agent to execute
+// the msg of type 'msgTypeURL' on the given pairs, all pairs if empty.
+func NewTradingAuthorization(
+	msgTypeURL string, pairs []string, maxLeverage sdk.Dec, maxNotional sdk.Int,
+) *TradingAuthorization {
+	return &TradingAuthorization{
+		Msg:          msgTypeURL,
+		Pairs:        pairs,
+		MaxLeverage:  maxLeverage,
+		MaxNotional:  maxNotional,
+		UsedNotional: sdk.ZeroInt(),
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a TradingAuthorization) MsgTypeURL() string {
+	return a.Msg
+}
+
+// Accept implements Authorization.Accept. The authorization lasts until it is
+// revoked or the grant expires. The notional of the positions opened by the
+// agent counts against MaxNotional, so the authorization is updated with the
+// notional used.
+func (a TradingAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	if sdk.MsgTypeURL(msg) != a.Msg {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+
+	var tokenPair string
+	var updated authz.Authorization
+	switch m := msg.(type) {
+	case *MsgOpenPosition:
+		tokenPair = m.TokenPair
+		if a.MaxLeverage.IsPositive() && m.Leverage.GT(a.MaxLeverage) {
+			return authz.AcceptResponse{}, ErrUnauthorized.Wrapf(
+				"leverage %s is above the max leverage of the agent %s", m.Leverage, a.MaxLeverage)
+		}
+		if a.MaxNotional.IsPositive() {
+			usedNotional := a.UsedNotional
+			if usedNotional.IsNil() {
+				usedNotional = sdk.ZeroInt()
+			}
+			notional := m.QuoteAssetAmount.ToDec().Mul(m.Leverage).Ceil().TruncateInt()
+			if usedNotional.Add(notional).GT(a.MaxNotional) {
+				return authz.AcceptResponse{}, ErrUnauthorized.Wrapf(
+					"notional %s on top of the %s already used is above the max notional of the agent %s",
+					notional, usedNotional, a.MaxNotional)
+			}
+			a.UsedNotional = usedNotional.Add(notional)
+			updated = &a
+		}
+	case *MsgClosePosition:
+		tokenPair = m.TokenPair
+	case *MsgPartialClose:
+		tokenPair = m.TokenPair
+	case *MsgAddMargin:
+		tokenPair = m.TokenPair
+	case *MsgRemoveMargin:
+		tokenPair = m.TokenPair
+	default:
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrapf("%T is not a trading msg", msg)
+	}
+
+	if !a.isPairAllowed(tokenPair) {
+		return authz.AcceptResponse{}, ErrUnauthorized.Wrapf("the agent is not allowed to trade %s", tokenPair)
+	}
+
+	return authz.AcceptResponse{Accept: true, Updated: updated}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a TradingAuthorization) ValidateBasic() error {
+	isTradingMsg := false
+	for _, msgTypeURL := range TradingMsgTypeURLs() {
+		if a.Msg == msgTypeURL {
+			isTradingMsg = true
+			break
+		}
+	}
+	if !isTradingMsg {
+		return fmt.Errorf("%s is not a trading msg", a.Msg)
+	}
+
+	seen := make(map[string]bool)
+	for _, pair := range a.Pairs {
+		if _, err := common.NewAssetPair(pair); err != nil {
+			return err
+		}
+		if seen[pair] {
+			return fmt.Errorf("duplicate pair %s", pair)
+		}
+		seen[pair] = true
+	}
+
+	if a.MaxLeverage.IsNil() || a.MaxLeverage.IsNegative() {
+		return fmt.Errorf("max leverage must be non-negative, not: %s", a.MaxLeverage)
+	}
+	if a.MaxNotional.IsNil() || a.MaxNotional.IsNegative() {
+		return fmt.Errorf("max notional must be non-negative, not: %s", a.MaxNotional)
+	}
+	if !a.UsedNotional.IsNil() && a.UsedNotional.IsNegative() {
+		return fmt.Errorf("used notional must be non-negative, not: %s", a.UsedNotional)
+	}
+
+	return nil
+}
+
+func (a TradingAuthorization) isPairAllowed(tokenPair string) bool {
+	if len(a.Pairs) == 0 {
+		return true
+	}
+	for _, pair := range a.Pairs {
+		if pair == tokenPair {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,539 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: perp/v1/authz.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/regen-network/cosmos-proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TradingAuthorization is an x/authz authorization that lets an agent trade
+// on the positions of the granter. It is granted once per trading msg: open
+// position, close position, partial close, add margin and remove margin.
+//
+// The agent executes the msgs with the granter as the sender, so the margin is
+// always taken from and paid back to the granter. The expiration of the grant
+// is the expiration of the x/authz grant.
+type TradingAuthorization struct {
+	// msg is the type URL of the trading msg the agent is allowed to execute.
+	Msg string `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	// pairs the agent is allowed to trade, all pairs if empty.
+	Pairs []string `protobuf:"bytes,2,rep,name=pairs,proto3" json:"pairs,omitempty"`
+	// max_leverage is the maximum leverage of the positions opened by the agent,
+	// no limit other than the vpool max leverage if zero.
+	MaxLeverage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=max_leverage,json=maxLeverage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_leverage"`
+	// max_notional is the maximum total notional (quote asset amount times
+	// leverage) the agent can open or add to positions over the lifetime of the
+	// grant, no limit if zero.
+	MaxNotional github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=max_notional,json=maxNotional,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_notional"`
+	// used_notional is the notional the agent already traded against
+	// max_notional. Splitting a trade doesn't get around the limit.
+	UsedNotional github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=used_notional,json=usedNotional,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"used_notional"`
+}
+
+func (m *TradingAuthorization) Reset()         { *m = TradingAuthorization{} }
+func (m *TradingAuthorization) String() string { return proto.CompactTextString(m) }
+func (*TradingAuthorization) ProtoMessage()    {}
+func (*TradingAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_58a015930f356eb7, []int{0}
+}
+func (m *TradingAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TradingAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TradingAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TradingAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TradingAuthorization.Merge(m, src)
+}
+func (m *TradingAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *TradingAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_TradingAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TradingAuthorization proto.InternalMessageInfo
+
+func (m *TradingAuthorization) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
+}
+
+func (m *TradingAuthorization) GetPairs() []string {
+	if m != nil {
+		return m.Pairs
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*TradingAuthorization)(nil), "nibiru.perp.v1.TradingAuthorization")
+}
+
+func init() { proto.RegisterFile("perp/v1/authz.proto", fileDescriptor_58a015930f356eb7) }
+
+var fileDescriptor_58a015930f356eb7 = []byte{
+	// 318 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x91, 0xb1, 0x4e, 0x02, 0x31,
+	0x1c, 0xc6, 0xef, 0x40, 0x4c, 0x38, 0xc1, 0xe8, 0xc9, 0x70, 0x32, 0x1c, 0xc4, 0xc1, 0x10, 0x13,
+	0xda, 0x10, 0x37, 0x37, 0x91, 0xc5, 0xc4, 0x90, 0x88, 0x4e, 0x2e, 0xa4, 0x40, 0xd3, 0x6b, 0xe4,
+	0xda, 0x4b, 0xdb, 0x23, 0xc8, 0x53, 0xf8, 0x30, 0x3e, 0x04, 0x83, 0x03, 0x71, 0x32, 0x0e, 0xc4,
+	0x70, 0x2f, 0x62, 0xda, 0x1e, 0x51, 0x47, 0x9d, 0xfa, 0xff, 0xf2, 0xb5, 0xbf, 0xaf, 0xf9, 0x7f,
+	0xde, 0x51, 0x82, 0x45, 0x02, 0x67, 0x1d, 0x88, 0x52, 0x15, 0x2d, 0x40, 0x22, 0xb8, 0xe2, 0xfe,
+	0x3e, 0xa3, 0x23, 0x2a, 0x52, 0xa0, 0x3d, 0x30, 0xeb, 0xd4, 0x6b, 0x84, 0x13, 0x6e, 0x2c, 0xa8,
+	0x27, 0x7b, 0xab, 0x7e, 0x3c, 0xe6, 0x32, 0xe6, 0x72, 0x68, 0x0d, 0x2b, 0xac, 0x75, 0xf2, 0x5a,
+	0xf0, 0x6a, 0xf7, 0x02, 0x4d, 0x28, 0x23, 0x97, 0xa9, 0x8a, 0xb8, 0xa0, 0x0b, 0xa4, 0x28, 0x67,
+	0xfe, 0x81, 0x57, 0x8c, 0x25, 0x09, 0xdc, 0xa6, 0xdb, 0x2a, 0x0f, 0xf4, 0xe8, 0xd7, 0xbc, 0x52,
+	0x82, 0xa8, 0x90, 0x41, 0xa1, 0x59, 0x6c, 0x95, 0x07, 0x56, 0xf8, 0xb7, 0x5e, 0x25, 0x46, 0xf3,
+	0xe1, 0x14, 0xcf, 0xb0, 0x40, 0x04, 0x07, 0x45, 0xfd, 0xa0, 0x0b, 0x96, 0xeb, 0x86, 0xf3, 0xb1,
+	0x6e, 0x9c, 0x12, 0xaa, 0xa2, 0x74, 0x04, 0xc6, 0x3c, 0xce, 0x73, 0xf3, 0xa3, 0x2d, 0x27, 0x8f,
+	0x50, 0x3d, 0x25, 0x58, 0x82, 0x1e, 0x1e, 0x0f, 0xf6, 0x62, 0x34, 0xbf, 0xc9, 0x11, 0x5b, 0x24,
+	0xe3, 0xfa, 0x23, 0x68, 0x1a, 0xec, 0xfc, 0x19, 0x79, 0xcd, 0x94, 0x41, 0xf6, 0x73, 0x84, 0x7f,
+	0xe7, 0x55, 0x53, 0x89, 0x27, 0xdf, 0xcc, 0xd2, 0xbf, 0x98, 0x15, 0x0d, 0xd9, 0x42, 0x2f, 0x0e,
+	0xdf, 0x5e, 0xda, 0xd5, 0x5f, 0x5b, 0xeb, 0xf6, 0x96, 0x9b, 0xd0, 0x5d, 0x6d, 0x42, 0xf7, 0x73,
+	0x13, 0xba, 0xcf, 0x59, 0xe8, 0xac, 0xb2, 0xd0, 0x79, 0xcf, 0x42, 0xe7, 0xe1, 0xec, 0x47, 0x44,
+	0xdf, 0x94, 0x76, 0x15, 0x21, 0xca, 0xa0, 0x2d, 0x10, 0xce, 0xa1, 0xa9, 0xd7, 0x44, 0x8d, 0x76,
+	0x4d, 0x37, 0xe7, 0x5f, 0x03, 0x00, 0x0d, 0xe2, 0x9c, 0x3e, 0xf3, 0x01, 0x00, 0x00,
+}
+
+func (m *TradingAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TradingAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TradingAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.UsedNotional.Size()
+		i -= size
+		if _, err := m.UsedNotional.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuthz(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MaxNotional.Size()
+		i -= size
+		if _, err := m.MaxNotional.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuthz(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MaxLeverage.Size()
+		i -= size
+		if _, err := m.MaxLeverage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuthz(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Pairs) > 0 {
+		for iNdEx := len(m.Pairs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Pairs[iNdEx])
+			copy(dAtA[i:], m.Pairs[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.Pairs[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuthz(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TradingAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if len(m.Pairs) > 0 {
+		for _, s := range m.Pairs {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	l = m.MaxLeverage.Size()
+	n += 1 + l + sovAuthz(uint64(l))
+	l = m.MaxNotional.Size()
+	n += 1 + l + sovAuthz(uint64(l))
+	l = m.UsedNotional.Size()
+	n += 1 + l + sovAuthz(uint64(l))
+	return n
+}
+
+func sovAuthz(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuthz(x uint64) (n int) {
+	return sovAuthz(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TradingAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TradingAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TradingAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pairs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pairs = append(m.Pairs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLeverage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxLeverage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNotional", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsedNotional", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UsedNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAuthz(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAuthz
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAuthz
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAuthz
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAuthz        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAuthz          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAuthz = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,145 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/x/testutil"
+)
+
+func TestTradingAuthorization_ValidateBasic(t *testing.T) {
+	openPosition := sdk.MsgTypeURL(&MsgOpenPosition{})
+
+	cases := map[string]struct {
+		authorization *TradingAuthorization
+		wantErr       bool
+	}{
+		"ok": {
+			authorization: NewTradingAuthorization(openPosition, []string{"ubtc:unusd"}, sdk.NewDec(10), sdk.NewInt(1_000)),
+			wantErr:       false,
+		},
+		"ok without limits": {
+			authorization: NewTradingAuthorization(openPosition, nil, sdk.ZeroDec(), sdk.ZeroInt()),
+			wantErr:       false,
+		},
+		"not a trading msg": {
+			authorization: NewTradingAuthorization(sdk.MsgTypeURL(&MsgTransferPosition{}), nil, sdk.ZeroDec(), sdk.ZeroInt()),
+			wantErr:       true,
+		},
+		"invalid pair": {
+			authorization: NewTradingAuthorization(openPosition, []string{"xxx:yyy:zzz"}, sdk.ZeroDec(), sdk.ZeroInt()),
+			wantErr:       true,
+		},
+		"duplicate pair": {
+			authorization: NewTradingAuthorization(openPosition, []string{"ubtc:unusd", "ubtc:unusd"}, sdk.ZeroDec(), sdk.ZeroInt()),
+			wantErr:       true,
+		},
+		"negative max leverage": {
+			authorization: NewTradingAuthorization(openPosition, nil, sdk.NewDec(-1), sdk.ZeroInt()),
+			wantErr:       true,
+		},
+		"negative max notional": {
+			authorization: NewTradingAuthorization(openPosition, nil, sdk.ZeroDec(), sdk.NewInt(-1)),
+			wantErr:       true,
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := tc.authorization.ValidateBasic()
+			if tc.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTradingAuthorization_Accept(t *testing.T) {
+	owner := testutil.AccAddress().String()
+	openPosition := func(pair string, quoteAssetAmount int64, leverage int64) *MsgOpenPosition {
+		return &MsgOpenPosition{
+			Sender:               owner,
+			TokenPair:            pair,
+			Side:                 Side_BUY,
+			QuoteAssetAmount:     sdk.NewInt(quoteAssetAmount),
+			Leverage:             sdk.NewDec(leverage),
+			BaseAssetAmountLimit: sdk.ZeroInt(),
+		}
+	}
+
+	usedAuthorization := func(usedNotional int64) *TradingAuthorization {
+		authorization := NewTradingAuthorization(sdk.MsgTypeURL(&MsgOpenPosition{}), []string{"ubtc:unusd"}, sdk.NewDec(10), sdk.NewInt(1_000))
+		authorization.UsedNotional = sdk.NewInt(usedNotional)
+		return authorization
+	}
+
+	cases := map[string]struct {
+		authorization *TradingAuthorization
+		msg           sdk.Msg
+		wantErr       error
+		wantUpdated   *TradingAuthorization
+	}{
+		"within the limits": {
+			authorization: usedAuthorization(0),
+			msg:           openPosition("ubtc:unusd", 100, 10),
+			wantUpdated:   usedAuthorization(1_000),
+		},
+		"within the limits after previous trades": {
+			authorization: usedAuthorization(400),
+			msg:           openPosition("ubtc:unusd", 60, 10),
+			wantUpdated:   usedAuthorization(1_000),
+		},
+		"split trade over the max notional": {
+			authorization: usedAuthorization(900),
+			msg:           openPosition("ubtc:unusd", 11, 10),
+			wantErr:       ErrUnauthorized,
+		},
+		"no limits": {
+			authorization: NewTradingAuthorization(sdk.MsgTypeURL(&MsgOpenPosition{}), nil, sdk.ZeroDec(), sdk.ZeroInt()),
+			msg:           openPosition("ueth:unusd", 1_000_000, 20),
+		},
+		"over the max leverage": {
+			authorization: NewTradingAuthorization(sdk.MsgTypeURL(&MsgOpenPosition{}), nil, sdk.NewDec(10), sdk.ZeroInt()),
+			msg:           openPosition("ubtc:unusd", 100, 11),
+			wantErr:       ErrUnauthorized,
+		},
+		"over the max notional": {
+			authorization: NewTradingAuthorization(sdk.MsgTypeURL(&MsgOpenPosition{}), nil, sdk.ZeroDec(), sdk.NewInt(1_000)),
+			msg:           openPosition("ubtc:unusd", 101, 10),
+			wantErr:       ErrUnauthorized,
+		},
+		"pair not allowed": {
+			authorization: NewTradingAuthorization(sdk.MsgTypeURL(&MsgClosePosition{}), []string{"ubtc:unusd"}, sdk.ZeroDec(), sdk.ZeroInt()),
+			msg:           &MsgClosePosition{Sender: owner, TokenPair: "ueth:unusd"},
+			wantErr:       ErrUnauthorized,
+		},
+		"remove margin on an allowed pair": {
+			authorization: NewTradingAuthorization(sdk.MsgTypeURL(&MsgRemoveMargin{}), []string{"ubtc:unusd"}, sdk.NewDec(1), sdk.NewInt(1)),
+			msg:           &MsgRemoveMargin{Sender: owner, TokenPair: "ubtc:unusd", Margin: sdk.NewInt64Coin("unusd", 1_000_000)},
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			resp, err := tc.authorization.Accept(sdk.Context{}, tc.msg)
+			if tc.wantErr != nil {
+				require.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, resp.Accept)
+			require.False(t, resp.Delete)
+			if tc.wantUpdated == nil {
+				require.Nil(t, resp.Updated)
+			} else {
+				require.EqualValues(t, tc.wantUpdated, resp.Updated)
+			}
+		})
+	}
+}
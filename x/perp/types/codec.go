@@ -5,6 +5,8 @@ import (
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 func RegisterCodec(cdc *codec.LegacyAmino) {
@@ -12,6 +14,14 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgAddMargin{}, "perp/add_margin", nil)
 	cdc.RegisterConcrete(&MsgLiquidate{}, "perp/liquidate", nil)
 	cdc.RegisterConcrete(&MsgClosePosition{}, "perp/close_position", nil)
+	cdc.RegisterConcrete(&MsgPartialClose{}, "perp/partial_close", nil)
+	cdc.RegisterConcrete(&MsgSettlePosition{}, "perp/settle_position", nil)
+	cdc.RegisterConcrete(&MsgPlaceOrder{}, "perp/place_order", nil)
+	cdc.RegisterConcrete(&MsgCancelOrder{}, "perp/cancel_order", nil)
+	cdc.RegisterConcrete(&MsgDepositCrossMargin{}, "perp/deposit_cross_margin", nil)
+	cdc.RegisterConcrete(&MsgWithdrawCrossMargin{}, "perp/withdraw_cross_margin", nil)
+	cdc.RegisterConcrete(&MsgTransferPosition{}, "perp/transfer_position", nil)
+	cdc.RegisterConcrete(&MsgBidLiquidationAuction{}, "perp/bid_liquidation_auction", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -23,9 +33,23 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgLiquidate{},
 		&MsgOpenPosition{},
 		&MsgClosePosition{},
+		&MsgPartialClose{},
+		&MsgSettlePosition{},
 		&MsgMultiLiquidate{},
+		&MsgPlaceOrder{},
+		&MsgCancelOrder{},
+		&MsgDepositCrossMargin{},
+		&MsgWithdrawCrossMargin{},
+		&MsgTransferPosition{},
+		&MsgBidLiquidationAuction{},
 	)
 
+	registry.RegisterImplementations(
+		(*govtypes.Content)(nil), &PairFeeRatiosProposal{}, &MarginPricePolicyProposal{}, &RepegPoolProposal{},
+		&LiquidityDepthPolicyProposal{}, &OpenInterestCapsProposal{})
+
+	registry.RegisterImplementations((*authz.Authorization)(nil), &TradingAuthorization{})
+
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
 
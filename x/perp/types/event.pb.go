@@ -57,10 +57,10 @@ type PositionChangedEvent struct {
 	// the quote reserves and base reserves
 	MarkPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=mark_price,json=markPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"mark_price"`
 	// A funding payment made or received by the trader on the current position.
-	// 'fundingPayment' is positive if 'owner' is the sender and negative if 'owner'
-	// is the receiver of the payment. Its magnitude is abs(vSize * fundingRate).
-	// Funding payments act to converge the mark price (vPrice) and index price
-	// (average price on major exchanges).
+	//'fundingPayment' is positive if 'owner' is the sender and negative if 'owner'
+	//is the receiver of the payment. Its magnitude is abs(vSize * fundingRate).
+	//Funding payments act to converge the mark price (vPrice) and index price
+	//(average price on major exchanges).
 	FundingPayment github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=funding_payment,json=fundingPayment,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"funding_payment"`
 	// The block number at which this position was changed.
 	BlockHeight int64 `protobuf:"varint,14,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
@@ -288,6 +288,16 @@ type PositionSettledEvent struct {
 	TraderAddress string `protobuf:"bytes,2,opt,name=trader_address,json=traderAddress,proto3" json:"trader_address,omitempty"`
 	// Settled coin as dictated by the settlement price of the vpool.
 	SettledCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=settled_coins,json=settledCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"settled_coins" yaml:"settled_coins"`
+	// The settlement price of the vpool.
+	SettlementPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=settlement_price,json=settlementPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"settlement_price"`
+	// Size of the settled position.
+	PositionSize github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=position_size,json=positionSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"position_size"`
+	// Bad debt of the position at the settlement price, covered by the ecosystem fund.
+	BadDebt types.Coin `protobuf:"bytes,6,opt,name=bad_debt,json=badDebt,proto3" json:"bad_debt" yaml:"bad_debt"`
+	// The block number at which the position was settled.
+	BlockHeight int64 `protobuf:"varint,7,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// The unix timestamp in milliseconds at which the position was settled.
+	BlockTimeMs int64 `protobuf:"varint,8,opt,name=block_time_ms,json=blockTimeMs,proto3" json:"block_time_ms,omitempty"`
 }
 
 func (m *PositionSettledEvent) Reset()         { *m = PositionSettledEvent{} }
@@ -344,6 +354,133 @@ func (m *PositionSettledEvent) GetSettledCoins() github_com_cosmos_cosmos_sdk_ty
 	return nil
 }
 
+func (m *PositionSettledEvent) GetBadDebt() types.Coin {
+	if m != nil {
+		return m.BadDebt
+	}
+	return types.Coin{}
+}
+
+func (m *PositionSettledEvent) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *PositionSettledEvent) GetBlockTimeMs() int64 {
+	if m != nil {
+		return m.BlockTimeMs
+	}
+	return 0
+}
+
+// Emitted when every position of a settled market has been settled.
+type MarketSettledEvent struct {
+	// Identifier for the virtual pool of the market.
+	Pair string `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	// The settlement price of the vpool.
+	SettlementPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=settlement_price,json=settlementPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"settlement_price"`
+	// Balance of the vault in the quote denom once the market is settled and
+	// the vault is reconciled.
+	VaultBalance types.Coin `protobuf:"bytes,3,opt,name=vault_balance,json=vaultBalance,proto3" json:"vault_balance" yaml:"vault_balance"`
+	// Bad debt of the quote denom prepaid by the ecosystem fund and not yet
+	// realized, once the vault is reconciled.
+	PrepaidBadDebt types.Coin `protobuf:"bytes,4,opt,name=prepaid_bad_debt,json=prepaidBadDebt,proto3" json:"prepaid_bad_debt" yaml:"prepaid_bad_debt"`
+	// The block number at which the market was settled.
+	BlockHeight int64 `protobuf:"varint,5,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// The unix timestamp in milliseconds at which the market was settled.
+	BlockTimeMs int64 `protobuf:"varint,6,opt,name=block_time_ms,json=blockTimeMs,proto3" json:"block_time_ms,omitempty"`
+	// Surplus of the vault moved to the ecosystem fund. Only the settlement
+	// of the last market of the quote denom reconciles the vault.
+	VaultSurplus types.Coin `protobuf:"bytes,7,opt,name=vault_surplus,json=vaultSurplus,proto3" json:"vault_surplus" yaml:"vault_surplus"`
+	// Prepaid bad debt of the quote denom cleared by the reconciliation, since
+	// no position is left to realize it.
+	ClearedPrepaidBadDebt types.Coin `protobuf:"bytes,8,opt,name=cleared_prepaid_bad_debt,json=clearedPrepaidBadDebt,proto3" json:"cleared_prepaid_bad_debt" yaml:"cleared_prepaid_bad_debt"`
+}
+
+func (m *MarketSettledEvent) Reset()         { *m = MarketSettledEvent{} }
+func (m *MarketSettledEvent) String() string { return proto.CompactTextString(m) }
+func (*MarketSettledEvent) ProtoMessage()    {}
+func (*MarketSettledEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b7f9ebcf2fdb5b, []int{3}
+}
+func (m *MarketSettledEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketSettledEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketSettledEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MarketSettledEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketSettledEvent.Merge(m, src)
+}
+func (m *MarketSettledEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketSettledEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketSettledEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarketSettledEvent proto.InternalMessageInfo
+
+func (m *MarketSettledEvent) GetPair() string {
+	if m != nil {
+		return m.Pair
+	}
+	return ""
+}
+
+func (m *MarketSettledEvent) GetVaultBalance() types.Coin {
+	if m != nil {
+		return m.VaultBalance
+	}
+	return types.Coin{}
+}
+
+func (m *MarketSettledEvent) GetPrepaidBadDebt() types.Coin {
+	if m != nil {
+		return m.PrepaidBadDebt
+	}
+	return types.Coin{}
+}
+
+func (m *MarketSettledEvent) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *MarketSettledEvent) GetBlockTimeMs() int64 {
+	if m != nil {
+		return m.BlockTimeMs
+	}
+	return 0
+}
+
+func (m *MarketSettledEvent) GetVaultSurplus() types.Coin {
+	if m != nil {
+		return m.VaultSurplus
+	}
+	return types.Coin{}
+}
+
+func (m *MarketSettledEvent) GetClearedPrepaidBadDebt() types.Coin {
+	if m != nil {
+		return m.ClearedPrepaidBadDebt
+	}
+	return types.Coin{}
+}
+
 // Emitted when a new funding rate is calculated.
 type FundingRateChangedEvent struct {
 	// The pair for which the funding rate was calculated.
@@ -352,7 +489,7 @@ type FundingRateChangedEvent struct {
 	MarkPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=mark_price,json=markPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"mark_price"`
 	// The oracle index price of the pair.
 	IndexPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=index_price,json=indexPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"index_price"`
-	// The latest funding rate.
+	// The latest funding rate, after smoothing and capping.
 	LatestFundingRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=latest_funding_rate,json=latestFundingRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"latest_funding_rate"`
 	// The latest premium fraction just calculated.
 	LatestPremiumFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=latest_premium_fraction,json=latestPremiumFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"latest_premium_fraction"`
@@ -364,13 +501,20 @@ type FundingRateChangedEvent struct {
 	BlockHeight int64 `protobuf:"varint,7,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
 	// The block time in unix milliseconds at which the funding rate was calculated.
 	BlockTimeMs int64 `protobuf:"varint,8,opt,name=block_time_ms,json=blockTimeMs,proto3" json:"block_time_ms,omitempty"`
+	// The premium fraction computed from the mark and index prices, before
+	// smoothing and capping.
+	RawPremiumFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=raw_premium_fraction,json=rawPremiumFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"raw_premium_fraction"`
+	// The funding rate of the raw premium fraction.
+	RawFundingRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=raw_funding_rate,json=rawFundingRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"raw_funding_rate"`
+	// Why the latest funding rate differs from the raw funding rate, if it does.
+	ClampReason FundingRateClampReason `protobuf:"varint,11,opt,name=clamp_reason,json=clampReason,proto3,enum=nibiru.perp.v1.FundingRateClampReason" json:"clamp_reason,omitempty"`
 }
 
 func (m *FundingRateChangedEvent) Reset()         { *m = FundingRateChangedEvent{} }
 func (m *FundingRateChangedEvent) String() string { return proto.CompactTextString(m) }
 func (*FundingRateChangedEvent) ProtoMessage()    {}
 func (*FundingRateChangedEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_19b7f9ebcf2fdb5b, []int{3}
+	return fileDescriptor_19b7f9ebcf2fdb5b, []int{4}
 }
 func (m *FundingRateChangedEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -420,694 +564,5906 @@ func (m *FundingRateChangedEvent) GetBlockTimeMs() int64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*PositionChangedEvent)(nil), "nibiru.perp.v1.PositionChangedEvent")
-	proto.RegisterType((*PositionLiquidatedEvent)(nil), "nibiru.perp.v1.PositionLiquidatedEvent")
-	proto.RegisterType((*PositionSettledEvent)(nil), "nibiru.perp.v1.PositionSettledEvent")
-	proto.RegisterType((*FundingRateChangedEvent)(nil), "nibiru.perp.v1.FundingRateChangedEvent")
+func (m *FundingRateChangedEvent) GetClampReason() FundingRateClampReason {
+	if m != nil {
+		return m.ClampReason
+	}
+	return FundingRateClampReason_NOT_CLAMPED
 }
 
-func init() { proto.RegisterFile("perp/v1/event.proto", fileDescriptor_19b7f9ebcf2fdb5b) }
-
-var fileDescriptor_19b7f9ebcf2fdb5b = []byte{
-	// 973 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x97, 0xdd, 0x6e, 0xdb, 0x36,
-	0x14, 0xc7, 0xe3, 0xc6, 0x4b, 0x1c, 0xfa, 0x23, 0x0b, 0xe3, 0x34, 0x6a, 0x57, 0x38, 0x9e, 0xb0,
-	0x0d, 0xc1, 0x80, 0x4a, 0xc8, 0x76, 0xd7, 0xbb, 0x7c, 0x34, 0xc8, 0x45, 0xdb, 0xb9, 0x4a, 0x80,
-	0x01, 0x1b, 0x30, 0x8d, 0x92, 0x8e, 0x65, 0x22, 0x12, 0xa9, 0x8a, 0x94, 0x11, 0xe7, 0x09, 0x76,
-	0xb9, 0xe7, 0xd8, 0x93, 0xf4, 0x62, 0x17, 0xc5, 0xae, 0x86, 0x62, 0xc8, 0x86, 0xe4, 0x0d, 0xf6,
-	0x04, 0x83, 0x44, 0xc9, 0x1f, 0x09, 0x90, 0xb4, 0x8a, 0x77, 0x25, 0xfb, 0x1c, 0xf1, 0x77, 0x0e,
-	0x8f, 0x78, 0xfe, 0x24, 0xd1, 0x7a, 0x04, 0x71, 0x64, 0x0e, 0x77, 0x4c, 0x18, 0x02, 0x93, 0x46,
-	0x14, 0x73, 0xc9, 0x71, 0x8b, 0x51, 0x87, 0xc6, 0x89, 0x91, 0xfa, 0x8c, 0xe1, 0xce, 0xe3, 0xb6,
-	0xcf, 0x7d, 0x9e, 0xb9, 0xcc, 0xf4, 0x97, 0x7a, 0xeb, 0xf1, 0x13, 0x9f, 0x73, 0x3f, 0x00, 0x93,
-	0x44, 0xd4, 0x24, 0x8c, 0x71, 0x49, 0x24, 0xe5, 0x4c, 0xe4, 0xde, 0x8e, 0xcb, 0x45, 0xc8, 0x85,
-	0xe9, 0x10, 0x01, 0xe6, 0x70, 0xc7, 0x01, 0x49, 0x76, 0x4c, 0x97, 0x53, 0x96, 0xfb, 0xd7, 0x5d,
-	0x1e, 0x86, 0x9c, 0x99, 0xea, 0x51, 0x18, 0x8b, 0x6c, 0x84, 0x24, 0x12, 0x94, 0x51, 0x7f, 0x5f,
-	0x43, 0xed, 0x1e, 0x17, 0x34, 0xa5, 0xef, 0x0f, 0x08, 0xf3, 0xc1, 0x7b, 0x9e, 0x26, 0x8b, 0x31,
-	0xaa, 0x46, 0x84, 0xc6, 0x5a, 0xa5, 0x5b, 0xd9, 0x5e, 0xb1, 0xb2, 0xdf, 0xf8, 0x4b, 0xd4, 0x92,
-	0x31, 0xf1, 0x20, 0xb6, 0x89, 0xe7, 0xc5, 0x20, 0x84, 0xf6, 0x20, 0xf3, 0x36, 0x95, 0x75, 0x57,
-	0x19, 0xf1, 0x11, 0x5a, 0x0a, 0x49, 0xec, 0x53, 0xa6, 0x2d, 0x76, 0x2b, 0xdb, 0xf5, 0x6f, 0x1e,
-	0x19, 0x2a, 0x5d, 0x23, 0x4d, 0xd7, 0xc8, 0xd3, 0x35, 0xf6, 0x39, 0x65, 0x7b, 0x1b, 0x6f, 0x2f,
-	0xb6, 0x16, 0xfe, 0xbd, 0xd8, 0x6a, 0x8e, 0x48, 0x18, 0x3c, 0xd3, 0xd5, 0x30, 0xdd, 0xca, 0xc7,
-	0xe3, 0x1f, 0xd1, 0x5a, 0x94, 0x27, 0x67, 0x33, 0x9e, 0x3e, 0x48, 0xa0, 0x55, 0xd3, 0x98, 0x7b,
-	0x46, 0x3a, 0xf2, 0xfd, 0xc5, 0xd6, 0x57, 0x3e, 0x95, 0x83, 0xc4, 0x31, 0x5c, 0x1e, 0x9a, 0x79,
-	0x55, 0xd4, 0xe3, 0xa9, 0xf0, 0x4e, 0x4d, 0x39, 0x8a, 0x40, 0x18, 0x07, 0xe0, 0x5a, 0x9f, 0x16,
-	0xa0, 0x57, 0x39, 0x07, 0xf7, 0xd1, 0x26, 0x9c, 0xb9, 0x6a, 0xce, 0xf6, 0x38, 0x8c, 0xa0, 0xe7,
-	0xa0, 0x7d, 0x52, 0x2a, 0xc4, 0xc6, 0x18, 0x57, 0x54, 0xf4, 0x98, 0x9e, 0x03, 0x76, 0xd0, 0xaa,
-	0x8c, 0x09, 0x13, 0xc4, 0xcd, 0x02, 0xf4, 0x01, 0xb4, 0xa5, 0xbb, 0xea, 0xd2, 0xc9, 0xeb, 0xf2,
-	0x50, 0xd5, 0xe5, 0xda, 0x78, 0xdd, 0x6a, 0x4d, 0x59, 0x0e, 0x01, 0xf0, 0x31, 0x6a, 0xce, 0xce,
-	0x60, 0xb9, 0xd4, 0x0c, 0x1a, 0xd1, 0x74, 0xe2, 0xaf, 0x51, 0x23, 0x06, 0x12, 0xd0, 0xf3, 0xb4,
-	0x3e, 0x2c, 0xd0, 0x6a, 0xa5, 0x98, 0xf5, 0x82, 0xd1, 0x63, 0x01, 0xfe, 0x19, 0xb5, 0x13, 0x36,
-	0x0d, 0xb5, 0x49, 0x5f, 0x42, 0xac, 0xad, 0x94, 0x42, 0xe3, 0x09, 0xab, 0xc7, 0x82, 0xdd, 0x94,
-	0x84, 0x9f, 0xa1, 0x9a, 0x43, 0x3c, 0xdb, 0x03, 0x47, 0x6a, 0xe8, 0xae, 0x32, 0x57, 0xd3, 0x80,
-	0xd6, 0xb2, 0x43, 0xbc, 0x03, 0x70, 0x24, 0xb6, 0xd1, 0x7a, 0x40, 0xdf, 0x24, 0xd4, 0xcb, 0x9a,
-	0xcd, 0x8e, 0x80, 0x91, 0x40, 0x8e, 0xb4, 0x7a, 0xb9, 0xe4, 0xa6, 0x50, 0x3d, 0x45, 0xc2, 0x2f,
-	0x11, 0x0a, 0x49, 0x7c, 0x6a, 0x47, 0x31, 0x75, 0x41, 0x6b, 0x94, 0xe2, 0xae, 0xa4, 0x84, 0x5e,
-	0x0a, 0xc0, 0xdf, 0xa3, 0xd5, 0x7e, 0xc2, 0x3c, 0xca, 0x7c, 0x3b, 0x22, 0xa3, 0x10, 0x98, 0xd4,
-	0x9a, 0xa5, 0x98, 0xad, 0x1c, 0xd3, 0x53, 0x14, 0xfc, 0x39, 0x6a, 0x38, 0x01, 0x77, 0x4f, 0xed,
-	0x01, 0x50, 0x7f, 0x20, 0xb5, 0x56, 0xb7, 0xb2, 0xbd, 0x68, 0xd5, 0x33, 0xdb, 0x51, 0x66, 0xc2,
-	0x3a, 0x6a, 0xaa, 0x57, 0x24, 0x0d, 0xc1, 0x0e, 0x85, 0xb6, 0x3a, 0xf5, 0xce, 0x09, 0x0d, 0xe1,
-	0xa5, 0xd0, 0xff, 0xa8, 0xa1, 0xcd, 0xa2, 0x15, 0x5e, 0xe4, 0xd5, 0x98, 0x83, 0xbe, 0x78, 0xe8,
-	0xe1, 0xa4, 0x71, 0xdf, 0x24, 0x5c, 0x82, 0x4d, 0x42, 0x9e, 0x30, 0x99, 0xe9, 0xcd, 0xc7, 0xcf,
-	0xbe, 0x3d, 0xa6, 0xbd, 0x4e, 0x61, 0xbb, 0x19, 0xeb, 0x36, 0x79, 0xa8, 0xce, 0x53, 0x1e, 0x9e,
-	0xa2, 0xf1, 0x4a, 0xe1, 0x93, 0x89, 0x67, 0x0a, 0x64, 0xad, 0x4d, 0x3c, 0xc5, 0xe4, 0x7d, 0xb4,
-	0xd6, 0x07, 0xb0, 0x25, 0xb7, 0x27, 0xbe, 0xbb, 0xf5, 0xa4, 0x9b, 0xeb, 0x89, 0xa6, 0xf4, 0xe4,
-	0x06, 0x41, 0xb7, 0x56, 0xfb, 0x00, 0x27, 0xfc, 0xc5, 0xd8, 0x82, 0x63, 0xb4, 0x91, 0xbf, 0x06,
-	0x2e, 0x17, 0x23, 0x21, 0x21, 0xb4, 0xd3, 0x65, 0x92, 0x49, 0xcb, 0xad, 0xc1, 0xbe, 0xc8, 0x83,
-	0x3d, 0x99, 0x09, 0x36, 0x4b, 0xd1, 0x2d, 0x9c, 0x05, 0x7c, 0x5e, 0x58, 0x0f, 0x13, 0xe6, 0xcd,
-	0x34, 0x6f, 0xed, 0x23, 0x9b, 0x77, 0xb2, 0xeb, 0xac, 0xfc, 0x1f, 0xbb, 0x0e, 0x9a, 0xd3, 0xae,
-	0x73, 0x43, 0xa9, 0xeb, 0x73, 0x50, 0xea, 0x13, 0xd4, 0x9c, 0x91, 0xc2, 0x92, 0xd2, 0x32, 0x0b,
-	0xb9, 0xa6, 0x56, 0xcd, 0xfb, 0xaa, 0xd5, 0x9c, 0x44, 0xe5, 0xaf, 0xca, 0xe4, 0xc4, 0x72, 0x0c,
-	0x52, 0x06, 0x73, 0x50, 0x94, 0x5f, 0x2a, 0xa8, 0x29, 0x14, 0xcb, 0x4e, 0x8f, 0x51, 0x42, 0x5b,
-	0xec, 0x2e, 0xde, 0xbe, 0x86, 0x8e, 0xf2, 0x35, 0xd4, 0x56, 0x6b, 0x68, 0x66, 0xb4, 0xfe, 0xdb,
-	0xdf, 0x5b, 0xdb, 0x1f, 0x50, 0xa0, 0x14, 0x24, 0xac, 0x46, 0x3e, 0x36, 0xfb, 0xa7, 0xff, 0x5e,
-	0x45, 0x9b, 0x87, 0x4a, 0x8d, 0x2d, 0x22, 0xe1, 0xce, 0x33, 0xd9, 0xec, 0x47, 0x7a, 0x70, 0xdf,
-	0x8f, 0xf4, 0x1d, 0xaa, 0x53, 0xe6, 0xc1, 0x59, 0xce, 0x2b, 0x27, 0xa8, 0x28, 0x43, 0x28, 0xe0,
-	0x4f, 0x68, 0x3d, 0x20, 0x12, 0x84, 0xb4, 0x8b, 0xad, 0x2a, 0x26, 0xb2, 0xac, 0x84, 0xae, 0x29,
-	0xd4, 0x54, 0x7d, 0x52, 0x99, 0xce, 0xf9, 0x51, 0x0c, 0x21, 0x4d, 0x42, 0xbb, 0x1f, 0xab, 0x73,
-	0x51, 0xd9, 0x53, 0x9c, 0xc2, 0xf5, 0x14, 0xed, 0x30, 0x87, 0x61, 0x86, 0x3e, 0x73, 0x93, 0x30,
-	0x09, 0x88, 0xa4, 0x43, 0xb8, 0x19, 0x6b, 0xa9, 0x54, 0xac, 0x47, 0x13, 0xe4, 0xf5, 0x78, 0xd7,
-	0xbb, 0x65, 0xf9, 0x03, 0xba, 0xa5, 0x76, 0xa3, 0x5b, 0xf6, 0x0e, 0xde, 0x5e, 0x76, 0x2a, 0xef,
-	0x2e, 0x3b, 0x95, 0x7f, 0x2e, 0x3b, 0x95, 0x5f, 0xaf, 0x3a, 0x0b, 0xef, 0xae, 0x3a, 0x0b, 0x7f,
-	0x5e, 0x75, 0x16, 0x7e, 0xf8, 0x7a, 0x2a, 0xc7, 0x57, 0xd9, 0x95, 0x64, 0x7f, 0x40, 0x28, 0x33,
-	0xd5, 0xf5, 0xc4, 0x3c, 0x33, 0xb3, 0xeb, 0x42, 0x96, 0xab, 0xb3, 0x94, 0x5d, 0x16, 0xbe, 0xfd,
-	0x2f, 0x00, 0x00, 0xff, 0xff, 0xfe, 0x43, 0xae, 0x8e, 0xd1, 0x0c, 0x00, 0x00,
+// Emitted when a conditional order is placed.
+type OrderPlacedEvent struct {
+	Order Order `protobuf:"bytes,1,opt,name=order,proto3" json:"order"`
+	// The block number at which the order was placed.
+	BlockHeight int64 `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// The block time in unix milliseconds at which the order was placed.
+	BlockTimeMs int64 `protobuf:"varint,3,opt,name=block_time_ms,json=blockTimeMs,proto3" json:"block_time_ms,omitempty"`
 }
 
-func (m *PositionChangedEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *OrderPlacedEvent) Reset()         { *m = OrderPlacedEvent{} }
+func (m *OrderPlacedEvent) String() string { return proto.CompactTextString(m) }
+func (*OrderPlacedEvent) ProtoMessage()    {}
+func (*OrderPlacedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b7f9ebcf2fdb5b, []int{5}
+}
+func (m *OrderPlacedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrderPlacedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrderPlacedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *OrderPlacedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderPlacedEvent.Merge(m, src)
+}
+func (m *OrderPlacedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrderPlacedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderPlacedEvent.DiscardUnknown(m)
 }
 
-func (m *PositionChangedEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_OrderPlacedEvent proto.InternalMessageInfo
+
+func (m *OrderPlacedEvent) GetOrder() Order {
+	if m != nil {
+		return m.Order
+	}
+	return Order{}
 }
 
-func (m *PositionChangedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BlockTimeMs != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.BlockTimeMs))
-		i--
-		dAtA[i] = 0x78
+func (m *OrderPlacedEvent) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x70
+	return 0
+}
+
+func (m *OrderPlacedEvent) GetBlockTimeMs() int64 {
+	if m != nil {
+		return m.BlockTimeMs
 	}
-	{
-		size := m.FundingPayment.Size()
-		i -= size
-		if _, err := m.FundingPayment.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return 0
+}
+
+// Emitted when a conditional order is removed from the order book without
+// being executed.
+type OrderCancelledEvent struct {
+	Order Order `protobuf:"bytes,1,opt,name=order,proto3" json:"order"`
+	// Why the order was cancelled, e.g. cancelled by the trader, expired or
+	// failed to execute.
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// The block number at which the order was cancelled.
+	BlockHeight int64 `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// The block time in unix milliseconds at which the order was cancelled.
+	BlockTimeMs int64 `protobuf:"varint,4,opt,name=block_time_ms,json=blockTimeMs,proto3" json:"block_time_ms,omitempty"`
+}
+
+func (m *OrderCancelledEvent) Reset()         { *m = OrderCancelledEvent{} }
+func (m *OrderCancelledEvent) String() string { return proto.CompactTextString(m) }
+func (*OrderCancelledEvent) ProtoMessage()    {}
+func (*OrderCancelledEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b7f9ebcf2fdb5b, []int{6}
+}
+func (m *OrderCancelledEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrderCancelledEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrderCancelledEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x6a
-	{
-		size := m.MarkPrice.Size()
-		i -= size
-		if _, err := m.MarkPrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+}
+func (m *OrderCancelledEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderCancelledEvent.Merge(m, src)
+}
+func (m *OrderCancelledEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrderCancelledEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderCancelledEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderCancelledEvent proto.InternalMessageInfo
+
+func (m *OrderCancelledEvent) GetOrder() Order {
+	if m != nil {
+		return m.Order
 	}
-	i--
-	dAtA[i] = 0x62
-	{
-		size := m.LiquidationPenalty.Size()
-		i -= size
-		if _, err := m.LiquidationPenalty.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+	return Order{}
+}
+
+func (m *OrderCancelledEvent) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	i--
-	dAtA[i] = 0x5a
-	{
-		size, err := m.BadDebt.MarshalToSizedBuffer(dAtA[:i])
+	return ""
+}
+
+func (m *OrderCancelledEvent) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *OrderCancelledEvent) GetBlockTimeMs() int64 {
+	if m != nil {
+		return m.BlockTimeMs
+	}
+	return 0
+}
+
+// Emitted when a conditional order is triggered and executed.
+type OrderExecutedEvent struct {
+	Order Order `protobuf:"bytes,1,opt,name=order,proto3" json:"order"`
+	// The mark price of the pair when the order was executed.
+	MarkPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=mark_price,json=markPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"mark_price"`
+	// The index price of the pair when the order was executed. Zero if the
+	// oracle had no price for the pair.
+	IndexPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=index_price,json=indexPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"index_price"`
+	// The block number at which the order was executed.
+	BlockHeight int64 `protobuf:"varint,4,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// The block time in unix milliseconds at which the order was executed.
+	BlockTimeMs int64 `protobuf:"varint,5,opt,name=block_time_ms,json=blockTimeMs,proto3" json:"block_time_ms,omitempty"`
+}
+
+func (m *OrderExecutedEvent) Reset()         { *m = OrderExecutedEvent{} }
+func (m *OrderExecutedEvent) String() string { return proto.CompactTextString(m) }
+func (*OrderExecutedEvent) ProtoMessage()    {}
+func (*OrderExecutedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b7f9ebcf2fdb5b, []int{7}
+}
+func (m *OrderExecutedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrderExecutedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrderExecutedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x52
-	{
-		size := m.UnrealizedPnlAfter.Size()
-		i -= size
-		if _, err := m.UnrealizedPnlAfter.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+}
+func (m *OrderExecutedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderExecutedEvent.Merge(m, src)
+}
+func (m *OrderExecutedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrderExecutedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderExecutedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderExecutedEvent proto.InternalMessageInfo
+
+func (m *OrderExecutedEvent) GetOrder() Order {
+	if m != nil {
+		return m.Order
 	}
-	i--
-	dAtA[i] = 0x4a
-	{
-		size := m.RealizedPnl.Size()
-		i -= size
-		if _, err := m.RealizedPnl.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+	return Order{}
+}
+
+func (m *OrderExecutedEvent) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	i--
-	dAtA[i] = 0x42
-	{
-		size := m.PositionSize.Size()
-		i -= size
-		if _, err := m.PositionSize.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+	return 0
+}
+
+func (m *OrderExecutedEvent) GetBlockTimeMs() int64 {
+	if m != nil {
+		return m.BlockTimeMs
 	}
-	i--
-	dAtA[i] = 0x3a
-	{
-		size, err := m.TransactionFee.MarshalToSizedBuffer(dAtA[:i])
+	return 0
+}
+
+// Emitted when the collateral of a cross margin account changes, either through
+// deposits and withdrawals or when margin moves between the account and its
+// positions.
+type CrossMarginCollateralChangedEvent struct {
+	// Owner of the cross margin account.
+	TraderAddress string `protobuf:"bytes,1,opt,name=trader_address,json=traderAddress,proto3" json:"trader_address,omitempty"`
+	// The collateral of the account after the change.
+	Collateral github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=collateral,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"collateral"`
+	// The amount added to the collateral, negative when collateral is removed.
+	CollateralChange github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=collateral_change,json=collateralChange,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"collateral_change"`
+	// The denom of the collateral change.
+	Denom string `protobuf:"bytes,4,opt,name=denom,proto3" json:"denom,omitempty"`
+	// The block number at which the collateral changed.
+	BlockHeight int64 `protobuf:"varint,5,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// The block time in unix milliseconds at which the collateral changed.
+	BlockTimeMs int64 `protobuf:"varint,6,opt,name=block_time_ms,json=blockTimeMs,proto3" json:"block_time_ms,omitempty"`
+}
+
+func (m *CrossMarginCollateralChangedEvent) Reset()         { *m = CrossMarginCollateralChangedEvent{} }
+func (m *CrossMarginCollateralChangedEvent) String() string { return proto.CompactTextString(m) }
+func (*CrossMarginCollateralChangedEvent) ProtoMessage()    {}
+func (*CrossMarginCollateralChangedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b7f9ebcf2fdb5b, []int{8}
+}
+func (m *CrossMarginCollateralChangedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CrossMarginCollateralChangedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CrossMarginCollateralChangedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintEvent(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size := m.ExchangedPositionSize.Size()
-		i -= size
-		if _, err := m.ExchangedPositionSize.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+			return nil, err
 		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.PositionNotional.Size()
-		i -= size
-		if _, err := m.PositionNotional.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+}
+func (m *CrossMarginCollateralChangedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CrossMarginCollateralChangedEvent.Merge(m, src)
+}
+func (m *CrossMarginCollateralChangedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *CrossMarginCollateralChangedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_CrossMarginCollateralChangedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CrossMarginCollateralChangedEvent proto.InternalMessageInfo
+
+func (m *CrossMarginCollateralChangedEvent) GetTraderAddress() string {
+	if m != nil {
+		return m.TraderAddress
 	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size, err := m.Margin.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+	return ""
+}
+
+func (m *CrossMarginCollateralChangedEvent) GetCollateral() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Collateral
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.TraderAddress) > 0 {
-		i -= len(m.TraderAddress)
-		copy(dAtA[i:], m.TraderAddress)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.TraderAddress)))
-		i--
-		dAtA[i] = 0x12
+	return nil
+}
+
+func (m *CrossMarginCollateralChangedEvent) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	if len(m.Pair) > 0 {
-		i -= len(m.Pair)
-		copy(dAtA[i:], m.Pair)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Pair)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *CrossMarginCollateralChangedEvent) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *PositionLiquidatedEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CrossMarginCollateralChangedEvent) GetBlockTimeMs() int64 {
+	if m != nil {
+		return m.BlockTimeMs
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *PositionLiquidatedEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Emitted for each step of the bad debt payout waterfall.
+type BadDebtCoveredEvent struct {
+	// The id of the shortfall being covered.
+	ShortfallId uint64       `protobuf:"varint,1,opt,name=shortfall_id,json=shortfallId,proto3" json:"shortfall_id,omitempty"`
+	Payer       BadDebtPayer `protobuf:"varint,2,opt,name=payer,proto3,enum=nibiru.perp.v1.BadDebtPayer" json:"payer,omitempty"`
+	// The amount covered by the payer. For SOCIALIZED_LOSS, the amount taken
+	// from the margin of the open positions.
+	Amount types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+	// The bad debt still uncovered after this step.
+	RemainingBadDebt github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=remaining_bad_debt,json=remainingBadDebt,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"remaining_bad_debt"`
+	// The block number at which the bad debt was covered.
+	BlockHeight int64 `protobuf:"varint,5,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// The block time in unix milliseconds at which the bad debt was covered.
+	BlockTimeMs int64 `protobuf:"varint,6,opt,name=block_time_ms,json=blockTimeMs,proto3" json:"block_time_ms,omitempty"`
 }
 
-func (m *PositionLiquidatedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BlockTimeMs != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.BlockTimeMs))
-		i--
-		dAtA[i] = 0x78
+func (m *BadDebtCoveredEvent) Reset()         { *m = BadDebtCoveredEvent{} }
+func (m *BadDebtCoveredEvent) String() string { return proto.CompactTextString(m) }
+func (*BadDebtCoveredEvent) ProtoMessage()    {}
+func (*BadDebtCoveredEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b7f9ebcf2fdb5b, []int{9}
+}
+func (m *BadDebtCoveredEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BadDebtCoveredEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BadDebtCoveredEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x70
+}
+func (m *BadDebtCoveredEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BadDebtCoveredEvent.Merge(m, src)
+}
+func (m *BadDebtCoveredEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *BadDebtCoveredEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_BadDebtCoveredEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BadDebtCoveredEvent proto.InternalMessageInfo
+
+func (m *BadDebtCoveredEvent) GetShortfallId() uint64 {
+	if m != nil {
+		return m.ShortfallId
 	}
-	{
-		size := m.MarkPrice.Size()
-		i -= size
-		if _, err := m.MarkPrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+	return 0
+}
+
+func (m *BadDebtCoveredEvent) GetPayer() BadDebtPayer {
+	if m != nil {
+		return m.Payer
 	}
-	i--
-	dAtA[i] = 0x6a
-	{
-		size := m.UnrealizedPnl.Size()
-		i -= size
-		if _, err := m.UnrealizedPnl.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+	return BadDebtPayer_BAD_DEBT_PAYER_UNSPECIFIED
+}
+
+func (m *BadDebtCoveredEvent) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	i--
-	dAtA[i] = 0x62
-	{
-		size := m.PositionSize.Size()
-		i -= size
-		if _, err := m.PositionSize.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+	return types.Coin{}
+}
+
+func (m *BadDebtCoveredEvent) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	i--
-	dAtA[i] = 0x5a
-	{
-		size := m.PositionNotional.Size()
-		i -= size
-		if _, err := m.PositionNotional.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+	return 0
+}
+
+func (m *BadDebtCoveredEvent) GetBlockTimeMs() int64 {
+	if m != nil {
+		return m.BlockTimeMs
 	}
-	i--
-	dAtA[i] = 0x52
-	{
-		size, err := m.Margin.MarshalToSizedBuffer(dAtA[:i])
+	return 0
+}
+
+// Emitted when a position is reduced by auto-deleveraging to cover the bad
+// debt of a liquidated position on the opposite side.
+type PositionAutoDeleveragedEvent struct {
+	// identifier of the corresponding virtual pool for the position
+	Pair string `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	// owner of the position.
+	TraderAddress string `protobuf:"bytes,2,opt,name=trader_address,json=traderAddress,proto3" json:"trader_address,omitempty"`
+	// The id of the shortfall being covered.
+	ShortfallId uint64 `protobuf:"varint,3,opt,name=shortfall_id,json=shortfallId,proto3" json:"shortfall_id,omitempty"`
+	// The bankruptcy price of the liquidated position, at which the position
+	// was reduced.
+	BankruptcyPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=bankruptcy_price,json=bankruptcyPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"bankruptcy_price"`
+	// The size by which the position was reduced, signed like a trade.
+	ExchangedPositionSize github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=exchanged_position_size,json=exchangedPositionSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchanged_position_size"`
+	// The notional value the reduction traded for in the vpool.
+	ExchangedQuoteAmount github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=exchanged_quote_amount,json=exchangedQuoteAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchanged_quote_amount"`
+	// The PnL realized by the trader at the bankruptcy price.
+	RealizedPnl github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=realized_pnl,json=realizedPnl,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"realized_pnl"`
+	// The profit withheld from the trader to cover the bad debt.
+	CoveredBadDebt types.Coin `protobuf:"bytes,8,opt,name=covered_bad_debt,json=coveredBadDebt,proto3" json:"covered_bad_debt"`
+	// The remaining margin of the position.
+	Margin types.Coin `protobuf:"bytes,9,opt,name=margin,proto3" json:"margin"`
+	// The remaining size of the position.
+	PositionSize github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=position_size,json=positionSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"position_size"`
+	// The block number at which the position was deleveraged.
+	BlockHeight int64 `protobuf:"varint,11,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// The block time in unix milliseconds at which the position was deleveraged.
+	BlockTimeMs int64 `protobuf:"varint,12,opt,name=block_time_ms,json=blockTimeMs,proto3" json:"block_time_ms,omitempty"`
+}
+
+func (m *PositionAutoDeleveragedEvent) Reset()         { *m = PositionAutoDeleveragedEvent{} }
+func (m *PositionAutoDeleveragedEvent) String() string { return proto.CompactTextString(m) }
+func (*PositionAutoDeleveragedEvent) ProtoMessage()    {}
+func (*PositionAutoDeleveragedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b7f9ebcf2fdb5b, []int{10}
+}
+func (m *PositionAutoDeleveragedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PositionAutoDeleveragedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PositionAutoDeleveragedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x4a
-	{
-		size, err := m.BadDebt.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+}
+func (m *PositionAutoDeleveragedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PositionAutoDeleveragedEvent.Merge(m, src)
+}
+func (m *PositionAutoDeleveragedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *PositionAutoDeleveragedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_PositionAutoDeleveragedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PositionAutoDeleveragedEvent proto.InternalMessageInfo
+
+func (m *PositionAutoDeleveragedEvent) GetPair() string {
+	if m != nil {
+		return m.Pair
 	}
-	i--
-	dAtA[i] = 0x42
-	{
-		size, err := m.FeeToEcosystemFund.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+	return ""
+}
+
+func (m *PositionAutoDeleveragedEvent) GetTraderAddress() string {
+	if m != nil {
+		return m.TraderAddress
 	}
-	i--
-	dAtA[i] = 0x3a
-	{
-		size, err := m.FeeToLiquidator.MarshalToSizedBuffer(dAtA[:i])
+	return ""
+}
+
+func (m *PositionAutoDeleveragedEvent) GetShortfallId() uint64 {
+	if m != nil {
+		return m.ShortfallId
+	}
+	return 0
+}
+
+func (m *PositionAutoDeleveragedEvent) GetCoveredBadDebt() types.Coin {
+	if m != nil {
+		return m.CoveredBadDebt
+	}
+	return types.Coin{}
+}
+
+func (m *PositionAutoDeleveragedEvent) GetMargin() types.Coin {
+	if m != nil {
+		return m.Margin
+	}
+	return types.Coin{}
+}
+
+func (m *PositionAutoDeleveragedEvent) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *PositionAutoDeleveragedEvent) GetBlockTimeMs() int64 {
+	if m != nil {
+		return m.BlockTimeMs
+	}
+	return 0
+}
+
+// Emitted when a fully liquidated position is put up for a liquidation auction.
+type LiquidationAuctionStartedEvent struct {
+	// identifier of the corresponding virtual pool for the position
+	Pair string `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	// owner of the position.
+	TraderAddress string `protobuf:"bytes,2,opt,name=trader_address,json=traderAddress,proto3" json:"trader_address,omitempty"`
+	// Address of the liquidator who started the auction.
+	LiquidatorAddress string `protobuf:"bytes,3,opt,name=liquidator_address,json=liquidatorAddress,proto3" json:"liquidator_address,omitempty"`
+	// The size of the auctioned position.
+	PositionSize github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=position_size,json=positionSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"position_size"`
+	// The index price of the pair when the auction started.
+	IndexPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=index_price,json=indexPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"index_price"`
+	// The worst price a bid can take the position over at.
+	LimitPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=limit_price,json=limitPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"limit_price"`
+	// The time in unix milliseconds at which the auction ends.
+	EndTimeMs int64 `protobuf:"varint,7,opt,name=end_time_ms,json=endTimeMs,proto3" json:"end_time_ms,omitempty"`
+	// The block number at which the auction started.
+	BlockHeight int64 `protobuf:"varint,8,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// The block time in unix milliseconds at which the auction started.
+	BlockTimeMs int64 `protobuf:"varint,9,opt,name=block_time_ms,json=blockTimeMs,proto3" json:"block_time_ms,omitempty"`
+}
+
+func (m *LiquidationAuctionStartedEvent) Reset()         { *m = LiquidationAuctionStartedEvent{} }
+func (m *LiquidationAuctionStartedEvent) String() string { return proto.CompactTextString(m) }
+func (*LiquidationAuctionStartedEvent) ProtoMessage()    {}
+func (*LiquidationAuctionStartedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b7f9ebcf2fdb5b, []int{11}
+}
+func (m *LiquidationAuctionStartedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LiquidationAuctionStartedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LiquidationAuctionStartedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x32
-	if len(m.LiquidatorAddress) > 0 {
-		i -= len(m.LiquidatorAddress)
-		copy(dAtA[i:], m.LiquidatorAddress)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.LiquidatorAddress)))
-		i--
-		dAtA[i] = 0x2a
+}
+func (m *LiquidationAuctionStartedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidationAuctionStartedEvent.Merge(m, src)
+}
+func (m *LiquidationAuctionStartedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *LiquidationAuctionStartedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidationAuctionStartedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiquidationAuctionStartedEvent proto.InternalMessageInfo
+
+func (m *LiquidationAuctionStartedEvent) GetPair() string {
+	if m != nil {
+		return m.Pair
 	}
-	{
-		size := m.ExchangedPositionSize.Size()
-		i -= size
-		if _, err := m.ExchangedPositionSize.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+	return ""
+}
+
+func (m *LiquidationAuctionStartedEvent) GetTraderAddress() string {
+	if m != nil {
+		return m.TraderAddress
 	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.ExchangedQuoteAmount.Size()
-		i -= size
-		if _, err := m.ExchangedQuoteAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+	return ""
+}
+
+func (m *LiquidationAuctionStartedEvent) GetLiquidatorAddress() string {
+	if m != nil {
+		return m.LiquidatorAddress
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.TraderAddress) > 0 {
-		i -= len(m.TraderAddress)
-		copy(dAtA[i:], m.TraderAddress)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.TraderAddress)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *LiquidationAuctionStartedEvent) GetEndTimeMs() int64 {
+	if m != nil {
+		return m.EndTimeMs
 	}
-	if len(m.Pair) > 0 {
-		i -= len(m.Pair)
-		copy(dAtA[i:], m.Pair)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Pair)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *LiquidationAuctionStartedEvent) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *PositionSettledEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *LiquidationAuctionStartedEvent) GetBlockTimeMs() int64 {
+	if m != nil {
+		return m.BlockTimeMs
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *PositionSettledEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Emitted when a liquidator places the best bid of a liquidation auction.
+type LiquidationAuctionBidEvent struct {
+	// identifier of the corresponding virtual pool for the position
+	Pair string `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	// owner of the auctioned position.
+	TraderAddress string `protobuf:"bytes,2,opt,name=trader_address,json=traderAddress,proto3" json:"trader_address,omitempty"`
+	// Address of the liquidator who placed the bid.
+	Bidder string `protobuf:"bytes,3,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	// The price the bidder takes the position over at.
+	Price github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	// The margin escrowed by the bidder.
+	Margin types.Coin `protobuf:"bytes,5,opt,name=margin,proto3" json:"margin"`
+	// The block number at which the bid was placed.
+	BlockHeight int64 `protobuf:"varint,6,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// The block time in unix milliseconds at which the bid was placed.
+	BlockTimeMs int64 `protobuf:"varint,7,opt,name=block_time_ms,json=blockTimeMs,proto3" json:"block_time_ms,omitempty"`
 }
 
-func (m *PositionSettledEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SettledCoins) > 0 {
-		for iNdEx := len(m.SettledCoins) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SettledCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvent(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *LiquidationAuctionBidEvent) Reset()         { *m = LiquidationAuctionBidEvent{} }
+func (m *LiquidationAuctionBidEvent) String() string { return proto.CompactTextString(m) }
+func (*LiquidationAuctionBidEvent) ProtoMessage()    {}
+func (*LiquidationAuctionBidEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b7f9ebcf2fdb5b, []int{12}
+}
+func (m *LiquidationAuctionBidEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LiquidationAuctionBidEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LiquidationAuctionBidEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.TraderAddress) > 0 {
-		i -= len(m.TraderAddress)
-		copy(dAtA[i:], m.TraderAddress)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.TraderAddress)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *LiquidationAuctionBidEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidationAuctionBidEvent.Merge(m, src)
+}
+func (m *LiquidationAuctionBidEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *LiquidationAuctionBidEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidationAuctionBidEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiquidationAuctionBidEvent proto.InternalMessageInfo
+
+func (m *LiquidationAuctionBidEvent) GetPair() string {
+	if m != nil {
+		return m.Pair
 	}
-	if len(m.Pair) > 0 {
-		i -= len(m.Pair)
-		copy(dAtA[i:], m.Pair)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Pair)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *LiquidationAuctionBidEvent) GetTraderAddress() string {
+	if m != nil {
+		return m.TraderAddress
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *FundingRateChangedEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *LiquidationAuctionBidEvent) GetBidder() string {
+	if m != nil {
+		return m.Bidder
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *FundingRateChangedEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *LiquidationAuctionBidEvent) GetMargin() types.Coin {
+	if m != nil {
+		return m.Margin
+	}
+	return types.Coin{}
 }
 
-func (m *FundingRateChangedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BlockTimeMs != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.BlockTimeMs))
-		i--
-		dAtA[i] = 0x40
+func (m *LiquidationAuctionBidEvent) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x38
+	return 0
+}
+
+func (m *LiquidationAuctionBidEvent) GetBlockTimeMs() int64 {
+	if m != nil {
+		return m.BlockTimeMs
 	}
-	{
-		size := m.CumulativePremiumFraction.Size()
-		i -= size
-		if _, err := m.CumulativePremiumFraction.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return 0
+}
+
+// Emitted when a liquidation auction ends.
+type LiquidationAuctionEndedEvent struct {
+	// identifier of the corresponding virtual pool for the position
+	Pair string `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	// owner of the auctioned position.
+	TraderAddress string                    `protobuf:"bytes,2,opt,name=trader_address,json=traderAddress,proto3" json:"trader_address,omitempty"`
+	Outcome       LiquidationAuctionOutcome `protobuf:"varint,3,opt,name=outcome,proto3,enum=nibiru.perp.v1.LiquidationAuctionOutcome" json:"outcome,omitempty"`
+	// Address of the winning bidder, only set when the position was sold.
+	Winner string `protobuf:"bytes,4,opt,name=winner,proto3" json:"winner,omitempty"`
+	// The price the position was sold at, zero unless it was sold.
+	Price github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	// The block number at which the auction ended.
+	BlockHeight int64 `protobuf:"varint,6,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// The block time in unix milliseconds at which the auction ended.
+	BlockTimeMs int64 `protobuf:"varint,7,opt,name=block_time_ms,json=blockTimeMs,proto3" json:"block_time_ms,omitempty"`
+}
+
+func (m *LiquidationAuctionEndedEvent) Reset()         { *m = LiquidationAuctionEndedEvent{} }
+func (m *LiquidationAuctionEndedEvent) String() string { return proto.CompactTextString(m) }
+func (*LiquidationAuctionEndedEvent) ProtoMessage()    {}
+func (*LiquidationAuctionEndedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b7f9ebcf2fdb5b, []int{13}
+}
+func (m *LiquidationAuctionEndedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LiquidationAuctionEndedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LiquidationAuctionEndedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size := m.LatestPremiumFraction.Size()
-		i -= size
-		if _, err := m.LatestPremiumFraction.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+}
+func (m *LiquidationAuctionEndedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidationAuctionEndedEvent.Merge(m, src)
+}
+func (m *LiquidationAuctionEndedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *LiquidationAuctionEndedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidationAuctionEndedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiquidationAuctionEndedEvent proto.InternalMessageInfo
+
+func (m *LiquidationAuctionEndedEvent) GetPair() string {
+	if m != nil {
+		return m.Pair
+	}
+	return ""
+}
+
+func (m *LiquidationAuctionEndedEvent) GetTraderAddress() string {
+	if m != nil {
+		return m.TraderAddress
+	}
+	return ""
+}
+
+func (m *LiquidationAuctionEndedEvent) GetOutcome() LiquidationAuctionOutcome {
+	if m != nil {
+		return m.Outcome
+	}
+	return LiquidationAuctionOutcome_LIQUIDATION_AUCTION_OUTCOME_UNSPECIFIED
+}
+
+func (m *LiquidationAuctionEndedEvent) GetWinner() string {
+	if m != nil {
+		return m.Winner
+	}
+	return ""
+}
+
+func (m *LiquidationAuctionEndedEvent) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *LiquidationAuctionEndedEvent) GetBlockTimeMs() int64 {
+	if m != nil {
+		return m.BlockTimeMs
+	}
+	return 0
+}
+
+// Emitted when governance repegs a vpool, with what the repeg cost the
+// ecosystem fund.
+type PoolRepeggedEvent struct {
+	// identifier of the corresponding virtual pool
+	Pair string `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	// The net size of the positions of the pair, the longs minus the shorts,
+	// in base asset units.
+	NetSize github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=net_size,json=netSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"net_size"`
+	// How much the repeg changed the value of the net position. When
+	// positive, the ecosystem fund paid it to the vault, when negative, the
+	// vault paid it to the ecosystem fund.
+	Cost github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=cost,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"cost"`
+	// The mark price of the vpool before the repeg.
+	OldMarkPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=old_mark_price,json=oldMarkPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"old_mark_price"`
+	// The mark price of the vpool after the repeg.
+	NewMarkPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=new_mark_price,json=newMarkPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"new_mark_price"`
+	// The block number at which the pool was repegged.
+	BlockHeight int64 `protobuf:"varint,6,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// The block time in unix milliseconds at which the pool was repegged.
+	BlockTimeMs int64 `protobuf:"varint,7,opt,name=block_time_ms,json=blockTimeMs,proto3" json:"block_time_ms,omitempty"`
+}
+
+func (m *PoolRepeggedEvent) Reset()         { *m = PoolRepeggedEvent{} }
+func (m *PoolRepeggedEvent) String() string { return proto.CompactTextString(m) }
+func (*PoolRepeggedEvent) ProtoMessage()    {}
+func (*PoolRepeggedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b7f9ebcf2fdb5b, []int{14}
+}
+func (m *PoolRepeggedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolRepeggedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolRepeggedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+		return b[:n], nil
+	}
+}
+func (m *PoolRepeggedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolRepeggedEvent.Merge(m, src)
+}
+func (m *PoolRepeggedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolRepeggedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolRepeggedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolRepeggedEvent proto.InternalMessageInfo
+
+func (m *PoolRepeggedEvent) GetPair() string {
+	if m != nil {
+		return m.Pair
+	}
+	return ""
+}
+
+func (m *PoolRepeggedEvent) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *PoolRepeggedEvent) GetBlockTimeMs() int64 {
+	if m != nil {
+		return m.BlockTimeMs
+	}
+	return 0
+}
+
+// Emitted when the liquidity depth policy of a pair scales the depth of its
+// vpool, with what the adjustment cost the ecosystem fund.
+type PoolDepthAdjustedEvent struct {
+	// identifier of the corresponding virtual pool
+	Pair string `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	// The factor both reserves were multiplied by.
+	Multiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=multiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"multiplier"`
+	// The net size of the positions of the pair, the longs minus the shorts,
+	// in base asset units.
+	NetSize github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=net_size,json=netSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"net_size"`
+	// How much the adjustment changed the value of the net position. When
+	// positive, the ecosystem fund paid it to the vault, when negative, the
+	// vault paid it to the ecosystem fund.
+	Cost github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=cost,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"cost"`
+	// The quote reserve of the vpool before the adjustment.
+	OldQuoteReserve github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=old_quote_reserve,json=oldQuoteReserve,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"old_quote_reserve"`
+	// The quote reserve of the vpool after the adjustment.
+	NewQuoteReserve github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=new_quote_reserve,json=newQuoteReserve,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"new_quote_reserve"`
+	// The target quote reserve of the liquidity depth policy.
+	TargetQuoteReserve github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=target_quote_reserve,json=targetQuoteReserve,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"target_quote_reserve"`
+	// The block number at which the depth was adjusted.
+	BlockHeight int64 `protobuf:"varint,8,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// The block time in unix milliseconds at which the depth was adjusted.
+	BlockTimeMs int64 `protobuf:"varint,9,opt,name=block_time_ms,json=blockTimeMs,proto3" json:"block_time_ms,omitempty"`
+}
+
+func (m *PoolDepthAdjustedEvent) Reset()         { *m = PoolDepthAdjustedEvent{} }
+func (m *PoolDepthAdjustedEvent) String() string { return proto.CompactTextString(m) }
+func (*PoolDepthAdjustedEvent) ProtoMessage()    {}
+func (*PoolDepthAdjustedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b7f9ebcf2fdb5b, []int{15}
+}
+func (m *PoolDepthAdjustedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolDepthAdjustedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolDepthAdjustedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolDepthAdjustedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolDepthAdjustedEvent.Merge(m, src)
+}
+func (m *PoolDepthAdjustedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolDepthAdjustedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolDepthAdjustedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolDepthAdjustedEvent proto.InternalMessageInfo
+
+func (m *PoolDepthAdjustedEvent) GetPair() string {
+	if m != nil {
+		return m.Pair
+	}
+	return ""
+}
+
+func (m *PoolDepthAdjustedEvent) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *PoolDepthAdjustedEvent) GetBlockTimeMs() int64 {
+	if m != nil {
+		return m.BlockTimeMs
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*PositionChangedEvent)(nil), "nibiru.perp.v1.PositionChangedEvent")
+	proto.RegisterType((*PositionLiquidatedEvent)(nil), "nibiru.perp.v1.PositionLiquidatedEvent")
+	proto.RegisterType((*PositionSettledEvent)(nil), "nibiru.perp.v1.PositionSettledEvent")
+	proto.RegisterType((*MarketSettledEvent)(nil), "nibiru.perp.v1.MarketSettledEvent")
+	proto.RegisterType((*FundingRateChangedEvent)(nil), "nibiru.perp.v1.FundingRateChangedEvent")
+	proto.RegisterType((*OrderPlacedEvent)(nil), "nibiru.perp.v1.OrderPlacedEvent")
+	proto.RegisterType((*OrderCancelledEvent)(nil), "nibiru.perp.v1.OrderCancelledEvent")
+	proto.RegisterType((*OrderExecutedEvent)(nil), "nibiru.perp.v1.OrderExecutedEvent")
+	proto.RegisterType((*CrossMarginCollateralChangedEvent)(nil), "nibiru.perp.v1.CrossMarginCollateralChangedEvent")
+	proto.RegisterType((*BadDebtCoveredEvent)(nil), "nibiru.perp.v1.BadDebtCoveredEvent")
+	proto.RegisterType((*PositionAutoDeleveragedEvent)(nil), "nibiru.perp.v1.PositionAutoDeleveragedEvent")
+	proto.RegisterType((*LiquidationAuctionStartedEvent)(nil), "nibiru.perp.v1.LiquidationAuctionStartedEvent")
+	proto.RegisterType((*LiquidationAuctionBidEvent)(nil), "nibiru.perp.v1.LiquidationAuctionBidEvent")
+	proto.RegisterType((*LiquidationAuctionEndedEvent)(nil), "nibiru.perp.v1.LiquidationAuctionEndedEvent")
+	proto.RegisterType((*PoolRepeggedEvent)(nil), "nibiru.perp.v1.PoolRepeggedEvent")
+	proto.RegisterType((*PoolDepthAdjustedEvent)(nil), "nibiru.perp.v1.PoolDepthAdjustedEvent")
+}
+
+func init() { proto.RegisterFile("perp/v1/event.proto", fileDescriptor_19b7f9ebcf2fdb5b) }
+
+var fileDescriptor_19b7f9ebcf2fdb5b = []byte{
+	// 1933 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0x4f, 0x73, 0xdc, 0x48,
+	0x15, 0xcf, 0xfc, 0xb7, 0xdf, 0xfc, 0xb1, 0x2d, 0x3b, 0xb6, 0x36, 0xb8, 0xc6, 0xce, 0x14, 0x2c,
+	0x81, 0xaa, 0x9d, 0xc1, 0xe6, 0xb0, 0x55, 0x7b, 0xf3, 0x9f, 0xa4, 0xe2, 0xaa, 0x38, 0x99, 0x95,
+	0x5d, 0x05, 0xbb, 0x6c, 0xa1, 0xed, 0x91, 0xda, 0x63, 0x61, 0xa9, 0x5b, 0xdb, 0x6a, 0x8d, 0xe3,
+	0xf0, 0x05, 0x38, 0x70, 0xe0, 0xc8, 0x99, 0x0b, 0x05, 0x07, 0x6e, 0x7c, 0x02, 0x2e, 0x5b, 0x9c,
+	0xb6, 0xe0, 0x42, 0xed, 0x21, 0x50, 0xc9, 0x05, 0xaa, 0xb8, 0xc0, 0x27, 0xa0, 0xa4, 0x6e, 0xcd,
+	0x8c, 0xa4, 0x38, 0x33, 0xd1, 0x08, 0x8a, 0xd3, 0xa4, 0x5b, 0xdd, 0xbf, 0xd7, 0xfd, 0x7b, 0xef,
+	0xfd, 0xfa, 0x75, 0xc7, 0xb0, 0xee, 0x62, 0xe6, 0xf6, 0x46, 0x7b, 0x3d, 0x3c, 0xc2, 0x84, 0x77,
+	0x5d, 0x46, 0x39, 0x55, 0x5a, 0xc4, 0x1a, 0x58, 0xcc, 0xef, 0x06, 0xdf, 0xba, 0xa3, 0xbd, 0x7b,
+	0x1b, 0x43, 0x3a, 0xa4, 0xe1, 0xa7, 0x5e, 0xf0, 0x2f, 0x31, 0xea, 0xde, 0xf6, 0x90, 0xd2, 0xa1,
+	0x8d, 0x7b, 0xc8, 0xb5, 0x7a, 0x88, 0x10, 0xca, 0x11, 0xb7, 0x28, 0xf1, 0xe4, 0xd7, 0xb6, 0x41,
+	0x3d, 0x87, 0x7a, 0xbd, 0x01, 0xf2, 0x70, 0x6f, 0xb4, 0x37, 0xc0, 0x1c, 0xed, 0xf5, 0x0c, 0x6a,
+	0x11, 0xf9, 0x7d, 0xdd, 0xa0, 0x8e, 0x43, 0x49, 0x4f, 0xfc, 0x44, 0x9d, 0xd1, 0x6a, 0x3c, 0x8e,
+	0x38, 0x16, 0x9d, 0x9d, 0xaf, 0x97, 0x60, 0xa3, 0x4f, 0x3d, 0x2b, 0x40, 0x3f, 0xba, 0x44, 0x64,
+	0x88, 0xcd, 0x87, 0xc1, 0x62, 0x15, 0x05, 0xca, 0x2e, 0xb2, 0x98, 0x5a, 0xd8, 0x2d, 0x3c, 0x58,
+	0xd6, 0xc2, 0x7f, 0x2b, 0xdf, 0x82, 0x16, 0x67, 0xc8, 0xc4, 0x4c, 0x47, 0xa6, 0xc9, 0xb0, 0xe7,
+	0xa9, 0xc5, 0xf0, 0x6b, 0x53, 0xf4, 0x1e, 0x88, 0x4e, 0xe5, 0x31, 0x54, 0x1d, 0xc4, 0x86, 0x16,
+	0x51, 0x4b, 0xbb, 0x85, 0x07, 0xf5, 0xfd, 0xf7, 0xba, 0x62, 0xb9, 0xdd, 0x60, 0xb9, 0x5d, 0xb9,
+	0xdc, 0xee, 0x11, 0xb5, 0xc8, 0xe1, 0xdd, 0x2f, 0x5f, 0xee, 0xdc, 0xf9, 0xf7, 0xcb, 0x9d, 0xe6,
+	0x0d, 0x72, 0xec, 0x8f, 0x3a, 0x62, 0x5a, 0x47, 0x93, 0xf3, 0x95, 0x1f, 0xc1, 0x9a, 0x2b, 0x17,
+	0xa7, 0x13, 0x1a, 0xfc, 0x20, 0x5b, 0x2d, 0x07, 0x36, 0x0f, 0xbb, 0xc1, 0xcc, 0xaf, 0x5f, 0xee,
+	0xbc, 0x3f, 0xb4, 0xf8, 0xa5, 0x3f, 0xe8, 0x1a, 0xd4, 0xe9, 0x49, 0x56, 0xc4, 0xcf, 0x07, 0x9e,
+	0x79, 0xd5, 0xe3, 0x37, 0x2e, 0xf6, 0xba, 0xc7, 0xd8, 0xd0, 0x56, 0x23, 0xa0, 0xa7, 0x12, 0x47,
+	0xb9, 0x80, 0x2d, 0xfc, 0xdc, 0x10, 0x7b, 0xd6, 0xc7, 0x66, 0x3c, 0xeb, 0x05, 0x56, 0x2b, 0x99,
+	0x4c, 0xdc, 0x1d, 0xc3, 0x45, 0x8c, 0x9e, 0x59, 0x2f, 0xb0, 0x32, 0x80, 0x15, 0xce, 0x10, 0xf1,
+	0x90, 0x11, 0x1a, 0xb8, 0xc0, 0x58, 0xad, 0xce, 0xe2, 0xa5, 0x2d, 0x79, 0xd9, 0x14, 0xbc, 0x24,
+	0xe6, 0x77, 0xb4, 0xd6, 0x54, 0xcf, 0x23, 0x8c, 0x95, 0x33, 0x68, 0xc6, 0x77, 0x50, 0xcb, 0xb4,
+	0x83, 0x86, 0x3b, 0xbd, 0xf0, 0x8f, 0xa1, 0xc1, 0x30, 0xb2, 0xad, 0x17, 0x01, 0x3f, 0xc4, 0x56,
+	0x97, 0x32, 0x61, 0xd6, 0x23, 0x8c, 0x3e, 0xb1, 0x95, 0xcf, 0x61, 0xc3, 0x27, 0xd3, 0xa0, 0x3a,
+	0xba, 0xe0, 0x98, 0xa9, 0xcb, 0x99, 0xa0, 0x95, 0x09, 0x56, 0x9f, 0xd8, 0x07, 0x01, 0x92, 0xf2,
+	0x11, 0x2c, 0x0d, 0x90, 0xa9, 0x9b, 0x78, 0xc0, 0x55, 0x98, 0x45, 0x73, 0x39, 0x30, 0xa8, 0xd5,
+	0x06, 0xc8, 0x3c, 0xc6, 0x03, 0xae, 0xe8, 0xb0, 0x6e, 0x5b, 0x5f, 0xf8, 0x96, 0x19, 0x26, 0x9b,
+	0xee, 0x62, 0x82, 0x6c, 0x7e, 0xa3, 0xd6, 0xb3, 0x2d, 0x6e, 0x0a, 0xaa, 0x2f, 0x90, 0x94, 0x53,
+	0x00, 0x07, 0xb1, 0x2b, 0xdd, 0x65, 0x96, 0x81, 0xd5, 0x46, 0x26, 0xdc, 0xe5, 0x00, 0xa1, 0x1f,
+	0x00, 0x28, 0x3f, 0x80, 0x95, 0x0b, 0x9f, 0x98, 0x16, 0x19, 0xea, 0x2e, 0xba, 0x71, 0x30, 0xe1,
+	0x6a, 0x33, 0x13, 0x66, 0x4b, 0xc2, 0xf4, 0x05, 0x8a, 0x72, 0x1f, 0x1a, 0x03, 0x9b, 0x1a, 0x57,
+	0xfa, 0x25, 0xb6, 0x86, 0x97, 0x5c, 0x6d, 0xed, 0x16, 0x1e, 0x94, 0xb4, 0x7a, 0xd8, 0xf7, 0x38,
+	0xec, 0x52, 0x3a, 0xd0, 0x14, 0x43, 0xb8, 0xe5, 0x60, 0xdd, 0xf1, 0xd4, 0x95, 0xa9, 0x31, 0xe7,
+	0x96, 0x83, 0x4f, 0xbd, 0xce, 0x9f, 0x96, 0x60, 0x2b, 0x4a, 0x85, 0x27, 0x92, 0x8d, 0x1c, 0xf4,
+	0xc5, 0x84, 0xcd, 0x49, 0xe2, 0x7e, 0xe1, 0x53, 0x8e, 0x75, 0xe4, 0x50, 0x9f, 0x70, 0xb5, 0x94,
+	0x69, 0xf7, 0x1b, 0x63, 0xb4, 0x8f, 0x03, 0xb0, 0x83, 0x10, 0xeb, 0x6d, 0xf2, 0x50, 0xce, 0x53,
+	0x1e, 0x3e, 0x80, 0x71, 0xa4, 0xd0, 0xc9, 0xc6, 0x43, 0x05, 0xd2, 0xd6, 0x26, 0x5f, 0xa2, 0xcd,
+	0x0f, 0x61, 0xed, 0x02, 0x63, 0x9d, 0x53, 0x7d, 0xf2, 0x6d, 0xb6, 0x9e, 0xec, 0x4a, 0x3d, 0x51,
+	0x85, 0x9e, 0xa4, 0x10, 0x3a, 0xda, 0xca, 0x05, 0xc6, 0xe7, 0xf4, 0xc9, 0xb8, 0x47, 0x61, 0x70,
+	0x57, 0x0e, 0xc3, 0x06, 0xf5, 0x6e, 0x3c, 0x8e, 0x1d, 0x3d, 0x08, 0x13, 0xb5, 0x36, 0xcb, 0xd8,
+	0x37, 0xa5, 0xb1, 0xed, 0x98, 0xb1, 0x38, 0x4a, 0x47, 0x53, 0x42, 0x83, 0x0f, 0xa3, 0xde, 0x47,
+	0x3e, 0x31, 0x63, 0xc9, 0xbb, 0xf4, 0x8e, 0xc9, 0x3b, 0x39, 0x75, 0x96, 0xff, 0x1b, 0xa7, 0x0e,
+	0xe4, 0x74, 0xea, 0xa4, 0x94, 0xba, 0x9e, 0x83, 0x52, 0x9f, 0x43, 0x33, 0x26, 0x85, 0x19, 0xa5,
+	0x25, 0x0e, 0x92, 0x50, 0xab, 0xe6, 0xa2, 0x6a, 0x95, 0x93, 0xa8, 0xfc, 0xaa, 0x3c, 0xa9, 0x58,
+	0xce, 0x30, 0xe7, 0x76, 0x0e, 0x8a, 0xf2, 0xb3, 0x02, 0x34, 0x3d, 0x81, 0xa5, 0x07, 0x65, 0x94,
+	0xa7, 0x96, 0x76, 0x4b, 0x6f, 0x8f, 0xa1, 0xc7, 0x32, 0x86, 0x36, 0x44, 0x0c, 0xc5, 0x66, 0x77,
+	0x7e, 0xfb, 0xd7, 0x9d, 0x07, 0x73, 0x10, 0x14, 0x00, 0x79, 0x5a, 0x43, 0xce, 0x0d, 0x5b, 0xca,
+	0x27, 0xb0, 0x2a, 0xda, 0x81, 0x10, 0x4b, 0xea, 0xb3, 0xe9, 0xcd, 0xca, 0x04, 0x47, 0x38, 0x20,
+	0x15, 0x7a, 0x95, 0x1c, 0x42, 0xef, 0x74, 0x2a, 0x65, 0x67, 0xca, 0xd0, 0x96, 0x24, 0x6d, 0x45,
+	0x90, 0x16, 0x4d, 0xec, 0x4c, 0xb2, 0x38, 0x19, 0x24, 0xb5, 0x39, 0x82, 0x64, 0x29, 0x1d, 0x24,
+	0xff, 0x28, 0x83, 0x72, 0x8a, 0xd8, 0x15, 0xe6, 0x33, 0x43, 0xe4, 0x4d, 0x84, 0x17, 0xf3, 0x21,
+	0xfc, 0x33, 0x68, 0x8e, 0x90, 0x6f, 0x73, 0x7d, 0x80, 0x6c, 0x44, 0x0c, 0x3c, 0xbb, 0x1e, 0xde,
+	0x8e, 0x47, 0x55, 0x6c, 0x76, 0x47, 0x6b, 0x84, 0xed, 0x43, 0xd1, 0x54, 0x4c, 0x58, 0x75, 0x19,
+	0x76, 0x91, 0x65, 0xea, 0x63, 0x0f, 0x94, 0x67, 0x19, 0xd8, 0x91, 0x06, 0xb6, 0x84, 0x81, 0x24,
+	0x40, 0x47, 0x6b, 0xc9, 0xae, 0xc3, 0x5b, 0x1c, 0x52, 0x99, 0xc3, 0x21, 0xd5, 0x94, 0x43, 0x26,
+	0x54, 0x78, 0x3e, 0x73, 0x6d, 0xdf, 0x53, 0x6b, 0x99, 0xa8, 0x90, 0xb3, 0x23, 0x2a, 0xce, 0x44,
+	0x53, 0xf9, 0x29, 0xa8, 0x86, 0x8d, 0x11, 0x0b, 0x4e, 0xea, 0x24, 0x25, 0x33, 0xcf, 0x91, 0x6f,
+	0x4b, 0x43, 0x3b, 0xc2, 0xd0, 0x6d, 0x40, 0x1d, 0xed, 0xae, 0xfc, 0xd4, 0x8f, 0x31, 0xd4, 0xf9,
+	0x73, 0x15, 0xb6, 0x1e, 0x89, 0xfa, 0x49, 0x43, 0x1c, 0xcf, 0xbc, 0x45, 0xc5, 0x65, 0xb5, 0xb8,
+	0xa8, 0xac, 0x3e, 0x83, 0xba, 0x45, 0x4c, 0xfc, 0x5c, 0xe2, 0x65, 0x2b, 0x81, 0x20, 0x84, 0x10,
+	0x80, 0x3f, 0x86, 0x75, 0x1b, 0x71, 0xec, 0x71, 0x3d, 0x2a, 0x2e, 0x19, 0xe2, 0x59, 0x45, 0x68,
+	0x4d, 0x40, 0x4d, 0xf1, 0x13, 0x14, 0x56, 0x12, 0xdf, 0x65, 0xd8, 0xb1, 0x7c, 0x47, 0xbf, 0x60,
+	0xe2, 0x26, 0x93, 0xf5, 0xde, 0x25, 0xe0, 0xfa, 0x02, 0xed, 0x91, 0x04, 0x53, 0x08, 0x7c, 0xc3,
+	0xf0, 0x1d, 0xdf, 0x46, 0xdc, 0x1a, 0xe1, 0xb4, 0xad, 0x6a, 0x26, 0x5b, 0xef, 0x4d, 0x20, 0x93,
+	0xf6, 0xf2, 0x91, 0xae, 0xe0, 0x8a, 0xc4, 0xd0, 0x75, 0x7a, 0xbd, 0x19, 0xaf, 0x48, 0x0c, 0x5d,
+	0x27, 0x17, 0xfa, 0x43, 0x58, 0x0d, 0x2c, 0xc4, 0xbc, 0x9b, 0xad, 0xbc, 0x69, 0x31, 0x74, 0x3d,
+	0xed, 0xda, 0x13, 0x68, 0x18, 0x36, 0x72, 0x5c, 0x9d, 0x61, 0xe4, 0x51, 0x12, 0xd6, 0x36, 0xad,
+	0xfd, 0xf7, 0xbb, 0xf1, 0x27, 0x8f, 0xee, 0x74, 0xb6, 0x04, 0xc3, 0xb5, 0x70, 0xb4, 0x56, 0x37,
+	0x26, 0x8d, 0xce, 0xcf, 0x0b, 0xb0, 0xfa, 0x8c, 0x99, 0x98, 0xf5, 0x6d, 0x64, 0x44, 0xe9, 0xb4,
+	0x07, 0x15, 0x1a, 0xf4, 0x85, 0xf9, 0x54, 0xdf, 0xbf, 0x9b, 0x04, 0x0e, 0x27, 0xc8, 0xc2, 0x50,
+	0x8c, 0x4c, 0x79, 0xa5, 0x38, 0x87, 0x57, 0x4a, 0xe9, 0x03, 0xe5, 0xd7, 0x05, 0x58, 0x0f, 0xd1,
+	0x8f, 0x02, 0xed, 0xb5, 0xed, 0x05, 0x56, 0xb4, 0x09, 0x55, 0x49, 0x8f, 0xa8, 0x45, 0x64, 0x2b,
+	0xb5, 0xd2, 0xd2, 0x1c, 0x2b, 0x2d, 0xa7, 0x57, 0xfa, 0xbb, 0x22, 0x28, 0xa1, 0xd5, 0x87, 0xcf,
+	0xb1, 0xe1, 0xf3, 0x05, 0x16, 0xfa, 0xff, 0x2e, 0x54, 0x49, 0xc2, 0xca, 0x73, 0x10, 0x56, 0x49,
+	0x13, 0xf6, 0xaf, 0x22, 0xdc, 0x3f, 0x62, 0xd4, 0xf3, 0x4e, 0xc3, 0xf2, 0xff, 0x88, 0xda, 0x81,
+	0x9e, 0x30, 0x64, 0xc7, 0x94, 0x3c, 0x5d, 0x49, 0x16, 0xde, 0x54, 0x49, 0x5e, 0x01, 0x18, 0x63,
+	0x00, 0xb5, 0x38, 0xab, 0x8a, 0xfc, 0x5e, 0xb0, 0xfd, 0x77, 0xaa, 0x16, 0xa7, 0xe0, 0x83, 0x8b,
+	0xca, 0xa4, 0xa5, 0x8b, 0xcb, 0x65, 0x06, 0x5e, 0x4f, 0x08, 0xd7, 0x56, 0x8d, 0xc4, 0xb6, 0x95,
+	0x0d, 0xa8, 0x98, 0x98, 0x50, 0x47, 0x08, 0xbf, 0x26, 0x1a, 0x39, 0x95, 0x03, 0x9d, 0x3f, 0x16,
+	0x61, 0x5d, 0x9e, 0x9f, 0x47, 0x74, 0x84, 0x59, 0xc4, 0xf2, 0x7d, 0x68, 0x78, 0x97, 0x94, 0xf1,
+	0x0b, 0x64, 0xdb, 0xba, 0x65, 0x86, 0x1c, 0x97, 0xb5, 0xfa, 0xb8, 0xef, 0xc4, 0x54, 0xf6, 0xa1,
+	0xe2, 0xa2, 0x1b, 0xcc, 0xc2, 0x80, 0x6c, 0xed, 0x6f, 0x27, 0x03, 0x59, 0xc2, 0xf6, 0x83, 0x31,
+	0x9a, 0x18, 0xaa, 0x7c, 0x08, 0xd5, 0xa9, 0x17, 0x82, 0x39, 0x6e, 0x95, 0x72, 0xb8, 0xf2, 0x19,
+	0x28, 0x0c, 0x3b, 0xc8, 0x22, 0x81, 0x50, 0xc6, 0xaa, 0xac, 0x0c, 0x14, 0x8f, 0x91, 0xf2, 0xad,
+	0xad, 0x3a, 0xbf, 0xac, 0xc2, 0x76, 0x74, 0x23, 0x3a, 0xf0, 0x39, 0x3d, 0xc6, 0x36, 0x1e, 0x61,
+	0x86, 0xf2, 0x78, 0xcb, 0x4d, 0x3a, 0xa4, 0x94, 0x76, 0xc8, 0x27, 0xb0, 0x3a, 0x40, 0xe4, 0x8a,
+	0xf9, 0x2e, 0x37, 0x6e, 0x16, 0xbb, 0xb1, 0x4c, 0x70, 0x44, 0x86, 0xff, 0xaf, 0x9e, 0x68, 0x6f,
+	0x7f, 0x51, 0xaa, 0xe6, 0xf8, 0xa2, 0x94, 0x7c, 0x4f, 0xad, 0x2d, 0xfe, 0x9e, 0x7a, 0x02, 0xab,
+	0x86, 0xc8, 0x1f, 0xfd, 0x5d, 0x1f, 0x4e, 0x5a, 0x72, 0x62, 0x14, 0x8c, 0x1f, 0xce, 0xff, 0x7e,
+	0x22, 0x73, 0x44, 0x0c, 0x4f, 0x5f, 0x2b, 0x21, 0x87, 0x6b, 0x65, 0x32, 0x35, 0xea, 0x73, 0xa4,
+	0x46, 0x23, 0x9d, 0x1a, 0x7f, 0x2f, 0x41, 0xfb, 0xc9, 0xe4, 0x1d, 0xf6, 0xc0, 0x0f, 0x2b, 0xa0,
+	0x33, 0x8e, 0x58, 0x1e, 0x0f, 0x91, 0x6f, 0x7e, 0xba, 0x2b, 0xdd, 0xf6, 0x74, 0x97, 0x22, 0xaa,
+	0x9c, 0x03, 0x51, 0x89, 0x53, 0xb5, 0xb2, 0xf0, 0xa9, 0xfa, 0x0c, 0xea, 0xb6, 0xe5, 0x58, 0xd1,
+	0x55, 0x38, 0x5b, 0x02, 0x40, 0x08, 0x21, 0x00, 0xdb, 0x50, 0xc7, 0xc4, 0x1c, 0x7b, 0x49, 0x94,
+	0xc5, 0xcb, 0x98, 0x98, 0xb2, 0xe0, 0x4d, 0xba, 0x7a, 0x69, 0x0e, 0x57, 0x2f, 0xa7, 0x5d, 0xfd,
+	0xfb, 0x22, 0xdc, 0x4b, 0xbb, 0xfa, 0xd0, 0x5a, 0xdc, 0xcd, 0x9b, 0x50, 0x1d, 0x58, 0x66, 0x50,
+	0x3b, 0x09, 0xd7, 0xca, 0x96, 0x72, 0x0c, 0x95, 0x45, 0xd4, 0x4e, 0x4c, 0x9e, 0xca, 0xbb, 0xca,
+	0xbb, 0xe5, 0x5d, 0x92, 0xb7, 0xea, 0x1c, 0xbc, 0xd5, 0xd2, 0xbc, 0xfd, 0xa1, 0x08, 0xdb, 0x69,
+	0xde, 0x1e, 0x12, 0x33, 0x87, 0x04, 0x39, 0x82, 0x1a, 0xf5, 0xb9, 0x41, 0x1d, 0x51, 0x96, 0xb4,
+	0xf6, 0xbf, 0x93, 0x3c, 0xad, 0xd3, 0x96, 0x9f, 0x89, 0x09, 0x5a, 0x34, 0x33, 0xa0, 0xff, 0xda,
+	0x22, 0x04, 0x33, 0x59, 0x89, 0xc8, 0xd6, 0x84, 0xfe, 0xca, 0x22, 0xf4, 0xe7, 0xc4, 0xe2, 0x6f,
+	0x4a, 0xb0, 0xd6, 0xa7, 0xd4, 0xd6, 0xb0, 0x8b, 0x87, 0x6f, 0x3d, 0x78, 0x4f, 0x60, 0x89, 0x60,
+	0x2e, 0x04, 0x20, 0x5b, 0x4d, 0x5d, 0x23, 0x98, 0x87, 0xb9, 0x7f, 0x08, 0x65, 0x83, 0x7a, 0x59,
+	0xff, 0xdb, 0x23, 0x9c, 0xab, 0x9c, 0x43, 0x8b, 0xda, 0xa6, 0x3e, 0x55, 0xe8, 0x67, 0x54, 0x25,
+	0x6a, 0x9b, 0xa7, 0xe3, 0x5a, 0xff, 0x1c, 0x5a, 0x04, 0x5f, 0x4f, 0xa3, 0x66, 0x7c, 0x6b, 0x24,
+	0xf8, 0xfa, 0xf4, 0xd6, 0x17, 0xe4, 0x8c, 0xbe, 0xfa, 0x67, 0x19, 0x36, 0x03, 0x5f, 0x1d, 0x63,
+	0x97, 0x5f, 0x1e, 0x98, 0x3f, 0xf1, 0xbd, 0xb7, 0x1e, 0x06, 0x4f, 0x01, 0x1c, 0xdf, 0xe6, 0x96,
+	0x6b, 0x5b, 0xb2, 0xea, 0xcc, 0xa0, 0x87, 0x13, 0x84, 0x58, 0x00, 0x94, 0xf2, 0x09, 0x80, 0xf2,
+	0x02, 0x01, 0xf0, 0x29, 0xac, 0x51, 0x3b, 0xaa, 0x7a, 0x18, 0xf6, 0x30, 0x1b, 0x65, 0xf5, 0xd6,
+	0x0a, 0xb5, 0x45, 0xc1, 0xa3, 0x09, 0x98, 0x00, 0x3b, 0x08, 0x83, 0x38, 0x76, 0xb6, 0x13, 0x65,
+	0x85, 0xe0, 0xeb, 0x18, 0xf6, 0xe7, 0xb0, 0xc1, 0x11, 0x1b, 0x62, 0x9e, 0x80, 0xcf, 0x56, 0x55,
+	0x29, 0x02, 0x2b, 0x66, 0x21, 0x9f, 0x83, 0xe9, 0xf0, 0xf8, 0xcb, 0x57, 0xed, 0xc2, 0x57, 0xaf,
+	0xda, 0x85, 0xbf, 0xbd, 0x6a, 0x17, 0x7e, 0xf1, 0xba, 0x7d, 0xe7, 0xab, 0xd7, 0xed, 0x3b, 0x7f,
+	0x79, 0xdd, 0xbe, 0xf3, 0xe9, 0x77, 0xa7, 0x16, 0xf7, 0x34, 0xd4, 0xc5, 0xa3, 0x4b, 0x64, 0x91,
+	0x9e, 0xd0, 0xc8, 0xde, 0xf3, 0x5e, 0xf8, 0x17, 0x1b, 0xe1, 0x22, 0x07, 0xd5, 0xf0, 0xef, 0x35,
+	0xbe, 0xff, 0x9f, 0x01, 0x00, 0x91, 0x39, 0xec, 0xa4, 0x54, 0x22, 0x00, 0x00,
+}
+
+func (m *PositionChangedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PositionChangedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PositionChangedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockTimeMs != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockTimeMs))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x70
 	}
-	i--
-	dAtA[i] = 0x2a
 	{
-		size := m.LatestFundingRate.Size()
+		size := m.FundingPayment.Size()
 		i -= size
-		if _, err := m.LatestFundingRate.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.FundingPayment.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintEvent(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x22
+	dAtA[i] = 0x6a
 	{
-		size := m.IndexPrice.Size()
+		size := m.MarkPrice.Size()
 		i -= size
-		if _, err := m.IndexPrice.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.MarkPrice.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintEvent(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
+	dAtA[i] = 0x62
 	{
-		size := m.MarkPrice.Size()
+		size := m.LiquidationPenalty.Size()
 		i -= size
-		if _, err := m.MarkPrice.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.LiquidationPenalty.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintEvent(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
-	if len(m.Pair) > 0 {
-		i -= len(m.Pair)
-		copy(dAtA[i:], m.Pair)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Pair)))
-		i--
-		dAtA[i] = 0xa
+	dAtA[i] = 0x5a
+	{
+		size, err := m.BadDebt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvent(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.UnrealizedPnlAfter.Size()
+		i -= size
+		if _, err := m.UnrealizedPnlAfter.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *PositionChangedEvent) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.RealizedPnl.Size()
+		i -= size
+		if _, err := m.RealizedPnl.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	l = len(m.Pair)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.PositionSize.Size()
+		i -= size
+		if _, err := m.PositionSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
 	}
-	l = len(m.TraderAddress)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.TransactionFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
 	}
-	l = m.Margin.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.PositionNotional.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.ExchangedPositionSize.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.TransactionFee.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.PositionSize.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.RealizedPnl.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.UnrealizedPnlAfter.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.BadDebt.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.LiquidationPenalty.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.MarkPrice.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.FundingPayment.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	if m.BlockHeight != 0 {
-		n += 1 + sovEvent(uint64(m.BlockHeight))
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.ExchangedPositionSize.Size()
+		i -= size
+		if _, err := m.ExchangedPositionSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
 	}
-	if m.BlockTimeMs != 0 {
-		n += 1 + sovEvent(uint64(m.BlockTimeMs))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.PositionNotional.Size()
+		i -= size
+		if _, err := m.PositionNotional.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Margin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.TraderAddress) > 0 {
+		i -= len(m.TraderAddress)
+		copy(dAtA[i:], m.TraderAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.TraderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Pair) > 0 {
+		i -= len(m.Pair)
+		copy(dAtA[i:], m.Pair)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Pair)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *PositionLiquidatedEvent) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PositionLiquidatedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PositionLiquidatedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PositionLiquidatedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Pair)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if m.BlockTimeMs != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockTimeMs))
+		i--
+		dAtA[i] = 0x78
 	}
-	l = len(m.TraderAddress)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x70
 	}
-	l = m.ExchangedQuoteAmount.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.ExchangedPositionSize.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = len(m.LiquidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	{
+		size := m.MarkPrice.Size()
+		i -= size
+		if _, err := m.MarkPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
 	}
-	l = m.FeeToLiquidator.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.FeeToEcosystemFund.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.BadDebt.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.Margin.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.PositionNotional.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.PositionSize.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.UnrealizedPnl.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.MarkPrice.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	if m.BlockHeight != 0 {
-		n += 1 + sovEvent(uint64(m.BlockHeight))
+	i--
+	dAtA[i] = 0x6a
+	{
+		size := m.UnrealizedPnl.Size()
+		i -= size
+		if _, err := m.UnrealizedPnl.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
 	}
-	if m.BlockTimeMs != 0 {
-		n += 1 + sovEvent(uint64(m.BlockTimeMs))
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.PositionSize.Size()
+		i -= size
+		if _, err := m.PositionSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func (m *PositionSettledEvent) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.PositionNotional.Size()
+		i -= size
+		if _, err := m.PositionNotional.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	l = len(m.Pair)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	i--
+	dAtA[i] = 0x52
+	{
+		size, err := m.Margin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
 	}
-	l = len(m.TraderAddress)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	i--
+	dAtA[i] = 0x4a
+	{
+		size, err := m.BadDebt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
 	}
-	if len(m.SettledCoins) > 0 {
-		for _, e := range m.SettledCoins {
-			l = e.Size()
-			n += 1 + l + sovEvent(uint64(l))
+	i--
+	dAtA[i] = 0x42
+	{
+		size, err := m.FeeToEcosystemFund.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.FeeToLiquidator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.LiquidatorAddress) > 0 {
+		i -= len(m.LiquidatorAddress)
+		copy(dAtA[i:], m.LiquidatorAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.LiquidatorAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.ExchangedPositionSize.Size()
+		i -= size
+		if _, err := m.ExchangedPositionSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.ExchangedQuoteAmount.Size()
+		i -= size
+		if _, err := m.ExchangedQuoteAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.TraderAddress) > 0 {
+		i -= len(m.TraderAddress)
+		copy(dAtA[i:], m.TraderAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.TraderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Pair) > 0 {
+		i -= len(m.Pair)
+		copy(dAtA[i:], m.Pair)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Pair)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PositionSettledEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PositionSettledEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PositionSettledEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockTimeMs != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockTimeMs))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size, err := m.BadDebt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.PositionSize.Size()
+		i -= size
+		if _, err := m.PositionSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.SettlementPrice.Size()
+		i -= size
+		if _, err := m.SettlementPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.SettledCoins) > 0 {
+		for iNdEx := len(m.SettledCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SettledCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.TraderAddress) > 0 {
+		i -= len(m.TraderAddress)
+		copy(dAtA[i:], m.TraderAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.TraderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Pair) > 0 {
+		i -= len(m.Pair)
+		copy(dAtA[i:], m.Pair)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Pair)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketSettledEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketSettledEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketSettledEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ClearedPrepaidBadDebt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size, err := m.VaultSurplus.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.BlockTimeMs != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockTimeMs))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.PrepaidBadDebt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.VaultBalance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.SettlementPrice.Size()
+		i -= size
+		if _, err := m.SettlementPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Pair) > 0 {
+		i -= len(m.Pair)
+		copy(dAtA[i:], m.Pair)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Pair)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FundingRateChangedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FundingRateChangedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FundingRateChangedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ClampReason != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.ClampReason))
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size := m.RawFundingRate.Size()
+		i -= size
+		if _, err := m.RawFundingRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.RawPremiumFraction.Size()
+		i -= size
+		if _, err := m.RawPremiumFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.BlockTimeMs != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockTimeMs))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.CumulativePremiumFraction.Size()
+		i -= size
+		if _, err := m.CumulativePremiumFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.LatestPremiumFraction.Size()
+		i -= size
+		if _, err := m.LatestPremiumFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.LatestFundingRate.Size()
+		i -= size
+		if _, err := m.LatestFundingRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.IndexPrice.Size()
+		i -= size
+		if _, err := m.IndexPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MarkPrice.Size()
+		i -= size
+		if _, err := m.MarkPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Pair) > 0 {
+		i -= len(m.Pair)
+		copy(dAtA[i:], m.Pair)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Pair)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OrderPlacedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrderPlacedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderPlacedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockTimeMs != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockTimeMs))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *OrderCancelledEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrderCancelledEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderCancelledEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockTimeMs != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockTimeMs))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *OrderExecutedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrderExecutedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderExecutedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockTimeMs != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockTimeMs))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.IndexPrice.Size()
+		i -= size
+		if _, err := m.IndexPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MarkPrice.Size()
+		i -= size
+		if _, err := m.MarkPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CrossMarginCollateralChangedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CrossMarginCollateralChangedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CrossMarginCollateralChangedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockTimeMs != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockTimeMs))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.CollateralChange.Size()
+		i -= size
+		if _, err := m.CollateralChange.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Collateral) > 0 {
+		for iNdEx := len(m.Collateral) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Collateral[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.TraderAddress) > 0 {
+		i -= len(m.TraderAddress)
+		copy(dAtA[i:], m.TraderAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.TraderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BadDebtCoveredEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BadDebtCoveredEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BadDebtCoveredEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockTimeMs != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockTimeMs))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.RemainingBadDebt.Size()
+		i -= size
+		if _, err := m.RemainingBadDebt.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Payer != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Payer))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ShortfallId != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.ShortfallId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PositionAutoDeleveragedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PositionAutoDeleveragedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PositionAutoDeleveragedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockTimeMs != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockTimeMs))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size := m.PositionSize.Size()
+		i -= size
+		if _, err := m.PositionSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size, err := m.Margin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size, err := m.CoveredBadDebt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.RealizedPnl.Size()
+		i -= size
+		if _, err := m.RealizedPnl.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.ExchangedQuoteAmount.Size()
+		i -= size
+		if _, err := m.ExchangedQuoteAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.ExchangedPositionSize.Size()
+		i -= size
+		if _, err := m.ExchangedPositionSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.BankruptcyPrice.Size()
+		i -= size
+		if _, err := m.BankruptcyPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.ShortfallId != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.ShortfallId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TraderAddress) > 0 {
+		i -= len(m.TraderAddress)
+		copy(dAtA[i:], m.TraderAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.TraderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Pair) > 0 {
+		i -= len(m.Pair)
+		copy(dAtA[i:], m.Pair)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Pair)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LiquidationAuctionStartedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidationAuctionStartedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidationAuctionStartedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockTimeMs != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockTimeMs))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.EndTimeMs != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.EndTimeMs))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.LimitPrice.Size()
+		i -= size
+		if _, err := m.LimitPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.IndexPrice.Size()
+		i -= size
+		if _, err := m.IndexPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.PositionSize.Size()
+		i -= size
+		if _, err := m.PositionSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.LiquidatorAddress) > 0 {
+		i -= len(m.LiquidatorAddress)
+		copy(dAtA[i:], m.LiquidatorAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.LiquidatorAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TraderAddress) > 0 {
+		i -= len(m.TraderAddress)
+		copy(dAtA[i:], m.TraderAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.TraderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Pair) > 0 {
+		i -= len(m.Pair)
+		copy(dAtA[i:], m.Pair)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Pair)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LiquidationAuctionBidEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidationAuctionBidEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidationAuctionBidEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockTimeMs != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockTimeMs))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size, err := m.Margin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TraderAddress) > 0 {
+		i -= len(m.TraderAddress)
+		copy(dAtA[i:], m.TraderAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.TraderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Pair) > 0 {
+		i -= len(m.Pair)
+		copy(dAtA[i:], m.Pair)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Pair)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LiquidationAuctionEndedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidationAuctionEndedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidationAuctionEndedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockTimeMs != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockTimeMs))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Winner) > 0 {
+		i -= len(m.Winner)
+		copy(dAtA[i:], m.Winner)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Winner)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Outcome != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Outcome))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TraderAddress) > 0 {
+		i -= len(m.TraderAddress)
+		copy(dAtA[i:], m.TraderAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.TraderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Pair) > 0 {
+		i -= len(m.Pair)
+		copy(dAtA[i:], m.Pair)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Pair)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolRepeggedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolRepeggedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolRepeggedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockTimeMs != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockTimeMs))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.NewMarkPrice.Size()
+		i -= size
+		if _, err := m.NewMarkPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.OldMarkPrice.Size()
+		i -= size
+		if _, err := m.OldMarkPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Cost.Size()
+		i -= size
+		if _, err := m.Cost.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.NetSize.Size()
+		i -= size
+		if _, err := m.NetSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Pair) > 0 {
+		i -= len(m.Pair)
+		copy(dAtA[i:], m.Pair)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Pair)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolDepthAdjustedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolDepthAdjustedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolDepthAdjustedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockTimeMs != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockTimeMs))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.TargetQuoteReserve.Size()
+		i -= size
+		if _, err := m.TargetQuoteReserve.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.NewQuoteReserve.Size()
+		i -= size
+		if _, err := m.NewQuoteReserve.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.OldQuoteReserve.Size()
+		i -= size
+		if _, err := m.OldQuoteReserve.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Cost.Size()
+		i -= size
+		if _, err := m.Cost.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.NetSize.Size()
+		i -= size
+		if _, err := m.NetSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Multiplier.Size()
+		i -= size
+		if _, err := m.Multiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Pair) > 0 {
+		i -= len(m.Pair)
+		copy(dAtA[i:], m.Pair)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Pair)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvent(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PositionChangedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pair)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.TraderAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.Margin.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.PositionNotional.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.ExchangedPositionSize.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.TransactionFee.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.PositionSize.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.RealizedPnl.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.UnrealizedPnlAfter.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.BadDebt.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.LiquidationPenalty.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.MarkPrice.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.FundingPayment.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvent(uint64(m.BlockHeight))
+	}
+	if m.BlockTimeMs != 0 {
+		n += 1 + sovEvent(uint64(m.BlockTimeMs))
+	}
+	return n
+}
+
+func (m *PositionLiquidatedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pair)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.TraderAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.ExchangedQuoteAmount.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.ExchangedPositionSize.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.LiquidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.FeeToLiquidator.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.FeeToEcosystemFund.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.BadDebt.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.Margin.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.PositionNotional.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.PositionSize.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.UnrealizedPnl.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.MarkPrice.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvent(uint64(m.BlockHeight))
+	}
+	if m.BlockTimeMs != 0 {
+		n += 1 + sovEvent(uint64(m.BlockTimeMs))
+	}
+	return n
+}
+
+func (m *PositionSettledEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pair)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.TraderAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if len(m.SettledCoins) > 0 {
+		for _, e := range m.SettledCoins {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	l = m.SettlementPrice.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.PositionSize.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.BadDebt.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvent(uint64(m.BlockHeight))
+	}
+	if m.BlockTimeMs != 0 {
+		n += 1 + sovEvent(uint64(m.BlockTimeMs))
+	}
+	return n
+}
+
+func (m *MarketSettledEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pair)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.SettlementPrice.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.VaultBalance.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.PrepaidBadDebt.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvent(uint64(m.BlockHeight))
+	}
+	if m.BlockTimeMs != 0 {
+		n += 1 + sovEvent(uint64(m.BlockTimeMs))
+	}
+	l = m.VaultSurplus.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.ClearedPrepaidBadDebt.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *FundingRateChangedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pair)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.MarkPrice.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.IndexPrice.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.LatestFundingRate.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.LatestPremiumFraction.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.CumulativePremiumFraction.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvent(uint64(m.BlockHeight))
+	}
+	if m.BlockTimeMs != 0 {
+		n += 1 + sovEvent(uint64(m.BlockTimeMs))
+	}
+	l = m.RawPremiumFraction.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.RawFundingRate.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.ClampReason != 0 {
+		n += 1 + sovEvent(uint64(m.ClampReason))
+	}
+	return n
+}
+
+func (m *OrderPlacedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Order.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvent(uint64(m.BlockHeight))
+	}
+	if m.BlockTimeMs != 0 {
+		n += 1 + sovEvent(uint64(m.BlockTimeMs))
+	}
+	return n
+}
+
+func (m *OrderCancelledEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Order.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvent(uint64(m.BlockHeight))
+	}
+	if m.BlockTimeMs != 0 {
+		n += 1 + sovEvent(uint64(m.BlockTimeMs))
+	}
+	return n
+}
+
+func (m *OrderExecutedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Order.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.MarkPrice.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.IndexPrice.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvent(uint64(m.BlockHeight))
+	}
+	if m.BlockTimeMs != 0 {
+		n += 1 + sovEvent(uint64(m.BlockTimeMs))
+	}
+	return n
+}
+
+func (m *CrossMarginCollateralChangedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TraderAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if len(m.Collateral) > 0 {
+		for _, e := range m.Collateral {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	l = m.CollateralChange.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvent(uint64(m.BlockHeight))
+	}
+	if m.BlockTimeMs != 0 {
+		n += 1 + sovEvent(uint64(m.BlockTimeMs))
+	}
+	return n
+}
+
+func (m *BadDebtCoveredEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShortfallId != 0 {
+		n += 1 + sovEvent(uint64(m.ShortfallId))
+	}
+	if m.Payer != 0 {
+		n += 1 + sovEvent(uint64(m.Payer))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.RemainingBadDebt.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvent(uint64(m.BlockHeight))
+	}
+	if m.BlockTimeMs != 0 {
+		n += 1 + sovEvent(uint64(m.BlockTimeMs))
+	}
+	return n
+}
+
+func (m *PositionAutoDeleveragedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pair)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.TraderAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.ShortfallId != 0 {
+		n += 1 + sovEvent(uint64(m.ShortfallId))
+	}
+	l = m.BankruptcyPrice.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.ExchangedPositionSize.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.ExchangedQuoteAmount.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.RealizedPnl.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.CoveredBadDebt.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.Margin.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.PositionSize.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvent(uint64(m.BlockHeight))
+	}
+	if m.BlockTimeMs != 0 {
+		n += 1 + sovEvent(uint64(m.BlockTimeMs))
+	}
+	return n
+}
+
+func (m *LiquidationAuctionStartedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pair)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.TraderAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.LiquidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.PositionSize.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.IndexPrice.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.LimitPrice.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.EndTimeMs != 0 {
+		n += 1 + sovEvent(uint64(m.EndTimeMs))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvent(uint64(m.BlockHeight))
+	}
+	if m.BlockTimeMs != 0 {
+		n += 1 + sovEvent(uint64(m.BlockTimeMs))
+	}
+	return n
+}
+
+func (m *LiquidationAuctionBidEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pair)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.TraderAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.Margin.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvent(uint64(m.BlockHeight))
+	}
+	if m.BlockTimeMs != 0 {
+		n += 1 + sovEvent(uint64(m.BlockTimeMs))
+	}
+	return n
+}
+
+func (m *LiquidationAuctionEndedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pair)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.TraderAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Outcome != 0 {
+		n += 1 + sovEvent(uint64(m.Outcome))
+	}
+	l = len(m.Winner)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvent(uint64(m.BlockHeight))
+	}
+	if m.BlockTimeMs != 0 {
+		n += 1 + sovEvent(uint64(m.BlockTimeMs))
+	}
+	return n
+}
+
+func (m *PoolRepeggedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pair)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.NetSize.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.Cost.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.OldMarkPrice.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.NewMarkPrice.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvent(uint64(m.BlockHeight))
+	}
+	if m.BlockTimeMs != 0 {
+		n += 1 + sovEvent(uint64(m.BlockTimeMs))
+	}
+	return n
+}
+
+func (m *PoolDepthAdjustedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pair)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.Multiplier.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.NetSize.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.Cost.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.OldQuoteReserve.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.NewQuoteReserve.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.TargetQuoteReserve.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvent(uint64(m.BlockHeight))
+	}
+	if m.BlockTimeMs != 0 {
+		n += 1 + sovEvent(uint64(m.BlockTimeMs))
+	}
+	return n
+}
+
+func sovEvent(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvent(x uint64) (n int) {
+	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PositionChangedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PositionChangedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TraderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Margin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Margin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionNotional", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PositionNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedPositionSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangedPositionSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransactionFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TransactionFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PositionSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RealizedPnl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RealizedPnl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnrealizedPnlAfter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnrealizedPnlAfter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BadDebt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BadDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationPenalty", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidationPenalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MarkPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingPayment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FundingPayment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimeMs", wireType)
+			}
+			m.BlockTimeMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockTimeMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PositionLiquidatedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PositionLiquidatedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TraderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedQuoteAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangedQuoteAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedPositionSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangedPositionSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeToLiquidator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeToLiquidator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeToEcosystemFund", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeToEcosystemFund.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BadDebt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BadDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Margin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Margin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionNotional", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PositionNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PositionSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnrealizedPnl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnrealizedPnl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MarkPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimeMs", wireType)
+			}
+			m.BlockTimeMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockTimeMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PositionSettledEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PositionSettledEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PositionSettledEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TraderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettledCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SettledCoins = append(m.SettledCoins, types.Coin{})
+			if err := m.SettledCoins[len(m.SettledCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SettlementPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PositionSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BadDebt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BadDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimeMs", wireType)
+			}
+			m.BlockTimeMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockTimeMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketSettledEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketSettledEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketSettledEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SettlementPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultBalance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VaultBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrepaidBadDebt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PrepaidBadDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimeMs", wireType)
+			}
+			m.BlockTimeMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockTimeMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultSurplus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VaultSurplus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClearedPrepaidBadDebt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClearedPrepaidBadDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FundingRateChangedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FundingRateChangedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FundingRateChangedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MarkPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IndexPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestFundingRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LatestFundingRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestPremiumFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LatestPremiumFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativePremiumFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CumulativePremiumFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimeMs", wireType)
+			}
+			m.BlockTimeMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockTimeMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawPremiumFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RawPremiumFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawFundingRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RawFundingRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClampReason", wireType)
+			}
+			m.ClampReason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClampReason |= FundingRateClampReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OrderPlacedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrderPlacedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrderPlacedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimeMs", wireType)
+			}
+			m.BlockTimeMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockTimeMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OrderCancelledEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrderCancelledEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrderCancelledEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimeMs", wireType)
+			}
+			m.BlockTimeMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockTimeMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OrderExecutedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrderExecutedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrderExecutedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MarkPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IndexPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimeMs", wireType)
+			}
+			m.BlockTimeMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockTimeMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CrossMarginCollateralChangedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CrossMarginCollateralChangedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CrossMarginCollateralChangedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TraderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Collateral = append(m.Collateral, types.Coin{})
+			if err := m.Collateral[len(m.Collateral)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralChange", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CollateralChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimeMs", wireType)
+			}
+			m.BlockTimeMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockTimeMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BadDebtCoveredEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BadDebtCoveredEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BadDebtCoveredEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShortfallId", wireType)
+			}
+			m.ShortfallId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShortfallId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payer", wireType)
+			}
+			m.Payer = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Payer |= BadDebtPayer(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingBadDebt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemainingBadDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimeMs", wireType)
+			}
+			m.BlockTimeMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockTimeMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *FundingRateChangedEvent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Pair)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = m.MarkPrice.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.IndexPrice.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.LatestFundingRate.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.LatestPremiumFraction.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.CumulativePremiumFraction.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	if m.BlockHeight != 0 {
-		n += 1 + sovEvent(uint64(m.BlockHeight))
-	}
-	if m.BlockTimeMs != 0 {
-		n += 1 + sovEvent(uint64(m.BlockTimeMs))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovEvent(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvent(x uint64) (n int) {
-	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
+func (m *PositionAutoDeleveragedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1130,10 +6486,10 @@ func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PositionChangedEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: PositionAutoDeleveragedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PositionChangedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PositionAutoDeleveragedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1201,10 +6557,29 @@ func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
 			m.TraderAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShortfallId", wireType)
+			}
+			m.ShortfallId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShortfallId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Margin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BankruptcyPrice", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1214,28 +6589,29 @@ func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Margin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BankruptcyPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PositionNotional", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedPositionSize", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1263,13 +6639,147 @@ func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PositionNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExchangedPositionSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedPositionSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedQuoteAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangedQuoteAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RealizedPnl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RealizedPnl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoveredBadDebt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CoveredBadDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Margin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Margin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionSize", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1297,15 +6807,15 @@ func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExchangedPositionSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PositionSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransactionFee", wireType)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			var msglen int
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1315,30 +6825,16 @@ func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.BlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.TransactionFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PositionSize", wireType)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimeMs", wireType)
 			}
-			var stringLen uint64
+			m.BlockTimeMs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1348,29 +6844,64 @@ func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BlockTimeMs |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvent
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PositionSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidationAuctionStartedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
 			}
-			iNdEx = postIndex
-		case 8:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidationAuctionStartedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidationAuctionStartedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RealizedPnl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1398,13 +6929,11 @@ func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RealizedPnl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Pair = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnrealizedPnlAfter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TraderAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1432,15 +6961,13 @@ func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.UnrealizedPnlAfter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TraderAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BadDebt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1450,28 +6977,27 @@ func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BadDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.LiquidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationPenalty", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionSize", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1499,13 +7025,13 @@ func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LiquidationPenalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PositionSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarkPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1533,13 +7059,13 @@ func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MarkPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.IndexPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FundingPayment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1567,11 +7093,30 @@ func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FundingPayment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LimitPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTimeMs", wireType)
+			}
+			m.EndTimeMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTimeMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
@@ -1590,7 +7135,7 @@ func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 15:
+		case 9:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimeMs", wireType)
 			}
@@ -1630,7 +7175,7 @@ func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
+func (m *LiquidationAuctionBidEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1653,10 +7198,10 @@ func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PositionLiquidatedEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: LiquidationAuctionBidEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PositionLiquidatedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LiquidationAuctionBidEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1725,7 +7270,7 @@ func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedQuoteAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1753,13 +7298,11 @@ func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExchangedQuoteAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedPositionSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1787,45 +7330,13 @@ func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExchangedPositionSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidatorAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.LiquidatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeToLiquidator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Margin", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1852,15 +7363,15 @@ func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FeeToLiquidator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Margin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeToEcosystemFund", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			var msglen int
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1870,30 +7381,16 @@ func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.BlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.FeeToEcosystemFund.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BadDebt", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimeMs", wireType)
 			}
-			var msglen int
+			m.BlockTimeMs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1903,30 +7400,66 @@ func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.BlockTimeMs |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvent
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvent
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidationAuctionEndedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BadDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 9:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidationAuctionEndedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidationAuctionEndedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Margin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1936,28 +7469,27 @@ func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Margin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Pair = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PositionNotional", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TraderAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1985,15 +7517,13 @@ func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PositionNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TraderAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PositionSize", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outcome", wireType)
 			}
-			var stringLen uint64
+			m.Outcome = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -2003,29 +7533,14 @@ func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Outcome |= LiquidationAuctionOutcome(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.PositionSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 12:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnrealizedPnl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Winner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2053,13 +7568,11 @@ func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.UnrealizedPnl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Winner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarkPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2087,11 +7600,11 @@ func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MarkPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
+		case 6:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
@@ -2110,7 +7623,7 @@ func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 15:
+		case 7:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimeMs", wireType)
 			}
@@ -2150,7 +7663,7 @@ func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PositionSettledEvent) Unmarshal(dAtA []byte) error {
+func (m *PoolRepeggedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2173,10 +7686,10 @@ func (m *PositionSettledEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PositionSettledEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolRepeggedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PositionSettledEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolRepeggedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2213,7 +7726,7 @@ func (m *PositionSettledEvent) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TraderAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NetSize", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2241,13 +7754,15 @@ func (m *PositionSettledEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TraderAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.NetSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SettledCoins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cost", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -2257,26 +7772,132 @@ func (m *PositionSettledEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SettledCoins = append(m.SettledCoins, types.Coin{})
-			if err := m.SettledCoins[len(m.SettledCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Cost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldMarkPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OldMarkPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewMarkPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NewMarkPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimeMs", wireType)
+			}
+			m.BlockTimeMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockTimeMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -2298,7 +7919,7 @@ func (m *PositionSettledEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FundingRateChangedEvent) Unmarshal(dAtA []byte) error {
+func (m *PoolDepthAdjustedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2321,10 +7942,10 @@ func (m *FundingRateChangedEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FundingRateChangedEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolDepthAdjustedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FundingRateChangedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolDepthAdjustedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2361,7 +7982,7 @@ func (m *FundingRateChangedEvent) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarkPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2389,13 +8010,13 @@ func (m *FundingRateChangedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MarkPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Multiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IndexPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NetSize", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2423,13 +8044,13 @@ func (m *FundingRateChangedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.IndexPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NetSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestFundingRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cost", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2457,13 +8078,13 @@ func (m *FundingRateChangedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LatestFundingRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Cost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestPremiumFraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldQuoteReserve", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2491,13 +8112,13 @@ func (m *FundingRateChangedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LatestPremiumFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OldQuoteReserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CumulativePremiumFraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewQuoteReserve", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2525,11 +8146,45 @@ func (m *FundingRateChangedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CumulativePremiumFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NewQuoteReserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetQuoteReserve", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetQuoteReserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
@@ -2548,7 +8203,7 @@ func (m *FundingRateChangedEvent) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 8:
+		case 9:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimeMs", wireType)
 			}
@@ -110,6 +110,12 @@ type VpoolKeeper interface {
 	GetMaxLeverage(ctx sdk.Context, pair common.AssetPair) sdk.Dec
 	ExistsPool(ctx sdk.Context, pair common.AssetPair) bool
 	GetSettlementPrice(ctx sdk.Context, pair common.AssetPair) (sdk.Dec, error)
+	IsPoolSettled(ctx sdk.Context, pair common.AssetPair) bool
+	GetPoolStatus(ctx sdk.Context, pair common.AssetPair) vpooltypes.PoolStatus
+	RepegPool(ctx sdk.Context, pair common.AssetPair) (vpooltypes.VPool, error)
+	GetPool(ctx sdk.Context, pair common.AssetPair) (vpooltypes.VPool, error)
+	AdjustPoolDepth(ctx sdk.Context, pair common.AssetPair, multiplier sdk.Dec) (vpooltypes.VPool, error)
+	GetQuoteVolume(ctx sdk.Context, pair common.AssetPair, since time.Time) sdk.Dec
 }
 
 type EpochKeeper interface {
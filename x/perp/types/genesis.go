@@ -2,15 +2,29 @@ package types
 
 import (
 	"fmt"
+
+	"github.com/NibiruChain/nibiru/collections"
+	"github.com/NibiruChain/nibiru/x/common"
 )
 
 // DefaultGenesis returns the default Capability genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		Params:          DefaultParams(),
-		PairMetadata:    []PairMetadata{},
-		Positions:       []Position{},
-		PrepaidBadDebts: []PrepaidBadDebt{},
+		Params:              DefaultParams(),
+		PairMetadata:        []PairMetadata{},
+		Positions:           []Position{},
+		PrepaidBadDebts:     []PrepaidBadDebt{},
+		Orders:              []Order{},
+		NextOrderId:         collections.DefaultSequenceStart,
+		CrossMarginAccounts: []CrossMarginAccount{},
+		SettledPairs:        []common.AssetPair{},
+		InsuranceFunds:      []InsuranceFund{},
+		Shortfalls:          []Shortfall{},
+		NextShortfallId:     collections.DefaultSequenceStart,
+		TraderVolumes:       []TraderVolume{},
+		FundingRates:        []FundingRate{},
+		LiquidationAuctions: []LiquidationAuction{},
+		SettlementCursors:   []SettlementCursor{},
 	}
 }
 
@@ -39,5 +53,65 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	for i, o := range gs.Orders {
+		if err := o.Validate(); err != nil {
+			return fmt.Errorf("malformed order %s at index %d: %w", &o, i, err)
+		}
+		if o.Id >= gs.NextOrderId {
+			return fmt.Errorf("order id %d at index %d is not below the next order id %d", o.Id, i, gs.NextOrderId)
+		}
+	}
+
+	for i, a := range gs.CrossMarginAccounts {
+		if err := a.Validate(); err != nil {
+			return fmt.Errorf("malformed cross margin account %s at index %d: %w", &a, i, err)
+		}
+	}
+
+	for i, pair := range gs.SettledPairs {
+		if err := pair.Validate(); err != nil {
+			return fmt.Errorf("malformed settled pair %s at index %d: %w", pair, i, err)
+		}
+	}
+
+	for i, c := range gs.SettlementCursors {
+		if err := c.Validate(); err != nil {
+			return fmt.Errorf("malformed settlement cursor %s at index %d: %w", &c, i, err)
+		}
+	}
+
+	for i, f := range gs.InsuranceFunds {
+		if err := f.Validate(); err != nil {
+			return fmt.Errorf("malformed insurance fund %s at index %d: %w", &f, i, err)
+		}
+	}
+
+	for i, s := range gs.Shortfalls {
+		if err := s.Validate(); err != nil {
+			return fmt.Errorf("malformed shortfall %s at index %d: %w", &s, i, err)
+		}
+		if s.Id >= gs.NextShortfallId {
+			return fmt.Errorf("shortfall id %d at index %d is not below the next shortfall id %d", s.Id, i, gs.NextShortfallId)
+		}
+	}
+
+	for i, v := range gs.TraderVolumes {
+		if err := v.Validate(); err != nil {
+			return fmt.Errorf("malformed trader volume %s at index %d: %w", &v, i, err)
+		}
+	}
+
+	for i, r := range gs.FundingRates {
+		if err := r.Validate(); err != nil {
+			return fmt.Errorf("malformed funding rate %s at index %d: %w", &r, i, err)
+		}
+	}
+
+	for i, a := range gs.LiquidationAuctions {
+		if err := a.Validate(); err != nil {
+			return fmt.Errorf("malformed liquidation auction %s at index %d: %w", &a, i, err)
+		}
+	}
+
 	return nil
 }
@@ -5,7 +5,7 @@ package types
 
 import (
 	fmt "fmt"
-	_ "github.com/NibiruChain/nibiru/x/common"
+	common "github.com/NibiruChain/nibiru/x/common"
 	_ "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
@@ -32,6 +32,21 @@ type GenesisState struct {
 	PairMetadata    []PairMetadata   `protobuf:"bytes,2,rep,name=pair_metadata,json=pairMetadata,proto3" json:"pair_metadata"`
 	Positions       []Position       `protobuf:"bytes,3,rep,name=positions,proto3" json:"positions"`
 	PrepaidBadDebts []PrepaidBadDebt `protobuf:"bytes,4,rep,name=prepaid_bad_debts,json=prepaidBadDebts,proto3" json:"prepaid_bad_debts"`
+	Orders          []Order          `protobuf:"bytes,5,rep,name=orders,proto3" json:"orders"`
+	// the id that will be assigned to the next placed order
+	NextOrderId         uint64               `protobuf:"varint,6,opt,name=next_order_id,json=nextOrderId,proto3" json:"next_order_id,omitempty"`
+	CrossMarginAccounts []CrossMarginAccount `protobuf:"bytes,7,rep,name=cross_margin_accounts,json=crossMarginAccounts,proto3" json:"cross_margin_accounts"`
+	// pairs whose settled market has been entirely settled
+	SettledPairs   []common.AssetPair `protobuf:"bytes,8,rep,name=settled_pairs,json=settledPairs,proto3" json:"settled_pairs"`
+	InsuranceFunds []InsuranceFund    `protobuf:"bytes,9,rep,name=insurance_funds,json=insuranceFunds,proto3" json:"insurance_funds"`
+	Shortfalls     []Shortfall        `protobuf:"bytes,10,rep,name=shortfalls,proto3" json:"shortfalls"`
+	// the id that will be assigned to the next shortfall
+	NextShortfallId     uint64               `protobuf:"varint,11,opt,name=next_shortfall_id,json=nextShortfallId,proto3" json:"next_shortfall_id,omitempty"`
+	TraderVolumes       []TraderVolume       `protobuf:"bytes,12,rep,name=trader_volumes,json=traderVolumes,proto3" json:"trader_volumes"`
+	FundingRates        []FundingRate        `protobuf:"bytes,13,rep,name=funding_rates,json=fundingRates,proto3" json:"funding_rates"`
+	LiquidationAuctions []LiquidationAuction `protobuf:"bytes,14,rep,name=liquidation_auctions,json=liquidationAuctions,proto3" json:"liquidation_auctions"`
+	// where the settlement sweep of the settled markets still being swept stopped
+	SettlementCursors []SettlementCursor `protobuf:"bytes,15,rep,name=settlement_cursors,json=settlementCursors,proto3" json:"settlement_cursors"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -95,6 +110,83 @@ func (m *GenesisState) GetPrepaidBadDebts() []PrepaidBadDebt {
 	return nil
 }
 
+func (m *GenesisState) GetOrders() []Order {
+	if m != nil {
+		return m.Orders
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextOrderId() uint64 {
+	if m != nil {
+		return m.NextOrderId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetCrossMarginAccounts() []CrossMarginAccount {
+	if m != nil {
+		return m.CrossMarginAccounts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSettledPairs() []common.AssetPair {
+	if m != nil {
+		return m.SettledPairs
+	}
+	return nil
+}
+
+func (m *GenesisState) GetInsuranceFunds() []InsuranceFund {
+	if m != nil {
+		return m.InsuranceFunds
+	}
+	return nil
+}
+
+func (m *GenesisState) GetShortfalls() []Shortfall {
+	if m != nil {
+		return m.Shortfalls
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextShortfallId() uint64 {
+	if m != nil {
+		return m.NextShortfallId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetTraderVolumes() []TraderVolume {
+	if m != nil {
+		return m.TraderVolumes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFundingRates() []FundingRate {
+	if m != nil {
+		return m.FundingRates
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLiquidationAuctions() []LiquidationAuction {
+	if m != nil {
+		return m.LiquidationAuctions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSettlementCursors() []SettlementCursor {
+	if m != nil {
+		return m.SettlementCursors
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "nibiru.perp.v1.GenesisState")
 }
@@ -102,29 +194,48 @@ func init() {
 func init() { proto.RegisterFile("perp/v1/genesis.proto", fileDescriptor_24e163498ed621a8) }
 
 var fileDescriptor_24e163498ed621a8 = []byte{
-	// 348 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x90, 0xcf, 0x6a, 0x2a, 0x31,
-	0x14, 0xc6, 0x67, 0x54, 0x84, 0x3b, 0x7a, 0xef, 0xa5, 0xd3, 0x3f, 0x0c, 0x22, 0xa9, 0x74, 0x25,
-	0x5d, 0x4c, 0x18, 0xdb, 0x65, 0x57, 0x56, 0x70, 0xd5, 0x22, 0xed, 0xae, 0x1b, 0x39, 0x99, 0x09,
-	0x63, 0xa0, 0x93, 0x13, 0x26, 0x51, 0xda, 0xb7, 0xe8, 0x0b, 0xf4, 0x7d, 0x5c, 0xba, 0xec, 0xaa,
-	0x14, 0x7d, 0x91, 0x62, 0x26, 0xd2, 0x8a, 0xab, 0x84, 0xef, 0xfb, 0xce, 0x2f, 0x5f, 0x4e, 0x70,
-	0xaa, 0x78, 0xa9, 0xe8, 0x22, 0xa1, 0x39, 0x97, 0x5c, 0x0b, 0x1d, 0xab, 0x12, 0x0d, 0x86, 0xff,
-	0xa4, 0x60, 0xa2, 0x9c, 0xc7, 0x5b, 0x37, 0x5e, 0x24, 0x9d, 0x93, 0x1c, 0x73, 0xb4, 0x16, 0xdd,
-	0xde, 0xaa, 0x54, 0xa7, 0x9b, 0x23, 0xe6, 0xcf, 0x9c, 0x82, 0x12, 0x14, 0xa4, 0x44, 0x03, 0x46,
-	0xa0, 0x74, 0x8c, 0x0e, 0x49, 0x51, 0x17, 0xa8, 0x29, 0x03, 0xcd, 0xe9, 0x22, 0x61, 0xdc, 0x40,
-	0x42, 0x53, 0x14, 0xd2, 0xf9, 0xc7, 0x29, 0x16, 0x05, 0x4a, 0x5a, 0x1d, 0x3b, 0x71, 0xd7, 0x47,
-	0x1b, 0x30, 0xbc, 0x12, 0x2f, 0xde, 0x6b, 0x41, 0x7b, 0x5c, 0xf5, 0x7b, 0xdc, 0xca, 0xe1, 0x75,
-	0xd0, 0x54, 0x50, 0x42, 0xa1, 0x23, 0xbf, 0xe7, 0xf7, 0x5b, 0x83, 0xb3, 0x78, 0xbf, 0x6f, 0x3c,
-	0xb1, 0xee, 0xb0, 0xb1, 0xfc, 0x3c, 0xf7, 0x1e, 0x5c, 0x36, 0x1c, 0x07, 0x7f, 0x15, 0x88, 0x72,
-	0x5a, 0x70, 0x03, 0x19, 0x18, 0x88, 0x6a, 0xbd, 0x7a, 0xbf, 0x35, 0xe8, 0x1e, 0x0e, 0x8b, 0xf2,
-	0xce, 0x65, 0x1c, 0xa2, 0xad, 0x7e, 0x69, 0xe1, 0x4d, 0xf0, 0x47, 0xa1, 0x16, 0xf6, 0xb3, 0x51,
-	0xdd, 0x42, 0xa2, 0x03, 0x88, 0x0b, 0x38, 0xc0, 0xcf, 0x40, 0x38, 0x09, 0x8e, 0x54, 0xc9, 0x15,
-	0x88, 0x6c, 0xca, 0x20, 0x9b, 0x66, 0x9c, 0x19, 0x1d, 0x35, 0x2c, 0x85, 0x1c, 0x50, 0xaa, 0xe0,
-	0x10, 0xb2, 0x11, 0x67, 0xc6, 0xb1, 0xfe, 0xab, 0x3d, 0x55, 0x0f, 0x47, 0xcb, 0x35, 0xf1, 0x57,
-	0x6b, 0xe2, 0x7f, 0xad, 0x89, 0xff, 0xb6, 0x21, 0xde, 0x6a, 0x43, 0xbc, 0x8f, 0x0d, 0xf1, 0x9e,
-	0x2e, 0x73, 0x61, 0x66, 0x73, 0x16, 0xa7, 0x58, 0xd0, 0x7b, 0x8b, 0xbe, 0x9d, 0x81, 0x90, 0xb4,
-	0x7a, 0x86, 0xbe, 0x50, 0xbb, 0x6e, 0xf3, 0xaa, 0xb8, 0x66, 0x4d, 0xbb, 0xec, 0xab, 0xef, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x20, 0xf0, 0x8f, 0x0d, 0x13, 0x02, 0x00, 0x00,
+	// 652 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x94, 0x4f, 0x4f, 0xdb, 0x3e,
+	0x18, 0xc7, 0xdb, 0x1f, 0xfc, 0x3a, 0x70, 0xff, 0x09, 0x03, 0x93, 0xc7, 0x58, 0x56, 0x71, 0x42,
+	0x1c, 0x1a, 0x15, 0x76, 0x9c, 0x34, 0x41, 0x11, 0xa8, 0x12, 0x6c, 0x08, 0xb6, 0x1d, 0xb6, 0x49,
+	0x91, 0x93, 0x98, 0x60, 0x29, 0xb1, 0x33, 0x3f, 0x4e, 0xc5, 0xde, 0xc5, 0xee, 0x7b, 0x43, 0x1c,
+	0x39, 0xee, 0x34, 0x4d, 0xf0, 0x46, 0x26, 0x3b, 0x0e, 0xf4, 0x0f, 0xa7, 0xb6, 0xdf, 0xe7, 0xe3,
+	0x8f, 0x9d, 0xe7, 0x71, 0x83, 0xd6, 0x73, 0xa6, 0x72, 0x7f, 0x3c, 0xf0, 0x13, 0x26, 0x18, 0x70,
+	0xe8, 0xe7, 0x4a, 0x6a, 0x89, 0x3b, 0x82, 0x87, 0x5c, 0x15, 0x7d, 0x53, 0xed, 0x8f, 0x07, 0x1b,
+	0x6b, 0x89, 0x4c, 0xa4, 0x2d, 0xf9, 0xe6, 0x5b, 0x49, 0x6d, 0x6c, 0x26, 0x52, 0x26, 0x29, 0xf3,
+	0x69, 0xce, 0x7d, 0x2a, 0x84, 0xd4, 0x54, 0x73, 0x29, 0x9c, 0x63, 0xc3, 0x8b, 0x24, 0x64, 0x12,
+	0xfc, 0x90, 0x02, 0xf3, 0xc7, 0x83, 0x90, 0x69, 0x3a, 0xf0, 0x23, 0xc9, 0x85, 0xab, 0xaf, 0x46,
+	0x32, 0xcb, 0xa4, 0xf0, 0xcb, 0x8f, 0x2a, 0xac, 0xce, 0x03, 0x9a, 0x6a, 0x56, 0x86, 0x5b, 0xbf,
+	0x96, 0x50, 0xeb, 0xb8, 0x3c, 0xdf, 0x85, 0x89, 0xf1, 0x1b, 0xd4, 0xc8, 0xa9, 0xa2, 0x19, 0x90,
+	0x7a, 0xaf, 0xbe, 0xdd, 0xdc, 0x7d, 0xde, 0x9f, 0x3e, 0x6f, 0xff, 0xcc, 0x56, 0x0f, 0x16, 0x6f,
+	0xfe, 0xbc, 0xae, 0x9d, 0x3b, 0x16, 0x1f, 0xa3, 0x76, 0x4e, 0xb9, 0x0a, 0x32, 0xa6, 0x69, 0x4c,
+	0x35, 0x25, 0xff, 0xf5, 0x16, 0xb6, 0x9b, 0xbb, 0x9b, 0xf3, 0x8b, 0xb9, 0x3a, 0x75, 0x8c, 0x53,
+	0xb4, 0xf2, 0x89, 0x0c, 0xbf, 0x45, 0xcb, 0xb9, 0x04, 0x6e, 0x1f, 0x96, 0x2c, 0x58, 0x09, 0x99,
+	0x93, 0x38, 0xc0, 0x09, 0x1e, 0x17, 0xe0, 0x33, 0xb4, 0x92, 0x2b, 0x96, 0x53, 0x1e, 0x07, 0x21,
+	0x8d, 0x83, 0x98, 0x85, 0x1a, 0xc8, 0xa2, 0xb5, 0x78, 0x73, 0x96, 0x12, 0x3c, 0xa0, 0xf1, 0x21,
+	0x0b, 0xb5, 0x73, 0x75, 0xf3, 0xa9, 0x14, 0xf0, 0x1e, 0x6a, 0x48, 0x15, 0x33, 0x05, 0xe4, 0x7f,
+	0xab, 0x59, 0x9f, 0xd5, 0x7c, 0x30, 0xd5, 0xaa, 0x1b, 0x25, 0x8a, 0xb7, 0x50, 0x5b, 0xb0, 0x6b,
+	0x1d, 0xd8, 0x9f, 0x01, 0x8f, 0x49, 0xa3, 0x57, 0xdf, 0x5e, 0x3c, 0x6f, 0x9a, 0xd0, 0xf2, 0xa3,
+	0x18, 0x7f, 0x43, 0xeb, 0x91, 0x92, 0x00, 0x41, 0x46, 0x55, 0xc2, 0x45, 0x40, 0xa3, 0x48, 0x16,
+	0x42, 0x03, 0x79, 0x66, 0xf7, 0xd9, 0x9a, 0xdd, 0x67, 0x68, 0xe0, 0x53, 0xcb, 0xee, 0x97, 0xa8,
+	0xdb, 0x74, 0x35, 0x9a, 0xab, 0x00, 0x1e, 0xa2, 0x36, 0x30, 0xad, 0x53, 0x16, 0x07, 0xa6, 0xbd,
+	0x40, 0x96, 0xa6, 0x5b, 0xe9, 0x2e, 0xc6, 0x3e, 0x00, 0xd3, 0x66, 0x26, 0xd5, 0x2c, 0xdc, 0x22,
+	0x13, 0x01, 0x3e, 0x41, 0x5d, 0x2e, 0xa0, 0x50, 0x54, 0x44, 0x2c, 0xb8, 0x2c, 0x44, 0x0c, 0x64,
+	0xd9, 0x6a, 0x5e, 0xcd, 0x1e, 0x6e, 0x54, 0x61, 0x47, 0x85, 0x88, 0x9d, 0xab, 0xc3, 0x27, 0x43,
+	0xc0, 0xef, 0x10, 0x82, 0x2b, 0xa9, 0xf4, 0x25, 0x4d, 0x53, 0x20, 0xc8, 0x8a, 0x5e, 0xcc, 0x8a,
+	0x2e, 0x2a, 0xc2, 0x49, 0x26, 0x96, 0xe0, 0x1d, 0xb4, 0x62, 0xbb, 0xfa, 0x10, 0x99, 0xce, 0x36,
+	0x6d, 0x67, 0xbb, 0xa6, 0xf0, 0xb0, 0x76, 0x14, 0xe3, 0x11, 0xea, 0x68, 0x45, 0x4d, 0xf7, 0xc7,
+	0x32, 0x2d, 0x32, 0x06, 0xa4, 0xf5, 0xf4, 0x85, 0xfc, 0x68, 0xa9, 0xcf, 0x16, 0x72, 0x7b, 0xb6,
+	0xf5, 0x44, 0x06, 0xf8, 0x08, 0xb5, 0xcd, 0xb3, 0x73, 0x91, 0x04, 0x8a, 0x6a, 0x06, 0xa4, 0x6d,
+	0x4d, 0x2f, 0x67, 0x4d, 0x47, 0x25, 0x74, 0x4e, 0x75, 0x25, 0x6a, 0x5d, 0x3e, 0x46, 0x80, 0xbf,
+	0xa2, 0xb5, 0x94, 0x7f, 0x2f, 0x78, 0x6c, 0xff, 0xc9, 0x01, 0x2d, 0xa2, 0xf2, 0x92, 0x77, 0x9e,
+	0x9e, 0xf7, 0xc9, 0x23, 0xbb, 0x5f, 0xa2, 0xd5, 0xbc, 0xd3, 0xb9, 0x0a, 0xe0, 0x4f, 0x08, 0x97,
+	0xa3, 0xcb, 0x98, 0xd0, 0x41, 0x54, 0x28, 0x90, 0x0a, 0x48, 0xd7, 0xaa, 0x7b, 0x73, 0x4d, 0x7e,
+	0x20, 0x87, 0x16, 0x74, 0xe2, 0x15, 0x98, 0xc9, 0xe1, 0xe0, 0xf0, 0xe6, 0xce, 0xab, 0xdf, 0xde,
+	0x79, 0xf5, 0xbf, 0x77, 0x5e, 0xfd, 0xe7, 0xbd, 0x57, 0xbb, 0xbd, 0xf7, 0x6a, 0xbf, 0xef, 0xbd,
+	0xda, 0x97, 0x9d, 0x84, 0xeb, 0xab, 0x22, 0x34, 0x17, 0xc9, 0x7f, 0x6f, 0xf5, 0xc3, 0x2b, 0xca,
+	0x85, 0x5f, 0x6e, 0xe5, 0x5f, 0xfb, 0xf6, 0x65, 0xa3, 0x7f, 0xe4, 0x0c, 0xc2, 0x86, 0x7d, 0xd5,
+	0xec, 0xfd, 0x1b, 0x00, 0xd7, 0xb5, 0x5c, 0x03, 0x11, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -147,6 +258,142 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SettlementCursors) > 0 {
+		for iNdEx := len(m.SettlementCursors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SettlementCursors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.LiquidationAuctions) > 0 {
+		for iNdEx := len(m.LiquidationAuctions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LiquidationAuctions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.FundingRates) > 0 {
+		for iNdEx := len(m.FundingRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FundingRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.TraderVolumes) > 0 {
+		for iNdEx := len(m.TraderVolumes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TraderVolumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.NextShortfallId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextShortfallId))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.Shortfalls) > 0 {
+		for iNdEx := len(m.Shortfalls) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Shortfalls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.InsuranceFunds) > 0 {
+		for iNdEx := len(m.InsuranceFunds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InsuranceFunds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.SettledPairs) > 0 {
+		for iNdEx := len(m.SettledPairs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SettledPairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.CrossMarginAccounts) > 0 {
+		for iNdEx := len(m.CrossMarginAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CrossMarginAccounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.NextOrderId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextOrderId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Orders) > 0 {
+		for iNdEx := len(m.Orders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Orders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.PrepaidBadDebts) > 0 {
 		for iNdEx := len(m.PrepaidBadDebts) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -239,6 +486,66 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Orders) > 0 {
+		for _, e := range m.Orders {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextOrderId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextOrderId))
+	}
+	if len(m.CrossMarginAccounts) > 0 {
+		for _, e := range m.CrossMarginAccounts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SettledPairs) > 0 {
+		for _, e := range m.SettledPairs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.InsuranceFunds) > 0 {
+		for _, e := range m.InsuranceFunds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Shortfalls) > 0 {
+		for _, e := range m.Shortfalls {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextShortfallId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextShortfallId))
+	}
+	if len(m.TraderVolumes) > 0 {
+		for _, e := range m.TraderVolumes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FundingRates) > 0 {
+		for _, e := range m.FundingRates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LiquidationAuctions) > 0 {
+		for _, e := range m.LiquidationAuctions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SettlementCursors) > 0 {
+		for _, e := range m.SettlementCursors {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -412,6 +719,350 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orders = append(m.Orders, Order{})
+			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextOrderId", wireType)
+			}
+			m.NextOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CrossMarginAccounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CrossMarginAccounts = append(m.CrossMarginAccounts, CrossMarginAccount{})
+			if err := m.CrossMarginAccounts[len(m.CrossMarginAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettledPairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SettledPairs = append(m.SettledPairs, common.AssetPair{})
+			if err := m.SettledPairs[len(m.SettledPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsuranceFunds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InsuranceFunds = append(m.InsuranceFunds, InsuranceFund{})
+			if err := m.InsuranceFunds[len(m.InsuranceFunds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shortfalls", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shortfalls = append(m.Shortfalls, Shortfall{})
+			if err := m.Shortfalls[len(m.Shortfalls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextShortfallId", wireType)
+			}
+			m.NextShortfallId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextShortfallId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraderVolumes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TraderVolumes = append(m.TraderVolumes, TraderVolume{})
+			if err := m.TraderVolumes[len(m.TraderVolumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FundingRates = append(m.FundingRates, FundingRate{})
+			if err := m.FundingRates[len(m.FundingRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationAuctions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidationAuctions = append(m.LiquidationAuctions, LiquidationAuction{})
+			if err := m.LiquidationAuctions[len(m.LiquidationAuctions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementCursors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SettlementCursors = append(m.SettlementCursors, SettlementCursor{})
+			if err := m.SettlementCursors[len(m.SettlementCursors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
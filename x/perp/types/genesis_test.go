@@ -2,6 +2,7 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	"github.com/NibiruChain/nibiru/x/testutil"
 
@@ -22,8 +23,8 @@ func TestGenesisState_Validate(t *testing.T) {
 				Params: DefaultParams(),
 				PairMetadata: []PairMetadata{
 					{
-						Pair:                       common.MustNewAssetPair("pair1:pair2"),
-						CumulativePremiumFractions: []sdk.Dec{sdk.MustNewDecFromStr("0.1")},
+						Pair:                            common.MustNewAssetPair("pair1:pair2"),
+						LatestCumulativePremiumFraction: sdk.MustNewDecFromStr("0.1"),
 					},
 				},
 				Positions: []Position{
@@ -43,6 +44,51 @@ func TestGenesisState_Validate(t *testing.T) {
 						Amount: sdk.NewInt(10),
 					},
 				},
+				Orders: []Order{
+					{
+						Id:              1,
+						TraderAddress:   testutil.AccAddress().String(),
+						Pair:            common.MustNewAssetPair("valid:pair"),
+						OrderType:       OrderType_TAKE_PROFIT,
+						Side:            Side_BUY,
+						TriggerPrice:    sdk.MustNewDecFromStr("1000"),
+						ExecutionReward: sdk.NewInt64Coin("pair", 10),
+					},
+				},
+				NextOrderId: 2,
+				CrossMarginAccounts: []CrossMarginAccount{
+					{
+						TraderAddress: testutil.AccAddress().String(),
+						Collateral:    sdk.NewCoins(sdk.NewInt64Coin("pair", 10)),
+					},
+				},
+				InsuranceFunds: []InsuranceFund{
+					{
+						Denom:               "pair",
+						TotalContributions:  sdk.NewInt(10),
+						TotalPayouts:        sdk.NewInt(5),
+						TotalSocializedLoss: sdk.ZeroInt(),
+					},
+				},
+				Shortfalls: []Shortfall{
+					{
+						Id:                        1,
+						Denom:                     "pair",
+						BadDebt:                   sdk.NewInt(10),
+						CoveredByInsuranceFund:    sdk.NewInt(5),
+						CoveredByEcosystemFund:    sdk.NewInt(5),
+						CoveredByAutoDeleveraging: sdk.ZeroInt(),
+						SocializedLoss:            sdk.ZeroInt(),
+					},
+				},
+				NextShortfallId: 2,
+				TraderVolumes: []TraderVolume{
+					{
+						TraderAddress: testutil.AccAddress().String(),
+						Day:           19_000,
+						Volume:        sdk.NewDec(1_000),
+					},
+				},
 			},
 			wantErr: false,
 		},
@@ -66,6 +112,21 @@ func TestGenesisState_Validate(t *testing.T) {
 			g:       &GenesisState{Params: DefaultParams(), PairMetadata: []PairMetadata{{Pair: common.AssetPair{}}}},
 			wantErr: true,
 		},
+		"margin price policy with a lookback window on the index": {
+			g: &GenesisState{Params: DefaultParams(), PairMetadata: []PairMetadata{{
+				Pair:                            common.MustNewAssetPair("valid:pair"),
+				LatestCumulativePremiumFraction: sdk.ZeroDec(),
+				MarginPricePolicy: &MarginPricePolicy{
+					Sources: []MarginPriceSource{{
+						Type:           MarginPriceSourceType_MARGIN_PRICE_SOURCE_INDEX,
+						LookbackWindow: time.Hour,
+					}},
+					Preference:            PnLPreferenceOption_MIN,
+					FullLiquidationSource: MarginPriceSource{Type: MarginPriceSourceType_MARGIN_PRICE_SOURCE_SPOT},
+				},
+			}}},
+			wantErr: true,
+		},
 
 		"bad prepaid bad debt": {
 			g: &GenesisState{Params: DefaultParams(), PrepaidBadDebts: []PrepaidBadDebt{{
@@ -74,6 +135,142 @@ func TestGenesisState_Validate(t *testing.T) {
 			}}},
 			wantErr: true,
 		},
+
+		"bad order": {
+			g: &GenesisState{Params: DefaultParams(), NextOrderId: 2, Orders: []Order{{
+				Id:            1,
+				TraderAddress: testutil.AccAddress().String(),
+				Pair:          common.MustNewAssetPair("valid:pair"),
+				OrderType:     OrderType_STOP_LOSS,
+				Side:          Side_SELL,
+				TriggerPrice:  sdk.ZeroDec(),
+			}}},
+			wantErr: true,
+		},
+
+		"order id not below next order id": {
+			g: &GenesisState{Params: DefaultParams(), NextOrderId: 1, Orders: []Order{{
+				Id:              1,
+				TraderAddress:   testutil.AccAddress().String(),
+				Pair:            common.MustNewAssetPair("valid:pair"),
+				OrderType:       OrderType_STOP_LOSS,
+				Side:            Side_SELL,
+				TriggerPrice:    sdk.OneDec(),
+				ExecutionReward: sdk.NewInt64Coin("pair", 10),
+			}}},
+			wantErr: true,
+		},
+
+		"bad cross margin account": {
+			g: &GenesisState{Params: DefaultParams(), NextOrderId: 1, CrossMarginAccounts: []CrossMarginAccount{{
+				TraderAddress: "invalid",
+				Collateral:    sdk.NewCoins(sdk.NewInt64Coin("pair", 10)),
+			}}},
+			wantErr: true,
+		},
+
+		"socialized loss before another payer": {
+			g: &GenesisState{Params: func() Params {
+				params := DefaultParams()
+				params.BadDebtPayoutOrder = []BadDebtPayer{BadDebtPayer_SOCIALIZED_LOSS, BadDebtPayer_INSURANCE_FUND}
+				return params
+			}()},
+			wantErr: true,
+		},
+
+		"duplicate bad debt payer": {
+			g: &GenesisState{Params: func() Params {
+				params := DefaultParams()
+				params.BadDebtPayoutOrder = []BadDebtPayer{BadDebtPayer_INSURANCE_FUND, BadDebtPayer_INSURANCE_FUND}
+				return params
+			}()},
+			wantErr: true,
+		},
+
+		"fee tiers not ordered by min volume": {
+			g: &GenesisState{Params: func() Params {
+				params := DefaultParams()
+				params.FeeTiers = []FeeTier{
+					{MinVolume: sdk.NewDec(1_000), FeeRatios: PairFeeRatios{FeePoolFeeRatio: sdk.ZeroDec(), EcosystemFundFeeRatio: sdk.ZeroDec()}},
+					{MinVolume: sdk.NewDec(1_000), FeeRatios: PairFeeRatios{FeePoolFeeRatio: sdk.ZeroDec(), EcosystemFundFeeRatio: sdk.ZeroDec()}},
+				}
+				return params
+			}()},
+			wantErr: true,
+		},
+
+		"fee tier with a bad ratio": {
+			g: &GenesisState{Params: func() Params {
+				params := DefaultParams()
+				params.FeeTiers = []FeeTier{
+					{MinVolume: sdk.NewDec(1_000), FeeRatios: PairFeeRatios{FeePoolFeeRatio: sdk.NewDec(2), EcosystemFundFeeRatio: sdk.ZeroDec()}},
+				}
+				return params
+			}()},
+			wantErr: true,
+		},
+
+		"negative max funding rate": {
+			g: &GenesisState{Params: func() Params {
+				params := DefaultParams()
+				params.MaxFundingRate = sdk.MustNewDecFromStr("-0.01")
+				return params
+			}()},
+			wantErr: true,
+		},
+
+		"premium smoothing factor of one": {
+			g: &GenesisState{Params: func() Params {
+				params := DefaultParams()
+				params.PremiumSmoothingFactor = sdk.OneDec()
+				return params
+			}()},
+			wantErr: true,
+		},
+
+		"liquidation auction max discount of one": {
+			g: &GenesisState{Params: func() Params {
+				params := DefaultParams()
+				params.LiquidationAuctionMaxDiscount = sdk.OneDec()
+				return params
+			}()},
+			wantErr: true,
+		},
+
+		"auction with a bad trader": {
+			g: &GenesisState{Params: DefaultParams(), LiquidationAuctions: []LiquidationAuction{{
+				Pair:              common.MustNewAssetPair("valid:pair"),
+				TraderAddress:     "invalid",
+				LiquidatorAddress: testutil.AccAddress().String(),
+				IndexPrice:        sdk.OneDec(),
+				LimitPrice:        sdk.OneDec(),
+				BestBidPrice:      sdk.ZeroDec(),
+				BestBidMargin:     sdk.NewInt64Coin("pair", 0),
+			}}},
+			wantErr: true,
+		},
+
+		"bad trader volume": {
+			g: &GenesisState{Params: DefaultParams(), TraderVolumes: []TraderVolume{{
+				TraderAddress: "invalid",
+				Day:           1,
+				Volume:        sdk.OneDec(),
+			}}},
+			wantErr: true,
+		},
+
+		"shortfall covered amounts do not add up": {
+			g: &GenesisState{Params: DefaultParams(), NextShortfallId: 2, Shortfalls: []Shortfall{{
+				Id:                        1,
+				Denom:                     "pair",
+				BadDebt:                   sdk.NewInt(10),
+				CoveredByInsuranceFund:    sdk.NewInt(5),
+				CoveredByEcosystemFund:    sdk.ZeroInt(),
+				CoveredByAutoDeleveraging: sdk.ZeroInt(),
+				SocializedLoss:            sdk.ZeroInt(),
+			}}},
+			wantErr: true,
+		},
 	}
 
 	for name, tc := range cases {
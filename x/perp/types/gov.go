@@ -0,0 +1,145 @@
+package types
+
+import (
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/NibiruChain/nibiru/x/common"
+)
+
+const (
+	ProposalTypePairFeeRatios        = "PairFeeRatios"
+	ProposalTypeMarginPricePolicy    = "MarginPricePolicy"
+	ProposalTypeRepegPool            = "RepegPool"
+	ProposalTypeLiquidityDepthPolicy = "LiquidityDepthPolicy"
+	ProposalTypeOpenInterestCaps     = "OpenInterestCaps"
+)
+
+var (
+	_ govtypes.Content = &PairFeeRatiosProposal{}
+	_ govtypes.Content = &MarginPricePolicyProposal{}
+	_ govtypes.Content = &RepegPoolProposal{}
+	_ govtypes.Content = &LiquidityDepthPolicyProposal{}
+	_ govtypes.Content = &OpenInterestCapsProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypePairFeeRatios)
+	govtypes.RegisterProposalTypeCodec(&PairFeeRatiosProposal{}, "nibiru/PairFeeRatiosProposal")
+	govtypes.RegisterProposalType(ProposalTypeMarginPricePolicy)
+	govtypes.RegisterProposalTypeCodec(&MarginPricePolicyProposal{}, "nibiru/MarginPricePolicyProposal")
+	govtypes.RegisterProposalType(ProposalTypeRepegPool)
+	govtypes.RegisterProposalTypeCodec(&RepegPoolProposal{}, "nibiru/RepegPoolProposal")
+	govtypes.RegisterProposalType(ProposalTypeLiquidityDepthPolicy)
+	govtypes.RegisterProposalTypeCodec(&LiquidityDepthPolicyProposal{}, "nibiru/LiquidityDepthPolicyProposal")
+	govtypes.RegisterProposalType(ProposalTypeOpenInterestCaps)
+	govtypes.RegisterProposalTypeCodec(&OpenInterestCapsProposal{}, "nibiru/OpenInterestCapsProposal")
+}
+
+func (m *PairFeeRatiosProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+func (m *PairFeeRatiosProposal) ProposalType() string {
+	return ProposalTypePairFeeRatios
+}
+
+func (m *PairFeeRatiosProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(m); err != nil {
+		return err
+	}
+
+	if _, err := common.NewAssetPair(m.Pair); err != nil {
+		return err
+	}
+
+	if m.FeeRatios != nil {
+		return m.FeeRatios.Validate()
+	}
+
+	return nil
+}
+
+func (m *MarginPricePolicyProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+func (m *MarginPricePolicyProposal) ProposalType() string {
+	return ProposalTypeMarginPricePolicy
+}
+
+func (m *MarginPricePolicyProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(m); err != nil {
+		return err
+	}
+
+	if _, err := common.NewAssetPair(m.Pair); err != nil {
+		return err
+	}
+
+	if m.Policy != nil {
+		return m.Policy.Validate()
+	}
+
+	return nil
+}
+
+func (m *RepegPoolProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+func (m *RepegPoolProposal) ProposalType() string {
+	return ProposalTypeRepegPool
+}
+
+func (m *RepegPoolProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(m); err != nil {
+		return err
+	}
+
+	_, err := common.NewAssetPair(m.Pair)
+	return err
+}
+
+func (m *LiquidityDepthPolicyProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+func (m *LiquidityDepthPolicyProposal) ProposalType() string {
+	return ProposalTypeLiquidityDepthPolicy
+}
+
+func (m *LiquidityDepthPolicyProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(m); err != nil {
+		return err
+	}
+
+	if _, err := common.NewAssetPair(m.Pair); err != nil {
+		return err
+	}
+
+	if m.Policy != nil {
+		return m.Policy.Validate()
+	}
+
+	return nil
+}
+
+func (m *OpenInterestCapsProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+func (m *OpenInterestCapsProposal) ProposalType() string {
+	return ProposalTypeOpenInterestCaps
+}
+
+func (m *OpenInterestCapsProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(m); err != nil {
+		return err
+	}
+
+	if _, err := common.NewAssetPair(m.Pair); err != nil {
+		return err
+	}
+
+	return ValidateOpenInterestCaps(m.MaxOpenInterest, m.MaxPositionSize)
+}
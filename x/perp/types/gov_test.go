@@ -0,0 +1,67 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestOpenInterestCapsProposal_ValidateBasic(t *testing.T) {
+	type test struct {
+		m         *OpenInterestCapsProposal
+		expectErr bool
+	}
+
+	cases := map[string]test{
+		"invalid pair": {&OpenInterestCapsProposal{
+			Title:           "caps proposal",
+			Description:     "some weird description",
+			Pair:            "invalidpair",
+			MaxOpenInterest: sdk.NewDec(1_000),
+			MaxPositionSize: sdk.NewDec(100),
+		}, true},
+
+		"negative max open interest": {&OpenInterestCapsProposal{
+			Title:           "caps proposal",
+			Description:     "some weird description",
+			Pair:            "valid:pair",
+			MaxOpenInterest: sdk.NewDec(-1),
+			MaxPositionSize: sdk.NewDec(100),
+		}, true},
+
+		"missing max position size": {&OpenInterestCapsProposal{
+			Title:           "caps proposal",
+			Description:     "some weird description",
+			Pair:            "valid:pair",
+			MaxOpenInterest: sdk.NewDec(1_000),
+		}, true},
+
+		"success": {&OpenInterestCapsProposal{
+			Title:           "caps proposal",
+			Description:     "some weird description",
+			Pair:            "valid:pair",
+			MaxOpenInterest: sdk.NewDec(1_000),
+			MaxPositionSize: sdk.NewDec(100),
+		}, false},
+
+		"success no caps": {&OpenInterestCapsProposal{
+			Title:           "caps proposal",
+			Description:     "some weird description",
+			Pair:            "valid:pair",
+			MaxOpenInterest: sdk.ZeroDec(),
+			MaxPositionSize: sdk.ZeroDec(),
+		}, false},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := tc.m.ValidateBasic()
+			if err == nil && tc.expectErr {
+				t.Fatal("error expected")
+			} else if err != nil && !tc.expectErr {
+				t.Fatal("unexpected error")
+			}
+		})
+	}
+}
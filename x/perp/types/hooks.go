@@ -0,0 +1,78 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PerpHooks lets other modules react to the lifecycle of the positions. The
+// hooks receive the typed event emitted for the position change.
+//
+// The hooks run in a cache context after the change: their state changes are
+// discarded if they return an error or panic, and the change goes through.
+type PerpHooks interface {
+	// AfterPositionOpened is called when a trader opens a position on a pair where they had none.
+	AfterPositionOpened(ctx sdk.Context, event PositionChangedEvent) error
+	// AfterPositionChanged is called when the size or the margin of an open position changes.
+	AfterPositionChanged(ctx sdk.Context, event PositionChangedEvent) error
+	// AfterPositionClosed is called when a position is closed by its trader or transferred away.
+	AfterPositionClosed(ctx sdk.Context, event PositionChangedEvent) error
+	// AfterPositionLiquidated is called when a position is fully or partially liquidated.
+	AfterPositionLiquidated(ctx sdk.Context, event PositionLiquidatedEvent) error
+	// AfterPositionSettled is called when a position of a settled market is settled.
+	AfterPositionSettled(ctx sdk.Context, event PositionSettledEvent) error
+}
+
+var _ PerpHooks = MultiPerpHooks{}
+
+// MultiPerpHooks combines multiple perp hooks, all hook functions are run in
+// array sequence until one of them fails.
+type MultiPerpHooks []PerpHooks
+
+func NewMultiPerpHooks(hooks ...PerpHooks) MultiPerpHooks {
+	return hooks
+}
+
+func (h MultiPerpHooks) AfterPositionOpened(ctx sdk.Context, event PositionChangedEvent) error {
+	for i := range h {
+		if err := h[i].AfterPositionOpened(ctx, event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiPerpHooks) AfterPositionChanged(ctx sdk.Context, event PositionChangedEvent) error {
+	for i := range h {
+		if err := h[i].AfterPositionChanged(ctx, event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiPerpHooks) AfterPositionClosed(ctx sdk.Context, event PositionChangedEvent) error {
+	for i := range h {
+		if err := h[i].AfterPositionClosed(ctx, event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiPerpHooks) AfterPositionLiquidated(ctx sdk.Context, event PositionLiquidatedEvent) error {
+	for i := range h {
+		if err := h[i].AfterPositionLiquidated(ctx, event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiPerpHooks) AfterPositionSettled(ctx sdk.Context, event PositionSettledEvent) error {
+	for i := range h {
+		if err := h[i].AfterPositionSettled(ctx, event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
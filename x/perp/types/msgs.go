@@ -14,7 +14,15 @@ var _ sdk.Msg = &MsgAddMargin{}
 var _ sdk.Msg = &MsgLiquidate{}
 var _ sdk.Msg = &MsgOpenPosition{}
 var _ sdk.Msg = &MsgClosePosition{}
+var _ sdk.Msg = &MsgPartialClose{}
+var _ sdk.Msg = &MsgSettlePosition{}
 var _ sdk.Msg = &MsgMultiLiquidate{}
+var _ sdk.Msg = &MsgPlaceOrder{}
+var _ sdk.Msg = &MsgCancelOrder{}
+var _ sdk.Msg = &MsgDepositCrossMargin{}
+var _ sdk.Msg = &MsgWithdrawCrossMargin{}
+var _ sdk.Msg = &MsgTransferPosition{}
+var _ sdk.Msg = &MsgBidLiquidationAuction{}
 
 // MsgRemoveMargin
 
@@ -218,6 +226,75 @@ func (m MsgClosePosition) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{signer}
 }
 
+// MsgPartialClose
+
+func (m MsgPartialClose) Route() string { return RouterKey }
+func (m MsgPartialClose) Type() string  { return "partial_close_msg" }
+
+func (m MsgPartialClose) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if _, err := common.NewAssetPair(m.TokenPair); err != nil {
+		return err
+	}
+
+	closeBySize := !m.Size_.IsNil() && !m.Size_.IsZero()
+	closeByNotional := !m.QuoteAssetAmount.IsNil() && !m.QuoteAssetAmount.IsZero()
+	if closeBySize == closeByNotional {
+		return fmt.Errorf("exactly one of size and quote asset amount must be set")
+	}
+	if closeBySize && !m.Size_.IsPositive() {
+		return fmt.Errorf("size must be positive, not: %s", m.Size_)
+	}
+	if closeByNotional && !m.QuoteAssetAmount.IsPositive() {
+		return fmt.Errorf("quote asset amount must be positive, not: %s", m.QuoteAssetAmount)
+	}
+	if !m.Limit.IsNil() && m.Limit.IsNegative() {
+		return fmt.Errorf("limit must not be negative")
+	}
+	return nil
+}
+
+func (m MsgPartialClose) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgPartialClose) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// MsgSettlePosition
+
+func (m MsgSettlePosition) Route() string { return RouterKey }
+func (m MsgSettlePosition) Type() string  { return "settle_position_msg" }
+
+func (m MsgSettlePosition) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if _, err := common.NewAssetPair(m.TokenPair); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m MsgSettlePosition) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSettlePosition) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
 // MsgDonateToEcosystemFund
 
 func (m MsgDonateToEcosystemFund) Route() string { return RouterKey }
@@ -244,3 +321,194 @@ func (m MsgDonateToEcosystemFund) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{signer}
 }
+
+// MsgPlaceOrder
+
+func (m MsgPlaceOrder) Route() string { return RouterKey }
+func (m MsgPlaceOrder) Type() string  { return "place_order_msg" }
+
+func (m MsgPlaceOrder) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if _, err := common.NewAssetPair(m.TokenPair); err != nil {
+		return err
+	}
+	return ValidateOrderFields(m.OrderType, m.Side, m.TriggerPrice, m.QuoteAssetAmount, m.Leverage, m.BaseAssetAmountLimit)
+}
+
+func (m MsgPlaceOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgPlaceOrder) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// MsgCancelOrder
+
+func (m MsgCancelOrder) Route() string { return RouterKey }
+func (m MsgCancelOrder) Type() string  { return "cancel_order_msg" }
+
+func (m MsgCancelOrder) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	return nil
+}
+
+func (m MsgCancelOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgCancelOrder) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// MsgDepositCrossMargin
+
+func (m MsgDepositCrossMargin) Route() string { return RouterKey }
+func (m MsgDepositCrossMargin) Type() string  { return "deposit_cross_margin_msg" }
+
+func (m MsgDepositCrossMargin) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if err := m.Collateral.Validate(); err != nil {
+		return err
+	}
+	if !m.Collateral.Amount.IsPositive() {
+		return fmt.Errorf("collateral must be positive, not: %v", m.Collateral.Amount.String())
+	}
+	return nil
+}
+
+func (m MsgDepositCrossMargin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgDepositCrossMargin) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// MsgWithdrawCrossMargin
+
+func (m MsgWithdrawCrossMargin) Route() string { return RouterKey }
+func (m MsgWithdrawCrossMargin) Type() string  { return "withdraw_cross_margin_msg" }
+
+func (m MsgWithdrawCrossMargin) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if err := m.Collateral.Validate(); err != nil {
+		return err
+	}
+	if !m.Collateral.Amount.IsPositive() {
+		return fmt.Errorf("collateral must be positive, not: %v", m.Collateral.Amount.String())
+	}
+	return nil
+}
+
+func (m MsgWithdrawCrossMargin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgWithdrawCrossMargin) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// MsgTransferPosition
+
+func (m MsgTransferPosition) Route() string { return RouterKey }
+func (m MsgTransferPosition) Type() string  { return "transfer_position_msg" }
+
+func (m MsgTransferPosition) ValidateBasic() error {
+	sender, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	receiver, err := sdk.AccAddressFromBech32(m.Receiver)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid receiver address (%s)", err)
+	}
+	if sender.Equals(receiver) {
+		return fmt.Errorf("sender and receiver must be different")
+	}
+	if _, err := common.NewAssetPair(m.TokenPair); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m MsgTransferPosition) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgTransferPosition) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// MsgBidLiquidationAuction
+
+func (m MsgBidLiquidationAuction) Route() string { return RouterKey }
+func (m MsgBidLiquidationAuction) Type() string  { return "bid_liquidation_auction_msg" }
+
+func (m MsgBidLiquidationAuction) ValidateBasic() error {
+	sender, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	trader, err := sdk.AccAddressFromBech32(m.Trader)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid trader address (%s)", err)
+	}
+	if sender.Equals(trader) {
+		return fmt.Errorf("cannot bid on the auction of the own position")
+	}
+	pair, err := common.NewAssetPair(m.TokenPair)
+	if err != nil {
+		return err
+	}
+	if m.Price.IsNil() || !m.Price.IsPositive() {
+		return fmt.Errorf("price must be positive")
+	}
+	if !m.Margin.IsValid() || !m.Margin.Amount.IsPositive() {
+		return fmt.Errorf("margin must be positive, not: %s", m.Margin)
+	}
+	if m.Margin.Denom != pair.QuoteDenom() {
+		return fmt.Errorf("invalid margin denom, expected %s, got %s", pair.QuoteDenom(), m.Margin.Denom)
+	}
+	return nil
+}
+
+func (m MsgBidLiquidationAuction) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgBidLiquidationAuction) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
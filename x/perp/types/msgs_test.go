@@ -387,3 +387,245 @@ func TestMsgMultiLiquidate_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgPartialClose_ValidateBasic(t *testing.T) {
+	type test struct {
+		msg     *MsgPartialClose
+		wantErr bool
+	}
+
+	cases := map[string]test{
+		"ok by size": {
+			msg: &MsgPartialClose{
+				Sender:    testutil.AccAddress().String(),
+				TokenPair: "NIBI:NUSD",
+				Size_:     sdk.NewDec(10),
+				Limit:     sdk.ZeroDec(),
+			},
+			wantErr: false,
+		},
+		"ok by quote asset amount": {
+			msg: &MsgPartialClose{
+				Sender:           testutil.AccAddress().String(),
+				TokenPair:        "NIBI:NUSD",
+				QuoteAssetAmount: sdk.NewDec(10),
+				Limit:            sdk.NewDec(5),
+			},
+			wantErr: false,
+		},
+		"both size and quote asset amount": {
+			msg: &MsgPartialClose{
+				Sender:           testutil.AccAddress().String(),
+				TokenPair:        "NIBI:NUSD",
+				Size_:            sdk.NewDec(10),
+				QuoteAssetAmount: sdk.NewDec(10),
+			},
+			wantErr: true,
+		},
+		"neither size nor quote asset amount": {
+			msg: &MsgPartialClose{
+				Sender:           testutil.AccAddress().String(),
+				TokenPair:        "NIBI:NUSD",
+				Size_:            sdk.ZeroDec(),
+				QuoteAssetAmount: sdk.ZeroDec(),
+			},
+			wantErr: true,
+		},
+		"negative size": {
+			msg: &MsgPartialClose{
+				Sender:    testutil.AccAddress().String(),
+				TokenPair: "NIBI:NUSD",
+				Size_:     sdk.NewDec(-10),
+			},
+			wantErr: true,
+		},
+		"negative limit": {
+			msg: &MsgPartialClose{
+				Sender:    testutil.AccAddress().String(),
+				TokenPair: "NIBI:NUSD",
+				Size_:     sdk.NewDec(10),
+				Limit:     sdk.NewDec(-1),
+			},
+			wantErr: true,
+		},
+		"invalid pair": {
+			msg: &MsgPartialClose{
+				Sender:    testutil.AccAddress().String(),
+				TokenPair: "xxx:yyy:zzz",
+				Size_:     sdk.NewDec(10),
+			},
+			wantErr: true,
+		},
+		"invalid sender": {
+			msg: &MsgPartialClose{
+				Sender:    "",
+				TokenPair: "NIBI:NUSD",
+				Size_:     sdk.NewDec(10),
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		name := name
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if err != nil && tc.wantErr == false {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if err == nil && tc.wantErr == true {
+				t.Fatalf("expected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestMsgTransferPosition_ValidateBasic(t *testing.T) {
+	sender := testutil.AccAddress().String()
+
+	cases := map[string]struct {
+		msg     *MsgTransferPosition
+		wantErr bool
+	}{
+		"ok": {
+			msg: &MsgTransferPosition{
+				Sender:    sender,
+				TokenPair: "NIBI:NUSD",
+				Receiver:  testutil.AccAddress().String(),
+			},
+			wantErr: false,
+		},
+		"invalid sender": {
+			msg: &MsgTransferPosition{
+				Sender:    "",
+				TokenPair: "NIBI:NUSD",
+				Receiver:  testutil.AccAddress().String(),
+			},
+			wantErr: true,
+		},
+		"invalid receiver": {
+			msg: &MsgTransferPosition{
+				Sender:    sender,
+				TokenPair: "NIBI:NUSD",
+				Receiver:  "",
+			},
+			wantErr: true,
+		},
+		"sender is the receiver": {
+			msg: &MsgTransferPosition{
+				Sender:    sender,
+				TokenPair: "NIBI:NUSD",
+				Receiver:  sender,
+			},
+			wantErr: true,
+		},
+		"invalid pair": {
+			msg: &MsgTransferPosition{
+				Sender:    sender,
+				TokenPair: "xxx:yyy:zzz",
+				Receiver:  testutil.AccAddress().String(),
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		name := name
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if err != nil && tc.wantErr == false {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if err == nil && tc.wantErr == true {
+				t.Fatalf("expected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestMsgBidLiquidationAuction_ValidateBasic(t *testing.T) {
+	sender := testutil.AccAddress().String()
+	trader := testutil.AccAddress().String()
+
+	cases := map[string]struct {
+		msg     *MsgBidLiquidationAuction
+		wantErr bool
+	}{
+		"ok": {
+			msg: &MsgBidLiquidationAuction{
+				Sender:    sender,
+				TokenPair: "NIBI:NUSD",
+				Trader:    trader,
+				Price:     sdk.OneDec(),
+				Margin:    sdk.NewInt64Coin("NUSD", 100),
+			},
+			wantErr: false,
+		},
+		"invalid sender": {
+			msg: &MsgBidLiquidationAuction{
+				Sender:    "",
+				TokenPair: "NIBI:NUSD",
+				Trader:    trader,
+				Price:     sdk.OneDec(),
+				Margin:    sdk.NewInt64Coin("NUSD", 100),
+			},
+			wantErr: true,
+		},
+		"sender is the trader": {
+			msg: &MsgBidLiquidationAuction{
+				Sender:    sender,
+				TokenPair: "NIBI:NUSD",
+				Trader:    sender,
+				Price:     sdk.OneDec(),
+				Margin:    sdk.NewInt64Coin("NUSD", 100),
+			},
+			wantErr: true,
+		},
+		"non-positive price": {
+			msg: &MsgBidLiquidationAuction{
+				Sender:    sender,
+				TokenPair: "NIBI:NUSD",
+				Trader:    trader,
+				Price:     sdk.ZeroDec(),
+				Margin:    sdk.NewInt64Coin("NUSD", 100),
+			},
+			wantErr: true,
+		},
+		"margin not in quote denom": {
+			msg: &MsgBidLiquidationAuction{
+				Sender:    sender,
+				TokenPair: "NIBI:NUSD",
+				Trader:    trader,
+				Price:     sdk.OneDec(),
+				Margin:    sdk.NewInt64Coin("NIBI", 100),
+			},
+			wantErr: true,
+		},
+		"zero margin": {
+			msg: &MsgBidLiquidationAuction{
+				Sender:    sender,
+				TokenPair: "NIBI:NUSD",
+				Trader:    trader,
+				Price:     sdk.OneDec(),
+				Margin:    sdk.NewInt64Coin("NUSD", 0),
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		name := name
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if err != nil && tc.wantErr == false {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if err == nil && tc.wantErr == true {
+				t.Fatalf("expected error: %s", err)
+			}
+		})
+	}
+}
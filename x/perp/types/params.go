@@ -58,6 +58,66 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 			&p.WhitelistedLiquidators,
 			validateAddress,
 		),
+		paramtypes.NewParamSetPair(
+			[]byte("OrderExecutionReward"),
+			&p.OrderExecutionReward,
+			validateOrderExecutionReward,
+		),
+		paramtypes.NewParamSetPair(
+			[]byte("MaxOrderDuration"),
+			&p.MaxOrderDuration,
+			validateMaxOrderDuration,
+		),
+		paramtypes.NewParamSetPair(
+			[]byte("InsuranceFundFeeShare"),
+			&p.InsuranceFundFeeShare,
+			validatePercentageRatio,
+		),
+		paramtypes.NewParamSetPair(
+			[]byte("InsuranceFundLiquidationShare"),
+			&p.InsuranceFundLiquidationShare,
+			validatePercentageRatio,
+		),
+		paramtypes.NewParamSetPair(
+			[]byte("BadDebtPayoutOrder"),
+			&p.BadDebtPayoutOrder,
+			validateBadDebtPayoutOrder,
+		),
+		paramtypes.NewParamSetPair(
+			[]byte("FeeTiers"),
+			&p.FeeTiers,
+			validateFeeTiers,
+		),
+		paramtypes.NewParamSetPair(
+			[]byte("FundingRateHistoryLength"),
+			&p.FundingRateHistoryLength,
+			validateFundingRateHistoryLength,
+		),
+		paramtypes.NewParamSetPair(
+			[]byte("MaxFundingRate"),
+			&p.MaxFundingRate,
+			validateMaxFundingRate,
+		),
+		paramtypes.NewParamSetPair(
+			[]byte("PremiumSmoothingFactor"),
+			&p.PremiumSmoothingFactor,
+			validatePremiumSmoothingFactor,
+		),
+		paramtypes.NewParamSetPair(
+			[]byte("LiquidationAuctionThreshold"),
+			&p.LiquidationAuctionThreshold,
+			validateLiquidationAuctionThreshold,
+		),
+		paramtypes.NewParamSetPair(
+			[]byte("LiquidationAuctionMaxDiscount"),
+			&p.LiquidationAuctionMaxDiscount,
+			validateLiquidationAuctionMaxDiscount,
+		),
+		paramtypes.NewParamSetPair(
+			[]byte("LiquidationAuctionDuration"),
+			&p.LiquidationAuctionDuration,
+			validateLiquidationAuctionDuration,
+		),
 	}
 }
 
@@ -70,6 +130,17 @@ func NewParams(
 	partialLiquidationRatio sdk.Dec,
 	fundingRateInterval string,
 	twapLookbackWindow time.Duration,
+	orderExecutionReward sdk.Int,
+	maxOrderDuration time.Duration,
+	insuranceFundFeeShare sdk.Dec,
+	insuranceFundLiquidationShare sdk.Dec,
+	badDebtPayoutOrder []BadDebtPayer,
+	fundingRateHistoryLength uint64,
+	maxFundingRate sdk.Dec,
+	premiumSmoothingFactor sdk.Dec,
+	liquidationAuctionThreshold sdk.Dec,
+	liquidationAuctionMaxDiscount sdk.Dec,
+	liquidationAuctionDuration time.Duration,
 ) Params {
 	return Params{
 		Stopped:                 stopped,
@@ -79,6 +150,18 @@ func NewParams(
 		PartialLiquidationRatio: partialLiquidationRatio,
 		FundingRateInterval:     fundingRateInterval,
 		TwapLookbackWindow:      twapLookbackWindow,
+		OrderExecutionReward:    orderExecutionReward,
+		MaxOrderDuration:        maxOrderDuration,
+
+		InsuranceFundFeeShare:         insuranceFundFeeShare,
+		InsuranceFundLiquidationShare: insuranceFundLiquidationShare,
+		BadDebtPayoutOrder:            badDebtPayoutOrder,
+		FundingRateHistoryLength:      fundingRateHistoryLength,
+		MaxFundingRate:                maxFundingRate,
+		PremiumSmoothingFactor:        premiumSmoothingFactor,
+		LiquidationAuctionThreshold:   liquidationAuctionThreshold,
+		LiquidationAuctionMaxDiscount: liquidationAuctionMaxDiscount,
+		LiquidationAuctionDuration:    liquidationAuctionDuration,
 	}
 }
 
@@ -92,6 +175,22 @@ func DefaultParams() Params {
 		/* partialLiquidationRatio */ sdk.MustNewDecFromStr("0.25"),
 		/* epochIdentifier */ "30 min",
 		/* twapLookbackWindow */ 15*time.Minute,
+		/* orderExecutionReward */ sdk.NewInt(1_000),
+		/* maxOrderDuration */ 7*24*time.Hour,
+		/* insuranceFundFeeShare */ sdk.MustNewDecFromStr("0.5"),
+		/* insuranceFundLiquidationShare */ sdk.MustNewDecFromStr("0.5"),
+		/* badDebtPayoutOrder */ []BadDebtPayer{
+			BadDebtPayer_INSURANCE_FUND,
+			BadDebtPayer_ECOSYSTEM_FUND,
+			BadDebtPayer_AUTO_DELEVERAGING,
+			BadDebtPayer_SOCIALIZED_LOSS,
+		},
+		/* fundingRateHistoryLength */ 30*48, // 30 days of 30 minute epochs
+		/* maxFundingRate */ sdk.MustNewDecFromStr("0.005"), // 50 bps per interval
+		/* premiumSmoothingFactor */ sdk.ZeroDec(),
+		/* liquidationAuctionThreshold */ sdk.ZeroDec(),
+		/* liquidationAuctionMaxDiscount */ sdk.MustNewDecFromStr("0.05"),
+		/* liquidationAuctionDuration */ 5*time.Minute,
 	)
 }
 
@@ -117,6 +216,66 @@ func (p *Params) Validate() error {
 		return err
 	}
 
+	err = validateOrderExecutionReward(p.OrderExecutionReward)
+	if err != nil {
+		return err
+	}
+
+	err = validateMaxOrderDuration(p.MaxOrderDuration)
+	if err != nil {
+		return err
+	}
+
+	err = validatePercentageRatio(p.InsuranceFundFeeShare)
+	if err != nil {
+		return err
+	}
+
+	err = validatePercentageRatio(p.InsuranceFundLiquidationShare)
+	if err != nil {
+		return err
+	}
+
+	err = validateBadDebtPayoutOrder(p.BadDebtPayoutOrder)
+	if err != nil {
+		return err
+	}
+
+	err = validateFeeTiers(p.FeeTiers)
+	if err != nil {
+		return err
+	}
+
+	err = validateFundingRateHistoryLength(p.FundingRateHistoryLength)
+	if err != nil {
+		return err
+	}
+
+	err = validateMaxFundingRate(p.MaxFundingRate)
+	if err != nil {
+		return err
+	}
+
+	err = validatePremiumSmoothingFactor(p.PremiumSmoothingFactor)
+	if err != nil {
+		return err
+	}
+
+	err = validateLiquidationAuctionThreshold(p.LiquidationAuctionThreshold)
+	if err != nil {
+		return err
+	}
+
+	err = validateLiquidationAuctionMaxDiscount(p.LiquidationAuctionMaxDiscount)
+	if err != nil {
+		return err
+	}
+
+	err = validateLiquidationAuctionDuration(p.LiquidationAuctionDuration)
+	if err != nil {
+		return err
+	}
+
 	return validatePercentageRatio(p.EcosystemFundFeeRatio)
 }
 
@@ -147,6 +306,81 @@ func validateFundingRateInterval(i interface{}) error {
 	return nil
 }
 
+func validateFundingRateHistoryLength(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateMaxFundingRate(i interface{}) error {
+	rate, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if rate.IsNil() {
+		return fmt.Errorf("invalid nil max funding rate")
+	}
+	if rate.IsNegative() {
+		return fmt.Errorf("max funding rate is negative: %s", rate.String())
+	}
+	return nil
+}
+
+func validatePremiumSmoothingFactor(i interface{}) error {
+	factor, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if factor.IsNil() {
+		return fmt.Errorf("invalid nil premium smoothing factor")
+	}
+	if factor.IsNegative() || factor.GTE(sdk.OneDec()) {
+		return fmt.Errorf("premium smoothing factor must be in [0, 1): %s", factor.String())
+	}
+	return nil
+}
+
+func validateLiquidationAuctionThreshold(i interface{}) error {
+	threshold, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if threshold.IsNil() {
+		return fmt.Errorf("invalid nil liquidation auction threshold")
+	}
+	if threshold.IsNegative() {
+		return fmt.Errorf("liquidation auction threshold is negative: %s", threshold.String())
+	}
+	return nil
+}
+
+func validateLiquidationAuctionMaxDiscount(i interface{}) error {
+	discount, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if discount.IsNil() {
+		return fmt.Errorf("invalid nil liquidation auction max discount")
+	}
+	if discount.IsNegative() || discount.GTE(sdk.OneDec()) {
+		return fmt.Errorf("liquidation auction max discount must be in [0, 1): %s", discount.String())
+	}
+	return nil
+}
+
+func validateLiquidationAuctionDuration(i interface{}) error {
+	val, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if val <= 0 {
+		return fmt.Errorf("liquidation auction duration must be positive, current value is %s", val.String())
+	}
+	return nil
+}
+
 func validateStopped(i interface{}) error {
 	_, ok := i.(bool)
 	if !ok {
@@ -187,3 +421,73 @@ func validateAddress(i interface{}) error {
 	}
 	return nil
 }
+
+func validateOrderExecutionReward(i interface{}) error {
+	reward, ok := i.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if reward.IsNil() {
+		return fmt.Errorf("invalid nil order execution reward")
+	}
+	if reward.IsNegative() {
+		return fmt.Errorf("order execution reward is negative: %s", reward.String())
+	}
+	return nil
+}
+
+func validateMaxOrderDuration(i interface{}) error {
+	val, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if val <= 0 {
+		return fmt.Errorf("max order duration must be positive, current value is %s", val.String())
+	}
+	return nil
+}
+
+func validateBadDebtPayoutOrder(i interface{}) error {
+	order, ok := i.([]BadDebtPayer)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if len(order) == 0 {
+		return fmt.Errorf("bad debt payout order cannot be empty")
+	}
+
+	seen := make(map[BadDebtPayer]bool, len(order))
+	for idx, payer := range order {
+		if _, known := BadDebtPayer_name[int32(payer)]; !known || payer == BadDebtPayer_BAD_DEBT_PAYER_UNSPECIFIED {
+			return fmt.Errorf("invalid bad debt payer: %s", payer)
+		}
+		if seen[payer] {
+			return fmt.Errorf("duplicate bad debt payer: %s", payer)
+		}
+		if payer == BadDebtPayer_SOCIALIZED_LOSS && idx != len(order)-1 {
+			return fmt.Errorf("%s must be the last bad debt payer", payer)
+		}
+		seen[payer] = true
+	}
+	return nil
+}
+
+func validateFeeTiers(i interface{}) error {
+	tiers, ok := i.([]FeeTier)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	for idx, tier := range tiers {
+		if tier.MinVolume.IsNil() || !tier.MinVolume.IsPositive() {
+			return fmt.Errorf("fee tier %d: min volume must be positive", idx+1)
+		}
+		if idx > 0 && tier.MinVolume.LTE(tiers[idx-1].MinVolume) {
+			return fmt.Errorf("fee tier %d: min volume must be above the one of the previous tier", idx+1)
+		}
+		if err := tier.FeeRatios.Validate(); err != nil {
+			return fmt.Errorf("fee tier %d: %w", idx+1, err)
+		}
+	}
+	return nil
+}
@@ -39,18 +39,364 @@ func (m *PairMetadata) Validate() error {
 		return err
 	}
 
+	// the deprecated list is only set by genesis files in the old layout
 	for i, pf := range m.CumulativePremiumFractions {
 		if pf.IsNil() {
 			return fmt.Errorf("invalid cumulative funding rate at index: %d", i)
 		}
 	}
 
+	if m.LatestCumulativePremiumFraction.IsNil() && len(m.CumulativePremiumFractions) == 0 {
+		return fmt.Errorf("invalid nil latest cumulative premium fraction")
+	}
+
+	if m.FeeRatios != nil {
+		if err := m.FeeRatios.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if m.MarginPricePolicy != nil {
+		if err := m.MarginPricePolicy.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if m.LiquidityDepthPolicy != nil {
+		if err := m.LiquidityDepthPolicy.Validate(); err != nil {
+			return err
+		}
+	}
+
+	// pairs created before the caps existed have no caps
+	if !m.MaxOpenInterest.IsNil() || !m.MaxPositionSize.IsNil() {
+		return ValidateOpenInterestCaps(m.MaxOpenInterest, m.MaxPositionSize)
+	}
+
+	return nil
+}
+
+// ValidateOpenInterestCaps checks that the open interest and position size caps
+// are not negative. A zero cap means no cap.
+func ValidateOpenInterestCaps(maxOpenInterest sdk.Dec, maxPositionSize sdk.Dec) error {
+	if maxOpenInterest.IsNil() || maxOpenInterest.IsNegative() {
+		return fmt.Errorf("max open interest must be >= 0")
+	}
+
+	if maxPositionSize.IsNil() || maxPositionSize.IsNegative() {
+		return fmt.Errorf("max position size must be >= 0")
+	}
+
+	return nil
+}
+
+// Validate checks that the fee ratios are between 0 and 1.
+func (m *PairFeeRatios) Validate() error {
+	if err := validatePercentageRatio(m.FeePoolFeeRatio); err != nil {
+		return fmt.Errorf("invalid fee pool fee ratio: %w", err)
+	}
+	if err := validatePercentageRatio(m.EcosystemFundFeeRatio); err != nil {
+		return fmt.Errorf("invalid ecosystem fund fee ratio: %w", err)
+	}
+	return nil
+}
+
+// DefaultMarginPricePolicy is the margin price policy of the pairs without an
+// override: the margin checks use the higher PnL of the SPOT and MARK_TWAP
+// prices, the liquidation check also uses the INDEX price when the mark price
+// is over the spread limit, and the SPOT price decides between a partial and a
+// full liquidation.
+func DefaultMarginPricePolicy() MarginPricePolicy {
+	return MarginPricePolicy{
+		Sources: []MarginPriceSource{
+			{Type: MarginPriceSourceType_MARGIN_PRICE_SOURCE_SPOT},
+			{Type: MarginPriceSourceType_MARGIN_PRICE_SOURCE_MARK_TWAP},
+		},
+		Preference:            PnLPreferenceOption_MAX,
+		FullLiquidationSource: MarginPriceSource{Type: MarginPriceSourceType_MARGIN_PRICE_SOURCE_SPOT},
+		IndexOverSpreadLimit:  true,
+	}
+}
+
+// Validate checks that the policy has at least one source, a preference and
+// valid sources.
+func (m *MarginPricePolicy) Validate() error {
+	if len(m.Sources) == 0 {
+		return fmt.Errorf("margin price policy must have at least one source")
+	}
+	for _, source := range m.Sources {
+		if err := source.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if m.Preference != PnLPreferenceOption_MAX && m.Preference != PnLPreferenceOption_MIN {
+		return fmt.Errorf("invalid margin price policy preference: %s", m.Preference)
+	}
+
+	if err := m.FullLiquidationSource.Validate(); err != nil {
+		return fmt.Errorf("invalid full liquidation source: %w", err)
+	}
+	return nil
+}
+
+// Validate checks that the policy has an epoch identifier, non-negative depth
+// ratios, a max change ratio between 0 and 1, a positive min quote reserve and
+// a max quote reserve, when set, above it.
+func (m *LiquidityDepthPolicy) Validate() error {
+	if m.EpochIdentifier == "" {
+		return fmt.Errorf("liquidity depth policy must have an epoch identifier")
+	}
+
+	for _, ratio := range []struct {
+		name  string
+		value sdk.Dec
+	}{
+		{"open interest depth ratio", m.OpenInterestDepthRatio},
+		{"volume depth ratio", m.VolumeDepthRatio},
+	} {
+		if ratio.value.IsNil() || ratio.value.IsNegative() {
+			return fmt.Errorf("invalid %s: %s", ratio.name, ratio.value)
+		}
+	}
+
+	if m.MaxChangeRatio.IsNil() || !m.MaxChangeRatio.IsPositive() || m.MaxChangeRatio.GTE(sdk.OneDec()) {
+		return fmt.Errorf("max change ratio must be in (0, 1), got %s", m.MaxChangeRatio)
+	}
+
+	if m.MinQuoteReserve.IsNil() || !m.MinQuoteReserve.IsPositive() {
+		return fmt.Errorf("min quote reserve must be positive, got %s", m.MinQuoteReserve)
+	}
+	if m.MaxQuoteReserve.IsNil() || m.MaxQuoteReserve.IsNegative() {
+		return fmt.Errorf("invalid max quote reserve: %s", m.MaxQuoteReserve)
+	}
+	if !m.MaxQuoteReserve.IsZero() && m.MaxQuoteReserve.LT(m.MinQuoteReserve) {
+		return fmt.Errorf(
+			"max quote reserve (%s) must not be below the min quote reserve (%s)",
+			m.MaxQuoteReserve, m.MinQuoteReserve)
+	}
 	return nil
 }
 
+// Validate checks the source type and that only MARK_TWAP sources have a
+// lookback window.
+func (m *MarginPriceSource) Validate() error {
+	switch m.Type {
+	case MarginPriceSourceType_MARGIN_PRICE_SOURCE_MARK_TWAP:
+		if m.LookbackWindow < 0 {
+			return fmt.Errorf("negative lookback window: %s", m.LookbackWindow)
+		}
+	case MarginPriceSourceType_MARGIN_PRICE_SOURCE_SPOT,
+		MarginPriceSourceType_MARGIN_PRICE_SOURCE_INDEX,
+		MarginPriceSourceType_MARGIN_PRICE_SOURCE_INDEX_TWAP:
+		if m.LookbackWindow != 0 {
+			return fmt.Errorf("the %s source has no lookback window", m.Type)
+		}
+	default:
+		return fmt.Errorf("invalid margin price source: %s", m.Type)
+	}
+	return nil
+}
+
+func (m *FundingRate) Validate() error {
+	if err := m.Pair.Validate(); err != nil {
+		return err
+	}
+
+	for _, dec := range []sdk.Dec{m.PremiumFraction, m.CumulativePremiumFraction, m.MarkPrice, m.IndexPrice} {
+		if dec.IsNil() {
+			return fmt.Errorf("invalid nil decimal")
+		}
+	}
+
+	if m.BlockHeight < 0 || m.BlockTimeMs < 0 {
+		return fmt.Errorf("invalid block height or time")
+	}
+
+	return nil
+}
+
+func (m *TraderVolume) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(m.TraderAddress); err != nil {
+		return err
+	}
+
+	if m.Volume.IsNil() || m.Volume.IsNegative() {
+		return fmt.Errorf("volume must be >= 0")
+	}
+
+	return nil
+}
+
+func (m *SettlementCursor) Validate() error {
+	if err := m.Pair.Validate(); err != nil {
+		return err
+	}
+	if m.TraderAddress == "" {
+		return nil
+	}
+	_, err := sdk.AccAddressFromBech32(m.TraderAddress)
+	return err
+}
+
 func (m *PrepaidBadDebt) Validate() error {
 	return sdk.Coin{
 		Denom:  m.Denom,
 		Amount: m.Amount,
 	}.Validate()
 }
+
+func (m *InsuranceFund) Validate() error {
+	for _, amount := range []sdk.Int{m.TotalContributions, m.TotalPayouts, m.TotalSocializedLoss} {
+		if err := (sdk.Coin{Denom: m.Denom, Amount: amount}).Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *Shortfall) Validate() error {
+	for _, amount := range []sdk.Int{
+		m.BadDebt, m.CoveredByInsuranceFund, m.CoveredByEcosystemFund, m.CoveredByAutoDeleveraging, m.SocializedLoss,
+	} {
+		if err := (sdk.Coin{Denom: m.Denom, Amount: amount}).Validate(); err != nil {
+			return err
+		}
+	}
+
+	covered := m.CoveredByInsuranceFund.
+		Add(m.CoveredByEcosystemFund).
+		Add(m.CoveredByAutoDeleveraging).
+		Add(m.SocializedLoss)
+	if !covered.Equal(m.BadDebt) {
+		return fmt.Errorf("the covered amounts add up to %s instead of the bad debt %s", covered, m.BadDebt)
+	}
+	return nil
+}
+
+func (m *CrossMarginAccount) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(m.TraderAddress); err != nil {
+		return err
+	}
+	return m.Collateral.Validate()
+}
+
+func (m *Order) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(m.TraderAddress); err != nil {
+		return err
+	}
+
+	if err := m.Pair.Validate(); err != nil {
+		return err
+	}
+
+	if err := ValidateOrderFields(m.OrderType, m.Side, m.TriggerPrice, m.QuoteAssetAmount, m.Leverage, m.BaseAssetAmountLimit); err != nil {
+		return err
+	}
+
+	if err := m.ExecutionReward.Validate(); err != nil {
+		return err
+	}
+
+	if m.BlockNumber < 0 {
+		return fmt.Errorf("invalid block number")
+	}
+
+	return nil
+}
+
+func (m *LiquidationAuction) Validate() error {
+	if err := m.Pair.Validate(); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.TraderAddress); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.LiquidatorAddress); err != nil {
+		return err
+	}
+
+	if m.IndexPrice.IsNil() || !m.IndexPrice.IsPositive() {
+		return fmt.Errorf("index price must be positive")
+	}
+
+	if m.LimitPrice.IsNil() || !m.LimitPrice.IsPositive() {
+		return fmt.Errorf("limit price must be positive")
+	}
+
+	if m.BestBidder == "" {
+		return nil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.BestBidder); err != nil {
+		return err
+	}
+
+	if m.BestBidPrice.IsNil() || !m.BestBidPrice.IsPositive() {
+		return fmt.Errorf("best bid price must be positive")
+	}
+
+	if m.BestBidMargin.Denom != m.Pair.QuoteDenom() {
+		return fmt.Errorf("invalid best bid margin denom, expected %s, got %s", m.Pair.QuoteDenom(), m.BestBidMargin.Denom)
+	}
+
+	return m.BestBidMargin.Validate()
+}
+
+// IsTriggeredByPriceRise returns true if the order gets triggered once the
+// price rises to or above its trigger price, and false if it gets triggered
+// once the price falls to or below its trigger price.
+func (m Order) IsTriggeredByPriceRise() bool {
+	switch m.OrderType {
+	case OrderType_STOP_LOSS:
+		// a stop loss protects a short (BUY to close) against a price rise
+		return m.Side == Side_BUY
+	default:
+		// a limit or take profit order sells high and buys low
+		return m.Side == Side_SELL
+	}
+}
+
+// IsTriggered returns true if the given price crosses the trigger price of the order.
+func (m Order) IsTriggered(price sdk.Dec) bool {
+	if m.IsTriggeredByPriceRise() {
+		return price.GTE(m.TriggerPrice)
+	}
+	return price.LTE(m.TriggerPrice)
+}
+
+// ValidateOrderFields performs the stateless checks shared by MsgPlaceOrder
+// and the orders of the genesis state.
+func ValidateOrderFields(
+	orderType OrderType,
+	side Side,
+	triggerPrice sdk.Dec,
+	quoteAssetAmount sdk.Int,
+	leverage sdk.Dec,
+	baseAssetAmountLimit sdk.Int,
+) error {
+	if orderType != OrderType_LIMIT && orderType != OrderType_STOP_LOSS && orderType != OrderType_TAKE_PROFIT {
+		return fmt.Errorf("invalid order type")
+	}
+	if side != Side_SELL && side != Side_BUY {
+		return fmt.Errorf("invalid side")
+	}
+	if triggerPrice.IsNil() || !triggerPrice.IsPositive() {
+		return fmt.Errorf("trigger price must be positive")
+	}
+	if orderType != OrderType_LIMIT {
+		return nil
+	}
+	if leverage.IsNil() || !leverage.IsPositive() {
+		return fmt.Errorf("leverage must always be greater than zero")
+	}
+	if baseAssetAmountLimit.IsNil() || baseAssetAmountLimit.IsNegative() {
+		return fmt.Errorf("base asset amount limit must not be negative")
+	}
+	if quoteAssetAmount.IsNil() || !quoteAssetAmount.IsPositive() {
+		return fmt.Errorf("quote asset amount must be always greater than zero")
+	}
+	return nil
+}
@@ -6,13 +6,14 @@ package types
 import (
 	fmt "fmt"
 	common "github.com/NibiruChain/nibiru/x/common"
-	_ "github.com/cosmos/cosmos-sdk/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "github.com/regen-network/cosmos-proto"
 	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -149,6 +150,192 @@ func (MarginCalculationPriceOption) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_0416b6ef16ef80be, []int{3}
 }
 
+type OrderType int32
+
+const (
+	OrderType_ORDER_TYPE_UNSPECIFIED OrderType = 0
+	// LIMIT opens or increases a position once the price reaches a level at
+	// least as good as the trigger price.
+	OrderType_LIMIT OrderType = 1
+	// STOP_LOSS closes the whole position once the price moves against it past
+	// the trigger price.
+	OrderType_STOP_LOSS OrderType = 2
+	// TAKE_PROFIT closes the whole position once the price moves in its favor
+	// past the trigger price.
+	OrderType_TAKE_PROFIT OrderType = 3
+)
+
+var OrderType_name = map[int32]string{
+	0: "ORDER_TYPE_UNSPECIFIED",
+	1: "LIMIT",
+	2: "STOP_LOSS",
+	3: "TAKE_PROFIT",
+}
+
+var OrderType_value = map[string]int32{
+	"ORDER_TYPE_UNSPECIFIED": 0,
+	"LIMIT":                  1,
+	"STOP_LOSS":              2,
+	"TAKE_PROFIT":            3,
+}
+
+func (x OrderType) String() string {
+	return proto.EnumName(OrderType_name, int32(x))
+}
+
+func (OrderType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_0416b6ef16ef80be, []int{4}
+}
+
+// BadDebtPayer is a source of funds in the bad debt payout waterfall.
+// FundingRateClampReason is why the premium fraction of a funding epoch
+// differs from the one computed from the mark and index prices.
+type FundingRateClampReason int32
+
+const (
+	// NOT_CLAMPED is the premium fraction computed from the prices.
+	FundingRateClampReason_NOT_CLAMPED FundingRateClampReason = 0
+	// PREMIUM_SMOOTHING averaged the premium fraction with the previous one.
+	FundingRateClampReason_PREMIUM_SMOOTHING FundingRateClampReason = 1
+	// MAX_FUNDING_RATE capped the funding rate at the max funding rate.
+	FundingRateClampReason_MAX_FUNDING_RATE FundingRateClampReason = 2
+)
+
+var FundingRateClampReason_name = map[int32]string{
+	0: "NOT_CLAMPED",
+	1: "PREMIUM_SMOOTHING",
+	2: "MAX_FUNDING_RATE",
+}
+
+var FundingRateClampReason_value = map[string]int32{
+	"NOT_CLAMPED":       0,
+	"PREMIUM_SMOOTHING": 1,
+	"MAX_FUNDING_RATE":  2,
+}
+
+func (x FundingRateClampReason) String() string {
+	return proto.EnumName(FundingRateClampReason_name, int32(x))
+}
+
+func (FundingRateClampReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_0416b6ef16ef80be, []int{5}
+}
+
+type BadDebtPayer int32
+
+const (
+	BadDebtPayer_BAD_DEBT_PAYER_UNSPECIFIED BadDebtPayer = 0
+	// INSURANCE_FUND pays from the insurance fund module account.
+	BadDebtPayer_INSURANCE_FUND BadDebtPayer = 1
+	// ECOSYSTEM_FUND pays from the PerpEF module account.
+	BadDebtPayer_ECOSYSTEM_FUND BadDebtPayer = 2
+	// SOCIALIZED_LOSS haircuts the margin of every open position quoted in the
+	// denom of the bad debt, pro rata to their margin, by the remaining bad debt.
+	BadDebtPayer_SOCIALIZED_LOSS BadDebtPayer = 3
+	// AUTO_DELEVERAGING reduces the profitable positions on the opposite side of
+	// a liquidated position at its bankruptcy price. It only covers bad debt
+	// realized by liquidations and is skipped otherwise.
+	BadDebtPayer_AUTO_DELEVERAGING BadDebtPayer = 4
+)
+
+var BadDebtPayer_name = map[int32]string{
+	0: "BAD_DEBT_PAYER_UNSPECIFIED",
+	1: "INSURANCE_FUND",
+	2: "ECOSYSTEM_FUND",
+	3: "SOCIALIZED_LOSS",
+	4: "AUTO_DELEVERAGING",
+}
+
+var BadDebtPayer_value = map[string]int32{
+	"BAD_DEBT_PAYER_UNSPECIFIED": 0,
+	"INSURANCE_FUND":             1,
+	"ECOSYSTEM_FUND":             2,
+	"SOCIALIZED_LOSS":            3,
+	"AUTO_DELEVERAGING":          4,
+}
+
+func (x BadDebtPayer) String() string {
+	return proto.EnumName(BadDebtPayer_name, int32(x))
+}
+
+func (BadDebtPayer) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_0416b6ef16ef80be, []int{6}
+}
+
+type LiquidationAuctionOutcome int32
+
+const (
+	LiquidationAuctionOutcome_LIQUIDATION_AUCTION_OUTCOME_UNSPECIFIED LiquidationAuctionOutcome = 0
+	// the position was taken over by the best bidder
+	LiquidationAuctionOutcome_SOLD LiquidationAuctionOutcome = 1
+	// there was no valid bid, the position was closed on the vpool
+	LiquidationAuctionOutcome_VPOOL_CLOSE LiquidationAuctionOutcome = 2
+	// the position was gone or back above the maintenance margin ratio when the
+	// auction ended, or its market was settled
+	LiquidationAuctionOutcome_CANCELLED LiquidationAuctionOutcome = 3
+)
+
+var LiquidationAuctionOutcome_name = map[int32]string{
+	0: "LIQUIDATION_AUCTION_OUTCOME_UNSPECIFIED",
+	1: "SOLD",
+	2: "VPOOL_CLOSE",
+	3: "CANCELLED",
+}
+
+var LiquidationAuctionOutcome_value = map[string]int32{
+	"LIQUIDATION_AUCTION_OUTCOME_UNSPECIFIED": 0,
+	"SOLD":        1,
+	"VPOOL_CLOSE": 2,
+	"CANCELLED":   3,
+}
+
+func (x LiquidationAuctionOutcome) String() string {
+	return proto.EnumName(LiquidationAuctionOutcome_name, int32(x))
+}
+
+func (LiquidationAuctionOutcome) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_0416b6ef16ef80be, []int{7}
+}
+
+type MarginPriceSourceType int32
+
+const (
+	MarginPriceSourceType_MARGIN_PRICE_SOURCE_TYPE_UNSPECIFIED MarginPriceSourceType = 0
+	// the price of closing the position on the vpool
+	MarginPriceSourceType_MARGIN_PRICE_SOURCE_SPOT MarginPriceSourceType = 1
+	// the TWAP of closing the position on the vpool
+	MarginPriceSourceType_MARGIN_PRICE_SOURCE_MARK_TWAP MarginPriceSourceType = 2
+	// the current price of the oracles
+	MarginPriceSourceType_MARGIN_PRICE_SOURCE_INDEX MarginPriceSourceType = 3
+	// the TWAP of the oracle prices, over the lookback window of the pricefeed
+	// module
+	MarginPriceSourceType_MARGIN_PRICE_SOURCE_INDEX_TWAP MarginPriceSourceType = 4
+)
+
+var MarginPriceSourceType_name = map[int32]string{
+	0: "MARGIN_PRICE_SOURCE_TYPE_UNSPECIFIED",
+	1: "MARGIN_PRICE_SOURCE_SPOT",
+	2: "MARGIN_PRICE_SOURCE_MARK_TWAP",
+	3: "MARGIN_PRICE_SOURCE_INDEX",
+	4: "MARGIN_PRICE_SOURCE_INDEX_TWAP",
+}
+
+var MarginPriceSourceType_value = map[string]int32{
+	"MARGIN_PRICE_SOURCE_TYPE_UNSPECIFIED": 0,
+	"MARGIN_PRICE_SOURCE_SPOT":             1,
+	"MARGIN_PRICE_SOURCE_MARK_TWAP":        2,
+	"MARGIN_PRICE_SOURCE_INDEX":            3,
+	"MARGIN_PRICE_SOURCE_INDEX_TWAP":       4,
+}
+
+func (x MarginPriceSourceType) String() string {
+	return proto.EnumName(MarginPriceSourceType_name, int32(x))
+}
+
+func (MarginPriceSourceType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_0416b6ef16ef80be, []int{8}
+}
+
 type Params struct {
 	// stopped identifies if the perp exchange is stopped or not
 	Stopped bool `protobuf:"varint,1,opt,name=stopped,proto3" json:"stopped,omitempty"`
@@ -170,6 +357,46 @@ type Params struct {
 	// whitelisted_liquidators defines the list of addresses
 	// which are allowed to liquidate a position.
 	WhitelistedLiquidators []string `protobuf:"bytes,9,rep,name=whitelisted_liquidators,json=whitelistedLiquidators,proto3" json:"whitelisted_liquidators,omitempty"`
+	// OrderExecutionReward is the amount of quote asset a trader escrows when
+	// placing a conditional order. It is paid to the fee pool when the order is
+	// executed and refunded when the order is cancelled or expires.
+	OrderExecutionReward github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,10,opt,name=order_execution_reward,json=orderExecutionReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"order_execution_reward"`
+	// MaxOrderDuration is the maximum amount of time a conditional order can
+	// rest in the order book before it expires.
+	MaxOrderDuration time.Duration `protobuf:"bytes,11,opt,name=max_order_duration,json=maxOrderDuration,proto3,stdduration" json:"max_order_duration,omitempty" yaml:"max_order_duration"`
+	// InsuranceFundFeeShare is the share of the ecosystem fund trading fee that
+	// is paid to the insurance fund instead.
+	InsuranceFundFeeShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=insurance_fund_fee_share,json=insuranceFundFeeShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"insurance_fund_fee_share"`
+	// InsuranceFundLiquidationShare is the share of the ecosystem fund
+	// liquidation penalty that is paid to the insurance fund instead.
+	InsuranceFundLiquidationShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=insurance_fund_liquidation_share,json=insuranceFundLiquidationShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"insurance_fund_liquidation_share"`
+	// BadDebtPayoutOrder is the order in which the payers cover bad debt the
+	// vault cannot pay for. SOCIALIZED_LOSS, when present, must be last.
+	BadDebtPayoutOrder []BadDebtPayer `protobuf:"varint,14,rep,packed,name=bad_debt_payout_order,json=badDebtPayoutOrder,proto3,enum=nibiru.perp.v1.BadDebtPayer" json:"bad_debt_payout_order,omitempty"`
+	// FeeTiers sets the trading fee ratios of traders by their rolling 30-day
+	// volume, ordered by increasing min_volume. Traders below the first tier
+	// pay the fee_pool_fee_ratio and ecosystem_fund_fee_ratio above.
+	FeeTiers []FeeTier `protobuf:"bytes,15,rep,name=fee_tiers,json=feeTiers,proto3" json:"fee_tiers"`
+	// FundingRateHistoryLength is the number of funding epochs of funding rate
+	// history kept per pair. Zero keeps the whole history.
+	FundingRateHistoryLength uint64 `protobuf:"varint,16,opt,name=funding_rate_history_length,json=fundingRateHistoryLength,proto3" json:"funding_rate_history_length,omitempty"`
+	// MaxFundingRate is the maximum absolute funding rate, the premium fraction
+	// over the index price, charged per funding interval. Zero disables the cap.
+	MaxFundingRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,17,opt,name=max_funding_rate,json=maxFundingRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_funding_rate"`
+	// PremiumSmoothingFactor is the weight of the previous premium fraction of
+	// a pair in the exponential moving average its premium fraction is smoothed
+	// with, in [0, 1). Zero disables the smoothing.
+	PremiumSmoothingFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,18,opt,name=premium_smoothing_factor,json=premiumSmoothingFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"premium_smoothing_factor"`
+	// LiquidationAuctionThreshold is the position notional above which a fully
+	// liquidated position is auctioned to the whitelisted liquidators instead of
+	// being closed on the vpool. Zero disables the auctions.
+	LiquidationAuctionThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,19,opt,name=liquidation_auction_threshold,json=liquidationAuctionThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidation_auction_threshold"`
+	// LiquidationAuctionMaxDiscount is the largest discount to the index price,
+	// in [0, 1), a liquidator can absorb an auctioned position at.
+	LiquidationAuctionMaxDiscount github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,20,opt,name=liquidation_auction_max_discount,json=liquidationAuctionMaxDiscount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidation_auction_max_discount"`
+	// LiquidationAuctionDuration is the amount of time a liquidation auction
+	// takes bids for.
+	LiquidationAuctionDuration time.Duration `protobuf:"bytes,21,opt,name=liquidation_auction_duration,json=liquidationAuctionDuration,proto3,stdduration" json:"liquidation_auction_duration,omitempty" yaml:"liquidation_auction_duration"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -233,6 +460,264 @@ func (m *Params) GetWhitelistedLiquidators() []string {
 	return nil
 }
 
+func (m *Params) GetMaxOrderDuration() time.Duration {
+	if m != nil {
+		return m.MaxOrderDuration
+	}
+	return 0
+}
+
+func (m *Params) GetBadDebtPayoutOrder() []BadDebtPayer {
+	if m != nil {
+		return m.BadDebtPayoutOrder
+	}
+	return nil
+}
+
+func (m *Params) GetFeeTiers() []FeeTier {
+	if m != nil {
+		return m.FeeTiers
+	}
+	return nil
+}
+
+func (m *Params) GetFundingRateHistoryLength() uint64 {
+	if m != nil {
+		return m.FundingRateHistoryLength
+	}
+	return 0
+}
+
+func (m *Params) GetLiquidationAuctionDuration() time.Duration {
+	if m != nil {
+		return m.LiquidationAuctionDuration
+	}
+	return 0
+}
+
+// FeeTier is the trading fee ratios of traders whose rolling 30-day volume is
+// at least min_volume.
+type FeeTier struct {
+	// The minimum volume, in quote asset units, to be in the tier.
+	MinVolume github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=min_volume,json=minVolume,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_volume"`
+	FeeRatios PairFeeRatios                          `protobuf:"bytes,2,opt,name=fee_ratios,json=feeRatios,proto3" json:"fee_ratios"`
+}
+
+func (m *FeeTier) Reset()         { *m = FeeTier{} }
+func (m *FeeTier) String() string { return proto.CompactTextString(m) }
+func (*FeeTier) ProtoMessage()    {}
+func (*FeeTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0416b6ef16ef80be, []int{1}
+}
+func (m *FeeTier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeTier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeTier.Merge(m, src)
+}
+func (m *FeeTier) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeTier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeTier proto.InternalMessageInfo
+
+func (m *FeeTier) GetFeeRatios() PairFeeRatios {
+	if m != nil {
+		return m.FeeRatios
+	}
+	return PairFeeRatios{}
+}
+
+// MarginPriceSource is a price the margin ratio of a position is measured at.
+type MarginPriceSource struct {
+	Type MarginPriceSourceType `protobuf:"varint,1,opt,name=type,proto3,enum=nibiru.perp.v1.MarginPriceSourceType" json:"type,omitempty"`
+	// The lookback window of the MARK_TWAP source, the twap_lookback_window of
+	// the params when zero. Must be zero for the other sources.
+	LookbackWindow time.Duration `protobuf:"bytes,2,opt,name=lookback_window,json=lookbackWindow,proto3,stdduration" json:"lookback_window"`
+}
+
+func (m *MarginPriceSource) Reset()         { *m = MarginPriceSource{} }
+func (m *MarginPriceSource) String() string { return proto.CompactTextString(m) }
+func (*MarginPriceSource) ProtoMessage()    {}
+func (*MarginPriceSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0416b6ef16ef80be, []int{2}
+}
+func (m *MarginPriceSource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarginPriceSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarginPriceSource.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MarginPriceSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarginPriceSource.Merge(m, src)
+}
+func (m *MarginPriceSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarginPriceSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarginPriceSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarginPriceSource proto.InternalMessageInfo
+
+func (m *MarginPriceSource) GetType() MarginPriceSourceType {
+	if m != nil {
+		return m.Type
+	}
+	return MarginPriceSourceType_MARGIN_PRICE_SOURCE_TYPE_UNSPECIFIED
+}
+
+func (m *MarginPriceSource) GetLookbackWindow() time.Duration {
+	if m != nil {
+		return m.LookbackWindow
+	}
+	return 0
+}
+
+// MarginPricePolicy is how the margin ratio of the positions of a pair is
+// measured for the margin checks and the liquidations. It only applies to
+// isolated margin positions, the positions of a cross margin account are
+// measured account-wide.
+type MarginPricePolicy struct {
+	// The sources the margin ratio of the margin checks and the liquidation
+	// check is measured at.
+	Sources []MarginPriceSource `protobuf:"bytes,1,rep,name=sources,proto3" json:"sources"`
+	// Whether the highest (MAX) or the lowest (MIN) position notional and
+	// unrealized PnL of the sources is used. MAX is the most lenient policy.
+	Preference PnLPreferenceOption `protobuf:"varint,2,opt,name=preference,proto3,enum=nibiru.perp.v1.PnLPreferenceOption" json:"preference,omitempty"`
+	// The source of the margin ratio deciding between a partial and a full
+	// liquidation.
+	FullLiquidationSource MarginPriceSource `protobuf:"bytes,3,opt,name=full_liquidation_source,json=fullLiquidationSource,proto3" json:"full_liquidation_source"`
+	// Whether the liquidation check also measures the margin ratio at the INDEX
+	// price when the mark price is over the spread limit, keeping the higher of
+	// both margin ratios.
+	IndexOverSpreadLimit bool `protobuf:"varint,4,opt,name=index_over_spread_limit,json=indexOverSpreadLimit,proto3" json:"index_over_spread_limit,omitempty"`
+}
+
+func (m *MarginPricePolicy) Reset()         { *m = MarginPricePolicy{} }
+func (m *MarginPricePolicy) String() string { return proto.CompactTextString(m) }
+func (*MarginPricePolicy) ProtoMessage()    {}
+func (*MarginPricePolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0416b6ef16ef80be, []int{3}
+}
+func (m *MarginPricePolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarginPricePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarginPricePolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MarginPricePolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarginPricePolicy.Merge(m, src)
+}
+func (m *MarginPricePolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarginPricePolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarginPricePolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarginPricePolicy proto.InternalMessageInfo
+
+func (m *MarginPricePolicy) GetSources() []MarginPriceSource {
+	if m != nil {
+		return m.Sources
+	}
+	return nil
+}
+
+func (m *MarginPricePolicy) GetPreference() PnLPreferenceOption {
+	if m != nil {
+		return m.Preference
+	}
+	return PnLPreferenceOption_PNL_PREFERENCE_UNSPECIFIED
+}
+
+func (m *MarginPricePolicy) GetFullLiquidationSource() MarginPriceSource {
+	if m != nil {
+		return m.FullLiquidationSource
+	}
+	return MarginPriceSource{}
+}
+
+func (m *MarginPricePolicy) GetIndexOverSpreadLimit() bool {
+	if m != nil {
+		return m.IndexOverSpreadLimit
+	}
+	return false
+}
+
+// PairFeeRatios is the ratios of the notional of a trade paid as fees.
+type PairFeeRatios struct {
+	FeePoolFeeRatio       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=fee_pool_fee_ratio,json=feePoolFeeRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_pool_fee_ratio"`
+	EcosystemFundFeeRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=ecosystem_fund_fee_ratio,json=ecosystemFundFeeRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"ecosystem_fund_fee_ratio"`
+}
+
+func (m *PairFeeRatios) Reset()         { *m = PairFeeRatios{} }
+func (m *PairFeeRatios) String() string { return proto.CompactTextString(m) }
+func (*PairFeeRatios) ProtoMessage()    {}
+func (*PairFeeRatios) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0416b6ef16ef80be, []int{4}
+}
+func (m *PairFeeRatios) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PairFeeRatios) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PairFeeRatios.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PairFeeRatios) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PairFeeRatios.Merge(m, src)
+}
+func (m *PairFeeRatios) XXX_Size() int {
+	return m.Size()
+}
+func (m *PairFeeRatios) XXX_DiscardUnknown() {
+	xxx_messageInfo_PairFeeRatios.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PairFeeRatios proto.InternalMessageInfo
+
 // Position identifies and records information on a user's position on one of
 // the virtual liquidity pools.
 type Position struct {
@@ -252,13 +737,16 @@ type Position struct {
 	LatestCumulativePremiumFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=latest_cumulative_premium_fraction,json=latestCumulativePremiumFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"latest_cumulative_premium_fraction"`
 	// BlockNumber is the last block number when this position was updated.
 	BlockNumber int64 `protobuf:"varint,7,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// The id of the last socialized loss taken from the margin. The losses of
+	// the quote denom after that id are still to be taken from the margin.
+	SocializedLossCursor uint64 `protobuf:"varint,8,opt,name=socialized_loss_cursor,json=socializedLossCursor,proto3" json:"socialized_loss_cursor,omitempty"`
 }
 
 func (m *Position) Reset()         { *m = Position{} }
 func (m *Position) String() string { return proto.CompactTextString(m) }
 func (*Position) ProtoMessage()    {}
 func (*Position) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0416b6ef16ef80be, []int{1}
+	return fileDescriptor_0416b6ef16ef80be, []int{5}
 }
 func (m *Position) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -308,20 +796,44 @@ func (m *Position) GetBlockNumber() int64 {
 	return 0
 }
 
+func (m *Position) GetSocializedLossCursor() uint64 {
+	if m != nil {
+		return m.SocializedLossCursor
+	}
+	return 0
+}
+
 type PairMetadata struct {
 	Pair common.AssetPair `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair"`
-	// The historical list of cumulative premium fractions for a given pair.
-	// Calculated once per epoch.
-	// A premium fraction is the difference between mark and index, divided by the number of payments per day.
-	// (mark - index) / # payments in a day
-	CumulativePremiumFractions []github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,rep,name=cumulative_premium_fractions,json=cumulativePremiumFractions,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"cumulative_premium_fractions"`
+	// Deprecated: the funding history is stored as FundingRate records. The
+	// list is only read when importing a genesis exported in the old layout,
+	// and is migrated to FundingRate records then.
+	CumulativePremiumFractions []github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,rep,name=cumulative_premium_fractions,json=cumulativePremiumFractions,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"cumulative_premium_fractions"` // Deprecated: Do not use.
+	// The fee ratios of the pair, overriding the fee ratios and the fee tiers
+	// of the params. Nil when the pair has no override.
+	FeeRatios *PairFeeRatios `protobuf:"bytes,3,opt,name=fee_ratios,json=feeRatios,proto3" json:"fee_ratios,omitempty"`
+	// The cumulative premium fraction of the latest funding epoch, zero before
+	// the first one.
+	LatestCumulativePremiumFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=latest_cumulative_premium_fraction,json=latestCumulativePremiumFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"latest_cumulative_premium_fraction"`
+	// The margin price policy of the pair, overriding the default policy. Nil
+	// when the pair has no override.
+	MarginPricePolicy *MarginPricePolicy `protobuf:"bytes,5,opt,name=margin_price_policy,json=marginPricePolicy,proto3" json:"margin_price_policy,omitempty"`
+	// The liquidity depth policy of the pair, scaling the depth of its vpool
+	// over time. Nil when the depth of the vpool is fixed.
+	LiquidityDepthPolicy *LiquidityDepthPolicy `protobuf:"bytes,6,opt,name=liquidity_depth_policy,json=liquidityDepthPolicy,proto3" json:"liquidity_depth_policy,omitempty"`
+	// max_open_interest caps the total size of the longs and of the shorts of
+	// the pair, in base asset units. Zero means no cap.
+	MaxOpenInterest github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=max_open_interest,json=maxOpenInterest,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_open_interest"`
+	// max_position_size caps the size of a single trader's position, in base
+	// asset units. Zero means no cap.
+	MaxPositionSize github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=max_position_size,json=maxPositionSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_position_size"`
 }
 
 func (m *PairMetadata) Reset()         { *m = PairMetadata{} }
 func (m *PairMetadata) String() string { return proto.CompactTextString(m) }
 func (*PairMetadata) ProtoMessage()    {}
 func (*PairMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0416b6ef16ef80be, []int{2}
+	return fileDescriptor_0416b6ef16ef80be, []int{6}
 }
 func (m *PairMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -357,23 +869,64 @@ func (m *PairMetadata) GetPair() common.AssetPair {
 	return common.AssetPair{}
 }
 
-type PrepaidBadDebt struct {
-	Denom  string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
-	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+func (m *PairMetadata) GetFeeRatios() *PairFeeRatios {
+	if m != nil {
+		return m.FeeRatios
+	}
+	return nil
 }
 
-func (m *PrepaidBadDebt) Reset()         { *m = PrepaidBadDebt{} }
-func (m *PrepaidBadDebt) String() string { return proto.CompactTextString(m) }
-func (*PrepaidBadDebt) ProtoMessage()    {}
-func (*PrepaidBadDebt) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0416b6ef16ef80be, []int{3}
+func (m *PairMetadata) GetMarginPricePolicy() *MarginPricePolicy {
+	if m != nil {
+		return m.MarginPricePolicy
+	}
+	return nil
 }
-func (m *PrepaidBadDebt) XXX_Unmarshal(b []byte) error {
+
+func (m *PairMetadata) GetLiquidityDepthPolicy() *LiquidityDepthPolicy {
+	if m != nil {
+		return m.LiquidityDepthPolicy
+	}
+	return nil
+}
+
+// LiquidityDepthPolicy scales the reserves of the vpool of a pair at the end of
+// every epoch, keeping its mark price unchanged, towards a target quote reserve
+// following the open interest and the volume of the pair.
+//
+// target quote reserve = open_interest_depth_ratio * open interest notional
+//   - volume_depth_ratio * quote volume of the epoch
+//
+// The target is bounded by min_quote_reserve and max_quote_reserve, and the
+// reserves move by at most max_change_ratio per epoch.
+type LiquidityDepthPolicy struct {
+	// The identifier of the epoch at the end of which the depth is adjusted.
+	EpochIdentifier string `protobuf:"bytes,1,opt,name=epoch_identifier,json=epochIdentifier,proto3" json:"epoch_identifier,omitempty"`
+	// The target quote reserve per unit of the notional of the open interest,
+	// the longs and the shorts, at the mark price.
+	OpenInterestDepthRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=open_interest_depth_ratio,json=openInterestDepthRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"open_interest_depth_ratio"`
+	// The target quote reserve per unit of quote asset traded over the epoch.
+	VolumeDepthRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=volume_depth_ratio,json=volumeDepthRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"volume_depth_ratio"`
+	// The largest relative change of the reserves in one epoch, between 0 and 1.
+	MaxChangeRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=max_change_ratio,json=maxChangeRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_change_ratio"`
+	// The lowest target quote reserve, positive.
+	MinQuoteReserve github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=min_quote_reserve,json=minQuoteReserve,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_quote_reserve"`
+	// The highest target quote reserve, zero for no bound.
+	MaxQuoteReserve github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=max_quote_reserve,json=maxQuoteReserve,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_quote_reserve"`
+}
+
+func (m *LiquidityDepthPolicy) Reset()         { *m = LiquidityDepthPolicy{} }
+func (m *LiquidityDepthPolicy) String() string { return proto.CompactTextString(m) }
+func (*LiquidityDepthPolicy) ProtoMessage()    {}
+func (*LiquidityDepthPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0416b6ef16ef80be, []int{7}
+}
+func (m *LiquidityDepthPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PrepaidBadDebt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *LiquidityDepthPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PrepaidBadDebt.Marshal(b, m, deterministic)
+		return xxx_messageInfo_LiquidityDepthPolicy.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -383,62 +936,58 @@ func (m *PrepaidBadDebt) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *PrepaidBadDebt) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PrepaidBadDebt.Merge(m, src)
+func (m *LiquidityDepthPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidityDepthPolicy.Merge(m, src)
 }
-func (m *PrepaidBadDebt) XXX_Size() int {
+func (m *LiquidityDepthPolicy) XXX_Size() int {
 	return m.Size()
 }
-func (m *PrepaidBadDebt) XXX_DiscardUnknown() {
-	xxx_messageInfo_PrepaidBadDebt.DiscardUnknown(m)
+func (m *LiquidityDepthPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidityDepthPolicy.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PrepaidBadDebt proto.InternalMessageInfo
+var xxx_messageInfo_LiquidityDepthPolicy proto.InternalMessageInfo
 
-func (m *PrepaidBadDebt) GetDenom() string {
+func (m *LiquidityDepthPolicy) GetEpochIdentifier() string {
 	if m != nil {
-		return m.Denom
+		return m.EpochIdentifier
 	}
 	return ""
 }
 
-type PositionResp struct {
-	Position *Position `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
-	// The amount of quote assets exchanged.
-	ExchangedNotionalValue github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=exchanged_notional_value,json=exchangedNotionalValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchanged_notional_value"`
-	// The amount of base assets exchanged.
-	ExchangedPositionSize github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=exchanged_position_size,json=exchangedPositionSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchanged_position_size"`
-	// The amount of bad debt accrued during this position change.
-	// Measured in absolute value of quote units.
-	// If greater than zero, then the position change event will likely fail.
-	BadDebt github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=bad_debt,json=badDebt,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"bad_debt"`
-	// The funding payment applied on this position change.
-	FundingPayment github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=funding_payment,json=fundingPayment,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"funding_payment"`
-	// The amount of PnL realized on this position changed, measured in quote
-	// units.
-	RealizedPnl github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=realized_pnl,json=realizedPnl,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"realized_pnl"`
-	// The unrealized PnL in the position after the position change.
-	UnrealizedPnlAfter github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=unrealized_pnl_after,json=unrealizedPnlAfter,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"unrealized_pnl_after"`
-	// The amount of margin the trader has to give to the vault.
-	// A negative value means the vault pays the trader.
-	MarginToVault github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=margin_to_vault,json=marginToVault,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"margin_to_vault"`
-	// The position's notional value after the position change, measured in quote
-	// units.
-	PositionNotional github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=position_notional,json=positionNotional,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"position_notional"`
+// FundingRate is the funding of a pair over a funding epoch.
+type FundingRate struct {
+	Pair common.AssetPair `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair"`
+	// The number of the funding epoch.
+	Epoch uint64 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// A premium fraction is the difference between mark and index, divided by
+	// the number of payments per day.
+	// (mark - index) / # payments in a day
+	PremiumFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=premium_fraction,json=premiumFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"premium_fraction"`
+	// The sum of the premium fractions of the pair up to this epoch.
+	CumulativePremiumFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=cumulative_premium_fraction,json=cumulativePremiumFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"cumulative_premium_fraction"`
+	// The mark price TWAP the premium fraction was computed from.
+	MarkPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=mark_price,json=markPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"mark_price"`
+	// The index price TWAP the premium fraction was computed from.
+	IndexPrice  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=index_price,json=indexPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"index_price"`
+	BlockHeight int64                                  `protobuf:"varint,7,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// The block time of the end of the epoch, in milliseconds since the unix
+	// epoch. Zero for records migrated from the old genesis layout.
+	BlockTimeMs int64 `protobuf:"varint,8,opt,name=block_time_ms,json=blockTimeMs,proto3" json:"block_time_ms,omitempty"`
 }
 
-func (m *PositionResp) Reset()         { *m = PositionResp{} }
-func (m *PositionResp) String() string { return proto.CompactTextString(m) }
-func (*PositionResp) ProtoMessage()    {}
-func (*PositionResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0416b6ef16ef80be, []int{4}
+func (m *FundingRate) Reset()         { *m = FundingRate{} }
+func (m *FundingRate) String() string { return proto.CompactTextString(m) }
+func (*FundingRate) ProtoMessage()    {}
+func (*FundingRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0416b6ef16ef80be, []int{8}
 }
-func (m *PositionResp) XXX_Unmarshal(b []byte) error {
+func (m *FundingRate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PositionResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *FundingRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PositionResp.Marshal(b, m, deterministic)
+		return xxx_messageInfo_FundingRate.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -448,50 +997,88 @@ func (m *PositionResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *PositionResp) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PositionResp.Merge(m, src)
+func (m *FundingRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundingRate.Merge(m, src)
 }
-func (m *PositionResp) XXX_Size() int {
+func (m *FundingRate) XXX_Size() int {
 	return m.Size()
 }
-func (m *PositionResp) XXX_DiscardUnknown() {
-	xxx_messageInfo_PositionResp.DiscardUnknown(m)
+func (m *FundingRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundingRate.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PositionResp proto.InternalMessageInfo
+var xxx_messageInfo_FundingRate proto.InternalMessageInfo
 
-func (m *PositionResp) GetPosition() *Position {
+func (m *FundingRate) GetPair() common.AssetPair {
 	if m != nil {
-		return m.Position
+		return m.Pair
 	}
-	return nil
+	return common.AssetPair{}
 }
 
-type LiquidateResp struct {
-	// Amount of bad debt created by the liquidation event
-	BadDebt github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=bad_debt,json=badDebt,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"bad_debt"`
-	// Fee paid to the liquidator
-	FeeToLiquidator github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=fee_to_liquidator,json=feeToLiquidator,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"fee_to_liquidator"`
-	// Fee paid to the Perp EF fund
-	FeeToPerpEcosystemFund github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=fee_to_perp_ecosystem_fund,json=feeToPerpEcosystemFund,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"fee_to_perp_ecosystem_fund"`
-	// Address of the liquidator
-	Liquidator string `protobuf:"bytes,4,opt,name=liquidator,proto3" json:"liquidator,omitempty"`
-	// Position response from the close or open reverse position
-	PositionResp *PositionResp `protobuf:"bytes,5,opt,name=position_resp,json=positionResp,proto3" json:"position_resp,omitempty"`
+func (m *FundingRate) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
 }
 
-func (m *LiquidateResp) Reset()         { *m = LiquidateResp{} }
-func (m *LiquidateResp) String() string { return proto.CompactTextString(m) }
-func (*LiquidateResp) ProtoMessage()    {}
-func (*LiquidateResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0416b6ef16ef80be, []int{5}
+func (m *FundingRate) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
 }
-func (m *LiquidateResp) XXX_Unmarshal(b []byte) error {
+
+func (m *FundingRate) GetBlockTimeMs() int64 {
+	if m != nil {
+		return m.BlockTimeMs
+	}
+	return 0
+}
+
+// Order is a conditional order resting in the order book of a virtual pool.
+// It is executed at the end of the first block in which either the mark price
+// or the index price crosses its trigger price.
+type Order struct {
+	// id is the unique identifier of the order
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// address of the trader who placed the order
+	TraderAddress string `protobuf:"bytes,2,opt,name=trader_address,json=traderAddress,proto3" json:"trader_address,omitempty"`
+	// pair identifies the virtual pool of the order
+	Pair      common.AssetPair `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair"`
+	OrderType OrderType        `protobuf:"varint,4,opt,name=order_type,json=orderType,proto3,enum=nibiru.perp.v1.OrderType" json:"order_type,omitempty"`
+	// The direction of the trade executed by the order. For STOP_LOSS and
+	// TAKE_PROFIT orders, this is the opposite side of the position to close.
+	Side Side `protobuf:"varint,5,opt,name=side,proto3,enum=nibiru.perp.v1.Side" json:"side,omitempty"`
+	// The price at which the order gets triggered.
+	TriggerPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=trigger_price,json=triggerPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"trigger_price"`
+	// The amount of margin to open the position with. Only used by LIMIT orders.
+	QuoteAssetAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=quote_asset_amount,json=quoteAssetAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"quote_asset_amount"`
+	// The leverage to open the position with. Only used by LIMIT orders.
+	Leverage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=leverage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"leverage"`
+	// The slippage limit on the base asset amount. Only used by LIMIT orders.
+	BaseAssetAmountLimit github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=base_asset_amount_limit,json=baseAssetAmountLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"base_asset_amount_limit"`
+	// The reward escrowed by the trader to pay for the execution of the order.
+	ExecutionReward types.Coin `protobuf:"bytes,10,opt,name=execution_reward,json=executionReward,proto3" json:"execution_reward"`
+	// The time after which the order is removed from the order book.
+	Expiry time.Time `protobuf:"bytes,11,opt,name=expiry,proto3,stdtime" json:"expiry"`
+	// BlockNumber is the block number at which the order was placed.
+	BlockNumber int64 `protobuf:"varint,12,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+}
+
+func (m *Order) Reset()         { *m = Order{} }
+func (m *Order) String() string { return proto.CompactTextString(m) }
+func (*Order) ProtoMessage()    {}
+func (*Order) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0416b6ef16ef80be, []int{9}
+}
+func (m *Order) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *LiquidateResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Order) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_LiquidateResp.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Order.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -501,747 +1088,6756 @@ func (m *LiquidateResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *LiquidateResp) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LiquidateResp.Merge(m, src)
+func (m *Order) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Order.Merge(m, src)
 }
-func (m *LiquidateResp) XXX_Size() int {
+func (m *Order) XXX_Size() int {
 	return m.Size()
 }
-func (m *LiquidateResp) XXX_DiscardUnknown() {
-	xxx_messageInfo_LiquidateResp.DiscardUnknown(m)
+func (m *Order) XXX_DiscardUnknown() {
+	xxx_messageInfo_Order.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LiquidateResp proto.InternalMessageInfo
+var xxx_messageInfo_Order proto.InternalMessageInfo
 
-func (m *LiquidateResp) GetLiquidator() string {
+func (m *Order) GetId() uint64 {
 	if m != nil {
-		return m.Liquidator
+		return m.Id
 	}
-	return ""
+	return 0
 }
 
-func (m *LiquidateResp) GetPositionResp() *PositionResp {
+func (m *Order) GetTraderAddress() string {
 	if m != nil {
-		return m.PositionResp
+		return m.TraderAddress
 	}
-	return nil
+	return ""
 }
 
-func init() {
-	proto.RegisterEnum("nibiru.perp.v1.Side", Side_name, Side_value)
-	proto.RegisterEnum("nibiru.perp.v1.PnLCalcOption", PnLCalcOption_name, PnLCalcOption_value)
-	proto.RegisterEnum("nibiru.perp.v1.PnLPreferenceOption", PnLPreferenceOption_name, PnLPreferenceOption_value)
-	proto.RegisterEnum("nibiru.perp.v1.MarginCalculationPriceOption", MarginCalculationPriceOption_name, MarginCalculationPriceOption_value)
-	proto.RegisterType((*Params)(nil), "nibiru.perp.v1.Params")
-	proto.RegisterType((*Position)(nil), "nibiru.perp.v1.Position")
-	proto.RegisterType((*PairMetadata)(nil), "nibiru.perp.v1.PairMetadata")
-	proto.RegisterType((*PrepaidBadDebt)(nil), "nibiru.perp.v1.PrepaidBadDebt")
-	proto.RegisterType((*PositionResp)(nil), "nibiru.perp.v1.PositionResp")
-	proto.RegisterType((*LiquidateResp)(nil), "nibiru.perp.v1.LiquidateResp")
+func (m *Order) GetPair() common.AssetPair {
+	if m != nil {
+		return m.Pair
+	}
+	return common.AssetPair{}
 }
 
-func init() { proto.RegisterFile("perp/v1/state.proto", fileDescriptor_0416b6ef16ef80be) }
-
-var fileDescriptor_0416b6ef16ef80be = []byte{
-	// 1265 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x97, 0xcf, 0x6f, 0xdb, 0x36,
-	0x14, 0xc7, 0xa3, 0x38, 0x3f, 0x5f, 0x7e, 0xd4, 0x65, 0x92, 0xd6, 0x4d, 0x0b, 0x3b, 0x33, 0xb0,
-	0x21, 0xc8, 0x36, 0x0b, 0xc9, 0x06, 0x0c, 0xd8, 0xcd, 0xbf, 0x52, 0x18, 0x70, 0x1c, 0x4d, 0x4e,
-	0xd3, 0xae, 0x3d, 0x68, 0x94, 0x44, 0x3b, 0x6c, 0x24, 0x52, 0x95, 0x28, 0xa7, 0xe9, 0xce, 0xbb,
-	0xef, 0x34, 0xec, 0x0f, 0xd8, 0x9f, 0xb0, 0xf3, 0xce, 0x3d, 0xf6, 0x38, 0xec, 0x90, 0x0d, 0x0d,
-	0xb0, 0xc3, 0x8e, 0xfb, 0x0b, 0x06, 0x52, 0xb2, 0xe2, 0x15, 0xe9, 0x80, 0x68, 0x27, 0x8b, 0x7c,
-	0xe4, 0xe7, 0xbd, 0x47, 0x7e, 0x1f, 0x49, 0xc3, 0x5a, 0x40, 0xc2, 0x40, 0x1f, 0xed, 0xea, 0x91,
-	0xc0, 0x82, 0xd4, 0x82, 0x90, 0x0b, 0x8e, 0x56, 0x19, 0xb5, 0x69, 0x18, 0xd7, 0xa4, 0xad, 0x36,
-	0xda, 0xdd, 0x5c, 0x1f, 0xf2, 0x21, 0x57, 0x26, 0x5d, 0x7e, 0x25, 0xa3, 0x36, 0xcb, 0x0e, 0x8f,
-	0x7c, 0x1e, 0xe9, 0x36, 0x8e, 0x88, 0x3e, 0xda, 0xb5, 0x89, 0xc0, 0xbb, 0xba, 0xc3, 0x29, 0x4b,
-	0xed, 0xf7, 0x12, 0xbb, 0x95, 0x4c, 0x4c, 0x1a, 0xe3, 0xa9, 0x43, 0xce, 0x87, 0x1e, 0xd1, 0x55,
-	0xcb, 0x8e, 0x07, 0xba, 0x1b, 0x87, 0x58, 0x50, 0x3e, 0x9e, 0xba, 0xe6, 0x70, 0xdf, 0xe7, 0x4c,
-	0x4f, 0x7e, 0x92, 0xce, 0xea, 0x2f, 0xb3, 0x30, 0x67, 0xe0, 0x10, 0xfb, 0x11, 0x2a, 0xc1, 0x7c,
-	0x24, 0x78, 0x10, 0x10, 0xb7, 0xa4, 0x6d, 0x69, 0xdb, 0x0b, 0xe6, 0xb8, 0x89, 0x9e, 0x01, 0x1a,
-	0x10, 0x62, 0x05, 0x9c, 0x7b, 0x96, 0xfc, 0x50, 0xd8, 0x52, 0x61, 0x4b, 0xdb, 0x5e, 0x6c, 0xd4,
-	0x5e, 0x5f, 0x54, 0xa6, 0x7e, 0xbb, 0xa8, 0x7c, 0x34, 0xa4, 0xe2, 0x24, 0xb6, 0x6b, 0x0e, 0xf7,
-	0xd3, 0xb0, 0xd2, 0x9f, 0x4f, 0x23, 0xf7, 0x54, 0x17, 0xe7, 0x01, 0x89, 0x6a, 0x2d, 0xe2, 0x98,
-	0xb7, 0x06, 0x84, 0x18, 0x9c, 0x7b, 0xfb, 0x84, 0x98, 0x12, 0x83, 0x86, 0x50, 0x22, 0x0e, 0x8f,
-	0xce, 0x23, 0x41, 0x7c, 0x6b, 0x10, 0x33, 0x77, 0xc2, 0xc5, 0x4c, 0x2e, 0x17, 0x1b, 0x19, 0x6f,
-	0x3f, 0x66, 0x6e, 0xe6, 0xc8, 0x86, 0x0d, 0x8f, 0xbe, 0x88, 0xa9, 0xab, 0x16, 0x65, 0xc2, 0xcb,
-	0x6c, 0x2e, 0x2f, 0x6b, 0x13, 0xb0, 0xcc, 0xc7, 0x73, 0xb8, 0x17, 0xe0, 0x50, 0x50, 0xec, 0x59,
-	0x93, 0xbe, 0x12, 0x3f, 0x73, 0xb9, 0xfc, 0xdc, 0x4d, 0x81, 0xdd, 0x2b, 0x5e, 0xe2, 0x6b, 0x0f,
-	0x36, 0xe4, 0x72, 0x51, 0x36, 0x94, 0x7c, 0x62, 0x51, 0x26, 0x48, 0x38, 0xc2, 0x5e, 0x69, 0x5e,
-	0xfa, 0x31, 0xd7, 0x52, 0xa3, 0x89, 0x05, 0xe9, 0xa4, 0x26, 0xf4, 0x83, 0x06, 0xeb, 0xe2, 0x0c,
-	0x07, 0x96, 0xc7, 0xf9, 0xa9, 0x8d, 0x9d, 0x53, 0xeb, 0x8c, 0x32, 0x97, 0x9f, 0x95, 0x16, 0xb6,
-	0xb4, 0xed, 0xa5, 0xbd, 0x7b, 0xb5, 0x44, 0x43, 0xb5, 0xb1, 0x86, 0x6a, 0xad, 0x54, 0x43, 0x8d,
-	0x8e, 0x0c, 0xfb, 0xaf, 0x8b, 0x4a, 0xf9, 0xba, 0xe9, 0x9f, 0x70, 0x9f, 0x0a, 0xe2, 0x07, 0xe2,
-	0xfc, 0xef, 0x8b, 0xca, 0xfd, 0x73, 0xec, 0x7b, 0x5f, 0x56, 0xaf, 0x1b, 0x57, 0xfd, 0xf1, 0xf7,
-	0x8a, 0x66, 0x22, 0x69, 0xea, 0xa6, 0x96, 0xc7, 0xca, 0x80, 0xbe, 0x80, 0xbb, 0x67, 0x27, 0x54,
-	0x10, 0x8f, 0x46, 0x82, 0xb8, 0xd9, 0xe2, 0xf1, 0x30, 0x2a, 0x2d, 0x6e, 0x15, 0xb6, 0x17, 0xcd,
-	0x3b, 0x13, 0xe6, 0xee, 0x95, 0xb5, 0xfa, 0x67, 0x01, 0x16, 0x0c, 0x1e, 0x51, 0x19, 0x24, 0xfa,
-	0x10, 0x56, 0x45, 0x88, 0x5d, 0x12, 0x5a, 0xd8, 0x75, 0x43, 0x12, 0x45, 0x4a, 0xc9, 0x8b, 0xe6,
-	0x4a, 0xd2, 0x5b, 0x4f, 0x3a, 0xd1, 0x1e, 0xcc, 0x04, 0x98, 0x86, 0xa5, 0x69, 0x95, 0x74, 0xa9,
-	0x96, 0x56, 0x66, 0x5a, 0x18, 0xf5, 0x28, 0x22, 0xc2, 0xc0, 0x34, 0x6c, 0xcc, 0xc8, 0x9c, 0x4d,
-	0x35, 0x16, 0x35, 0x60, 0x26, 0xa2, 0xaf, 0x48, 0x4e, 0xd5, 0xab, 0xb9, 0x68, 0x1f, 0xe6, 0x7c,
-	0x1c, 0x0e, 0x29, 0xcb, 0x29, 0xec, 0x74, 0x36, 0xea, 0xc3, 0x0a, 0x0f, 0x08, 0xb3, 0x18, 0x97,
-	0x59, 0x63, 0x2f, 0xa7, 0x82, 0x97, 0x25, 0xa4, 0x97, 0x32, 0xd0, 0xb7, 0x50, 0xf5, 0xb0, 0x20,
-	0x91, 0xb0, 0x9c, 0xd8, 0x8f, 0x3d, 0x2c, 0xe8, 0x88, 0x58, 0x41, 0x48, 0x7c, 0x1a, 0xfb, 0xd6,
-	0x20, 0xc4, 0x8e, 0x1c, 0x97, 0x53, 0xc3, 0x95, 0x84, 0xdc, 0xcc, 0xc0, 0x46, 0xc2, 0xdd, 0x4f,
-	0xb1, 0xe8, 0x03, 0x58, 0xb6, 0x3d, 0xee, 0x9c, 0x5a, 0x2c, 0xf6, 0x6d, 0x12, 0x2a, 0x09, 0x17,
-	0xcc, 0x25, 0xd5, 0xd7, 0x53, 0x5d, 0xd5, 0x9f, 0x35, 0x58, 0x96, 0xbb, 0x72, 0x40, 0x04, 0x76,
-	0xb1, 0xc0, 0xd9, 0x2e, 0x6a, 0x37, 0xd8, 0xc5, 0x00, 0x1e, 0xfc, 0x47, 0x76, 0x51, 0x69, 0x5a,
-	0x6a, 0xed, 0xc6, 0xe9, 0x6d, 0x3a, 0xef, 0x4b, 0x2c, 0xaa, 0x32, 0x58, 0x35, 0x42, 0x12, 0x60,
-	0xea, 0x36, 0xb0, 0xdb, 0x22, 0xb6, 0x40, 0xeb, 0x30, 0xeb, 0x12, 0xc6, 0xfd, 0x54, 0x9b, 0x49,
-	0x43, 0x6a, 0x03, 0xfb, 0x3c, 0x66, 0x42, 0xa9, 0xf2, 0x66, 0x31, 0x74, 0x98, 0x30, 0xd3, 0xd9,
-	0xd5, 0x9f, 0xe6, 0x60, 0x79, 0x5c, 0x0f, 0x26, 0x89, 0x02, 0xf4, 0x39, 0x2c, 0x04, 0x69, 0xfb,
-	0xdd, 0xa5, 0x4a, 0xaf, 0xa2, 0x5a, 0x36, 0x3e, 0x1b, 0x89, 0x4e, 0xa0, 0x44, 0x5e, 0x3a, 0x27,
-	0x98, 0x0d, 0x89, 0x9b, 0xe9, 0xcc, 0x1a, 0x61, 0x2f, 0x26, 0x39, 0x02, 0x94, 0x8b, 0x74, 0x27,
-	0xe3, 0x8d, 0x25, 0x77, 0x2c, 0x69, 0x68, 0x00, 0x77, 0xaf, 0x3c, 0x8d, 0xfd, 0x5b, 0xff, 0xa3,
-	0xd6, 0x36, 0x32, 0xdc, 0x38, 0xaf, 0xbe, 0x2c, 0xbe, 0x0e, 0x2c, 0xd8, 0xd8, 0xb5, 0x5c, 0x62,
-	0x8b, 0x9c, 0xe5, 0x37, 0x6f, 0xa7, 0x3b, 0xf8, 0x18, 0x6e, 0x8d, 0x4f, 0xde, 0x00, 0x9f, 0xfb,
-	0x84, 0x89, 0x9c, 0x15, 0xb8, 0x9a, 0x62, 0x8c, 0x84, 0x82, 0xbe, 0x82, 0xe5, 0x90, 0x60, 0x8f,
-	0xbe, 0x92, 0x4b, 0xc1, 0xbc, 0x9c, 0xd5, 0xb6, 0x34, 0x66, 0x18, 0xcc, 0x43, 0xdf, 0xc0, 0x7a,
-	0xcc, 0x26, 0xa1, 0x16, 0x1e, 0x88, 0xb4, 0xc2, 0x6e, 0x8e, 0x46, 0x57, 0x2c, 0x83, 0x79, 0x75,
-	0x49, 0x42, 0xc7, 0x70, 0x2b, 0x39, 0x97, 0x2c, 0xc1, 0xad, 0x11, 0x8e, 0x3d, 0xa1, 0x6e, 0x93,
-	0x9b, 0xc3, 0x57, 0x12, 0xcc, 0x11, 0x3f, 0x96, 0x10, 0xf4, 0x0c, 0x6e, 0x67, 0x72, 0xc8, 0x4e,
-	0xba, 0xc5, 0x5c, 0xe4, 0xe2, 0x18, 0x34, 0x96, 0x5e, 0xf5, 0xbb, 0x02, 0xac, 0x8c, 0xaf, 0x11,
-	0xa2, 0xea, 0x64, 0x52, 0x1f, 0x5a, 0xae, 0x12, 0xcc, 0xf4, 0xf1, 0x14, 0x6e, 0xcb, 0xd7, 0x85,
-	0xe0, 0x13, 0xf7, 0x58, 0xce, 0xb2, 0x96, 0xcf, 0xa5, 0x23, 0x7e, 0x75, 0xe1, 0xa1, 0xe7, 0xb0,
-	0x99, 0xb2, 0x65, 0xf5, 0x5a, 0xff, 0x7e, 0x3a, 0xe5, 0xa8, 0x18, 0xe9, 0xe4, 0x8e, 0x72, 0x62,
-	0x90, 0x30, 0x68, 0x4f, 0xbe, 0x9c, 0x50, 0x19, 0x60, 0x22, 0x01, 0x55, 0x34, 0xe6, 0x44, 0x0f,
-	0xaa, 0xc3, 0x4a, 0xb6, 0x43, 0x21, 0x89, 0x02, 0x55, 0x05, 0x4b, 0x7b, 0x0f, 0xde, 0x7b, 0xbe,
-	0x90, 0x28, 0x30, 0x97, 0x83, 0x89, 0xd6, 0x8e, 0x0e, 0x33, 0x7d, 0xea, 0x12, 0xb4, 0x0e, 0xc5,
-	0x7e, 0xa7, 0xd5, 0xb6, 0x1e, 0xf5, 0xfa, 0x46, 0xbb, 0xd9, 0xd9, 0xef, 0xb4, 0x5b, 0xc5, 0x29,
-	0x34, 0x0f, 0x85, 0xc6, 0xa3, 0xaf, 0x8b, 0x1a, 0x5a, 0x80, 0x99, 0x7e, 0xbb, 0xdb, 0x2d, 0x4e,
-	0xef, 0x1c, 0xc3, 0x8a, 0xc1, 0xba, 0x4d, 0xec, 0x39, 0x87, 0x81, 0x3a, 0xa9, 0x2a, 0x70, 0xdf,
-	0xe8, 0x75, 0xad, 0x66, 0xbd, 0xdb, 0xb4, 0x0e, 0x8d, 0xa3, 0xce, 0x61, 0xef, 0x1d, 0xc8, 0x2a,
-	0x40, 0xdf, 0x38, 0x3c, 0xb2, 0x0c, 0xb3, 0xd3, 0x6c, 0x27, 0xac, 0xa3, 0xc7, 0x75, 0xa3, 0x38,
-	0x8d, 0x00, 0xe6, 0x0e, 0xcd, 0x7a, 0xb3, 0xdb, 0x2e, 0x16, 0x76, 0x1e, 0xc2, 0x9a, 0xc1, 0xba,
-	0x46, 0x48, 0x06, 0x24, 0x24, 0xcc, 0x21, 0x29, 0xbd, 0x0c, 0x9b, 0x92, 0x6e, 0x98, 0xed, 0xfd,
-	0xb6, 0xd9, 0xee, 0x35, 0xaf, 0x89, 0xf0, 0xa0, 0xfe, 0xa4, 0xa8, 0xa9, 0x8f, 0x4e, 0xaf, 0x38,
-	0xbd, 0xf3, 0x02, 0x1e, 0x1c, 0x28, 0x1d, 0xcb, 0x18, 0xd5, 0xad, 0xc0, 0x99, 0x11, 0xd2, 0x8c,
-	0xa8, 0xc3, 0xc7, 0x07, 0x75, 0xf3, 0x61, 0xa7, 0xa7, 0x42, 0x7e, 0xd4, 0xad, 0xab, 0x90, 0x55,
-	0x70, 0xd7, 0xc7, 0x2f, 0x73, 0x37, 0x0e, 0x8f, 0x8a, 0x1a, 0x5a, 0x84, 0xd9, 0x4e, 0xaf, 0xd5,
-	0x7e, 0x52, 0x9c, 0x46, 0x4b, 0x30, 0x7f, 0x50, 0x7f, 0x62, 0x19, 0xbd, 0x6e, 0xb1, 0xd0, 0x68,
-	0xbd, 0x7e, 0x5b, 0xd6, 0xde, 0xbc, 0x2d, 0x6b, 0x7f, 0xbc, 0x2d, 0x6b, 0xdf, 0x5f, 0x96, 0xa7,
-	0xde, 0x5c, 0x96, 0xa7, 0x7e, 0xbd, 0x2c, 0x4f, 0x3d, 0xdd, 0x99, 0x50, 0x40, 0x4f, 0x6d, 0x4a,
-	0xf3, 0x04, 0x53, 0xa6, 0x27, 0x1b, 0xa4, 0xbf, 0xd4, 0xd5, 0x3f, 0x15, 0xa5, 0x04, 0x7b, 0x4e,
-	0x3d, 0xfa, 0x3e, 0xfb, 0x27, 0x00, 0x00, 0xff, 0xff, 0xd0, 0x0f, 0x54, 0x26, 0xbe, 0x0c, 0x00,
-	0x00,
+func (m *Order) GetOrderType() OrderType {
+	if m != nil {
+		return m.OrderType
+	}
+	return OrderType_ORDER_TYPE_UNSPECIFIED
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Order) GetSide() Side {
+	if m != nil {
+		return m.Side
 	}
-	return dAtA[:n], nil
+	return Side_SIDE_UNSPECIFIED
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Order) GetExecutionReward() types.Coin {
+	if m != nil {
+		return m.ExecutionReward
+	}
+	return types.Coin{}
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.WhitelistedLiquidators) > 0 {
-		for iNdEx := len(m.WhitelistedLiquidators) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.WhitelistedLiquidators[iNdEx])
-			copy(dAtA[i:], m.WhitelistedLiquidators[iNdEx])
-			i = encodeVarintState(dAtA, i, uint64(len(m.WhitelistedLiquidators[iNdEx])))
-			i--
-			dAtA[i] = 0x4a
-		}
-	}
-	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.TwapLookbackWindow, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.TwapLookbackWindow):])
-	if err1 != nil {
-		return 0, err1
-	}
-	i -= n1
-	i = encodeVarintState(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x42
-	if len(m.FundingRateInterval) > 0 {
-		i -= len(m.FundingRateInterval)
-		copy(dAtA[i:], m.FundingRateInterval)
-		i = encodeVarintState(dAtA, i, uint64(len(m.FundingRateInterval)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	{
-		size := m.PartialLiquidationRatio.Size()
-		i -= size
-		if _, err := m.PartialLiquidationRatio.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintState(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size := m.LiquidationFeeRatio.Size()
-		i -= size
-		if _, err := m.LiquidationFeeRatio.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintState(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.EcosystemFundFeeRatio.Size()
-		i -= size
-		if _, err := m.EcosystemFundFeeRatio.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintState(dAtA, i, uint64(size))
+func (m *Order) GetExpiry() time.Time {
+	if m != nil {
+		return m.Expiry
 	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.FeePoolFeeRatio.Size()
-		i -= size
-		if _, err := m.FeePoolFeeRatio.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintState(dAtA, i, uint64(size))
+	return time.Time{}
+}
+
+func (m *Order) GetBlockNumber() int64 {
+	if m != nil {
+		return m.BlockNumber
 	}
-	i--
-	dAtA[i] = 0x1a
-	if m.Stopped {
-		i--
-		if m.Stopped {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	return 0
+}
+
+type PrepaidBadDebt struct {
+	Denom  string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *PrepaidBadDebt) Reset()         { *m = PrepaidBadDebt{} }
+func (m *PrepaidBadDebt) String() string { return proto.CompactTextString(m) }
+func (*PrepaidBadDebt) ProtoMessage()    {}
+func (*PrepaidBadDebt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0416b6ef16ef80be, []int{10}
+}
+func (m *PrepaidBadDebt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PrepaidBadDebt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PrepaidBadDebt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *PrepaidBadDebt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrepaidBadDebt.Merge(m, src)
+}
+func (m *PrepaidBadDebt) XXX_Size() int {
+	return m.Size()
+}
+func (m *PrepaidBadDebt) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrepaidBadDebt.DiscardUnknown(m)
 }
 
-func (m *Position) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PrepaidBadDebt proto.InternalMessageInfo
+
+func (m *PrepaidBadDebt) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Position) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// InsuranceFund holds the lifetime statistics of the insurance fund of a
+// denom. The fund balance itself is the balance of the insurance fund module
+// account.
+type InsuranceFund struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// The total amount paid into the fund from fees and liquidation penalties.
+	TotalContributions github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=total_contributions,json=totalContributions,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_contributions"`
+	// The total amount the fund paid to cover bad debt.
+	TotalPayouts github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=total_payouts,json=totalPayouts,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_payouts"`
+	// The total bad debt that was socialized after the payers ran dry.
+	TotalSocializedLoss github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=total_socialized_loss,json=totalSocializedLoss,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_socialized_loss"`
 }
 
-func (m *Position) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BlockNumber != 0 {
-		i = encodeVarintState(dAtA, i, uint64(m.BlockNumber))
-		i--
-		dAtA[i] = 0x38
-	}
-	{
-		size := m.LatestCumulativePremiumFraction.Size()
-		i -= size
-		if _, err := m.LatestCumulativePremiumFraction.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintState(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size := m.OpenNotional.Size()
-		i -= size
-		if _, err := m.OpenNotional.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintState(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.Margin.Size()
-		i -= size
-		if _, err := m.Margin.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintState(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.Size_.Size()
-		i -= size
-		if _, err := m.Size_.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintState(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size, err := m.Pair.MarshalToSizedBuffer(dAtA[:i])
+func (m *InsuranceFund) Reset()         { *m = InsuranceFund{} }
+func (m *InsuranceFund) String() string { return proto.CompactTextString(m) }
+func (*InsuranceFund) ProtoMessage()    {}
+func (*InsuranceFund) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0416b6ef16ef80be, []int{11}
+}
+func (m *InsuranceFund) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InsuranceFund) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InsuranceFund.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintState(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.TraderAddress) > 0 {
-		i -= len(m.TraderAddress)
-		copy(dAtA[i:], m.TraderAddress)
-		i = encodeVarintState(dAtA, i, uint64(len(m.TraderAddress)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *InsuranceFund) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InsuranceFund.Merge(m, src)
+}
+func (m *InsuranceFund) XXX_Size() int {
+	return m.Size()
+}
+func (m *InsuranceFund) XXX_DiscardUnknown() {
+	xxx_messageInfo_InsuranceFund.DiscardUnknown(m)
 }
 
-func (m *PairMetadata) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_InsuranceFund proto.InternalMessageInfo
+
+func (m *InsuranceFund) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *PairMetadata) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Shortfall records a bad debt the vault could not pay for and how the payout
+// waterfall covered it.
+type Shortfall struct {
+	Id    uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// The amount of bad debt that had to be covered.
+	BadDebt                github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=bad_debt,json=badDebt,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"bad_debt"`
+	CoveredByInsuranceFund github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=covered_by_insurance_fund,json=coveredByInsuranceFund,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"covered_by_insurance_fund"`
+	CoveredByEcosystemFund github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=covered_by_ecosystem_fund,json=coveredByEcosystemFund,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"covered_by_ecosystem_fund"`
+	// The part of the bad debt left uncovered in the vault.
+	SocializedLoss github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=socialized_loss,json=socializedLoss,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"socialized_loss"`
+	// The block number at which the shortfall occurred.
+	BlockHeight int64 `protobuf:"varint,7,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// The block time in unix milliseconds at which the shortfall occurred.
+	BlockTimeMs int64 `protobuf:"varint,8,opt,name=block_time_ms,json=blockTimeMs,proto3" json:"block_time_ms,omitempty"`
+	// The part of the bad debt recovered by auto-deleveraging positions.
+	CoveredByAutoDeleveraging github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=covered_by_auto_deleveraging,json=coveredByAutoDeleveraging,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"covered_by_auto_deleveraging"`
 }
 
-func (m *PairMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CumulativePremiumFractions) > 0 {
-		for iNdEx := len(m.CumulativePremiumFractions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.CumulativePremiumFractions[iNdEx].Size()
-				i -= size
-				if _, err := m.CumulativePremiumFractions[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintState(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	{
-		size, err := m.Pair.MarshalToSizedBuffer(dAtA[:i])
+func (m *Shortfall) Reset()         { *m = Shortfall{} }
+func (m *Shortfall) String() string { return proto.CompactTextString(m) }
+func (*Shortfall) ProtoMessage()    {}
+func (*Shortfall) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0416b6ef16ef80be, []int{12}
+}
+func (m *Shortfall) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Shortfall) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Shortfall.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintState(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *Shortfall) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Shortfall.Merge(m, src)
+}
+func (m *Shortfall) XXX_Size() int {
+	return m.Size()
+}
+func (m *Shortfall) XXX_DiscardUnknown() {
+	xxx_messageInfo_Shortfall.DiscardUnknown(m)
 }
 
-func (m *PrepaidBadDebt) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_Shortfall proto.InternalMessageInfo
+
+func (m *Shortfall) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *PrepaidBadDebt) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Shortfall) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
 }
 
-func (m *PrepaidBadDebt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintState(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintState(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+func (m *Shortfall) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *PositionResp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Shortfall) GetBlockTimeMs() int64 {
+	if m != nil {
+		return m.BlockTimeMs
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *PositionResp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// SocializedLoss records a loss taken from the margin of the positions and the
+// cross margin collateral of a denom. The loss is taken lazily: it is applied
+// to each margin the next time the position or account is read.
+type SocializedLoss struct {
+	Id    uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// The fraction of each margin taken by the loss.
+	Ratio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=ratio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"ratio"`
+	// The id of the shortfall the loss was socialized for.
+	ShortfallId uint64 `protobuf:"varint,4,opt,name=shortfall_id,json=shortfallId,proto3" json:"shortfall_id,omitempty"`
 }
 
-func (m *PositionResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.PositionNotional.Size()
-		i -= size
-		if _, err := m.PositionNotional.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintState(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x4a
-	{
-		size := m.MarginToVault.Size()
-		i -= size
-		if _, err := m.MarginToVault.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *SocializedLoss) Reset()         { *m = SocializedLoss{} }
+func (m *SocializedLoss) String() string { return proto.CompactTextString(m) }
+func (*SocializedLoss) ProtoMessage()    {}
+func (*SocializedLoss) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0416b6ef16ef80be, []int{13}
+}
+func (m *SocializedLoss) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SocializedLoss) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SocializedLoss.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintState(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x42
-	{
-		size := m.UnrealizedPnlAfter.Size()
-		i -= size
-		if _, err := m.UnrealizedPnlAfter.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintState(dAtA, i, uint64(size))
+}
+func (m *SocializedLoss) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SocializedLoss.Merge(m, src)
+}
+func (m *SocializedLoss) XXX_Size() int {
+	return m.Size()
+}
+func (m *SocializedLoss) XXX_DiscardUnknown() {
+	xxx_messageInfo_SocializedLoss.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SocializedLoss proto.InternalMessageInfo
+
+func (m *SocializedLoss) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	i--
-	dAtA[i] = 0x3a
-	{
-		size := m.RealizedPnl.Size()
-		i -= size
-		if _, err := m.RealizedPnl.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintState(dAtA, i, uint64(size))
+	return 0
+}
+
+func (m *SocializedLoss) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size := m.FundingPayment.Size()
-		i -= size
-		if _, err := m.FundingPayment.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintState(dAtA, i, uint64(size))
+	return ""
+}
+
+func (m *SocializedLoss) GetShortfallId() uint64 {
+	if m != nil {
+		return m.ShortfallId
 	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.BadDebt.Size()
-		i -= size
-		if _, err := m.BadDebt.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintState(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.ExchangedPositionSize.Size()
-		i -= size
-		if _, err := m.ExchangedPositionSize.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintState(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.ExchangedNotionalValue.Size()
-		i -= size
-		if _, err := m.ExchangedNotionalValue.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintState(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if m.Position != nil {
-		{
-			size, err := m.Position.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintState(dAtA, i, uint64(size))
+	return 0
+}
+
+type PositionResp struct {
+	Position *Position `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
+	// The amount of quote assets exchanged.
+	ExchangedNotionalValue github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=exchanged_notional_value,json=exchangedNotionalValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchanged_notional_value"`
+	// The amount of base assets exchanged.
+	ExchangedPositionSize github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=exchanged_position_size,json=exchangedPositionSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchanged_position_size"`
+	// The amount of bad debt accrued during this position change.
+	// Measured in absolute value of quote units.
+	// If greater than zero, then the position change event will likely fail.
+	BadDebt github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=bad_debt,json=badDebt,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"bad_debt"`
+	// The funding payment applied on this position change.
+	FundingPayment github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=funding_payment,json=fundingPayment,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"funding_payment"`
+	// The amount of PnL realized on this position changed, measured in quote
+	// units.
+	RealizedPnl github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=realized_pnl,json=realizedPnl,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"realized_pnl"`
+	// The unrealized PnL in the position after the position change.
+	UnrealizedPnlAfter github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=unrealized_pnl_after,json=unrealizedPnlAfter,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"unrealized_pnl_after"`
+	// The amount of margin the trader has to give to the vault.
+	// A negative value means the vault pays the trader.
+	MarginToVault github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=margin_to_vault,json=marginToVault,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"margin_to_vault"`
+	// The position's notional value after the position change, measured in quote
+	// units.
+	PositionNotional github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=position_notional,json=positionNotional,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"position_notional"`
+}
+
+func (m *PositionResp) Reset()         { *m = PositionResp{} }
+func (m *PositionResp) String() string { return proto.CompactTextString(m) }
+func (*PositionResp) ProtoMessage()    {}
+func (*PositionResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0416b6ef16ef80be, []int{14}
+}
+func (m *PositionResp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PositionResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PositionResp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *PositionResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PositionResp.Merge(m, src)
+}
+func (m *PositionResp) XXX_Size() int {
+	return m.Size()
+}
+func (m *PositionResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_PositionResp.DiscardUnknown(m)
 }
 
-func (m *LiquidateResp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PositionResp proto.InternalMessageInfo
+
+func (m *PositionResp) GetPosition() *Position {
+	if m != nil {
+		return m.Position
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *LiquidateResp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type LiquidateResp struct {
+	// Amount of bad debt created by the liquidation event
+	BadDebt github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=bad_debt,json=badDebt,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"bad_debt"`
+	// Fee paid to the liquidator
+	FeeToLiquidator github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=fee_to_liquidator,json=feeToLiquidator,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"fee_to_liquidator"`
+	// Fee paid to the Perp EF fund
+	FeeToPerpEcosystemFund github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=fee_to_perp_ecosystem_fund,json=feeToPerpEcosystemFund,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"fee_to_perp_ecosystem_fund"`
+	// Address of the liquidator
+	Liquidator string `protobuf:"bytes,4,opt,name=liquidator,proto3" json:"liquidator,omitempty"`
+	// Position response from the close or open reverse position
+	PositionResp *PositionResp `protobuf:"bytes,5,opt,name=position_resp,json=positionResp,proto3" json:"position_resp,omitempty"`
 }
 
-func (m *LiquidateResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PositionResp != nil {
-		{
-			size, err := m.PositionResp.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintState(dAtA, i, uint64(size))
+func (m *LiquidateResp) Reset()         { *m = LiquidateResp{} }
+func (m *LiquidateResp) String() string { return proto.CompactTextString(m) }
+func (*LiquidateResp) ProtoMessage()    {}
+func (*LiquidateResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0416b6ef16ef80be, []int{15}
+}
+func (m *LiquidateResp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LiquidateResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LiquidateResp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Liquidator) > 0 {
-		i -= len(m.Liquidator)
-		copy(dAtA[i:], m.Liquidator)
-		i = encodeVarintState(dAtA, i, uint64(len(m.Liquidator)))
-		i--
-		dAtA[i] = 0x22
+		return b[:n], nil
 	}
-	{
-		size := m.FeeToPerpEcosystemFund.Size()
-		i -= size
-		if _, err := m.FeeToPerpEcosystemFund.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintState(dAtA, i, uint64(size))
+}
+func (m *LiquidateResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidateResp.Merge(m, src)
+}
+func (m *LiquidateResp) XXX_Size() int {
+	return m.Size()
+}
+func (m *LiquidateResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidateResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiquidateResp proto.InternalMessageInfo
+
+func (m *LiquidateResp) GetLiquidator() string {
+	if m != nil {
+		return m.Liquidator
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.FeeToLiquidator.Size()
-		i -= size
-		if _, err := m.FeeToLiquidator.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintState(dAtA, i, uint64(size))
+	return ""
+}
+
+func (m *LiquidateResp) GetPositionResp() *PositionResp {
+	if m != nil {
+		return m.PositionResp
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.BadDebt.Size()
-		i -= size
-		if _, err := m.BadDebt.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return nil
+}
+
+// CrossMarginAccount is the collateral a trader opted into sharing across all
+// of their positions. Margin required by the trader's positions is drawn from
+// the collateral and margin released by them is credited back to it, so the
+// positions are only liquidated when the account as a whole is undercollateralized.
+type CrossMarginAccount struct {
+	// address of the trader who owns the account
+	TraderAddress string `protobuf:"bytes,1,opt,name=trader_address,json=traderAddress,proto3" json:"trader_address,omitempty"`
+	// collateral held in the vault that is not allocated to any position
+	Collateral github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=collateral,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"collateral"`
+	// The id of the last socialized loss taken from the collateral. The losses
+	// after that id are still to be taken from the collateral.
+	SocializedLossCursor uint64 `protobuf:"varint,3,opt,name=socialized_loss_cursor,json=socializedLossCursor,proto3" json:"socialized_loss_cursor,omitempty"`
+}
+
+func (m *CrossMarginAccount) Reset()         { *m = CrossMarginAccount{} }
+func (m *CrossMarginAccount) String() string { return proto.CompactTextString(m) }
+func (*CrossMarginAccount) ProtoMessage()    {}
+func (*CrossMarginAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0416b6ef16ef80be, []int{16}
+}
+func (m *CrossMarginAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CrossMarginAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CrossMarginAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintState(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *CrossMarginAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CrossMarginAccount.Merge(m, src)
+}
+func (m *CrossMarginAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *CrossMarginAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_CrossMarginAccount.DiscardUnknown(m)
 }
 
-func encodeVarintState(dAtA []byte, offset int, v uint64) int {
-	offset -= sovState(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_CrossMarginAccount proto.InternalMessageInfo
+
+func (m *CrossMarginAccount) GetTraderAddress() string {
+	if m != nil {
+		return m.TraderAddress
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *CrossMarginAccount) GetCollateral() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Collateral
 	}
-	var l int
-	_ = l
-	if m.Stopped {
-		n += 2
+	return nil
+}
+
+func (m *CrossMarginAccount) GetSocializedLossCursor() uint64 {
+	if m != nil {
+		return m.SocializedLossCursor
 	}
-	l = m.FeePoolFeeRatio.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.EcosystemFundFeeRatio.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.LiquidationFeeRatio.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.PartialLiquidationRatio.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = len(m.FundingRateInterval)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
-	}
-	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.TwapLookbackWindow)
-	n += 1 + l + sovState(uint64(l))
-	if len(m.WhitelistedLiquidators) > 0 {
-		for _, s := range m.WhitelistedLiquidators {
-			l = len(s)
-			n += 1 + l + sovState(uint64(l))
+	return 0
+}
+
+// OpenInterest is the aggregate size and open notional of the positions of a
+// pair, per side.
+type OpenInterest struct {
+	Pair common.AssetPair `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair"`
+	// The sum of the sizes of the long positions, in base asset units.
+	LongSize github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=long_size,json=longSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"long_size"`
+	// The sum of the absolute sizes of the short positions, in base asset units.
+	ShortSize github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=short_size,json=shortSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"short_size"`
+	// The sum of the open notional of the long positions.
+	LongOpenNotional github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=long_open_notional,json=longOpenNotional,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"long_open_notional"`
+	// The sum of the open notional of the short positions.
+	ShortOpenNotional github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=short_open_notional,json=shortOpenNotional,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"short_open_notional"`
+}
+
+func (m *OpenInterest) Reset()         { *m = OpenInterest{} }
+func (m *OpenInterest) String() string { return proto.CompactTextString(m) }
+func (*OpenInterest) ProtoMessage()    {}
+func (*OpenInterest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0416b6ef16ef80be, []int{17}
+}
+func (m *OpenInterest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OpenInterest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OpenInterest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *OpenInterest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OpenInterest.Merge(m, src)
+}
+func (m *OpenInterest) XXX_Size() int {
+	return m.Size()
+}
+func (m *OpenInterest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OpenInterest.DiscardUnknown(m)
 }
 
-func (m *Position) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_OpenInterest proto.InternalMessageInfo
+
+func (m *OpenInterest) GetPair() common.AssetPair {
+	if m != nil {
+		return m.Pair
 	}
-	var l int
-	_ = l
-	l = len(m.TraderAddress)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	return common.AssetPair{}
+}
+
+// TraderVolume is the notional traded by a trader during a day.
+type TraderVolume struct {
+	TraderAddress string `protobuf:"bytes,1,opt,name=trader_address,json=traderAddress,proto3" json:"trader_address,omitempty"`
+	// The number of days since the unix epoch.
+	Day    uint64                                 `protobuf:"varint,2,opt,name=day,proto3" json:"day,omitempty"`
+	Volume github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=volume,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"volume"`
+}
+
+func (m *TraderVolume) Reset()         { *m = TraderVolume{} }
+func (m *TraderVolume) String() string { return proto.CompactTextString(m) }
+func (*TraderVolume) ProtoMessage()    {}
+func (*TraderVolume) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0416b6ef16ef80be, []int{18}
+}
+func (m *TraderVolume) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TraderVolume) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TraderVolume.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = m.Pair.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.Size_.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.Margin.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.OpenNotional.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.LatestCumulativePremiumFraction.Size()
-	n += 1 + l + sovState(uint64(l))
-	if m.BlockNumber != 0 {
-		n += 1 + sovState(uint64(m.BlockNumber))
+}
+func (m *TraderVolume) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TraderVolume.Merge(m, src)
+}
+func (m *TraderVolume) XXX_Size() int {
+	return m.Size()
+}
+func (m *TraderVolume) XXX_DiscardUnknown() {
+	xxx_messageInfo_TraderVolume.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TraderVolume proto.InternalMessageInfo
+
+func (m *TraderVolume) GetTraderAddress() string {
+	if m != nil {
+		return m.TraderAddress
 	}
-	return n
+	return ""
 }
 
-func (m *PairMetadata) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TraderVolume) GetDay() uint64 {
+	if m != nil {
+		return m.Day
 	}
-	var l int
-	_ = l
-	l = m.Pair.Size()
-	n += 1 + l + sovState(uint64(l))
-	if len(m.CumulativePremiumFractions) > 0 {
-		for _, e := range m.CumulativePremiumFractions {
-			l = e.Size()
-			n += 1 + l + sovState(uint64(l))
+	return 0
+}
+
+// LiquidationAuction is the auction of a fully liquidated position whose
+// notional is above the liquidation auction threshold. The position stays with
+// its trader, who cannot trade it, until the auction ends: it is then taken
+// over by the best bidder, or closed on the vpool if there was no valid bid.
+type LiquidationAuction struct {
+	Pair common.AssetPair `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair"`
+	// address of the trader whose position is auctioned
+	TraderAddress string `protobuf:"bytes,2,opt,name=trader_address,json=traderAddress,proto3" json:"trader_address,omitempty"`
+	// address of the liquidator who started the auction, who is paid the
+	// liquidator share of the liquidation fee
+	LiquidatorAddress string `protobuf:"bytes,3,opt,name=liquidator_address,json=liquidatorAddress,proto3" json:"liquidator_address,omitempty"`
+	// the index price of the pair when the auction started
+	IndexPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=index_price,json=indexPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"index_price"`
+	// the worst price a bid can take the position over at: the index price
+	// discounted by the max discount, below it for longs and above it for shorts
+	LimitPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=limit_price,json=limitPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"limit_price"`
+	// the time at which the auction ends
+	EndTime time.Time `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	// address of the best bidder, empty if there is no bid yet
+	BestBidder string `protobuf:"bytes,7,opt,name=best_bidder,json=bestBidder,proto3" json:"best_bidder,omitempty"`
+	// the price of the best bid
+	BestBidPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=best_bid_price,json=bestBidPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"best_bid_price"`
+	// the margin escrowed in the vault by the best bidder, which becomes the
+	// margin of the position taken over
+	BestBidMargin types.Coin `protobuf:"bytes,9,opt,name=best_bid_margin,json=bestBidMargin,proto3" json:"best_bid_margin"`
+}
+
+func (m *LiquidationAuction) Reset()         { *m = LiquidationAuction{} }
+func (m *LiquidationAuction) String() string { return proto.CompactTextString(m) }
+func (*LiquidationAuction) ProtoMessage()    {}
+func (*LiquidationAuction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0416b6ef16ef80be, []int{19}
+}
+func (m *LiquidationAuction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LiquidationAuction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LiquidationAuction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *LiquidationAuction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidationAuction.Merge(m, src)
+}
+func (m *LiquidationAuction) XXX_Size() int {
+	return m.Size()
+}
+func (m *LiquidationAuction) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidationAuction.DiscardUnknown(m)
 }
 
-func (m *PrepaidBadDebt) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_LiquidationAuction proto.InternalMessageInfo
+
+func (m *LiquidationAuction) GetPair() common.AssetPair {
+	if m != nil {
+		return m.Pair
 	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	return common.AssetPair{}
+}
+
+func (m *LiquidationAuction) GetTraderAddress() string {
+	if m != nil {
+		return m.TraderAddress
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovState(uint64(l))
-	return n
+	return ""
 }
 
-func (m *PositionResp) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *LiquidationAuction) GetLiquidatorAddress() string {
+	if m != nil {
+		return m.LiquidatorAddress
 	}
-	var l int
-	_ = l
-	if m.Position != nil {
-		l = m.Position.Size()
-		n += 1 + l + sovState(uint64(l))
+	return ""
+}
+
+func (m *LiquidationAuction) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
 	}
-	l = m.ExchangedNotionalValue.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.ExchangedPositionSize.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.BadDebt.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.FundingPayment.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.RealizedPnl.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.UnrealizedPnlAfter.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.MarginToVault.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.PositionNotional.Size()
-	n += 1 + l + sovState(uint64(l))
-	return n
+	return time.Time{}
 }
 
-func (m *LiquidateResp) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *LiquidationAuction) GetBestBidder() string {
+	if m != nil {
+		return m.BestBidder
 	}
-	var l int
-	_ = l
-	l = m.BadDebt.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.FeeToLiquidator.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.FeeToPerpEcosystemFund.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = len(m.Liquidator)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	return ""
+}
+
+func (m *LiquidationAuction) GetBestBidMargin() types.Coin {
+	if m != nil {
+		return m.BestBidMargin
 	}
-	if m.PositionResp != nil {
-		l = m.PositionResp.Size()
-		n += 1 + l + sovState(uint64(l))
+	return types.Coin{}
+}
+
+// SettlementCursor is the conditional order and the position at which the
+// settlement sweep of a settled market stopped, the next sweep resumes after
+// them.
+type SettlementCursor struct {
+	Pair common.AssetPair `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair"`
+	// address of the trader of the last position visited by the sweep, empty if
+	// the sweep of the positions starts from the first one
+	TraderAddress string `protobuf:"bytes,2,opt,name=trader_address,json=traderAddress,proto3" json:"trader_address,omitempty"`
+	// id of the last conditional order visited by the sweep, zero if the sweep
+	// of the orders starts from the first one
+	LastOrderId uint64 `protobuf:"varint,3,opt,name=last_order_id,json=lastOrderId,proto3" json:"last_order_id,omitempty"`
+}
+
+func (m *SettlementCursor) Reset()         { *m = SettlementCursor{} }
+func (m *SettlementCursor) String() string { return proto.CompactTextString(m) }
+func (*SettlementCursor) ProtoMessage()    {}
+func (*SettlementCursor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0416b6ef16ef80be, []int{20}
+}
+func (m *SettlementCursor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SettlementCursor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SettlementCursor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SettlementCursor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SettlementCursor.Merge(m, src)
+}
+func (m *SettlementCursor) XXX_Size() int {
+	return m.Size()
+}
+func (m *SettlementCursor) XXX_DiscardUnknown() {
+	xxx_messageInfo_SettlementCursor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SettlementCursor proto.InternalMessageInfo
+
+func (m *SettlementCursor) GetPair() common.AssetPair {
+	if m != nil {
+		return m.Pair
+	}
+	return common.AssetPair{}
+}
+
+func (m *SettlementCursor) GetTraderAddress() string {
+	if m != nil {
+		return m.TraderAddress
+	}
+	return ""
+}
+
+func (m *SettlementCursor) GetLastOrderId() uint64 {
+	if m != nil {
+		return m.LastOrderId
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("nibiru.perp.v1.Side", Side_name, Side_value)
+	proto.RegisterEnum("nibiru.perp.v1.PnLCalcOption", PnLCalcOption_name, PnLCalcOption_value)
+	proto.RegisterEnum("nibiru.perp.v1.PnLPreferenceOption", PnLPreferenceOption_name, PnLPreferenceOption_value)
+	proto.RegisterEnum("nibiru.perp.v1.MarginCalculationPriceOption", MarginCalculationPriceOption_name, MarginCalculationPriceOption_value)
+	proto.RegisterEnum("nibiru.perp.v1.OrderType", OrderType_name, OrderType_value)
+	proto.RegisterEnum("nibiru.perp.v1.FundingRateClampReason", FundingRateClampReason_name, FundingRateClampReason_value)
+	proto.RegisterEnum("nibiru.perp.v1.BadDebtPayer", BadDebtPayer_name, BadDebtPayer_value)
+	proto.RegisterEnum("nibiru.perp.v1.LiquidationAuctionOutcome", LiquidationAuctionOutcome_name, LiquidationAuctionOutcome_value)
+	proto.RegisterEnum("nibiru.perp.v1.MarginPriceSourceType", MarginPriceSourceType_name, MarginPriceSourceType_value)
+	proto.RegisterType((*Params)(nil), "nibiru.perp.v1.Params")
+	proto.RegisterType((*FeeTier)(nil), "nibiru.perp.v1.FeeTier")
+	proto.RegisterType((*MarginPriceSource)(nil), "nibiru.perp.v1.MarginPriceSource")
+	proto.RegisterType((*MarginPricePolicy)(nil), "nibiru.perp.v1.MarginPricePolicy")
+	proto.RegisterType((*PairFeeRatios)(nil), "nibiru.perp.v1.PairFeeRatios")
+	proto.RegisterType((*Position)(nil), "nibiru.perp.v1.Position")
+	proto.RegisterType((*PairMetadata)(nil), "nibiru.perp.v1.PairMetadata")
+	proto.RegisterType((*LiquidityDepthPolicy)(nil), "nibiru.perp.v1.LiquidityDepthPolicy")
+	proto.RegisterType((*FundingRate)(nil), "nibiru.perp.v1.FundingRate")
+	proto.RegisterType((*Order)(nil), "nibiru.perp.v1.Order")
+	proto.RegisterType((*PrepaidBadDebt)(nil), "nibiru.perp.v1.PrepaidBadDebt")
+	proto.RegisterType((*InsuranceFund)(nil), "nibiru.perp.v1.InsuranceFund")
+	proto.RegisterType((*Shortfall)(nil), "nibiru.perp.v1.Shortfall")
+	proto.RegisterType((*SocializedLoss)(nil), "nibiru.perp.v1.SocializedLoss")
+	proto.RegisterType((*PositionResp)(nil), "nibiru.perp.v1.PositionResp")
+	proto.RegisterType((*LiquidateResp)(nil), "nibiru.perp.v1.LiquidateResp")
+	proto.RegisterType((*CrossMarginAccount)(nil), "nibiru.perp.v1.CrossMarginAccount")
+	proto.RegisterType((*OpenInterest)(nil), "nibiru.perp.v1.OpenInterest")
+	proto.RegisterType((*TraderVolume)(nil), "nibiru.perp.v1.TraderVolume")
+	proto.RegisterType((*LiquidationAuction)(nil), "nibiru.perp.v1.LiquidationAuction")
+	proto.RegisterType((*SettlementCursor)(nil), "nibiru.perp.v1.SettlementCursor")
+}
+
+func init() { proto.RegisterFile("perp/v1/state.proto", fileDescriptor_0416b6ef16ef80be) }
+
+var fileDescriptor_0416b6ef16ef80be = []byte{
+	// 3248 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5a, 0xcb, 0x6f, 0xe3, 0xd6,
+	0xd5, 0x1f, 0x4a, 0xf2, 0x43, 0xc7, 0xb6, 0x2c, 0x5f, 0x3f, 0x86, 0xf6, 0x78, 0x6c, 0x47, 0x79,
+	0x7c, 0xfe, 0x9c, 0x2f, 0xf6, 0x37, 0x6e, 0x8a, 0xb6, 0x41, 0x8a, 0x42, 0x96, 0xe4, 0x89, 0x12,
+	0x3d, 0x38, 0x94, 0xec, 0x79, 0x24, 0x28, 0x4b, 0x89, 0xd7, 0x12, 0x33, 0x24, 0x2f, 0x43, 0x52,
+	0x1e, 0x2b, 0xdd, 0x15, 0xe8, 0xa2, 0x9b, 0x36, 0xdd, 0x14, 0x5d, 0x04, 0xe8, 0xa6, 0xab, 0x6e,
+	0x0b, 0x74, 0x5b, 0x74, 0x51, 0x20, 0x9b, 0x02, 0xd9, 0x14, 0x08, 0xba, 0x98, 0x14, 0x99, 0x55,
+	0xbb, 0xec, 0x5f, 0x50, 0xdc, 0x07, 0x65, 0x4a, 0xd6, 0xcc, 0x78, 0xe8, 0xc9, 0x4a, 0xe4, 0x7d,
+	0xfc, 0xce, 0xb9, 0xe7, 0x9e, 0xfb, 0xbb, 0xe7, 0x1c, 0x0a, 0x16, 0x5d, 0xec, 0xb9, 0x7b, 0xa7,
+	0xb7, 0xf6, 0xfc, 0x40, 0x0f, 0xf0, 0xae, 0xeb, 0x91, 0x80, 0xa0, 0x8c, 0x63, 0xb6, 0x4c, 0xaf,
+	0xb7, 0x4b, 0xfb, 0x76, 0x4f, 0x6f, 0xad, 0x2d, 0x75, 0x48, 0x87, 0xb0, 0xae, 0x3d, 0xfa, 0xc4,
+	0x47, 0xad, 0x6d, 0xb4, 0x89, 0x6f, 0x13, 0x7f, 0xaf, 0xa5, 0xfb, 0x78, 0xef, 0xf4, 0x56, 0x0b,
+	0x07, 0xfa, 0xad, 0xbd, 0x36, 0x31, 0x1d, 0xd1, 0xbf, 0xca, 0xfb, 0x35, 0x3e, 0x91, 0xbf, 0x84,
+	0x53, 0x3b, 0x84, 0x74, 0x2c, 0xbc, 0xc7, 0xde, 0x5a, 0xbd, 0x93, 0x3d, 0xa3, 0xe7, 0xe9, 0x81,
+	0x49, 0xc2, 0xa9, 0x9b, 0xa3, 0xfd, 0x81, 0x69, 0x63, 0x3f, 0xd0, 0x6d, 0x57, 0x0c, 0x58, 0x6c,
+	0x13, 0xdb, 0x26, 0xce, 0x1e, 0xff, 0xe1, 0x8d, 0xb9, 0xbf, 0xcd, 0xc3, 0xa4, 0xa2, 0x7b, 0xba,
+	0xed, 0x23, 0x19, 0xa6, 0xfc, 0x80, 0xb8, 0x2e, 0x36, 0x64, 0x69, 0x4b, 0xda, 0x9e, 0x56, 0xc3,
+	0x57, 0xf4, 0x21, 0xa0, 0x13, 0x8c, 0x35, 0x97, 0x10, 0x4b, 0xa3, 0x0f, 0x4c, 0xae, 0x9c, 0xdc,
+	0x92, 0xb6, 0xd3, 0x07, 0xbb, 0x5f, 0x3c, 0xde, 0xbc, 0xf6, 0x8f, 0xc7, 0x9b, 0x6f, 0x74, 0xcc,
+	0xa0, 0xdb, 0x6b, 0xed, 0xb6, 0x89, 0x2d, 0xf4, 0x16, 0x3f, 0x6f, 0xf9, 0xc6, 0xc3, 0xbd, 0xa0,
+	0xef, 0x62, 0x7f, 0xb7, 0x88, 0xdb, 0xea, 0xfc, 0x09, 0xc6, 0x0a, 0x21, 0xd6, 0x21, 0xc6, 0x2a,
+	0x85, 0x41, 0x1d, 0x90, 0x71, 0x9b, 0xf8, 0x7d, 0x3f, 0xc0, 0xb6, 0x76, 0xd2, 0x73, 0x8c, 0x88,
+	0x88, 0x54, 0x2c, 0x11, 0xcb, 0x03, 0xbc, 0xc3, 0x9e, 0x63, 0x0c, 0x04, 0xb5, 0x60, 0xd9, 0x32,
+	0x3f, 0xe9, 0x99, 0x06, 0x7d, 0x73, 0x22, 0x52, 0x26, 0x62, 0x49, 0x59, 0x8c, 0x80, 0x0d, 0x64,
+	0x7c, 0x0c, 0xab, 0xae, 0xee, 0x05, 0xa6, 0x6e, 0x69, 0x51, 0x59, 0x5c, 0xce, 0x64, 0x2c, 0x39,
+	0xd7, 0x05, 0x60, 0xe5, 0x1c, 0x8f, 0xcb, 0xda, 0x87, 0x65, 0x6a, 0x2e, 0xd3, 0xe9, 0x50, 0x7c,
+	0xac, 0x99, 0x4e, 0x80, 0xbd, 0x53, 0xdd, 0x92, 0xa7, 0xa8, 0x1c, 0x75, 0x51, 0x74, 0xaa, 0x7a,
+	0x80, 0xcb, 0xa2, 0x0b, 0xfd, 0x46, 0x82, 0xa5, 0xe0, 0x91, 0xee, 0x6a, 0x16, 0x21, 0x0f, 0x5b,
+	0x7a, 0xfb, 0xa1, 0xf6, 0xc8, 0x74, 0x0c, 0xf2, 0x48, 0x9e, 0xde, 0x92, 0xb6, 0x67, 0xf6, 0x57,
+	0x77, 0xb9, 0x13, 0xed, 0x86, 0x4e, 0xb4, 0x5b, 0x14, 0x4e, 0x76, 0x50, 0xa6, 0x6a, 0xff, 0xfb,
+	0xf1, 0xe6, 0xc6, 0xb8, 0xe9, 0xff, 0x47, 0x6c, 0x33, 0xc0, 0xb6, 0x1b, 0xf4, 0xff, 0xf3, 0x78,
+	0xf3, 0x46, 0x5f, 0xb7, 0xad, 0x77, 0x72, 0xe3, 0xc6, 0xe5, 0x7e, 0xfb, 0xf5, 0xa6, 0xa4, 0x22,
+	0xda, 0x55, 0x11, 0x3d, 0x77, 0x59, 0x07, 0xfa, 0x1e, 0x5c, 0x7f, 0xd4, 0x35, 0x03, 0x6c, 0x99,
+	0x7e, 0x80, 0x8d, 0x81, 0xf1, 0x88, 0xe7, 0xcb, 0xe9, 0xad, 0xe4, 0x76, 0x5a, 0x5d, 0x89, 0x74,
+	0x57, 0xce, 0x7b, 0x91, 0x01, 0x2b, 0xc4, 0x33, 0xb0, 0xa7, 0xe1, 0x33, 0xdc, 0xee, 0x71, 0x6b,
+	0xe3, 0x47, 0xba, 0x67, 0xc8, 0xf0, 0xc2, 0xe6, 0x2e, 0x3b, 0x81, 0xba, 0xc4, 0xd0, 0x4a, 0x21,
+	0x98, 0xca, 0xb0, 0xd0, 0x2f, 0x25, 0x40, 0xb6, 0x7e, 0xa6, 0x71, 0x51, 0xe1, 0xc9, 0x93, 0x67,
+	0x9e, 0x67, 0xb5, 0x92, 0xb0, 0xda, 0xfa, 0xc5, 0xc9, 0x43, 0x36, 0x5b, 0xe5, 0x36, 0xbb, 0x38,
+	0x8a, 0x5b, 0x2c, 0x6b, 0xeb, 0x67, 0x75, 0xda, 0x1e, 0x02, 0xd3, 0x53, 0x63, 0x3a, 0x7e, 0xcf,
+	0xd3, 0x9d, 0x36, 0x3e, 0x3f, 0x35, 0x7e, 0x57, 0xf7, 0xb0, 0x3c, 0x1b, 0xef, 0xd4, 0x0c, 0xf0,
+	0xc4, 0xa9, 0x69, 0x50, 0x30, 0xf4, 0x08, 0xb6, 0x46, 0x04, 0x45, 0x1d, 0x9b, 0x0b, 0x9c, 0x8b,
+	0x25, 0xf0, 0xe6, 0x90, 0xc0, 0x88, 0x7b, 0x73, 0xc1, 0x75, 0x58, 0x6e, 0xe9, 0x86, 0x66, 0xe0,
+	0x56, 0xa0, 0xb9, 0x7a, 0x9f, 0xf4, 0x02, 0x6e, 0x1a, 0x39, 0xb3, 0x95, 0xdc, 0xce, 0xec, 0xaf,
+	0xef, 0x0e, 0x13, 0xee, 0xee, 0x81, 0x6e, 0x14, 0x71, 0x2b, 0x50, 0xf4, 0x3e, 0xf6, 0x54, 0xd4,
+	0x1a, 0xbc, 0x91, 0x5e, 0xc0, 0x4c, 0x87, 0xde, 0x81, 0x34, 0xb5, 0x51, 0x60, 0x62, 0xcf, 0x97,
+	0xe7, 0xb7, 0x92, 0xdb, 0x33, 0xfb, 0xd7, 0x47, 0x41, 0x0e, 0x31, 0x6e, 0x9a, 0xd8, 0x3b, 0x48,
+	0xd1, 0xb5, 0xa8, 0xd3, 0x27, 0xfc, 0xd5, 0x47, 0x3f, 0x84, 0x1b, 0x43, 0x67, 0xad, 0x6b, 0xfa,
+	0x01, 0xf1, 0xfa, 0x9a, 0x85, 0x9d, 0x4e, 0xd0, 0x95, 0xb3, 0x5b, 0xd2, 0x76, 0x4a, 0x95, 0x23,
+	0x27, 0xee, 0x3d, 0x3e, 0xa0, 0xc2, 0xfa, 0xd1, 0x3d, 0xa0, 0x3b, 0xa8, 0x45, 0x21, 0xe4, 0x85,
+	0x58, 0x46, 0xcb, 0xd8, 0xfa, 0xd9, 0xe1, 0xb9, 0x18, 0xd4, 0x05, 0xd9, 0xf5, 0xb0, 0x6d, 0xf6,
+	0x6c, 0xcd, 0xb7, 0x09, 0x09, 0xba, 0x14, 0xff, 0x44, 0x6f, 0x07, 0xc4, 0x93, 0x51, 0x2c, 0x09,
+	0x2b, 0x02, 0xaf, 0x11, 0xc2, 0x1d, 0x32, 0x34, 0xe4, 0xc1, 0xcd, 0xe8, 0xce, 0xeb, 0xbd, 0x36,
+	0xfb, 0x0d, 0xba, 0x1e, 0xf6, 0xbb, 0xc4, 0x32, 0xe4, 0xc5, 0x58, 0xe2, 0x6e, 0x44, 0x40, 0xf3,
+	0x1c, 0xb3, 0x19, 0x42, 0x52, 0xe7, 0x1b, 0x27, 0x93, 0xda, 0xd2, 0x30, 0xfd, 0x36, 0xe9, 0x39,
+	0x81, 0xbc, 0x14, 0xcf, 0xf9, 0x2e, 0x8a, 0xad, 0xea, 0x67, 0x45, 0x01, 0x8a, 0xfe, 0x24, 0xc1,
+	0xfa, 0x38, 0xc9, 0x83, 0x93, 0xbf, 0xfc, 0xbc, 0x93, 0x7f, 0x5f, 0x9c, 0xfc, 0x37, 0x9e, 0x05,
+	0x33, 0xc4, 0x01, 0xaf, 0x72, 0x0e, 0x78, 0xd6, 0x78, 0xce, 0x06, 0x6b, 0x17, 0x75, 0x0f, 0xc5,
+	0xe6, 0x3e, 0x97, 0x60, 0x4a, 0x38, 0x31, 0xaa, 0x02, 0xd8, 0xa6, 0xa3, 0x9d, 0x12, 0xab, 0x67,
+	0x63, 0x59, 0x8a, 0x65, 0xa7, 0xb4, 0x6d, 0x3a, 0xc7, 0x0c, 0x00, 0x1d, 0x00, 0x0c, 0xee, 0x4c,
+	0x5f, 0x4e, 0x30, 0x03, 0xdc, 0x1c, 0x3d, 0x40, 0x8a, 0x6e, 0x7a, 0xe1, 0x6d, 0xe8, 0x8b, 0x63,
+	0x94, 0x3e, 0x09, 0x1b, 0xa8, 0x7a, 0x0b, 0x55, 0xdd, 0xeb, 0x98, 0x8e, 0xe2, 0x99, 0x6d, 0xdc,
+	0x20, 0x3d, 0xaf, 0x8d, 0xd1, 0x0f, 0x20, 0x45, 0x25, 0x32, 0x15, 0x33, 0xfb, 0xaf, 0x8f, 0x62,
+	0x5e, 0x98, 0xd0, 0xec, 0xbb, 0x58, 0x65, 0x53, 0x50, 0x05, 0xe6, 0x47, 0xaf, 0xb2, 0xc4, 0xf3,
+	0xb6, 0x66, 0x9a, 0x6a, 0xc5, 0x2c, 0x99, 0xb1, 0x86, 0x6e, 0xa1, 0xdc, 0x1f, 0x13, 0x43, 0xea,
+	0x29, 0xc4, 0x32, 0xdb, 0x7d, 0x94, 0x87, 0x29, 0x9f, 0xc9, 0xf5, 0x65, 0x89, 0xd1, 0xc6, 0x2b,
+	0xcf, 0xd5, 0x50, 0xac, 0x3c, 0x9c, 0x87, 0x0a, 0x00, 0xae, 0x87, 0x4f, 0xb0, 0x87, 0x9d, 0x36,
+	0x66, 0x1a, 0x66, 0xf6, 0x5f, 0xbd, 0x60, 0x3b, 0xa7, 0xa2, 0x0c, 0x06, 0xd5, 0x5d, 0x76, 0xfd,
+	0x44, 0xa6, 0x21, 0x0d, 0xae, 0x9f, 0xf4, 0xac, 0xe1, 0xc8, 0x82, 0x0b, 0x60, 0xb1, 0xd8, 0x0b,
+	0xe8, 0xb5, 0x4c, 0x71, 0xa2, 0x8c, 0xcb, 0xf7, 0xe1, 0xbb, 0x70, 0xdd, 0x74, 0x0c, 0x7c, 0xa6,
+	0x91, 0x53, 0xec, 0x69, 0xbe, 0xeb, 0x61, 0x9d, 0xd2, 0xbd, 0x6d, 0x06, 0x2c, 0x12, 0x9b, 0x56,
+	0x97, 0x58, 0x77, 0xfd, 0x14, 0x7b, 0x0d, 0xd6, 0x59, 0xa1, 0x7d, 0xb9, 0xbf, 0x4b, 0x30, 0x37,
+	0xb4, 0xef, 0x4f, 0x09, 0x18, 0xa5, 0x6f, 0x3f, 0x60, 0x4c, 0xbc, 0xc4, 0x80, 0x31, 0xf7, 0x79,
+	0x0a, 0xa6, 0x15, 0xe2, 0x9b, 0xec, 0xc2, 0x7d, 0x1d, 0x32, 0x81, 0xa7, 0xd3, 0xab, 0x59, 0x37,
+	0x0c, 0x0f, 0xfb, 0x3e, 0x5f, 0x8e, 0x3a, 0xc7, 0x5b, 0xf3, 0xbc, 0x11, 0xed, 0x43, 0xca, 0xd5,
+	0x4d, 0x4f, 0x38, 0xa1, 0x1c, 0x6e, 0x88, 0x88, 0xb9, 0xf3, 0xbe, 0x8f, 0x03, 0x6a, 0x2a, 0xb1,
+	0x0f, 0x6c, 0x2c, 0x3a, 0x80, 0x94, 0x6f, 0x7e, 0x8a, 0x63, 0x06, 0xd4, 0x6c, 0x2e, 0x3a, 0x84,
+	0x49, 0x9b, 0x6d, 0x76, 0xcc, 0x98, 0x59, 0xcc, 0x46, 0x0d, 0x98, 0x23, 0x2e, 0x76, 0x34, 0x87,
+	0xd0, 0x55, 0xeb, 0x56, 0xcc, 0xe0, 0x78, 0x96, 0x82, 0xd4, 0x04, 0x06, 0xfa, 0x29, 0xe4, 0x2c,
+	0x3d, 0xc0, 0x7e, 0xa0, 0xb5, 0x7b, 0x76, 0xcf, 0xd2, 0x03, 0xf3, 0x14, 0x6b, 0xe1, 0xb5, 0x75,
+	0xe2, 0xe9, 0x8c, 0xc2, 0x62, 0x86, 0xc7, 0x9b, 0x1c, 0xb9, 0x30, 0x00, 0x56, 0x38, 0xee, 0xa1,
+	0x80, 0x45, 0xaf, 0xc0, 0x6c, 0xcb, 0x22, 0xed, 0x87, 0x9a, 0xd3, 0xb3, 0x5b, 0xd8, 0x63, 0xd1,
+	0x71, 0x52, 0x9d, 0x61, 0x6d, 0x35, 0xd6, 0x84, 0xde, 0x86, 0x15, 0x9f, 0xb4, 0x4d, 0xdd, 0x32,
+	0x3f, 0xa5, 0xb1, 0x27, 0xf1, 0x7d, 0xad, 0xdd, 0xf3, 0x7c, 0xe2, 0xb1, 0xb0, 0x38, 0xa5, 0x2e,
+	0x9d, 0xf7, 0x56, 0x88, 0xef, 0x17, 0x58, 0x5f, 0xee, 0xab, 0x09, 0x98, 0xa5, 0x7b, 0x59, 0xc5,
+	0x81, 0x6e, 0xe8, 0x81, 0x3e, 0xd8, 0x7b, 0xe9, 0x05, 0xf6, 0xde, 0x83, 0xf5, 0x67, 0xd8, 0x84,
+	0xd2, 0x6c, 0x72, 0x3b, 0x7d, 0xf0, 0xff, 0x2f, 0x66, 0x14, 0x59, 0x52, 0xd7, 0xda, 0x4f, 0x33,
+	0x88, 0x8f, 0xde, 0x1d, 0x22, 0xf2, 0xe4, 0x25, 0x88, 0x3c, 0x42, 0xe1, 0x97, 0xdc, 0xcc, 0xd4,
+	0xb7, 0xb3, 0x99, 0x77, 0x60, 0x91, 0x3b, 0xaa, 0xe6, 0x52, 0x52, 0xd3, 0x5c, 0xc6, 0xd0, 0xf2,
+	0xc4, 0x73, 0xe9, 0x8f, 0x53, 0xb9, 0xba, 0x60, 0x8f, 0x36, 0xa1, 0x07, 0xb0, 0xc2, 0x09, 0xd5,
+	0x0c, 0xfa, 0x9a, 0x81, 0xdd, 0xa0, 0x1b, 0xa2, 0x4e, 0x32, 0xd4, 0xd7, 0x46, 0x51, 0x2b, 0xe1,
+	0xe8, 0x22, 0x1d, 0x2c, 0x80, 0x97, 0xac, 0x31, 0xad, 0xe8, 0x01, 0x2c, 0xb0, 0x90, 0x9e, 0x9e,
+	0x28, 0x96, 0x9e, 0x61, 0x3f, 0x90, 0xa7, 0x62, 0x99, 0x66, 0x9e, 0xa6, 0x00, 0x2e, 0x76, 0xca,
+	0x02, 0x26, 0xc4, 0x76, 0x05, 0x41, 0x69, 0x8c, 0x42, 0xa6, 0x63, 0x63, 0x87, 0x44, 0xd7, 0x30,
+	0x3f, 0xc5, 0x94, 0xf9, 0x96, 0xc6, 0x2d, 0x13, 0xfd, 0x2f, 0x64, 0xb1, 0x4b, 0xda, 0x5d, 0xcd,
+	0x34, 0xb0, 0x13, 0x98, 0x27, 0x26, 0xf6, 0x04, 0x0f, 0xce, 0xb3, 0xf6, 0xf2, 0xa0, 0x19, 0x99,
+	0xb0, 0x3a, 0xb4, 0x6e, 0x61, 0xdb, 0xab, 0xf0, 0xf4, 0x0a, 0x89, 0x18, 0x80, 0xa9, 0xc5, 0x6f,
+	0x84, 0x8f, 0x00, 0xf1, 0x20, 0x67, 0x48, 0x46, 0x3c, 0x3a, 0xcd, 0x72, 0xa4, 0x08, 0xba, 0x08,
+	0xde, 0xdb, 0x5d, 0xdd, 0xe9, 0x5c, 0xad, 0x30, 0x41, 0x83, 0xf7, 0x02, 0x83, 0xe1, 0xc8, 0x74,
+	0x0b, 0x4d, 0x47, 0xfb, 0xa4, 0x47, 0x02, 0xac, 0x79, 0xd8, 0xc7, 0xde, 0x29, 0x8e, 0x49, 0xb8,
+	0xf3, 0xb6, 0xe9, 0xdc, 0xa1, 0x38, 0x2a, 0x87, 0x09, 0xdd, 0x63, 0x18, 0x7b, 0x32, 0xb6, 0x7b,
+	0x44, 0xb1, 0x73, 0xbf, 0x48, 0xc1, 0x4c, 0x34, 0x09, 0x89, 0x43, 0x7c, 0x4b, 0x30, 0xc1, 0x3c,
+	0x86, 0xb9, 0x42, 0x4a, 0xe5, 0x2f, 0xe8, 0x3e, 0x64, 0x2f, 0x50, 0x49, 0xcc, 0x3a, 0x93, 0x3b,
+	0x42, 0x1d, 0x0e, 0xdc, 0x78, 0xf9, 0x84, 0xb5, 0xfa, 0x54, 0x9a, 0x65, 0xd1, 0xb7, 0xee, 0x3d,
+	0xe4, 0x44, 0x15, 0x73, 0x57, 0xd3, 0x14, 0x81, 0x91, 0x15, 0xaa, 0xc3, 0x0c, 0x8f, 0xcd, 0x38,
+	0x5e, 0xbc, 0x9d, 0x04, 0x06, 0xc1, 0x01, 0x07, 0xf7, 0x62, 0x17, 0x9b, 0x9d, 0x6e, 0x30, 0x74,
+	0x2f, 0xbe, 0xc7, 0x9a, 0x50, 0x0e, 0xe6, 0xf8, 0x90, 0xc0, 0xb4, 0xb1, 0x66, 0xfb, 0xf2, 0x74,
+	0x64, 0x4c, 0xd3, 0xb4, 0x71, 0xd5, 0xcf, 0xfd, 0x6b, 0x02, 0x26, 0x78, 0x7e, 0x9d, 0x81, 0x84,
+	0xc9, 0x4b, 0x87, 0x29, 0x35, 0x61, 0x1a, 0x63, 0x22, 0xa6, 0xc4, 0xb3, 0x22, 0xa6, 0xe4, 0x0b,
+	0x38, 0xcf, 0xf7, 0x01, 0x78, 0x99, 0x84, 0xa5, 0x0d, 0x29, 0x16, 0x4e, 0xaf, 0x8e, 0xf2, 0x34,
+	0xd3, 0x8a, 0xa5, 0x0a, 0x69, 0x12, 0x3e, 0xa2, 0x6d, 0x1a, 0x6b, 0x19, 0x7c, 0x3f, 0x32, 0xfb,
+	0x4b, 0xa3, 0x73, 0x1a, 0xa6, 0x81, 0x55, 0x36, 0x82, 0x46, 0x42, 0x81, 0x67, 0x76, 0x3a, 0xd8,
+	0xbb, 0x92, 0xc9, 0x67, 0x05, 0x08, 0x37, 0xfa, 0x47, 0x80, 0xf8, 0x89, 0xd4, 0xe9, 0xba, 0x34,
+	0xdd, 0x66, 0x29, 0xec, 0x54, 0xac, 0x4a, 0x55, 0x96, 0x21, 0x31, 0x03, 0xe5, 0x19, 0x0e, 0x7a,
+	0x1f, 0xa6, 0x2d, 0x7c, 0x8a, 0x3d, 0xbd, 0x13, 0xf7, 0x26, 0x18, 0xcc, 0x47, 0x18, 0xae, 0xd3,
+	0x22, 0xf5, 0x90, 0xa2, 0x22, 0x17, 0x48, 0xc7, 0x2b, 0xac, 0x51, 0xb8, 0x88, 0xb6, 0x2c, 0x77,
+	0x40, 0xef, 0x43, 0x76, 0x6c, 0xe1, 0x8e, 0x26, 0x70, 0x1c, 0x66, 0x97, 0xce, 0xdb, 0x15, 0xb5,
+	0xf2, 0xdd, 0x02, 0x31, 0x1d, 0xe1, 0x0a, 0xf3, 0x78, 0xa4, 0x48, 0xf7, 0x2e, 0x4c, 0xe2, 0x33,
+	0xd7, 0xf4, 0xfa, 0xa2, 0x2e, 0xb7, 0x76, 0x21, 0x05, 0x6c, 0x86, 0x25, 0x71, 0x9e, 0x03, 0x7e,
+	0x46, 0x73, 0x40, 0x31, 0xe7, 0x42, 0x9c, 0x38, 0x7b, 0x21, 0x4e, 0xcc, 0x39, 0x90, 0x51, 0x3c,
+	0xec, 0xea, 0xa6, 0x21, 0x8a, 0x4d, 0x94, 0xc5, 0x0c, 0xec, 0x10, 0x5b, 0x5c, 0x82, 0xfc, 0x85,
+	0x06, 0xe3, 0x62, 0x67, 0x13, 0xb1, 0x4c, 0x25, 0x66, 0xe7, 0xfe, 0x9c, 0x80, 0xb9, 0x72, 0xb4,
+	0x48, 0xf6, 0x14, 0x79, 0x1a, 0x2c, 0x06, 0x24, 0xd0, 0x2d, 0xad, 0x4d, 0x9c, 0xc0, 0x33, 0x5b,
+	0xbd, 0x30, 0x76, 0x8c, 0x23, 0x1c, 0x31, 0xa8, 0x42, 0x14, 0x89, 0x9d, 0x05, 0x26, 0x80, 0x17,
+	0xe2, 0x7c, 0x39, 0x19, 0x0b, 0x7a, 0x96, 0x81, 0xf0, 0x9a, 0x9c, 0x4f, 0xeb, 0xf1, 0x1c, 0x74,
+	0x24, 0xf6, 0x96, 0x53, 0xb1, 0xc0, 0xb9, 0x09, 0x1a, 0x43, 0x91, 0x7a, 0xee, 0x49, 0x0a, 0xd2,
+	0x8d, 0x2e, 0xf1, 0x82, 0x13, 0xdd, 0xb2, 0x2e, 0x30, 0xd4, 0xc0, 0x9a, 0x89, 0xa8, 0x35, 0xcb,
+	0x30, 0x1d, 0x16, 0x1e, 0x63, 0xae, 0x73, 0x4a, 0x54, 0x1f, 0x69, 0x0c, 0xd4, 0xa6, 0xb9, 0x34,
+	0x36, 0xb4, 0x56, 0x5f, 0x1b, 0xae, 0xa3, 0xc6, 0x5c, 0xe6, 0x8a, 0x00, 0x3c, 0xe8, 0x0f, 0x7b,
+	0xc6, 0xb0, 0xa8, 0xe1, 0x04, 0x59, 0x9e, 0xb8, 0xa2, 0xa8, 0x52, 0x34, 0x3f, 0x46, 0x77, 0x61,
+	0x7e, 0x74, 0xcb, 0x26, 0x63, 0x09, 0xc8, 0x0c, 0xe7, 0x55, 0x2f, 0xe9, 0x4a, 0x42, 0x04, 0xd6,
+	0x23, 0xa6, 0xd0, 0x7b, 0x01, 0xd1, 0x0c, 0x2c, 0x88, 0xcd, 0x74, 0x3a, 0x31, 0xf9, 0x6b, 0x75,
+	0x60, 0x8d, 0x7c, 0x2f, 0x20, 0xc5, 0x08, 0x60, 0xee, 0x77, 0x12, 0x64, 0x86, 0x1d, 0xef, 0x92,
+	0xae, 0x56, 0x84, 0x89, 0xab, 0xc4, 0xaa, 0x7c, 0x32, 0x35, 0x9b, 0x1f, 0xfa, 0xb8, 0x66, 0x72,
+	0xc7, 0x4a, 0xa9, 0x33, 0x83, 0xb6, 0xb2, 0x91, 0xfb, 0xfd, 0x24, 0xcc, 0x86, 0x11, 0xbe, 0x8a,
+	0x7d, 0x17, 0xbd, 0x0d, 0xd3, 0x61, 0xe6, 0x30, 0x1a, 0xb6, 0x0d, 0x32, 0xc0, 0x70, 0xfc, 0x60,
+	0x24, 0xad, 0x36, 0xe3, 0x33, 0x1e, 0x08, 0x1b, 0x83, 0x12, 0x81, 0x76, 0xaa, 0x5b, 0x3d, 0x1c,
+	0x37, 0xa4, 0x1f, 0xe0, 0x85, 0xd5, 0x82, 0x63, 0x8a, 0x86, 0x4e, 0xe0, 0xfa, 0xb9, 0xa4, 0xe1,
+	0x1c, 0x27, 0x19, 0xb3, 0xc6, 0x13, 0xc2, 0x45, 0x33, 0x9d, 0xa1, 0xc3, 0x1e, 0x2f, 0x04, 0x1c,
+	0x1c, 0xf6, 0xbb, 0x30, 0x1f, 0x16, 0xf8, 0x5d, 0xbd, 0x6f, 0x63, 0x27, 0x88, 0x19, 0xf5, 0x65,
+	0x04, 0x8c, 0xc2, 0x51, 0xd0, 0x1d, 0x98, 0xf5, 0xb0, 0x38, 0x6d, 0xae, 0x63, 0xc5, 0x0c, 0x44,
+	0x66, 0x42, 0x0c, 0xc5, 0xb1, 0xd0, 0x4f, 0x60, 0xa9, 0xe7, 0x44, 0x41, 0x35, 0xfd, 0x24, 0x10,
+	0xc5, 0x91, 0x17, 0x87, 0x46, 0xe7, 0x58, 0x8a, 0x63, 0xe5, 0x29, 0x12, 0x3a, 0x86, 0x79, 0x91,
+	0xa9, 0x07, 0x44, 0x3b, 0xd5, 0x7b, 0x56, 0x10, 0x33, 0x24, 0x99, 0xe3, 0x30, 0x4d, 0x72, 0x4c,
+	0x41, 0xd0, 0x87, 0xb0, 0x30, 0x70, 0x87, 0x41, 0x91, 0x2a, 0x1d, 0x2f, 0xd5, 0x0b, 0x81, 0x42,
+	0xd7, 0xcb, 0xfd, 0x3c, 0x09, 0x73, 0x61, 0x59, 0x14, 0xb3, 0x73, 0x12, 0xf5, 0x0f, 0xe9, 0x6a,
+	0x97, 0xc1, 0x03, 0x58, 0x60, 0xdf, 0x9f, 0x48, 0xe4, 0xeb, 0x66, 0xcc, 0x3b, 0x9a, 0xd6, 0x44,
+	0x9b, 0xe4, 0xfc, 0x33, 0x28, 0xfa, 0x18, 0xd6, 0x04, 0x36, 0x3d, 0xbd, 0xa3, 0xf4, 0x1f, 0xef,
+	0x16, 0x5b, 0x61, 0x42, 0x14, 0xec, 0xb9, 0xc3, 0xf4, 0xbf, 0x01, 0x10, 0x59, 0x00, 0x3b, 0x34,
+	0x6a, 0xa4, 0x05, 0xe5, 0x61, 0x6e, 0xb0, 0x43, 0x1e, 0xf6, 0x5d, 0x51, 0x9d, 0x59, 0x7f, 0x2a,
+	0xbf, 0x60, 0xdf, 0x55, 0x67, 0xdd, 0xc8, 0x5b, 0xee, 0xb1, 0x04, 0xa8, 0xe0, 0x11, 0xdf, 0xe7,
+	0x15, 0x9c, 0x7c, 0x9b, 0x7f, 0x95, 0xb9, 0x64, 0x0d, 0xf6, 0x21, 0x40, 0x9b, 0x58, 0xb4, 0x94,
+	0xe4, 0xe9, 0x16, 0xab, 0xa0, 0x3d, 0x33, 0x9a, 0x64, 0xc5, 0xb5, 0x3f, 0x7c, 0xbd, 0xb9, 0x7d,
+	0x09, 0xbb, 0xd0, 0x09, 0xbe, 0x1a, 0x81, 0x7f, 0x46, 0xed, 0x30, 0xf9, 0x8c, 0xda, 0xe1, 0xe7,
+	0x49, 0x98, 0x1d, 0xaa, 0xe6, 0xc4, 0x49, 0xa1, 0x3f, 0x80, 0xb4, 0x45, 0x9c, 0x0e, 0x67, 0xc5,
+	0x44, 0xcc, 0x78, 0x9f, 0x38, 0x1d, 0x46, 0x84, 0x55, 0x00, 0x76, 0x61, 0x5c, 0x85, 0x63, 0xd3,
+	0x0c, 0x81, 0xc1, 0x7d, 0x04, 0x88, 0xe9, 0x36, 0x5c, 0x4c, 0x8e, 0xc7, 0xb0, 0x59, 0x8a, 0x54,
+	0x8f, 0x16, 0x94, 0x7f, 0x0c, 0x8b, 0x5c, 0xd9, 0x97, 0x51, 0xab, 0x5e, 0x60, 0x50, 0x51, 0xfc,
+	0xdc, 0xaf, 0x24, 0x98, 0x6d, 0x32, 0x9f, 0x12, 0xdf, 0xbe, 0x2e, 0xe9, 0x79, 0x59, 0x48, 0x1a,
+	0x7a, 0x5f, 0x94, 0x34, 0xe8, 0x23, 0x4d, 0x05, 0xc4, 0xf7, 0xb7, 0x78, 0x26, 0x15, 0xb3, 0x73,
+	0x7f, 0x49, 0x01, 0xaa, 0x5c, 0xf8, 0xec, 0x17, 0xcb, 0x6d, 0x2e, 0x99, 0x97, 0xbf, 0x05, 0xe8,
+	0xfc, 0x50, 0x0f, 0x86, 0xb2, 0x55, 0xa8, 0x0b, 0xe7, 0x3d, 0xe1, 0xf0, 0x91, 0xfa, 0x44, 0xea,
+	0xca, 0xf5, 0x89, 0x3a, 0xcc, 0xb0, 0x74, 0xf3, 0x4a, 0x05, 0x14, 0x60, 0x10, 0x1c, 0xf0, 0x47,
+	0x30, 0x8d, 0x1d, 0x83, 0x05, 0x8e, 0xf2, 0xe4, 0x0b, 0x24, 0x88, 0x53, 0xd8, 0x31, 0x68, 0x3b,
+	0xda, 0x84, 0x99, 0x16, 0x2d, 0x64, 0xb6, 0x4c, 0xc3, 0x08, 0xef, 0x4a, 0x15, 0x68, 0xd3, 0x01,
+	0x6b, 0x41, 0x4d, 0xc8, 0x84, 0x03, 0x84, 0xd6, 0xf1, 0xae, 0xbc, 0x59, 0x81, 0xc9, 0xf5, 0xbe,
+	0x0d, 0xf3, 0x03, 0x54, 0xf1, 0x8d, 0x27, 0x7d, 0xb9, 0x0c, 0x79, 0x4e, 0xe0, 0x70, 0x16, 0xcd,
+	0xfd, 0x5a, 0x82, 0x6c, 0x03, 0x07, 0x81, 0x85, 0x69, 0x58, 0xc1, 0x99, 0xe8, 0xdb, 0xf4, 0xa0,
+	0x1c, 0xcc, 0x59, 0xba, 0x2f, 0xfe, 0xb6, 0x41, 0x03, 0x53, 0xce, 0x88, 0x33, 0xb4, 0x91, 0x15,
+	0x67, 0xca, 0xc6, 0xce, 0x1e, 0xa4, 0x68, 0xcd, 0x05, 0x2d, 0x41, 0xb6, 0x51, 0x2e, 0x96, 0xb4,
+	0xa3, 0x5a, 0x43, 0x29, 0x15, 0xca, 0x87, 0xe5, 0x52, 0x31, 0x7b, 0x0d, 0x4d, 0x41, 0xf2, 0xe0,
+	0xe8, 0x7e, 0x56, 0x42, 0xd3, 0x90, 0x6a, 0x94, 0x2a, 0x95, 0x6c, 0x62, 0xe7, 0x18, 0xe6, 0x14,
+	0xa7, 0x52, 0xd0, 0xad, 0x36, 0xff, 0x42, 0x8a, 0x36, 0xe1, 0x86, 0x52, 0xab, 0x68, 0x85, 0x7c,
+	0xa5, 0xa0, 0xd5, 0x95, 0x66, 0xb9, 0x5e, 0x1b, 0x01, 0xc9, 0x00, 0x34, 0x94, 0x7a, 0x53, 0x53,
+	0xd4, 0x72, 0xa1, 0xc4, 0xb1, 0x9a, 0x77, 0xf3, 0x4a, 0x36, 0x81, 0x00, 0x26, 0xeb, 0x6a, 0xbe,
+	0x50, 0x29, 0x65, 0x93, 0x3b, 0xb7, 0x61, 0x71, 0xcc, 0xf7, 0x57, 0xb4, 0x01, 0x6b, 0x14, 0x5d,
+	0x51, 0x4b, 0x87, 0x25, 0xb5, 0x54, 0x2b, 0x8c, 0xd1, 0xb0, 0x9a, 0xbf, 0x97, 0x95, 0xd8, 0x43,
+	0xb9, 0x96, 0x4d, 0xec, 0x7c, 0x02, 0xeb, 0xdc, 0xde, 0x54, 0x47, 0x56, 0x1b, 0x24, 0xfc, 0x7b,
+	0x83, 0x40, 0xdc, 0x83, 0x37, 0xab, 0x79, 0xf5, 0x76, 0xb9, 0xc6, 0x54, 0x3e, 0xaa, 0xe4, 0x99,
+	0xca, 0x4c, 0xb9, 0xf1, 0xfa, 0xd3, 0xb5, 0x2b, 0xf5, 0x66, 0x56, 0x42, 0x69, 0x98, 0x28, 0xd7,
+	0x8a, 0xa5, 0x7b, 0xd9, 0x04, 0x9a, 0x81, 0xa9, 0x6a, 0xfe, 0x9e, 0xa6, 0xd4, 0x2a, 0xd9, 0xe4,
+	0x8e, 0x0a, 0xe9, 0x41, 0xb1, 0x0b, 0xad, 0xc1, 0x4a, 0x5d, 0x2d, 0x96, 0x54, 0xad, 0x79, 0x5f,
+	0x19, 0xd5, 0x36, 0x0d, 0x13, 0x95, 0x72, 0xb5, 0x4c, 0xb1, 0xe6, 0x20, 0xdd, 0x68, 0xd6, 0x15,
+	0xad, 0x52, 0x6f, 0x34, 0xb2, 0x09, 0x34, 0x0f, 0x33, 0xcd, 0xfc, 0x07, 0x25, 0x4d, 0x51, 0xeb,
+	0x87, 0xe5, 0x66, 0x36, 0xb9, 0x73, 0x0c, 0x2b, 0x91, 0x12, 0x6f, 0xc1, 0xd2, 0x6d, 0x57, 0xc5,
+	0xba, 0x4f, 0x1c, 0x3a, 0xb4, 0x56, 0x6f, 0x6a, 0x85, 0x4a, 0xbe, 0xaa, 0x30, 0xd4, 0x65, 0x58,
+	0x50, 0xd4, 0x52, 0xb5, 0x7c, 0x54, 0xd5, 0x1a, 0xd5, 0x7a, 0xbd, 0xf9, 0x5e, 0xb9, 0x76, 0x3b,
+	0x2b, 0xd1, 0x2d, 0xa5, 0x2a, 0x1e, 0x1e, 0xd5, 0x8a, 0xe5, 0xda, 0x6d, 0x4d, 0xcd, 0x37, 0x4b,
+	0xd9, 0xc4, 0xce, 0xcf, 0x24, 0x98, 0x8d, 0xfe, 0x55, 0x87, 0x5a, 0xf8, 0x20, 0x5f, 0xd4, 0x8a,
+	0xa5, 0x83, 0xa6, 0xa6, 0xe4, 0xef, 0x97, 0xd4, 0x11, 0x9d, 0x11, 0x64, 0xca, 0xb5, 0xc6, 0x91,
+	0x9a, 0xa7, 0xc6, 0xa7, 0x60, 0x59, 0x89, 0xb6, 0x95, 0x0a, 0xf5, 0xc6, 0xfd, 0x46, 0xb3, 0x54,
+	0xe5, 0x6d, 0x09, 0xb4, 0x08, 0xf3, 0x8d, 0x7a, 0xa1, 0x9c, 0xaf, 0x94, 0x1f, 0x94, 0x8a, 0x7c,
+	0x59, 0x49, 0xaa, 0x5a, 0xfe, 0xa8, 0x59, 0xd7, 0x8a, 0xa5, 0x4a, 0xe9, 0xb8, 0xa4, 0xe6, 0x6f,
+	0x53, 0xd5, 0x52, 0x3b, 0x1e, 0xac, 0x5e, 0x24, 0xd3, 0x7a, 0x2f, 0x68, 0x13, 0x1b, 0xa3, 0x37,
+	0xe1, 0x7f, 0x2a, 0xe5, 0x3b, 0x47, 0xe5, 0x22, 0xdf, 0x99, 0xfc, 0x51, 0x81, 0xfd, 0xd6, 0x8f,
+	0x9a, 0x85, 0x7a, 0xb5, 0x34, 0x66, 0x73, 0xea, 0x15, 0xaa, 0xd3, 0x3c, 0xcc, 0x1c, 0x2b, 0xf5,
+	0x7a, 0x45, 0x2b, 0x54, 0xea, 0x8d, 0x52, 0x36, 0x41, 0x2d, 0x5c, 0xa0, 0x4a, 0x57, 0x2a, 0xa5,
+	0x62, 0x36, 0xb9, 0xf3, 0x57, 0x09, 0x96, 0xc7, 0xfe, 0x93, 0x01, 0x6d, 0xc3, 0x6b, 0xc2, 0x23,
+	0xb8, 0x17, 0x34, 0xea, 0x47, 0x6a, 0xa1, 0x34, 0x6e, 0xff, 0xd6, 0x41, 0x1e, 0x37, 0x52, 0xb8,
+	0xc7, 0x2b, 0x70, 0x73, 0x5c, 0x6f, 0x35, 0xaf, 0x7e, 0xa0, 0x09, 0x8f, 0xbf, 0x09, 0xab, 0xe3,
+	0x86, 0x70, 0xaf, 0x4a, 0xa2, 0x1c, 0x6c, 0x3c, 0xb5, 0x9b, 0x43, 0xa4, 0x0e, 0x8a, 0x5f, 0x7c,
+	0xb3, 0x21, 0x7d, 0xf9, 0xcd, 0x86, 0xf4, 0xcf, 0x6f, 0x36, 0xa4, 0xcf, 0x9e, 0x6c, 0x5c, 0xfb,
+	0xf2, 0xc9, 0xc6, 0xb5, 0xaf, 0x9e, 0x6c, 0x5c, 0x7b, 0xb0, 0x13, 0xa1, 0xb7, 0x1a, 0xa3, 0x91,
+	0x42, 0x57, 0x37, 0x9d, 0x3d, 0x4e, 0x29, 0x7b, 0x67, 0x7b, 0xec, 0x7f, 0xb3, 0x8c, 0xe6, 0x5a,
+	0x93, 0x8c, 0x72, 0xbf, 0xf3, 0xdf, 0x01, 0x00, 0x47, 0xfd, 0xdd, 0x81, 0x4c, 0x2b, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.LiquidationAuctionDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.LiquidationAuctionDuration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintState(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xaa
+	{
+		size := m.LiquidationAuctionMaxDiscount.Size()
+		i -= size
+		if _, err := m.LiquidationAuctionMaxDiscount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xa2
+	{
+		size := m.LiquidationAuctionThreshold.Size()
+		i -= size
+		if _, err := m.LiquidationAuctionThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
+	{
+		size := m.PremiumSmoothingFactor.Size()
+		i -= size
+		if _, err := m.PremiumSmoothingFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	{
+		size := m.MaxFundingRate.Size()
+		i -= size
+		if _, err := m.MaxFundingRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	if m.FundingRateHistoryLength != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.FundingRateHistoryLength))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.FeeTiers) > 0 {
+		for iNdEx := len(m.FeeTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintState(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.BadDebtPayoutOrder) > 0 {
+		dAtA3 := make([]byte, len(m.BadDebtPayoutOrder)*10)
+		var j2 int
+		for _, num := range m.BadDebtPayoutOrder {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintState(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x72
+	}
+	{
+		size := m.InsuranceFundLiquidationShare.Size()
+		i -= size
+		if _, err := m.InsuranceFundLiquidationShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	{
+		size := m.InsuranceFundFeeShare.Size()
+		i -= size
+		if _, err := m.InsuranceFundFeeShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxOrderDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxOrderDuration):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintState(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.OrderExecutionReward.Size()
+		i -= size
+		if _, err := m.OrderExecutionReward.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if len(m.WhitelistedLiquidators) > 0 {
+		for iNdEx := len(m.WhitelistedLiquidators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.WhitelistedLiquidators[iNdEx])
+			copy(dAtA[i:], m.WhitelistedLiquidators[iNdEx])
+			i = encodeVarintState(dAtA, i, uint64(len(m.WhitelistedLiquidators[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.TwapLookbackWindow, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.TwapLookbackWindow):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintState(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x42
+	if len(m.FundingRateInterval) > 0 {
+		i -= len(m.FundingRateInterval)
+		copy(dAtA[i:], m.FundingRateInterval)
+		i = encodeVarintState(dAtA, i, uint64(len(m.FundingRateInterval)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	{
+		size := m.PartialLiquidationRatio.Size()
+		i -= size
+		if _, err := m.PartialLiquidationRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.LiquidationFeeRatio.Size()
+		i -= size
+		if _, err := m.LiquidationFeeRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.EcosystemFundFeeRatio.Size()
+		i -= size
+		if _, err := m.EcosystemFundFeeRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.FeePoolFeeRatio.Size()
+		i -= size
+		if _, err := m.FeePoolFeeRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Stopped {
+		i--
+		if m.Stopped {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeTier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeTier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FeeRatios.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MinVolume.Size()
+		i -= size
+		if _, err := m.MinVolume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MarginPriceSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarginPriceSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarginPriceSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.LookbackWindow, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.LookbackWindow):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintState(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x12
+	if m.Type != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarginPricePolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarginPricePolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarginPricePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IndexOverSpreadLimit {
+		i--
+		if m.IndexOverSpreadLimit {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.FullLiquidationSource.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Preference != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Preference))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sources) > 0 {
+		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintState(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PairFeeRatios) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PairFeeRatios) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PairFeeRatios) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.EcosystemFundFeeRatio.Size()
+		i -= size
+		if _, err := m.EcosystemFundFeeRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.FeePoolFeeRatio.Size()
+		i -= size
+		if _, err := m.FeePoolFeeRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Position) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Position) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Position) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SocializedLossCursor != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.SocializedLossCursor))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.BlockNumber != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.BlockNumber))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.LatestCumulativePremiumFraction.Size()
+		i -= size
+		if _, err := m.LatestCumulativePremiumFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.OpenNotional.Size()
+		i -= size
+		if _, err := m.OpenNotional.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Margin.Size()
+		i -= size
+		if _, err := m.Margin.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Size_.Size()
+		i -= size
+		if _, err := m.Size_.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Pair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.TraderAddress) > 0 {
+		i -= len(m.TraderAddress)
+		copy(dAtA[i:], m.TraderAddress)
+		i = encodeVarintState(dAtA, i, uint64(len(m.TraderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PairMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PairMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PairMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxPositionSize.Size()
+		i -= size
+		if _, err := m.MaxPositionSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.MaxOpenInterest.Size()
+		i -= size
+		if _, err := m.MaxOpenInterest.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.LiquidityDepthPolicy != nil {
+		{
+			size, err := m.LiquidityDepthPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.MarginPricePolicy != nil {
+		{
+			size, err := m.MarginPricePolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.LatestCumulativePremiumFraction.Size()
+		i -= size
+		if _, err := m.LatestCumulativePremiumFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.FeeRatios != nil {
+		{
+			size, err := m.FeeRatios.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CumulativePremiumFractions) > 0 {
+		for iNdEx := len(m.CumulativePremiumFractions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.CumulativePremiumFractions[iNdEx].Size()
+				i -= size
+				if _, err := m.CumulativePremiumFractions[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintState(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Pair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *LiquidityDepthPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidityDepthPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidityDepthPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxQuoteReserve.Size()
+		i -= size
+		if _, err := m.MaxQuoteReserve.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MinQuoteReserve.Size()
+		i -= size
+		if _, err := m.MinQuoteReserve.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MaxChangeRatio.Size()
+		i -= size
+		if _, err := m.MaxChangeRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.VolumeDepthRatio.Size()
+		i -= size
+		if _, err := m.VolumeDepthRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.OpenInterestDepthRatio.Size()
+		i -= size
+		if _, err := m.OpenInterestDepthRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.EpochIdentifier) > 0 {
+		i -= len(m.EpochIdentifier)
+		copy(dAtA[i:], m.EpochIdentifier)
+		i = encodeVarintState(dAtA, i, uint64(len(m.EpochIdentifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FundingRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FundingRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FundingRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockTimeMs != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.BlockTimeMs))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.IndexPrice.Size()
+		i -= size
+		if _, err := m.IndexPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MarkPrice.Size()
+		i -= size
+		if _, err := m.MarkPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.CumulativePremiumFraction.Size()
+		i -= size
+		if _, err := m.CumulativePremiumFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.PremiumFraction.Size()
+		i -= size
+		if _, err := m.PremiumFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Epoch != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Pair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Order) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Order) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Order) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockNumber != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.BlockNumber))
+		i--
+		dAtA[i] = 0x60
+	}
+	n15, err15 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Expiry, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiry):])
+	if err15 != nil {
+		return 0, err15
+	}
+	i -= n15
+	i = encodeVarintState(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0x5a
+	{
+		size, err := m.ExecutionReward.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.BaseAssetAmountLimit.Size()
+		i -= size
+		if _, err := m.BaseAssetAmountLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.Leverage.Size()
+		i -= size
+		if _, err := m.Leverage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.QuoteAssetAmount.Size()
+		i -= size
+		if _, err := m.QuoteAssetAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.TriggerPrice.Size()
+		i -= size
+		if _, err := m.TriggerPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.Side != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Side))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.OrderType != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.OrderType))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Pair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.TraderAddress) > 0 {
+		i -= len(m.TraderAddress)
+		copy(dAtA[i:], m.TraderAddress)
+		i = encodeVarintState(dAtA, i, uint64(len(m.TraderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PrepaidBadDebt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrepaidBadDebt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PrepaidBadDebt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InsuranceFund) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InsuranceFund) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InsuranceFund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalSocializedLoss.Size()
+		i -= size
+		if _, err := m.TotalSocializedLoss.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.TotalPayouts.Size()
+		i -= size
+		if _, err := m.TotalPayouts.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.TotalContributions.Size()
+		i -= size
+		if _, err := m.TotalContributions.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Shortfall) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Shortfall) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Shortfall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CoveredByAutoDeleveraging.Size()
+		i -= size
+		if _, err := m.CoveredByAutoDeleveraging.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.BlockTimeMs != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.BlockTimeMs))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.SocializedLoss.Size()
+		i -= size
+		if _, err := m.SocializedLoss.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.CoveredByEcosystemFund.Size()
+		i -= size
+		if _, err := m.CoveredByEcosystemFund.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.CoveredByInsuranceFund.Size()
+		i -= size
+		if _, err := m.CoveredByInsuranceFund.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.BadDebt.Size()
+		i -= size
+		if _, err := m.BadDebt.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SocializedLoss) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SocializedLoss) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SocializedLoss) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ShortfallId != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.ShortfallId))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Ratio.Size()
+		i -= size
+		if _, err := m.Ratio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PositionResp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PositionResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PositionResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.PositionNotional.Size()
+		i -= size
+		if _, err := m.PositionNotional.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.MarginToVault.Size()
+		i -= size
+		if _, err := m.MarginToVault.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.UnrealizedPnlAfter.Size()
+		i -= size
+		if _, err := m.UnrealizedPnlAfter.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.RealizedPnl.Size()
+		i -= size
+		if _, err := m.RealizedPnl.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.FundingPayment.Size()
+		i -= size
+		if _, err := m.FundingPayment.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.BadDebt.Size()
+		i -= size
+		if _, err := m.BadDebt.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.ExchangedPositionSize.Size()
+		i -= size
+		if _, err := m.ExchangedPositionSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.ExchangedNotionalValue.Size()
+		i -= size
+		if _, err := m.ExchangedNotionalValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Position != nil {
+		{
+			size, err := m.Position.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LiquidateResp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidateResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidateResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PositionResp != nil {
+		{
+			size, err := m.PositionResp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Liquidator) > 0 {
+		i -= len(m.Liquidator)
+		copy(dAtA[i:], m.Liquidator)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Liquidator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.FeeToPerpEcosystemFund.Size()
+		i -= size
+		if _, err := m.FeeToPerpEcosystemFund.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.FeeToLiquidator.Size()
+		i -= size
+		if _, err := m.FeeToLiquidator.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.BadDebt.Size()
+		i -= size
+		if _, err := m.BadDebt.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CrossMarginAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CrossMarginAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CrossMarginAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SocializedLossCursor != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.SocializedLossCursor))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Collateral) > 0 {
+		for iNdEx := len(m.Collateral) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Collateral[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintState(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.TraderAddress) > 0 {
+		i -= len(m.TraderAddress)
+		copy(dAtA[i:], m.TraderAddress)
+		i = encodeVarintState(dAtA, i, uint64(len(m.TraderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OpenInterest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OpenInterest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OpenInterest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ShortOpenNotional.Size()
+		i -= size
+		if _, err := m.ShortOpenNotional.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.LongOpenNotional.Size()
+		i -= size
+		if _, err := m.LongOpenNotional.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.ShortSize.Size()
+		i -= size
+		if _, err := m.ShortSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.LongSize.Size()
+		i -= size
+		if _, err := m.LongSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Pair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TraderVolume) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TraderVolume) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TraderVolume) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Volume.Size()
+		i -= size
+		if _, err := m.Volume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Day != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Day))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TraderAddress) > 0 {
+		i -= len(m.TraderAddress)
+		copy(dAtA[i:], m.TraderAddress)
+		i = encodeVarintState(dAtA, i, uint64(len(m.TraderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LiquidationAuction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidationAuction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidationAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BestBidMargin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.BestBidPrice.Size()
+		i -= size
+		if _, err := m.BestBidPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.BestBidder) > 0 {
+		i -= len(m.BestBidder)
+		copy(dAtA[i:], m.BestBidder)
+		i = encodeVarintState(dAtA, i, uint64(len(m.BestBidder)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	n22, err22 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err22 != nil {
+		return 0, err22
+	}
+	i -= n22
+	i = encodeVarintState(dAtA, i, uint64(n22))
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.LimitPrice.Size()
+		i -= size
+		if _, err := m.LimitPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.IndexPrice.Size()
+		i -= size
+		if _, err := m.IndexPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.LiquidatorAddress) > 0 {
+		i -= len(m.LiquidatorAddress)
+		copy(dAtA[i:], m.LiquidatorAddress)
+		i = encodeVarintState(dAtA, i, uint64(len(m.LiquidatorAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TraderAddress) > 0 {
+		i -= len(m.TraderAddress)
+		copy(dAtA[i:], m.TraderAddress)
+		i = encodeVarintState(dAtA, i, uint64(len(m.TraderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Pair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SettlementCursor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SettlementCursor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SettlementCursor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastOrderId != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.LastOrderId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TraderAddress) > 0 {
+		i -= len(m.TraderAddress)
+		copy(dAtA[i:], m.TraderAddress)
+		i = encodeVarintState(dAtA, i, uint64(len(m.TraderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Pair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintState(dAtA []byte, offset int, v uint64) int {
+	offset -= sovState(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Stopped {
+		n += 2
+	}
+	l = m.FeePoolFeeRatio.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.EcosystemFundFeeRatio.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.LiquidationFeeRatio.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.PartialLiquidationRatio.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = len(m.FundingRateInterval)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.TwapLookbackWindow)
+	n += 1 + l + sovState(uint64(l))
+	if len(m.WhitelistedLiquidators) > 0 {
+		for _, s := range m.WhitelistedLiquidators {
+			l = len(s)
+			n += 1 + l + sovState(uint64(l))
+		}
+	}
+	l = m.OrderExecutionReward.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxOrderDuration)
+	n += 1 + l + sovState(uint64(l))
+	l = m.InsuranceFundFeeShare.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.InsuranceFundLiquidationShare.Size()
+	n += 1 + l + sovState(uint64(l))
+	if len(m.BadDebtPayoutOrder) > 0 {
+		l = 0
+		for _, e := range m.BadDebtPayoutOrder {
+			l += sovState(uint64(e))
+		}
+		n += 1 + sovState(uint64(l)) + l
+	}
+	if len(m.FeeTiers) > 0 {
+		for _, e := range m.FeeTiers {
+			l = e.Size()
+			n += 1 + l + sovState(uint64(l))
+		}
+	}
+	if m.FundingRateHistoryLength != 0 {
+		n += 2 + sovState(uint64(m.FundingRateHistoryLength))
+	}
+	l = m.MaxFundingRate.Size()
+	n += 2 + l + sovState(uint64(l))
+	l = m.PremiumSmoothingFactor.Size()
+	n += 2 + l + sovState(uint64(l))
+	l = m.LiquidationAuctionThreshold.Size()
+	n += 2 + l + sovState(uint64(l))
+	l = m.LiquidationAuctionMaxDiscount.Size()
+	n += 2 + l + sovState(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.LiquidationAuctionDuration)
+	n += 2 + l + sovState(uint64(l))
+	return n
+}
+
+func (m *FeeTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MinVolume.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.FeeRatios.Size()
+	n += 1 + l + sovState(uint64(l))
+	return n
+}
+
+func (m *MarginPriceSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovState(uint64(m.Type))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.LookbackWindow)
+	n += 1 + l + sovState(uint64(l))
+	return n
+}
+
+func (m *MarginPricePolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Sources) > 0 {
+		for _, e := range m.Sources {
+			l = e.Size()
+			n += 1 + l + sovState(uint64(l))
+		}
+	}
+	if m.Preference != 0 {
+		n += 1 + sovState(uint64(m.Preference))
+	}
+	l = m.FullLiquidationSource.Size()
+	n += 1 + l + sovState(uint64(l))
+	if m.IndexOverSpreadLimit {
+		n += 2
+	}
+	return n
+}
+
+func (m *PairFeeRatios) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FeePoolFeeRatio.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.EcosystemFundFeeRatio.Size()
+	n += 1 + l + sovState(uint64(l))
+	return n
+}
+
+func (m *Position) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TraderAddress)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = m.Pair.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.Size_.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.Margin.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.OpenNotional.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.LatestCumulativePremiumFraction.Size()
+	n += 1 + l + sovState(uint64(l))
+	if m.BlockNumber != 0 {
+		n += 1 + sovState(uint64(m.BlockNumber))
+	}
+	if m.SocializedLossCursor != 0 {
+		n += 1 + sovState(uint64(m.SocializedLossCursor))
+	}
+	return n
+}
+
+func (m *PairMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovState(uint64(l))
+	if len(m.CumulativePremiumFractions) > 0 {
+		for _, e := range m.CumulativePremiumFractions {
+			l = e.Size()
+			n += 1 + l + sovState(uint64(l))
+		}
+	}
+	if m.FeeRatios != nil {
+		l = m.FeeRatios.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = m.LatestCumulativePremiumFraction.Size()
+	n += 1 + l + sovState(uint64(l))
+	if m.MarginPricePolicy != nil {
+		l = m.MarginPricePolicy.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.LiquidityDepthPolicy != nil {
+		l = m.LiquidityDepthPolicy.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = m.MaxOpenInterest.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.MaxPositionSize.Size()
+	n += 1 + l + sovState(uint64(l))
+	return n
+}
+
+func (m *LiquidityDepthPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = m.OpenInterestDepthRatio.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.VolumeDepthRatio.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.MaxChangeRatio.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.MinQuoteReserve.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.MaxQuoteReserve.Size()
+	n += 1 + l + sovState(uint64(l))
+	return n
+}
+
+func (m *FundingRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovState(uint64(l))
+	if m.Epoch != 0 {
+		n += 1 + sovState(uint64(m.Epoch))
+	}
+	l = m.PremiumFraction.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.CumulativePremiumFraction.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.MarkPrice.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.IndexPrice.Size()
+	n += 1 + l + sovState(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovState(uint64(m.BlockHeight))
+	}
+	if m.BlockTimeMs != 0 {
+		n += 1 + sovState(uint64(m.BlockTimeMs))
+	}
+	return n
+}
+
+func (m *Order) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovState(uint64(m.Id))
+	}
+	l = len(m.TraderAddress)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = m.Pair.Size()
+	n += 1 + l + sovState(uint64(l))
+	if m.OrderType != 0 {
+		n += 1 + sovState(uint64(m.OrderType))
+	}
+	if m.Side != 0 {
+		n += 1 + sovState(uint64(m.Side))
+	}
+	l = m.TriggerPrice.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.QuoteAssetAmount.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.Leverage.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.BaseAssetAmountLimit.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.ExecutionReward.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiry)
+	n += 1 + l + sovState(uint64(l))
+	if m.BlockNumber != 0 {
+		n += 1 + sovState(uint64(m.BlockNumber))
+	}
+	return n
+}
+
+func (m *PrepaidBadDebt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovState(uint64(l))
+	return n
+}
+
+func (m *InsuranceFund) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = m.TotalContributions.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.TotalPayouts.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.TotalSocializedLoss.Size()
+	n += 1 + l + sovState(uint64(l))
+	return n
+}
+
+func (m *Shortfall) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovState(uint64(m.Id))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = m.BadDebt.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.CoveredByInsuranceFund.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.CoveredByEcosystemFund.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.SocializedLoss.Size()
+	n += 1 + l + sovState(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovState(uint64(m.BlockHeight))
+	}
+	if m.BlockTimeMs != 0 {
+		n += 1 + sovState(uint64(m.BlockTimeMs))
+	}
+	l = m.CoveredByAutoDeleveraging.Size()
+	n += 1 + l + sovState(uint64(l))
+	return n
+}
+
+func (m *SocializedLoss) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovState(uint64(m.Id))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = m.Ratio.Size()
+	n += 1 + l + sovState(uint64(l))
+	if m.ShortfallId != 0 {
+		n += 1 + sovState(uint64(m.ShortfallId))
+	}
+	return n
+}
+
+func (m *PositionResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Position != nil {
+		l = m.Position.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = m.ExchangedNotionalValue.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.ExchangedPositionSize.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.BadDebt.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.FundingPayment.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.RealizedPnl.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.UnrealizedPnlAfter.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.MarginToVault.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.PositionNotional.Size()
+	n += 1 + l + sovState(uint64(l))
+	return n
+}
+
+func (m *LiquidateResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BadDebt.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.FeeToLiquidator.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.FeeToPerpEcosystemFund.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = len(m.Liquidator)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.PositionResp != nil {
+		l = m.PositionResp.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *CrossMarginAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TraderAddress)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if len(m.Collateral) > 0 {
+		for _, e := range m.Collateral {
+			l = e.Size()
+			n += 1 + l + sovState(uint64(l))
+		}
+	}
+	if m.SocializedLossCursor != 0 {
+		n += 1 + sovState(uint64(m.SocializedLossCursor))
+	}
+	return n
+}
+
+func (m *OpenInterest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.LongSize.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.ShortSize.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.LongOpenNotional.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.ShortOpenNotional.Size()
+	n += 1 + l + sovState(uint64(l))
+	return n
+}
+
+func (m *TraderVolume) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TraderAddress)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.Day != 0 {
+		n += 1 + sovState(uint64(m.Day))
+	}
+	l = m.Volume.Size()
+	n += 1 + l + sovState(uint64(l))
+	return n
+}
+
+func (m *LiquidationAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = len(m.TraderAddress)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.LiquidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = m.IndexPrice.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.LimitPrice.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovState(uint64(l))
+	l = len(m.BestBidder)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = m.BestBidPrice.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.BestBidMargin.Size()
+	n += 1 + l + sovState(uint64(l))
+	return n
+}
+
+func (m *SettlementCursor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = len(m.TraderAddress)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.LastOrderId != 0 {
+		n += 1 + sovState(uint64(m.LastOrderId))
+	}
+	return n
+}
+
+func sovState(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozState(x uint64) (n int) {
+	return sovState(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stopped", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Stopped = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePoolFeeRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeePoolFeeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EcosystemFundFeeRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EcosystemFundFeeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationFeeRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidationFeeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartialLiquidationRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PartialLiquidationRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingRateInterval", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FundingRateInterval = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapLookbackWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.TwapLookbackWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WhitelistedLiquidators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WhitelistedLiquidators = append(m.WhitelistedLiquidators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderExecutionReward", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OrderExecutionReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOrderDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MaxOrderDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsuranceFundFeeShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InsuranceFundFeeShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsuranceFundLiquidationShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InsuranceFundLiquidationShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType == 0 {
+				var v BadDebtPayer
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowState
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= BadDebtPayer(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.BadDebtPayoutOrder = append(m.BadDebtPayoutOrder, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowState
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthState
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthState
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.BadDebtPayoutOrder) == 0 {
+					m.BadDebtPayoutOrder = make([]BadDebtPayer, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v BadDebtPayer
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowState
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= BadDebtPayer(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.BadDebtPayoutOrder = append(m.BadDebtPayoutOrder, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field BadDebtPayoutOrder", wireType)
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeTiers = append(m.FeeTiers, FeeTier{})
+			if err := m.FeeTiers[len(m.FeeTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingRateHistoryLength", wireType)
+			}
+			m.FundingRateHistoryLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FundingRateHistoryLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFundingRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxFundingRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PremiumSmoothingFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PremiumSmoothingFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationAuctionThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidationAuctionThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationAuctionMaxDiscount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidationAuctionMaxDiscount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationAuctionDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.LiquidationAuctionDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeTier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeTier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeTier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinVolume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinVolume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRatios", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeRatios.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarginPriceSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarginPriceSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarginPriceSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= MarginPriceSourceType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LookbackWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.LookbackWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarginPricePolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarginPricePolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarginPricePolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sources = append(m.Sources, MarginPriceSource{})
+			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Preference", wireType)
+			}
+			m.Preference = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Preference |= PnLPreferenceOption(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FullLiquidationSource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FullLiquidationSource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexOverSpreadLimit", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IndexOverSpreadLimit = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PairFeeRatios) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PairFeeRatios: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PairFeeRatios: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePoolFeeRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeePoolFeeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EcosystemFundFeeRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EcosystemFundFeeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Position) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Position: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Position: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TraderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Size_.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Margin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Margin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenNotional", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OpenNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestCumulativePremiumFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LatestCumulativePremiumFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+			}
+			m.BlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SocializedLossCursor", wireType)
+			}
+			m.SocializedLossCursor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SocializedLossCursor |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PairMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PairMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PairMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativePremiumFractions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.CumulativePremiumFractions = append(m.CumulativePremiumFractions, v)
+			if err := m.CumulativePremiumFractions[len(m.CumulativePremiumFractions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRatios", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FeeRatios == nil {
+				m.FeeRatios = &PairFeeRatios{}
+			}
+			if err := m.FeeRatios.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestCumulativePremiumFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LatestCumulativePremiumFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarginPricePolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MarginPricePolicy == nil {
+				m.MarginPricePolicy = &MarginPricePolicy{}
+			}
+			if err := m.MarginPricePolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityDepthPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LiquidityDepthPolicy == nil {
+				m.LiquidityDepthPolicy = &LiquidityDepthPolicy{}
+			}
+			if err := m.LiquidityDepthPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOpenInterest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxOpenInterest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPositionSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPositionSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidityDepthPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidityDepthPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidityDepthPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenInterestDepthRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OpenInterestDepthRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumeDepthRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VolumeDepthRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangeRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxChangeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinQuoteReserve", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinQuoteReserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxQuoteReserve", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxQuoteReserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FundingRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FundingRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FundingRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PremiumFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PremiumFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativePremiumFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CumulativePremiumFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MarkPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IndexPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimeMs", wireType)
+			}
+			m.BlockTimeMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockTimeMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Order) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Order: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Order: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TraderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
+			}
+			m.OrderType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderType |= OrderType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Side", wireType)
+			}
+			m.Side = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Side |= Side(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TriggerPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAssetAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.QuoteAssetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leverage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Leverage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAssetAmountLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseAssetAmountLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionReward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExecutionReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+			}
+			m.BlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PrepaidBadDebt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PrepaidBadDebt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PrepaidBadDebt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InsuranceFund) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InsuranceFund: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InsuranceFund: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalContributions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalContributions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPayouts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalPayouts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSocializedLoss", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalSocializedLoss.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Shortfall) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Shortfall: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Shortfall: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BadDebt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BadDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoveredByInsuranceFund", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CoveredByInsuranceFund.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoveredByEcosystemFund", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CoveredByEcosystemFund.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SocializedLoss", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SocializedLoss.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimeMs", wireType)
+			}
+			m.BlockTimeMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockTimeMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoveredByAutoDeleveraging", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CoveredByAutoDeleveraging.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SocializedLoss) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SocializedLoss: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SocializedLoss: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ratio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Ratio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShortfallId", wireType)
+			}
+			m.ShortfallId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShortfallId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovState(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozState(x uint64) (n int) {
-	return sovState(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *PositionResp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1264,17 +7860,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: PositionResp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PositionResp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stopped", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -1284,15 +7880,235 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Position == nil {
+				m.Position = &Position{}
+			}
+			if err := m.Position.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedNotionalValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangedNotionalValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedPositionSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangedPositionSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BadDebt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BadDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingPayment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FundingPayment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RealizedPnl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RealizedPnl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnrealizedPnlAfter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Stopped = bool(v != 0)
-		case 3:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnrealizedPnlAfter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeePoolFeeRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarginToVault", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1320,13 +8136,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FeePoolFeeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MarginToVault.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EcosystemFundFeeRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionNotional", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1354,13 +8170,63 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.EcosystemFundFeeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PositionNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidateResp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidateResp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidateResp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationFeeRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BadDebt", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1388,13 +8254,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LiquidationFeeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BadDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PartialLiquidationRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeToLiquidator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1422,13 +8288,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PartialLiquidationRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FeeToLiquidator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FundingRateInterval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeToPerpEcosystemFund", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1456,13 +8322,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FundingRateInterval = string(dAtA[iNdEx:postIndex])
+			if err := m.FeeToPerpEcosystemFund.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TwapLookbackWindow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -1472,30 +8340,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.TwapLookbackWindow, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Liquidator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WhitelistedLiquidators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionResp", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -1505,23 +8372,27 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WhitelistedLiquidators = append(m.WhitelistedLiquidators, string(dAtA[iNdEx:postIndex]))
+			if m.PositionResp == nil {
+				m.PositionResp = &PositionResp{}
+			}
+			if err := m.PositionResp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1544,7 +8415,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Position) Unmarshal(dAtA []byte) error {
+func (m *CrossMarginAccount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1567,10 +8438,10 @@ func (m *Position) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Position: wiretype end group for non-group")
+			return fmt.Errorf("proto: CrossMarginAccount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Position: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CrossMarginAccount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1607,7 +8478,7 @@ func (m *Position) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1634,15 +8505,16 @@ func (m *Position) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Collateral = append(m.Collateral, types.Coin{})
+			if err := m.Collateral[len(m.Collateral)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SocializedLossCursor", wireType)
 			}
-			var stringLen uint64
+			m.SocializedLossCursor = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -1652,65 +8524,66 @@ func (m *Position) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SocializedLossCursor |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthState
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthState
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Size_.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Margin", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowState
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthState
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthState
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OpenInterest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Margin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 5:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OpenInterest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OpenInterest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OpenNotional", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -1720,29 +8593,28 @@ func (m *Position) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OpenNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestCumulativePremiumFraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LongSize", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1770,15 +8642,15 @@ func (m *Position) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LatestCumulativePremiumFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LongSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShortSize", wireType)
 			}
-			m.BlockNumber = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -1788,66 +8660,31 @@ func (m *Position) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockNumber |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipState(dAtA[iNdEx:])
-			if err != nil {
-				return err
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthState
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PairMetadata) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowState
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.ShortSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PairMetadata: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PairMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LongOpenNotional", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -1857,28 +8694,29 @@ func (m *PairMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LongOpenNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CumulativePremiumFractions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShortOpenNotional", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1906,9 +8744,7 @@ func (m *PairMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_cosmos_cosmos_sdk_types.Dec
-			m.CumulativePremiumFractions = append(m.CumulativePremiumFractions, v)
-			if err := m.CumulativePremiumFractions[len(m.CumulativePremiumFractions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ShortOpenNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1933,7 +8769,7 @@ func (m *PairMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PrepaidBadDebt) Unmarshal(dAtA []byte) error {
+func (m *TraderVolume) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1956,15 +8792,15 @@ func (m *PrepaidBadDebt) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PrepaidBadDebt: wiretype end group for non-group")
+			return fmt.Errorf("proto: TraderVolume: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PrepaidBadDebt: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TraderVolume: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TraderAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1992,11 +8828,30 @@ func (m *PrepaidBadDebt) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.TraderAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Day", wireType)
+			}
+			m.Day = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Day |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2024,7 +8879,7 @@ func (m *PrepaidBadDebt) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Volume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2049,7 +8904,7 @@ func (m *PrepaidBadDebt) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PositionResp) Unmarshal(dAtA []byte) error {
+func (m *LiquidationAuction) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2072,15 +8927,15 @@ func (m *PositionResp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PositionResp: wiretype end group for non-group")
+			return fmt.Errorf("proto: LiquidationAuction: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PositionResp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LiquidationAuction: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2107,16 +8962,13 @@ func (m *PositionResp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Position == nil {
-				m.Position = &Position{}
-			}
-			if err := m.Position.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedNotionalValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TraderAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2144,13 +8996,11 @@ func (m *PositionResp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExchangedNotionalValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TraderAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedPositionSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2178,13 +9028,11 @@ func (m *PositionResp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExchangedPositionSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.LiquidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BadDebt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2212,13 +9060,13 @@ func (m *PositionResp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BadDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.IndexPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FundingPayment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2246,15 +9094,15 @@ func (m *PositionResp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FundingPayment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LimitPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RealizedPnl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -2264,29 +9112,28 @@ func (m *PositionResp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RealizedPnl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnrealizedPnlAfter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BestBidder", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2314,13 +9161,11 @@ func (m *PositionResp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.UnrealizedPnlAfter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BestBidder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarginToVault", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BestBidPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2348,15 +9193,15 @@ func (m *PositionResp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MarginToVault.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BestBidPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PositionNotional", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BestBidMargin", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -2366,23 +9211,22 @@ func (m *PositionResp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PositionNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BestBidMargin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2407,7 +9251,7 @@ func (m *PositionResp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LiquidateResp) Unmarshal(dAtA []byte) error {
+func (m *SettlementCursor) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2430,17 +9274,17 @@ func (m *LiquidateResp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidateResp: wiretype end group for non-group")
+			return fmt.Errorf("proto: SettlementCursor: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidateResp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SettlementCursor: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BadDebt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -2450,29 +9294,28 @@ func (m *LiquidateResp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BadDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeToLiquidator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TraderAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2500,81 +9343,13 @@ func (m *LiquidateResp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FeeToLiquidator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TraderAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeToPerpEcosystemFund", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowState
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthState
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthState
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.FeeToPerpEcosystemFund.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Liquidator", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowState
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthState
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthState
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Liquidator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PositionResp", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastOrderId", wireType)
 			}
-			var msglen int
+			m.LastOrderId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -2584,28 +9359,11 @@ func (m *LiquidateResp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.LastOrderId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthState
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthState
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.PositionResp == nil {
-				m.PositionResp = &PositionResp{}
-			}
-			if err := m.PositionResp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipState(dAtA[iNdEx:])
@@ -107,8 +107,8 @@ func TestPairMetadata_Validate(t *testing.T) {
 	cases := map[string]test{
 		"success": {
 			p: &PairMetadata{
-				Pair:                       common.MustNewAssetPair("pair1:pair2"),
-				CumulativePremiumFractions: []sdk.Dec{sdk.MustNewDecFromStr("0.1")},
+				Pair:                            common.MustNewAssetPair("pair1:pair2"),
+				LatestCumulativePremiumFraction: sdk.MustNewDecFromStr("0.1"),
 			},
 		},
 
@@ -117,6 +117,20 @@ func TestPairMetadata_Validate(t *testing.T) {
 			wantErr: true,
 		},
 
+		"nil latest cumulative premium fraction": {
+			p: &PairMetadata{
+				Pair: common.MustNewAssetPair("pair1:pair2"),
+			},
+			wantErr: true,
+		},
+
+		"cumulative premium fractions of the old genesis layout": {
+			p: &PairMetadata{
+				Pair:                       common.MustNewAssetPair("pair1:pair2"),
+				CumulativePremiumFractions: []sdk.Dec{sdk.MustNewDecFromStr("0.1")},
+			},
+		},
+
 		"invalid cumulative funding rate": {
 			p: &PairMetadata{
 				Pair:                       common.MustNewAssetPair("pair1:pair2"),
@@ -124,6 +138,34 @@ func TestPairMetadata_Validate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+
+		"invalid liquidity depth policy": {
+			p: &PairMetadata{
+				Pair:                            common.MustNewAssetPair("pair1:pair2"),
+				LatestCumulativePremiumFraction: sdk.MustNewDecFromStr("0.1"),
+				LiquidityDepthPolicy:            &LiquidityDepthPolicy{},
+			},
+			wantErr: true,
+		},
+
+		"open interest caps": {
+			p: &PairMetadata{
+				Pair:                            common.MustNewAssetPair("pair1:pair2"),
+				LatestCumulativePremiumFraction: sdk.MustNewDecFromStr("0.1"),
+				MaxOpenInterest:                 sdk.NewDec(1_000),
+				MaxPositionSize:                 sdk.NewDec(100),
+			},
+		},
+
+		"negative max position size": {
+			p: &PairMetadata{
+				Pair:                            common.MustNewAssetPair("pair1:pair2"),
+				LatestCumulativePremiumFraction: sdk.MustNewDecFromStr("0.1"),
+				MaxOpenInterest:                 sdk.NewDec(1_000),
+				MaxPositionSize:                 sdk.NewDec(-1),
+			},
+			wantErr: true,
+		},
 	}
 
 	for name, tc := range cases {
@@ -139,6 +181,69 @@ func TestPairMetadata_Validate(t *testing.T) {
 	}
 }
 
+func TestLiquidityDepthPolicy_Validate(t *testing.T) {
+	validPolicy := func() *LiquidityDepthPolicy {
+		return &LiquidityDepthPolicy{
+			EpochIdentifier:        "15 min",
+			OpenInterestDepthRatio: sdk.NewDec(2),
+			VolumeDepthRatio:       sdk.MustNewDecFromStr("0.5"),
+			MaxChangeRatio:         sdk.MustNewDecFromStr("0.05"),
+			MinQuoteReserve:        sdk.NewDec(1_000),
+			MaxQuoteReserve:        sdk.ZeroDec(),
+		}
+	}
+
+	cases := map[string]struct {
+		modify  func(p *LiquidityDepthPolicy)
+		wantErr bool
+	}{
+		"success":                     {modify: func(p *LiquidityDepthPolicy) {}},
+		"max quote reserve above min": {modify: func(p *LiquidityDepthPolicy) { p.MaxQuoteReserve = sdk.NewDec(2_000) }},
+		"no epoch identifier": {
+			modify:  func(p *LiquidityDepthPolicy) { p.EpochIdentifier = "" },
+			wantErr: true,
+		},
+		"nil open interest depth ratio": {
+			modify:  func(p *LiquidityDepthPolicy) { p.OpenInterestDepthRatio = sdk.Dec{} },
+			wantErr: true,
+		},
+		"negative volume depth ratio": {
+			modify:  func(p *LiquidityDepthPolicy) { p.VolumeDepthRatio = sdk.NewDec(-1) },
+			wantErr: true,
+		},
+		"zero max change ratio": {
+			modify:  func(p *LiquidityDepthPolicy) { p.MaxChangeRatio = sdk.ZeroDec() },
+			wantErr: true,
+		},
+		"max change ratio of one": {
+			modify:  func(p *LiquidityDepthPolicy) { p.MaxChangeRatio = sdk.OneDec() },
+			wantErr: true,
+		},
+		"zero min quote reserve": {
+			modify:  func(p *LiquidityDepthPolicy) { p.MinQuoteReserve = sdk.ZeroDec() },
+			wantErr: true,
+		},
+		"max quote reserve below min": {
+			modify:  func(p *LiquidityDepthPolicy) { p.MaxQuoteReserve = sdk.NewDec(999) },
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			p := validPolicy()
+			tc.modify(p)
+			err := p.Validate()
+			if tc.wantErr && err == nil {
+				t.Fatal("expected an error")
+			} else if !tc.wantErr && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
 func BenchmarkPosition_Validate(b *testing.B) {
 	t := &Position{
 		TraderAddress:                   testutil.AccAddress().String(),
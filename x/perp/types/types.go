@@ -14,6 +14,28 @@ const (
 	VaultModuleAccount   = "vault"
 	PerpEFModuleAccount  = "perp_ef"
 	FeePoolModuleAccount = "fee_pool"
+	// InsuranceFundModuleAccount holds the insurance fund, which covers bad
+	// debt before the ecosystem fund.
+	InsuranceFundModuleAccount = "perp_insurance_fund"
+
+	// MaxPositionsSettledPerBlock bounds the number of positions and
+	// conditional orders of settled markets the EndBlocker settles or cancels
+	// in a single block.
+	MaxPositionsSettledPerBlock = 100
+
+	// MaxExpiredOrdersRemovedPerBlock bounds the number of expired conditional
+	// orders the EndBlocker removes in a single block.
+	MaxExpiredOrdersRemovedPerBlock = 100
+
+	// MaxTriggeredOrdersExecutedPerBlock bounds the number of triggered
+	// conditional orders the EndBlocker executes in a single block, across all
+	// the markets.
+	MaxTriggeredOrdersExecutedPerBlock = 100
+
+	// MaxADLCandidatesScanned bounds the number of positions, taken by most
+	// profitable entry price, ranked in the auto-deleveraging queue of a pair
+	// and side.
+	MaxADLCandidatesScanned = 100
 )
 
 // x/perp module sentinel errors
@@ -27,6 +49,17 @@ var (
 	ErrMarginRatioTooLow                 = sdkerrors.Register(ModuleName, 7, "margin ratio did not meet maintenance margin ratio")
 	ErrLeverageIsTooHigh                 = sdkerrors.Register(ModuleName, 8, "leverage cannot be higher than vpool parameter")
 	ErrUnauthorized                      = sdkerrors.Register(ModuleName, 9, "operation not authorized")
+	ErrInvalidOrder                      = sdkerrors.Register(ModuleName, 10, "invalid conditional order")
+	ErrNotEnoughCrossMargin              = sdkerrors.Register(ModuleName, 11, "not enough free collateral in the cross margin account")
+	ErrInvalidPartialClose               = sdkerrors.Register(ModuleName, 12, "partial close must reduce the position without closing or reversing it")
+	ErrPairSettled                       = sdkerrors.Register(ModuleName, 13, "pair is settled, positions can only be settled")
+	ErrVaultInsolvent                    = sdkerrors.Register(ModuleName, 14, "the vault and the bad debt payers cannot cover the withdrawal")
+	ErrOpenInterestCapExceeded           = sdkerrors.Register(ModuleName, 15, "the open interest or position size cap is exceeded")
+	ErrPairMetadataNotFound              = sdkerrors.Register(ModuleName, 16, "pair metadata not found")
+	ErrInvalidPositionTransfer           = sdkerrors.Register(ModuleName, 17, "position cannot be transferred")
+	ErrPositionInAuction                 = sdkerrors.Register(ModuleName, 18, "position is locked by a liquidation auction")
+	ErrInvalidAuctionBid                 = sdkerrors.Register(ModuleName, 19, "invalid liquidation auction bid")
+	ErrPairReduceOnly                    = sdkerrors.Register(ModuleName, 20, "pair is reduce only, positions can only be decreased or closed")
 )
 
 func ZeroPosition(ctx sdk.Context, tokenPair common.AssetPair, traderAddr sdk.AccAddress) Position {
@@ -91,6 +91,43 @@ func QueryBaseAssetPrice(clientCtx client.Context, pair common.AssetPair, direct
 	return &queryResp, nil
 }
 
+func QueryMarkPriceCandles(clientCtx client.Context, pair common.AssetPair, resolution string, from string, to string,
+) (*vpooltypes.QueryMarkPriceCandlesResponse, error) {
+	var queryResp vpooltypes.QueryMarkPriceCandlesResponse
+	if err := ExecQuery(clientCtx, vpoolcli.CmdGetMarkPriceCandles(), []string{pair.String(), resolution, from, to}, &queryResp); err != nil {
+		return nil, err
+	}
+	return &queryResp, nil
+}
+
+func QueryTwap(clientCtx client.Context, pair common.AssetPair, twapCalcOption string, lookbackInterval string, extraArgs ...string,
+) (*vpooltypes.QueryTwapResponse, error) {
+	var queryResp vpooltypes.QueryTwapResponse
+	args := append([]string{pair.String(), twapCalcOption, lookbackInterval}, extraArgs...)
+	if err := ExecQuery(clientCtx, vpoolcli.CmdGetTwap(), args, &queryResp); err != nil {
+		return nil, err
+	}
+	return &queryResp, nil
+}
+
+func QueryEstimateSwapQuoteForBase(clientCtx client.Context, pair common.AssetPair, direction string, quoteAmount string,
+) (*vpooltypes.QueryEstimateSwapQuoteForBaseResponse, error) {
+	var queryResp vpooltypes.QueryEstimateSwapQuoteForBaseResponse
+	if err := ExecQuery(clientCtx, vpoolcli.CmdEstimateSwapQuoteForBase(), []string{pair.String(), direction, quoteAmount}, &queryResp); err != nil {
+		return nil, err
+	}
+	return &queryResp, nil
+}
+
+func QueryPoolDepth(clientCtx client.Context, pair common.AssetPair, direction string, steps string,
+) (*vpooltypes.QueryPoolDepthResponse, error) {
+	var queryResp vpooltypes.QueryPoolDepthResponse
+	if err := ExecQuery(clientCtx, vpoolcli.CmdGetPoolDepth(), []string{pair.String(), direction, steps}, &queryResp); err != nil {
+		return nil, err
+	}
+	return &queryResp, nil
+}
+
 func QueryPosition(ctx client.Context, pair common.AssetPair, trader sdk.AccAddress) (*perptypes.QueryPositionResponse, error) {
 	var queryResp perptypes.QueryPositionResponse
 	if err := ExecQuery(ctx, perpcli.CmdQueryPosition(), []string{trader.String(), pair.String()}, &queryResp); err != nil {
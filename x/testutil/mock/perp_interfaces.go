@@ -293,6 +293,21 @@ func (m *MockVpoolKeeper) EXPECT() *MockVpoolKeeperMockRecorder {
 	return m.recorder
 }
 
+// AdjustPoolDepth mocks base method.
+func (m *MockVpoolKeeper) AdjustPoolDepth(arg0 types2.Context, arg1 common.AssetPair, arg2 types2.Dec) (types1.VPool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdjustPoolDepth", arg0, arg1, arg2)
+	ret0, _ := ret[0].(types1.VPool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AdjustPoolDepth indicates an expected call of AdjustPoolDepth.
+func (mr *MockVpoolKeeperMockRecorder) AdjustPoolDepth(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdjustPoolDepth", reflect.TypeOf((*MockVpoolKeeper)(nil).AdjustPoolDepth), arg0, arg1, arg2)
+}
+
 // ExistsPool mocks base method.
 func (m *MockVpoolKeeper) ExistsPool(arg0 types2.Context, arg1 common.AssetPair) bool {
 	m.ctrl.T.Helper()
@@ -409,6 +424,35 @@ func (mr *MockVpoolKeeperMockRecorder) GetMaxLeverage(arg0, arg1 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMaxLeverage", reflect.TypeOf((*MockVpoolKeeper)(nil).GetMaxLeverage), arg0, arg1)
 }
 
+// GetPool mocks base method.
+func (m *MockVpoolKeeper) GetPool(arg0 types2.Context, arg1 common.AssetPair) (types1.VPool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPool", arg0, arg1)
+	ret0, _ := ret[0].(types1.VPool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPool indicates an expected call of GetPool.
+func (mr *MockVpoolKeeperMockRecorder) GetPool(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPool", reflect.TypeOf((*MockVpoolKeeper)(nil).GetPool), arg0, arg1)
+}
+
+// GetPoolStatus mocks base method.
+func (m *MockVpoolKeeper) GetPoolStatus(arg0 types2.Context, arg1 common.AssetPair) types1.PoolStatus {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPoolStatus", arg0, arg1)
+	ret0, _ := ret[0].(types1.PoolStatus)
+	return ret0
+}
+
+// GetPoolStatus indicates an expected call of GetPoolStatus.
+func (mr *MockVpoolKeeperMockRecorder) GetPoolStatus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPoolStatus", reflect.TypeOf((*MockVpoolKeeper)(nil).GetPoolStatus), arg0, arg1)
+}
+
 // GetQuoteAssetPrice mocks base method.
 func (m *MockVpoolKeeper) GetQuoteAssetPrice(arg0 types2.Context, arg1 common.AssetPair, arg2 types1.Direction, arg3 types2.Dec) (types2.Dec, error) {
 	m.ctrl.T.Helper()
@@ -424,6 +468,20 @@ func (mr *MockVpoolKeeperMockRecorder) GetQuoteAssetPrice(arg0, arg1, arg2, arg3
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuoteAssetPrice", reflect.TypeOf((*MockVpoolKeeper)(nil).GetQuoteAssetPrice), arg0, arg1, arg2, arg3)
 }
 
+// GetQuoteVolume mocks base method.
+func (m *MockVpoolKeeper) GetQuoteVolume(arg0 types2.Context, arg1 common.AssetPair, arg2 time.Time) types2.Dec {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQuoteVolume", arg0, arg1, arg2)
+	ret0, _ := ret[0].(types2.Dec)
+	return ret0
+}
+
+// GetQuoteVolume indicates an expected call of GetQuoteVolume.
+func (mr *MockVpoolKeeperMockRecorder) GetQuoteVolume(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuoteVolume", reflect.TypeOf((*MockVpoolKeeper)(nil).GetQuoteVolume), arg0, arg1, arg2)
+}
+
 // GetSettlementPrice mocks base method.
 func (m *MockVpoolKeeper) GetSettlementPrice(arg0 types2.Context, arg1 common.AssetPair) (types2.Dec, error) {
 	m.ctrl.T.Helper()
@@ -453,6 +511,35 @@ func (mr *MockVpoolKeeperMockRecorder) IsOverSpreadLimit(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsOverSpreadLimit", reflect.TypeOf((*MockVpoolKeeper)(nil).IsOverSpreadLimit), arg0, arg1)
 }
 
+// IsPoolSettled mocks base method.
+func (m *MockVpoolKeeper) IsPoolSettled(arg0 types2.Context, arg1 common.AssetPair) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsPoolSettled", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsPoolSettled indicates an expected call of IsPoolSettled.
+func (mr *MockVpoolKeeperMockRecorder) IsPoolSettled(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsPoolSettled", reflect.TypeOf((*MockVpoolKeeper)(nil).IsPoolSettled), arg0, arg1)
+}
+
+// RepegPool mocks base method.
+func (m *MockVpoolKeeper) RepegPool(arg0 types2.Context, arg1 common.AssetPair) (types1.VPool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RepegPool", arg0, arg1)
+	ret0, _ := ret[0].(types1.VPool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RepegPool indicates an expected call of RepegPool.
+func (mr *MockVpoolKeeperMockRecorder) RepegPool(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RepegPool", reflect.TypeOf((*MockVpoolKeeper)(nil).RepegPool), arg0, arg1)
+}
+
 // SwapBaseForQuote mocks base method.
 func (m *MockVpoolKeeper) SwapBaseForQuote(arg0 types2.Context, arg1 common.AssetPair, arg2 types1.Direction, arg3, arg4 types2.Dec, arg5 bool) (types2.Dec, error) {
 	m.ctrl.T.Helper()
@@ -9,6 +9,7 @@ var ModuleAccounts = []string{
 	perptypes.ModuleName,
 	perptypes.VaultModuleAccount,
 	perptypes.PerpEFModuleAccount,
+	perptypes.InsuranceFundModuleAccount,
 	perptypes.FeePoolModuleAccount,
 	common.TreasuryPoolModuleAccount,
 }
@@ -13,15 +13,28 @@ import (
 	"github.com/NibiruChain/nibiru/x/vpool/keeper"
 )
 
-// EndBlocker Called every block to store a snapshot of the vpool.
+// BeginBlocker anchors the curves of the oracle-pegged pools around the
+// pricefeed price at the start of every block. A pool that fails to anchor is
+// logged and keeps its reserves.
+func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	for _, pool := range k.Pools.Iterate(ctx, collections.Range[common.AssetPair]{}).Values() {
+		if !pool.IsOraclePegged() || k.IsPoolSettled(ctx, pool.Pair) {
+			continue
+		}
+		if _, err := k.AnchorOraclePeggedPool(ctx, pool.Pair); err != nil {
+			k.Logger(ctx).Error("failed to anchor oracle-pegged pool", "pair", pool.Pair, "error", err)
+		}
+	}
+}
+
+// EndBlocker Called every block to store a snapshot of the vpool, to
+// downsample and prune the older snapshots according to the params and the
+// TWAP lookbacks in use, and to prune the trade volumes as old as the pruned
+// snapshots.
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) []abci.ValidatorUpdate {
+	params := k.GetParams(ctx)
 	for _, pool := range k.Pools.Iterate(ctx, collections.Range[common.AssetPair]{}).Values() {
-		snapshot := types.NewReserveSnapshot(
-			pool.Pair,
-			pool.BaseAssetReserve,
-			pool.QuoteAssetReserve,
-			ctx.BlockTime(),
-		)
+		snapshot := types.NewPoolReserveSnapshot(pool, ctx.BlockTime())
 		k.ReserveSnapshots.Insert(ctx, collections.Join(pool.Pair, ctx.BlockTime()), snapshot)
 
 		_ = ctx.EventManager().EmitTypedEvent(&types.ReserveSnapshotSavedEvent{
@@ -32,6 +45,11 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) []abci.ValidatorUpdate {
 			BlockHeight:    ctx.BlockHeight(),
 			BlockTimestamp: ctx.BlockTime(),
 		})
+
+		retention := k.GetSnapshotRetention(ctx, pool.Pair)
+		k.DownsampleSnapshots(ctx, pool.Pair, k.GetSnapshotDownsampleAfter(ctx, pool.Pair), params.SnapshotDownsampleInterval)
+		k.PruneSnapshots(ctx, pool.Pair, retention)
+		k.PruneTradeVolumes(ctx, pool.Pair, retention)
 	}
 	return []abci.ValidatorUpdate{}
 }
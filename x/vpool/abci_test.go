@@ -12,6 +12,7 @@ import (
 
 	"github.com/NibiruChain/nibiru/simapp"
 	"github.com/NibiruChain/nibiru/x/common"
+	perptypes "github.com/NibiruChain/nibiru/x/perp/types"
 	testutil "github.com/NibiruChain/nibiru/x/testutil"
 	"github.com/NibiruChain/nibiru/x/vpool"
 	"github.com/NibiruChain/nibiru/x/vpool/types"
@@ -88,3 +89,167 @@ func TestSnapshotUpdates(t *testing.T) {
 		BlockTimestamp: ctxAtSnapshot.BlockTime(),
 	})
 }
+
+func TestSnapshotRetention(t *testing.T) {
+	nibiruApp, ctx := simapp.NewTestNibiruAppAndContext(true)
+	vpoolKeeper := nibiruApp.VpoolKeeper
+	ctx = ctx.WithBlockTime(time.Date(2015, 10, 21, 0, 0, 0, 0, time.UTC)).WithBlockHeight(1)
+
+	vpoolKeeper.SetParams(ctx, types.NewParams(
+		/* snapshotRetention */ 10*time.Minute,
+		/* snapshotDownsampleAfter */ 2*time.Minute,
+		/* snapshotDownsampleInterval */ time.Minute,
+	))
+	perpParams := nibiruApp.PerpKeeper.GetParams(ctx)
+	perpParams.TwapLookbackWindow = time.Minute
+	nibiruApp.PerpKeeper.SetParams(ctx, perpParams)
+	vpoolKeeper.CreatePool(
+		ctx,
+		common.Pair_BTC_NUSD,
+		sdk.OneDec(),
+		sdk.NewDec(10),
+		sdk.NewDec(10),
+		sdk.NewDec(3),
+		sdk.OneDec(),
+		sdk.OneDec(),
+		sdk.NewDec(10),
+	)
+
+	t.Log("run an hour of 6 second blocks")
+	for i := 0; i < 600; i++ {
+		vpool.EndBlocker(ctx, vpoolKeeper)
+		ctx = ctx.
+			WithBlockHeight(ctx.BlockHeight() + 1).
+			WithBlockTime(ctx.BlockTime().Add(6 * time.Second))
+	}
+
+	// at most 30 per-block snapshots for the last 2 minutes and the minute
+	// being completed, one per minute for the minutes before and the one giving
+	// the price at the start of the window
+	snapshots := vpoolKeeper.ReserveSnapshots.Iterate(ctx, collections.PairRange[common.AssetPair, time.Time]{}).Values()
+	assert.LessOrEqual(t, len(snapshots), 40)
+
+	twap, err := vpoolKeeper.GetMarkPriceTWAP(ctx, common.Pair_BTC_NUSD, 10*time.Minute)
+	require.NoError(t, err)
+	assert.EqualValues(t, sdk.OneDec(), twap)
+}
+
+func TestSnapshotRetentionCoversTwapLookbacks(t *testing.T) {
+	nibiruApp, ctx := simapp.NewTestNibiruAppAndContext(true)
+	vpoolKeeper := nibiruApp.VpoolKeeper
+	ctx = ctx.WithBlockTime(time.Date(2015, 10, 21, 0, 0, 0, 0, time.UTC)).WithBlockHeight(1)
+
+	vpoolKeeper.SetParams(ctx, types.NewParams(
+		/* snapshotRetention */ 10*time.Minute,
+		/* snapshotDownsampleAfter */ 2*time.Minute,
+		/* snapshotDownsampleInterval */ time.Minute,
+	))
+	perpParams := nibiruApp.PerpKeeper.GetParams(ctx)
+	perpParams.TwapLookbackWindow = 20 * time.Minute
+	nibiruApp.PerpKeeper.SetParams(ctx, perpParams)
+	nibiruApp.PerpKeeper.PairsMetadata.Insert(ctx, common.Pair_BTC_NUSD, perptypes.PairMetadata{
+		Pair:                            common.Pair_BTC_NUSD,
+		LatestCumulativePremiumFraction: sdk.ZeroDec(),
+		MarginPricePolicy: &perptypes.MarginPricePolicy{
+			Sources: []perptypes.MarginPriceSource{{
+				Type:           perptypes.MarginPriceSourceType_MARGIN_PRICE_SOURCE_MARK_TWAP,
+				LookbackWindow: 30 * time.Minute,
+			}},
+			Preference:            perptypes.PnLPreferenceOption_MAX,
+			FullLiquidationSource: perptypes.MarginPriceSource{Type: perptypes.MarginPriceSourceType_MARGIN_PRICE_SOURCE_SPOT},
+		},
+	})
+	vpoolKeeper.CreatePool(
+		ctx,
+		common.Pair_BTC_NUSD,
+		sdk.OneDec(),
+		sdk.NewDec(10),
+		sdk.NewDec(10),
+		sdk.NewDec(3),
+		sdk.OneDec(),
+		sdk.OneDec(),
+		sdk.NewDec(10),
+	)
+
+	t.Log("the retention and the downsampling age are raised to the lookbacks of the pair")
+	assert.EqualValues(t, 30*time.Minute, vpoolKeeper.GetSnapshotRetention(ctx, common.Pair_BTC_NUSD))
+	assert.EqualValues(t, 30*time.Minute, vpoolKeeper.GetSnapshotDownsampleAfter(ctx, common.Pair_BTC_NUSD))
+	assert.EqualValues(t, 20*time.Minute, vpoolKeeper.GetSnapshotRetention(ctx, common.Pair_ETH_NUSD))
+	assert.EqualValues(t, 20*time.Minute, vpoolKeeper.GetSnapshotDownsampleAfter(ctx, common.Pair_ETH_NUSD))
+
+	t.Log("run an hour of 6 second blocks")
+	for i := 0; i < 600; i++ {
+		vpool.EndBlocker(ctx, vpoolKeeper)
+		ctx = ctx.
+			WithBlockHeight(ctx.BlockHeight() + 1).
+			WithBlockTime(ctx.BlockTime().Add(6 * time.Second))
+	}
+
+	t.Log("the swap twap over the lookback of the margin price policy is not downsampled")
+	quoteAmount, err := vpoolKeeper.GetBaseAssetTWAP(
+		ctx, common.Pair_BTC_NUSD, types.Direction_ADD_TO_POOL, sdk.OneDec(), 30*time.Minute)
+	require.NoError(t, err)
+	assert.True(t, quoteAmount.IsPositive())
+}
+
+func TestOraclePeggedPoolAnchoring(t *testing.T) {
+	nibiruApp, ctx := simapp.NewTestNibiruAppAndContext(true)
+	vpoolKeeper := nibiruApp.VpoolKeeper
+	ctx = ctx.WithBlockTime(time.Date(2015, 10, 21, 0, 0, 0, 0, time.UTC)).WithBlockHeight(1)
+
+	oracle := testutil.AccAddress()
+	nibiruApp.PricefeedKeeper.WhitelistOracles(ctx, []sdk.AccAddress{oracle})
+	postIndexPrice := func(price sdk.Dec) {
+		require.NoError(t, nibiruApp.PricefeedKeeper.PostRawPrice(
+			ctx, oracle, common.Pair_BTC_NUSD.String(), price, ctx.BlockTime().Add(time.Hour)))
+		require.NoError(t, nibiruApp.PricefeedKeeper.GatherRawPrices(ctx, common.DenomBTC, common.DenomNUSD))
+	}
+
+	proposal := &types.CreatePoolProposal{
+		Title:                  "Create an oracle-pegged BTC:NUSD pool",
+		Description:            "a pool tracking the index price",
+		Pair:                   common.Pair_BTC_NUSD.String(),
+		TradeLimitRatio:        sdk.MustNewDecFromStr("0.9"),
+		QuoteAssetReserve:      sdk.NewDec(1_000_000),
+		BaseAssetReserve:       sdk.NewDec(1_000_000),
+		FluctuationLimitRatio:  sdk.MustNewDecFromStr("0.1"),
+		MaxOracleSpreadRatio:   sdk.MustNewDecFromStr("0.1"),
+		MaintenanceMarginRatio: sdk.MustNewDecFromStr("0.0625"),
+		MaxLeverage:            sdk.NewDec(10),
+		CurveType:              types.CurveType_ORACLE_PEGGED,
+		OraclePegConfig: &types.OraclePegConfig{
+			QuoteDepth:  sdk.NewDec(10_000_000),
+			SpreadRatio: sdk.MustNewDecFromStr("0.001"),
+		},
+	}
+	handler := vpool.NewCreatePoolProposalHandler(vpoolKeeper)
+
+	t.Log("an oracle-pegged pool cannot be created without a pricefeed price")
+	cachedCtx, _ := ctx.CacheContext()
+	require.Error(t, handler(cachedCtx, proposal))
+
+	t.Log("the pool is anchored at the index price when created")
+	postIndexPrice(sdk.NewDec(20))
+	require.NoError(t, handler(ctx, proposal))
+	markPrice, err := vpoolKeeper.GetMarkPrice(ctx, common.Pair_BTC_NUSD)
+	require.NoError(t, err)
+	assert.EqualValues(t, sdk.NewDec(20), markPrice)
+
+	t.Log("every block anchors the pool toward the index price within the fluctuation limit, keeping its depth without open positions")
+	vpool.EndBlocker(ctx, vpoolKeeper)
+	ctx = ctx.WithBlockHeight(2).WithBlockTime(ctx.BlockTime().Add(5 * time.Second))
+	postIndexPrice(sdk.NewDec(25))
+	vpool.BeginBlocker(ctx, vpoolKeeper)
+
+	pool, err := vpoolKeeper.GetPool(ctx, common.Pair_BTC_NUSD)
+	require.NoError(t, err)
+	assert.EqualValues(t, sdk.NewDec(22), pool.GetMarkPrice())
+	assert.EqualValues(t, sdk.NewDec(10_000_000), pool.QuoteAssetReserve)
+
+	t.Log("the TWAP follows the anchored prices")
+	vpool.EndBlocker(ctx, vpoolKeeper)
+	ctx = ctx.WithBlockHeight(3).WithBlockTime(ctx.BlockTime().Add(5 * time.Second))
+	twap, err := vpoolKeeper.GetMarkPriceTWAP(ctx, common.Pair_BTC_NUSD, 10*time.Second)
+	require.NoError(t, err)
+	assert.EqualValues(t, sdk.NewDec(21), twap)
+}
@@ -209,6 +209,7 @@ e.g. $ nibid tx gov vote 1 yes`)
 				MaxOracleSpreadRatio:   proposal.MaxOracleSpreadRatio,
 				MaintenanceMarginRatio: proposal.MaintenanceMarginRatio,
 				MaxLeverage:            proposal.MaxLeverage,
+				Status:                 vpooltypes.PoolStatus_ACTIVE,
 			}, pool)
 			found = true
 		}
@@ -230,6 +231,51 @@ func (s *IntegrationTestSuite) TestGetPrices() {
 	s.T().Logf("priceInfo: %+v", priceInfo)
 	s.EqualValues(sdk.MustNewDecFromStr("599994.000059999400006000"), priceInfo.PriceInQuoteDenom)
 	s.NoError(err)
+
+	s.T().Log("check the spot price twap")
+	twap, err := testutilcli.QueryTwap(val.ClientCtx, common.Pair_ETH_NUSD, "spot", "1m")
+	s.Require().NoError(err)
+	s.EqualValues(sdk.NewDec(6_000), twap.Twap)
+
+	s.T().Log("check the base swap twap")
+	twap, err = testutilcli.QueryTwap(val.ClientCtx, common.Pair_ETH_NUSD, "base-swap", "1m",
+		"--direction=add", "--asset-amount=100")
+	s.Require().NoError(err)
+	s.EqualValues(sdk.MustNewDecFromStr("599994.000059999400006000"), twap.Twap)
+
+	s.T().Log("check a swap estimate")
+	estimate, err := testutilcli.QueryEstimateSwapQuoteForBase(val.ClientCtx, common.Pair_ETH_NUSD, "add", "600000")
+	s.Require().NoError(err)
+	s.EqualValues(sdk.MustNewDecFromStr("99.999000009999900001"), estimate.BaseAmount)
+	s.False(estimate.OverTradingLimit)
+	s.False(estimate.OverFluctuationLimit)
+
+	s.T().Log("check the pool depth")
+	depth, err := testutilcli.QueryPoolDepth(val.ClientCtx, common.Pair_ETH_NUSD, "remove", "5")
+	s.Require().NoError(err)
+	s.EqualValues(sdk.NewDec(6_000), depth.MarkPrice)
+	s.Len(depth.Levels, 5)
+}
+
+func (s *IntegrationTestSuite) TestGetMarkPriceCandles() {
+	val := s.network.Validators[0]
+
+	s.T().Log("query the candles since the genesis")
+	status, err := val.RPCClient.Status(context.Background())
+	s.Require().NoError(err)
+	latestBlockTime := status.SyncInfo.LatestBlockTime
+	candlesResp, err := testutilcli.QueryMarkPriceCandles(val.ClientCtx, common.Pair_ETH_NUSD, "1m",
+		latestBlockTime.Add(-time.Hour).Format(time.RFC3339), latestBlockTime.Add(time.Minute).Format(time.RFC3339))
+	s.Require().NoError(err)
+	s.Require().NotEmpty(candlesResp.Candles)
+	for _, candle := range candlesResp.Candles {
+		s.EqualValues(sdk.NewDec(6_000), candle.Close)
+	}
+
+	s.T().Log("a resolution below one minute is rejected")
+	_, err = testutilcli.QueryMarkPriceCandles(val.ClientCtx, common.Pair_ETH_NUSD, "30s",
+		latestBlockTime.Add(-time.Hour).Format(time.RFC3339), latestBlockTime.Format(time.RFC3339))
+	s.Error(err)
 }
 
 func TestIntegrationTestSuite(t *testing.T) {
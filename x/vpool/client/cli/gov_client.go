@@ -31,6 +31,42 @@ var (
 				},
 			}
 		})
+
+	SettlePoolProposalHandler = govclient.NewProposalHandler(
+		/* govclient.CLIHandlerFn */ CmdSettlePoolProposal,
+		/* govclient.RESTHandlerFn */ func(context client.Context) govclientrest.ProposalRESTHandler {
+			return govclientrest.ProposalRESTHandler{
+				SubRoute: "settle_pool",
+				Handler: func(writer http.ResponseWriter, request *http.Request) {
+					_, _ = writer.Write([]byte("deprecated"))
+					writer.WriteHeader(http.StatusMethodNotAllowed)
+				},
+			}
+		})
+
+	PoolStatusProposalHandler = govclient.NewProposalHandler(
+		/* govclient.CLIHandlerFn */ CmdPoolStatusProposal,
+		/* govclient.RESTHandlerFn */ func(context client.Context) govclientrest.ProposalRESTHandler {
+			return govclientrest.ProposalRESTHandler{
+				SubRoute: "pool_status",
+				Handler: func(writer http.ResponseWriter, request *http.Request) {
+					_, _ = writer.Write([]byte("deprecated"))
+					writer.WriteHeader(http.StatusMethodNotAllowed)
+				},
+			}
+		})
+
+	EditPoolConfigProposalHandler = govclient.NewProposalHandler(
+		/* govclient.CLIHandlerFn */ CmdEditPoolConfigProposal,
+		/* govclient.RESTHandlerFn */ func(context client.Context) govclientrest.ProposalRESTHandler {
+			return govclientrest.ProposalRESTHandler{
+				SubRoute: "edit_pool_config",
+				Handler: func(writer http.ResponseWriter, request *http.Request) {
+					_, _ = writer.Write([]byte("deprecated"))
+					writer.WriteHeader(http.StatusMethodNotAllowed)
+				},
+			}
+		})
 )
 
 // CmdCreatePoolProposal implements the client command to submit a governance
@@ -47,6 +83,11 @@ func CmdCreatePoolProposal() *cobra.Command {
 		Long: strings.TrimSpace(
 			`Submits a proposal to create a new vpool, which in turn create a new x/perp market
 
+			The "curve_type" is CONSTANT_PRODUCT by default. An ORACLE_PEGGED pool
+			takes an "oracle_peg_config" with a "quote_depth" and a "spread_ratio",
+			e.g. {"quote_depth": "10000000", "spread_ratio": "0.001"}: it is
+			anchored around the pricefeed price every block.
+
 			A proposal.json for 'CreatePoolProposal' contains:
 			{
 			  "title": "Create vpool for ETH:USDT",
@@ -106,3 +147,234 @@ func CmdCreatePoolProposal() *cobra.Command {
 
 	return cmd
 }
+
+// CmdSettlePoolProposal implements the client command to submit a governance
+// proposal to settle a vpool and the positions of its market.
+func CmdSettlePoolProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "settle-pool [proposal-json] --deposit=[deposit]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to settle a vpool and its market",
+		Example: strings.TrimSpace(fmt.Sprintf(`
+			Example: 
+			$ %s tx gov submit-proposal settle-pool <path/to/proposal.json> --deposit="1000unibi" --from=<key_or_address> 
+			`, version.AppName)),
+		Long: strings.TrimSpace(
+			`Submits a proposal to settle a vpool at a settlement price, after which
+			the positions of its x/perp market can only be settled. The price source
+			is PRICEFEED_TWAP, MARK_TWAP or MARK_TWAP_OR_PRICEFEED_TWAP, the latter
+			falling back to the pricefeed TWAP when the mark TWAP is unavailable.
+
+			A proposal.json for 'SettlePoolProposal' contains:
+			{
+			  "title": "Delist ETH:USDT",
+			  "description": "Settle the ETH:USDT market at the mark TWAP",
+			  "pair": "ETH:USDT",
+			  "price_source": "MARK_TWAP",
+			  "twap_lookback_window": "900s"
+			}
+			`),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			proposal := &types.SettlePoolProposal{}
+			contents, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			// marshals the contents into the proto.Message to which 'proposal' points.
+			if err = clientCtx.Codec.UnmarshalJSON(contents, proposal); err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(proposal, deposit, from)
+			if err != nil {
+				return err
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(
+		/*name=*/ govcli.FlagDeposit,
+		/*defaultValue=*/ "",
+		/*usage=*/ "governance deposit for proposal")
+	if err := cmd.MarkFlagRequired(govcli.FlagDeposit); err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
+
+// CmdPoolStatusProposal implements the client command to submit a governance
+// proposal to change the status of a vpool.
+func CmdPoolStatusProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pool-status [proposal-json] --deposit=[deposit]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to make a vpool active, reduce only or frozen",
+		Example: strings.TrimSpace(fmt.Sprintf(`
+			Example: 
+			$ %s tx gov submit-proposal pool-status <path/to/proposal.json> --deposit="1000unibi" --from=<key_or_address> 
+			`, version.AppName)),
+		Long: strings.TrimSpace(
+			`Submits a proposal to move a vpool to the ACTIVE, REDUCE_ONLY or FROZEN
+			status. The positions of a REDUCE_ONLY vpool can only be decreased or
+			closed, and a FROZEN vpool rejects every trade. A vpool is settled with
+			a 'settle-pool' proposal.
+
+			A proposal.json for 'PoolStatusProposal' contains:
+			{
+			  "title": "Pause ETH:USDT",
+			  "description": "Freeze ETH:USDT during the oracle outage",
+			  "pair": "ETH:USDT",
+			  "status": "FROZEN"
+			}
+			`),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			proposal := &types.PoolStatusProposal{}
+			contents, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			// marshals the contents into the proto.Message to which 'proposal' points.
+			if err = clientCtx.Codec.UnmarshalJSON(contents, proposal); err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(proposal, deposit, from)
+			if err != nil {
+				return err
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(
+		/*name=*/ govcli.FlagDeposit,
+		/*defaultValue=*/ "",
+		/*usage=*/ "governance deposit for proposal")
+	if err := cmd.MarkFlagRequired(govcli.FlagDeposit); err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
+
+// CmdEditPoolConfigProposal implements the client command to submit a
+// governance proposal to edit the risk parameters of a vpool.
+func CmdEditPoolConfigProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "edit-pool-config [proposal-json] --deposit=[deposit]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to edit the risk parameters of a vpool",
+		Example: strings.TrimSpace(fmt.Sprintf(`
+			Example: 
+			$ %s tx gov submit-proposal edit-pool-config <path/to/proposal.json> --deposit="1000unibi" --from=<key_or_address> 
+			`, version.AppName)),
+		Long: strings.TrimSpace(
+			`Submits a proposal to edit the risk parameters of an existing vpool. Every
+			ratio must be set, the reserves of the vpool are left untouched.
+
+			A proposal.json for 'EditPoolConfigProposal' contains:
+			{
+			  "title": "Tighten ETH:USDT",
+			  "description": "Lower the max leverage of ETH:USDT",
+			  "pair": "ETH:USDT",
+			  "trade_limit_ratio": "0.2",
+			  "fluctuation_limit_ratio": "0.1",
+			  "max_oracle_spread_ratio": "0.1",
+			  "maintenance_margin_ratio": "0.0625",
+			  "max_leverage": "10"
+			}
+			`),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			proposal := &types.EditPoolConfigProposal{}
+			contents, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			// marshals the contents into the proto.Message to which 'proposal' points.
+			if err = clientCtx.Codec.UnmarshalJSON(contents, proposal); err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(proposal, deposit, from)
+			if err != nil {
+				return err
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(
+		/*name=*/ govcli.FlagDeposit,
+		/*defaultValue=*/ "",
+		/*usage=*/ "governance deposit for proposal")
+	if err := cmd.MarkFlagRequired(govcli.FlagDeposit); err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -33,6 +34,11 @@ func GetQueryCmd() *cobra.Command {
 		CmdGetVpoolReserveAssets(),
 		CmdGetVpools(),
 		CmdGetBaseAssetPrice(),
+		CmdGetMarkPriceCandles(),
+		CmdGetTwap(),
+		CmdEstimateSwapQuoteForBase(),
+		CmdEstimateSwapBaseForQuote(),
+		CmdGetPoolDepth(),
 	} {
 		queryCommand.AddCommand(cmd)
 	}
@@ -128,14 +134,9 @@ func CmdGetBaseAssetPrice() *cobra.Command {
 				return fmt.Errorf("invalid base asset amount %s", args[2])
 			}
 
-			var direction types.Direction
-			switch strings.TrimSpace(args[1]) {
-			case "add":
-				direction = types.Direction_ADD_TO_POOL
-			case "remove":
-				direction = types.Direction_REMOVE_FROM_POOL
-			default:
-				return fmt.Errorf("invalid direction %s", args[1])
+			direction, err := parseDirection(args[1])
+			if err != nil {
+				return err
 			}
 
 			queryClient := types.NewQueryClient(clientCtx)
@@ -160,3 +161,316 @@ func CmdGetBaseAssetPrice() *cobra.Command {
 
 	return cmd
 }
+
+func CmdGetMarkPriceCandles() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "candles [pair] [resolution] [from] [to]",
+		Short: "query the mark price candles of a pool",
+		Long: strings.TrimSpace(`Query the open, high, low and close mark prices and the traded volumes
+of a pool over the intervals of a resolution, a multiple of one minute.
+The candles start from the one containing from and end before to, both RFC3339 times.
+
+Example:
+$ nibid query vpool candles ubtc:unusd 1h 2022-10-01T00:00:00Z 2022-10-02T00:00:00Z
+`),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			tokenPair, err := common.NewAssetPair(args[0])
+			if err != nil {
+				return err
+			}
+
+			resolution, err := time.ParseDuration(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid resolution %s", args[1])
+			}
+
+			from, err := time.Parse(time.RFC3339, args[2])
+			if err != nil {
+				return fmt.Errorf("invalid from time %s", args[2])
+			}
+
+			to, err := time.Parse(time.RFC3339, args[3])
+			if err != nil {
+				return fmt.Errorf("invalid to time %s", args[3])
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.MarkPriceCandles(
+				cmd.Context(),
+				&types.QueryMarkPriceCandlesRequest{
+					Pair:       tokenPair.String(),
+					Resolution: resolution,
+					From:       from,
+					To:         to,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdGetTwap() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "twap [pair] [twap-calc-option] [lookback-interval]",
+		Short: "query the time-weighted average of the spot price or of a swap output of a pool",
+		Long: strings.TrimSpace(`Query the time-weighted average over a lookback interval of either:
+- spot: the spot price
+- quote-swap: the base asset output of swapping the --asset-amount of quote assets in the --direction
+- base-swap: the quote asset output of swapping the --asset-amount of base assets in the --direction
+
+Example:
+$ nibid query vpool twap ubtc:unusd spot 15m
+$ nibid query vpool twap ubtc:unusd base-swap 1h --direction add --asset-amount 100
+`),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			tokenPair, err := common.NewAssetPair(args[0])
+			if err != nil {
+				return err
+			}
+
+			var twapCalcOption types.TwapCalcOption
+			switch strings.TrimSpace(args[1]) {
+			case "spot":
+				twapCalcOption = types.TwapCalcOption_SPOT
+			case "quote-swap":
+				twapCalcOption = types.TwapCalcOption_QUOTE_ASSET_SWAP
+			case "base-swap":
+				twapCalcOption = types.TwapCalcOption_BASE_ASSET_SWAP
+			default:
+				return fmt.Errorf("invalid twap calc option %s", args[1])
+			}
+
+			lookbackInterval, err := time.ParseDuration(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid lookback interval %s", args[2])
+			}
+
+			req := &types.QueryTwapRequest{
+				Pair:             tokenPair.String(),
+				TwapCalcOption:   twapCalcOption,
+				AssetAmount:      sdk.ZeroDec(),
+				LookbackInterval: lookbackInterval,
+			}
+			if twapCalcOption != types.TwapCalcOption_SPOT {
+				directionStr, err := cmd.Flags().GetString("direction")
+				if err != nil {
+					return err
+				}
+				if req.Direction, err = parseDirection(directionStr); err != nil {
+					return err
+				}
+
+				assetAmountStr, err := cmd.Flags().GetString("asset-amount")
+				if err != nil {
+					return err
+				}
+				if req.AssetAmount, err = sdk.NewDecFromStr(assetAmountStr); err != nil {
+					return fmt.Errorf("invalid asset amount %s", assetAmountStr)
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Twap(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String("direction", "", "direction of the swap, add (ADD_TO_POOL) or remove (REMOVE_FROM_POOL)")
+	cmd.Flags().String("asset-amount", "0", "amount of assets swapped, quote assets for quote-swap and base assets for base-swap")
+
+	return cmd
+}
+
+func CmdEstimateSwapQuoteForBase() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimate-swap-quote-for-base [pair] [direction] [quote-amount]",
+		Short: "estimate the base asset output of swapping quote assets, direction is add (ADD_TO_POOL) or remove (REMOVE_FROM_POOL)",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			tokenPair, err := common.NewAssetPair(args[0])
+			if err != nil {
+				return err
+			}
+
+			direction, err := parseDirection(args[1])
+			if err != nil {
+				return err
+			}
+
+			quoteAmount, err := sdk.NewDecFromStr(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid quote amount %s", args[2])
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EstimateSwapQuoteForBase(
+				cmd.Context(),
+				&types.QueryEstimateSwapQuoteForBaseRequest{
+					Pair:        tokenPair.String(),
+					Direction:   direction,
+					QuoteAmount: quoteAmount,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdEstimateSwapBaseForQuote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimate-swap-base-for-quote [pair] [direction] [base-amount]",
+		Short: "estimate the quote asset output of swapping base assets, direction is add (ADD_TO_POOL) or remove (REMOVE_FROM_POOL)",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			tokenPair, err := common.NewAssetPair(args[0])
+			if err != nil {
+				return err
+			}
+
+			direction, err := parseDirection(args[1])
+			if err != nil {
+				return err
+			}
+
+			baseAmount, err := sdk.NewDecFromStr(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid base amount %s", args[2])
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EstimateSwapBaseForQuote(
+				cmd.Context(),
+				&types.QueryEstimateSwapBaseForQuoteRequest{
+					Pair:       tokenPair.String(),
+					Direction:  direction,
+					BaseAmount: baseAmount,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdGetPoolDepth() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "depth [pair] [direction] [steps]",
+		Short: "query the mark price a pool moves to against the size of a swap",
+		Long: strings.TrimSpace(`Query the ladder of the mark prices a pool moves to against the cumulative
+quote amount swapped, up to the largest swap the trade and fluctuation limits
+currently allow. The direction of the quote asset is add (ADD_TO_POOL) to buy
+base assets, or remove (REMOVE_FROM_POOL) to sell base assets.
+
+Example:
+$ nibid query vpool depth ubtc:unusd add 10
+`),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			tokenPair, err := common.NewAssetPair(args[0])
+			if err != nil {
+				return err
+			}
+
+			direction, err := parseDirection(args[1])
+			if err != nil {
+				return err
+			}
+
+			steps, err := strconv.ParseUint(args[2], 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid steps %s", args[2])
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PoolDepth(
+				cmd.Context(),
+				&types.QueryPoolDepthRequest{
+					Pair:      tokenPair.String(),
+					Direction: direction,
+					Steps:     uint32(steps),
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseDirection parses a swap direction, add (ADD_TO_POOL) or remove (REMOVE_FROM_POOL).
+func parseDirection(direction string) (types.Direction, error) {
+	switch strings.TrimSpace(direction) {
+	case "add":
+		return types.Direction_ADD_TO_POOL, nil
+	case "remove":
+		return types.Direction_REMOVE_FROM_POOL, nil
+	default:
+		return types.Direction_DIRECTION_UNSPECIFIED, fmt.Errorf("invalid direction %s", direction)
+	}
+}
@@ -16,6 +16,8 @@ import (
 // InitGenesis initializes the capability module's state from a provided genesis
 // state.
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	k.SetParams(ctx, genState.Params)
+
 	for _, vp := range genState.Vpools {
 		k.CreatePool(
 			ctx,
@@ -28,18 +30,38 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 			vp.MaintenanceMarginRatio,
 			vp.MaxLeverage,
 		)
+		pool, err := k.Pools.Get(ctx, vp.Pair)
+		if err != nil {
+			panic(err)
+		}
+		pool.Status = vp.Status
+		pool.CurveType = vp.CurveType
+		pool.OraclePegConfig = vp.OraclePegConfig
+		pool.NetBaseSize = vp.NetBaseSize
+		k.Pools.Insert(ctx, vp.Pair, pool)
 	}
 
 	for _, snapshot := range genState.Snapshots {
 		// TODO snapshot.TimestampMs can just be time...
 		k.ReserveSnapshots.Insert(ctx, collections.Join(snapshot.Pair, time.UnixMilli(snapshot.TimestampMs)), snapshot)
 	}
+
+	for _, settlement := range genState.Settlements {
+		k.Settlements.Insert(ctx, settlement.Pair, settlement)
+	}
+
+	for _, volume := range genState.TradeVolumes {
+		k.TradeVolumes.Insert(ctx, collections.Join(volume.Pair, time.UnixMilli(volume.TimestampMs)), volume)
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		Vpools:    k.Pools.Iterate(ctx, collections.Range[common.AssetPair]{}).Values(),
-		Snapshots: k.ReserveSnapshots.Iterate(ctx, collections.PairRange[common.AssetPair, time.Time]{}).Values(),
+		Vpools:       k.Pools.Iterate(ctx, collections.Range[common.AssetPair]{}).Values(),
+		Snapshots:    k.ReserveSnapshots.Iterate(ctx, collections.PairRange[common.AssetPair, time.Time]{}).Values(),
+		Settlements:  k.Settlements.Iterate(ctx, collections.Range[common.AssetPair]{}).Values(),
+		Params:       k.GetParams(ctx),
+		TradeVolumes: k.TradeVolumes.Iterate(ctx, collections.PairRange[common.AssetPair, time.Time]{}).Values(),
 	}
 }
@@ -15,6 +15,7 @@ import (
 )
 
 func TestGenesis(t *testing.T) {
+	netBaseSize := sdk.NewDec(-1_000)
 	vpools := []types.VPool{
 		{
 			Pair:                   common.MustNewAssetPair("BTC:NUSD"),
@@ -36,6 +37,22 @@ func TestGenesis(t *testing.T) {
 			MaintenanceMarginRatio: sdk.MustNewDecFromStr("0.0625"),
 			MaxLeverage:            sdk.MustNewDecFromStr("15"),
 		},
+		{
+			Pair:                   common.MustNewAssetPair("ATOM:NUSD"),
+			BaseAssetReserve:       sdk.NewDec(1_000_000),
+			QuoteAssetReserve:      sdk.NewDec(10_000_000),
+			TradeLimitRatio:        sdk.MustNewDecFromStr("0.9"),
+			FluctuationLimitRatio:  sdk.MustNewDecFromStr("0.1"),
+			MaxOracleSpreadRatio:   sdk.MustNewDecFromStr("0.1"),
+			MaintenanceMarginRatio: sdk.MustNewDecFromStr("0.0625"),
+			MaxLeverage:            sdk.MustNewDecFromStr("10"),
+			CurveType:              types.CurveType_ORACLE_PEGGED,
+			OraclePegConfig: &types.OraclePegConfig{
+				QuoteDepth:  sdk.NewDec(10_000_000),
+				SpreadRatio: sdk.MustNewDecFromStr("0.001"),
+			},
+			NetBaseSize: &netBaseSize,
+		},
 	}
 
 	snapshots := []types.ReserveSnapshot{
@@ -59,10 +76,22 @@ func TestGenesis(t *testing.T) {
 		),
 	}
 
+	settlements := []types.PoolSettlement{
+		{
+			Pair:            common.MustNewAssetPair("ETH:NUSD"),
+			SettlementPrice: sdk.NewDec(30_000),
+			PriceSource:     types.SettlementPriceSource_MARK_TWAP,
+			TimestampMs:     223456,
+			BlockNumber:     10,
+		},
+	}
+
 	genesisState := types.GenesisState{
-		Vpools:    vpools,
-		Snapshots: snapshots,
+		Vpools:      vpools,
+		Snapshots:   snapshots,
+		Settlements: settlements,
 	}
+	require.NoError(t, genesisState.Validate())
 
 	nibiruApp, ctx := simapp.NewTestNibiruAppAndContext(true)
 	k := nibiruApp.VpoolKeeper
@@ -73,8 +102,8 @@ func TestGenesis(t *testing.T) {
 	}
 
 	exportedGenesis := vpool.ExportGenesis(ctx, k)
-	require.Len(t, exportedGenesis.Vpools, 2)
-	require.Len(t, exportedGenesis.Snapshots, 5) // 3 from imported + 2 created when creating a pool
+	require.Len(t, exportedGenesis.Vpools, 3)
+	require.Len(t, exportedGenesis.Snapshots, 6) // 3 from imported + 3 created when creating a pool
 
 	for _, pool := range genesisState.Vpools {
 		require.Contains(t, exportedGenesis.Vpools, pool)
@@ -83,4 +112,13 @@ func TestGenesis(t *testing.T) {
 	for _, snapshot := range genesisState.Snapshots {
 		require.Contains(t, exportedGenesis.Snapshots, snapshot)
 	}
+
+	require.Equal(t, settlements, exportedGenesis.Settlements)
+	require.True(t, k.IsPoolSettled(ctx, common.MustNewAssetPair("ETH:NUSD")))
+	require.False(t, k.IsPoolSettled(ctx, common.MustNewAssetPair("BTC:NUSD")))
+
+	pool, err := k.GetPool(ctx, common.MustNewAssetPair("ATOM:NUSD"))
+	require.NoError(t, err)
+	require.Equal(t, vpools[2], pool)
+	require.True(t, pool.IsOraclePegged())
 }
@@ -24,6 +24,8 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 	}
 }
 
+// NewCreatePoolProposalHandler handles the CreatePoolProposal, SettlePoolProposal,
+// PoolStatusProposal and EditPoolConfigProposal of the vpool module.
 func NewCreatePoolProposalHandler(k keeper.Keeper) govtypes.Handler {
 	return func(ctx sdk.Context, content govtypes.Content) error {
 		switch m := content.(type) {
@@ -43,7 +45,40 @@ func NewCreatePoolProposalHandler(k keeper.Keeper) govtypes.Handler {
 				m.MaintenanceMarginRatio,
 				m.MaxLeverage,
 			)
+			if m.CurveType == types.CurveType_ORACLE_PEGGED {
+				_, err := k.SetOraclePeg(ctx, pair, *m.OraclePegConfig)
+				return err
+			}
 			return nil
+		case *types.SettlePoolProposal:
+			if err := m.ValidateBasic(); err != nil {
+				return err
+			}
+			_, err := k.SettlePool(
+				ctx,
+				common.MustNewAssetPair(m.Pair),
+				m.PriceSource,
+				m.TwapLookbackWindow,
+			)
+			return err
+		case *types.PoolStatusProposal:
+			if err := m.ValidateBasic(); err != nil {
+				return err
+			}
+			return k.SetPoolStatus(ctx, common.MustNewAssetPair(m.Pair), m.Status)
+		case *types.EditPoolConfigProposal:
+			if err := m.ValidateBasic(); err != nil {
+				return err
+			}
+			return k.EditPoolConfig(
+				ctx,
+				common.MustNewAssetPair(m.Pair),
+				m.TradeLimitRatio,
+				m.FluctuationLimitRatio,
+				m.MaxOracleSpreadRatio,
+				m.MaintenanceMarginRatio,
+				m.MaxLeverage,
+			)
 		default:
 			return sdkerrors.Wrapf(
 				sdkerrors.ErrUnknownRequest,
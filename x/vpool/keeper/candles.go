@@ -0,0 +1,182 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/collections"
+	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/vpool/types"
+)
+
+// addTradeVolume adds the amounts of a swap to the trade volume of the pair over
+// the current volume interval.
+func (k Keeper) addTradeVolume(ctx sdk.Context, pair common.AssetPair, baseAmt sdk.Dec, quoteAmt sdk.Dec) {
+	intervalStart := ctx.BlockTime().Truncate(types.VolumeInterval)
+	key := collections.Join(pair, intervalStart)
+
+	volume := k.TradeVolumes.GetOr(ctx, key, types.NewTradeVolume(pair, intervalStart))
+	volume.BaseVolume = volume.BaseVolume.Add(baseAmt.Abs())
+	volume.QuoteVolume = volume.QuoteVolume.Add(quoteAmt.Abs())
+	k.TradeVolumes.Insert(ctx, key, volume)
+}
+
+// GetQuoteVolume returns the quote asset traded on a pair since a time, from the
+// start of the volume interval containing it.
+func (k Keeper) GetQuoteVolume(ctx sdk.Context, pair common.AssetPair, since time.Time) sdk.Dec {
+	quoteVolume := sdk.ZeroDec()
+	for _, volume := range k.TradeVolumes.Iterate(
+		ctx,
+		collections.PairRange[common.AssetPair, time.Time]{}.
+			Prefix(pair).
+			StartInclusive(since.Truncate(types.VolumeInterval)),
+	).Values() {
+		quoteVolume = quoteVolume.Add(volume.QuoteVolume)
+	}
+	return quoteVolume
+}
+
+/*
+PruneTradeVolumes deletes the trade volumes of a pair older than the retention
+window, the same window as the one of the reserve snapshots, at most
+types.MaxSnapshotsPrunedPerBlock of them per call, the oldest first.
+
+args:
+  - ctx: cosmos-sdk context
+  - pair: the token pair
+  - retention: how long the trade volumes are kept, zero to keep them forever
+*/
+func (k Keeper) PruneTradeVolumes(ctx sdk.Context, pair common.AssetPair, retention time.Duration) {
+	if retention <= 0 {
+		return
+	}
+
+	// collected before writing to the store
+	var keys []collections.Pair[common.AssetPair, time.Time]
+	iter := k.TradeVolumes.Iterate(
+		ctx,
+		collections.PairRange[common.AssetPair, time.Time]{}.
+			Prefix(pair).
+			EndExclusive(ctx.BlockTime().Add(-retention)),
+	)
+	for ; iter.Valid() && len(keys) < types.MaxSnapshotsPrunedPerBlock; iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		_ = k.TradeVolumes.Delete(ctx, key)
+	}
+}
+
+/*
+GetMarkPriceCandles builds the open, high, low and close mark prices and the
+traded volumes of a pair over the intervals of a resolution between two times.
+
+The prices are the ones of the reserve snapshots, taken once per block: a candle
+opens at the price of the last snapshot before it and closes at the price of its
+last snapshot, a candle without snapshots staying flat. The snapshots older than
+the downsampling age are time-weighted averages, so the older candles are
+smoothed. The candles before the first snapshot of the pair are left out.
+
+args:
+  - ctx: cosmos-sdk context
+  - pair: the token pair
+  - resolution: the length of a candle, a multiple of the volume interval
+  - from: the candles start from the candle containing from
+  - to: the candles end before to, the last candle can be incomplete
+
+ret:
+  - candles: the candles, in chronological order
+  - err: error
+*/
+func (k Keeper) GetMarkPriceCandles(
+	ctx sdk.Context, pair common.AssetPair, resolution time.Duration, from time.Time, to time.Time,
+) (candles []types.Candle, err error) {
+	numCandles, err := types.ValidateCandleRange(resolution, from, to)
+	if err != nil {
+		return nil, err
+	}
+	if !k.ExistsPool(ctx, pair) {
+		return nil, types.ErrPairNotSupported.Wrap(pair.String())
+	}
+
+	start := from.Truncate(resolution)
+	candles = make([]types.Candle, numCandles)
+	for i := range candles {
+		candles[i] = types.Candle{
+			StartTime:   start.Add(time.Duration(i) * resolution),
+			BaseVolume:  sdk.ZeroDec(),
+			QuoteVolume: sdk.ZeroDec(),
+		}
+	}
+
+	lastPrice := k.getLastSnapshotPriceBefore(ctx, pair, start)
+
+	snapshots := k.ReserveSnapshots.Iterate(
+		ctx,
+		collections.PairRange[common.AssetPair, time.Time]{}.
+			Prefix(pair).
+			StartInclusive(start).
+			EndExclusive(to),
+	)
+	defer snapshots.Close()
+
+	for i := range candles {
+		candle := &candles[i]
+		if !lastPrice.IsNil() {
+			candle.Open, candle.High, candle.Low, candle.Close = lastPrice, lastPrice, lastPrice, lastPrice
+		}
+
+		end := candle.StartTime.Add(resolution)
+		for ; snapshots.Valid() && snapshots.Key().K2().Before(end); snapshots.Next() {
+			snapshot := snapshots.Value()
+			price := snapshot.QuoteAssetReserve.Quo(snapshot.BaseAssetReserve)
+			if candle.Open.IsNil() {
+				candle.Open, candle.High, candle.Low = price, price, price
+			}
+			candle.High = sdk.MaxDec(candle.High, price)
+			candle.Low = sdk.MinDec(candle.Low, price)
+			candle.Close = price
+			lastPrice = price
+		}
+	}
+
+	for _, volume := range k.TradeVolumes.Iterate(
+		ctx,
+		collections.PairRange[common.AssetPair, time.Time]{}.
+			Prefix(pair).
+			StartInclusive(start).
+			EndExclusive(to),
+	).Values() {
+		candle := &candles[time.UnixMilli(volume.TimestampMs).Sub(start)/resolution]
+		candle.BaseVolume = candle.BaseVolume.Add(volume.BaseVolume)
+		candle.QuoteVolume = candle.QuoteVolume.Add(volume.QuoteVolume)
+	}
+
+	for len(candles) > 0 && candles[0].Open.IsNil() {
+		candles = candles[1:]
+	}
+
+	return candles, nil
+}
+
+// getLastSnapshotPriceBefore returns the spot price of the last reserve snapshot
+// of a pair strictly before a time, or a nil sdk.Dec if there is none.
+func (k Keeper) getLastSnapshotPriceBefore(ctx sdk.Context, pair common.AssetPair, t time.Time) sdk.Dec {
+	iter := k.ReserveSnapshots.Iterate(
+		ctx,
+		collections.PairRange[common.AssetPair, time.Time]{}.
+			Prefix(pair).
+			EndExclusive(t).
+			Descending(),
+	)
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return sdk.Dec{}
+	}
+	snapshot := iter.Value()
+	return snapshot.QuoteAssetReserve.Quo(snapshot.BaseAssetReserve)
+}
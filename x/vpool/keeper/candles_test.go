@@ -0,0 +1,161 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/collections"
+	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/vpool/types"
+)
+
+func TestGetMarkPriceCandles(t *testing.T) {
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	setup := func(t *testing.T) (Keeper, sdk.Context) {
+		vpoolKeeper, _, ctx := getKeeper(t)
+		vpoolKeeper.Pools.Insert(ctx, common.Pair_BTC_NUSD, types.VPool{
+			Pair:              common.Pair_BTC_NUSD,
+			BaseAssetReserve:  sdk.NewDec(10),
+			QuoteAssetReserve: sdk.NewDec(100),
+		})
+		setSnapshots(ctx, vpoolKeeper, start,
+			[]time.Duration{
+				0, 20 * time.Second, 40 * time.Second, // first minute
+				time.Minute, 90 * time.Second, // second minute
+				// third minute without snapshots
+				3*time.Minute + 30*time.Second, // fourth minute
+			},
+			[]int64{10, 30, 20, 5, 15, 40},
+		)
+		return vpoolKeeper, ctx
+	}
+
+	candle := func(startTime time.Time, open, high, low, close int64, baseVolume, quoteVolume int64) types.Candle {
+		return types.Candle{
+			StartTime:   startTime,
+			Open:        sdk.NewDec(open),
+			High:        sdk.NewDec(high),
+			Low:         sdk.NewDec(low),
+			Close:       sdk.NewDec(close),
+			BaseVolume:  sdk.NewDec(baseVolume),
+			QuoteVolume: sdk.NewDec(quoteVolume),
+		}
+	}
+
+	t.Run("one minute candles", func(t *testing.T) {
+		vpoolKeeper, ctx := setup(t)
+		vpoolKeeper.addTradeVolume(ctx.WithBlockTime(start.Add(10*time.Second)), common.Pair_BTC_NUSD, sdk.NewDec(1), sdk.NewDec(10))
+		vpoolKeeper.addTradeVolume(ctx.WithBlockTime(start.Add(50*time.Second)), common.Pair_BTC_NUSD, sdk.NewDec(2), sdk.NewDec(40))
+		vpoolKeeper.addTradeVolume(ctx.WithBlockTime(start.Add(3*time.Minute)), common.Pair_BTC_NUSD, sdk.NewDec(3), sdk.NewDec(90))
+
+		candles, err := vpoolKeeper.GetMarkPriceCandles(ctx, common.Pair_BTC_NUSD, time.Minute, start, start.Add(4*time.Minute))
+		require.NoError(t, err)
+		assert.EqualValues(t, []types.Candle{
+			candle(start, 10, 30, 10, 20, 3, 50),
+			candle(start.Add(time.Minute), 20, 20, 5, 15, 0, 0),
+			candle(start.Add(2*time.Minute), 15, 15, 15, 15, 0, 0),
+			candle(start.Add(3*time.Minute), 15, 40, 15, 40, 3, 90),
+		}, candles)
+	})
+
+	t.Run("the candles contain the range and aggregate the volumes", func(t *testing.T) {
+		vpoolKeeper, ctx := setup(t)
+		vpoolKeeper.addTradeVolume(ctx.WithBlockTime(start.Add(10*time.Second)), common.Pair_BTC_NUSD, sdk.NewDec(1), sdk.NewDec(10))
+		vpoolKeeper.addTradeVolume(ctx.WithBlockTime(start.Add(70*time.Second)), common.Pair_BTC_NUSD, sdk.NewDec(2), sdk.NewDec(20))
+
+		candles, err := vpoolKeeper.GetMarkPriceCandles(
+			ctx, common.Pair_BTC_NUSD, 2*time.Minute, start.Add(30*time.Second), start.Add(3*time.Minute))
+		require.NoError(t, err)
+		assert.EqualValues(t, []types.Candle{
+			candle(start, 10, 30, 5, 15, 3, 30),
+			candle(start.Add(2*time.Minute), 15, 15, 15, 15, 0, 0),
+		}, candles)
+	})
+
+	t.Run("the candles before the first snapshot are left out", func(t *testing.T) {
+		vpoolKeeper, ctx := setup(t)
+
+		candles, err := vpoolKeeper.GetMarkPriceCandles(
+			ctx, common.Pair_BTC_NUSD, time.Minute, start.Add(-2*time.Minute), start.Add(time.Minute))
+		require.NoError(t, err)
+		assert.EqualValues(t, []types.Candle{
+			candle(start, 10, 30, 10, 20, 0, 0),
+		}, candles)
+	})
+
+	t.Run("unknown pair", func(t *testing.T) {
+		vpoolKeeper, ctx := setup(t)
+
+		_, err := vpoolKeeper.GetMarkPriceCandles(ctx, common.Pair_ETH_NUSD, time.Minute, start, start.Add(time.Minute))
+		require.ErrorIs(t, err, types.ErrPairNotSupported)
+	})
+
+	t.Run("invalid resolution", func(t *testing.T) {
+		vpoolKeeper, ctx := setup(t)
+
+		_, err := vpoolKeeper.GetMarkPriceCandles(ctx, common.Pair_BTC_NUSD, 90*time.Second, start, start.Add(time.Hour))
+		require.Error(t, err)
+	})
+}
+
+func TestGetQuoteVolume(t *testing.T) {
+	vpoolKeeper, _, ctx := getKeeper(t)
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 4; i++ {
+		vpoolKeeper.addTradeVolume(
+			ctx.WithBlockTime(start.Add(time.Duration(i)*time.Minute+30*time.Second)),
+			common.Pair_BTC_NUSD, sdk.OneDec(), sdk.NewDec(int64(10*(i+1))),
+		)
+	}
+	vpoolKeeper.addTradeVolume(ctx.WithBlockTime(start), common.Pair_ETH_NUSD, sdk.OneDec(), sdk.NewDec(1_000))
+
+	assert.EqualValues(t, sdk.NewDec(100), vpoolKeeper.GetQuoteVolume(ctx, common.Pair_BTC_NUSD, start))
+	t.Log("the volume interval containing the start time is included")
+	assert.EqualValues(t, sdk.NewDec(70), vpoolKeeper.GetQuoteVolume(ctx, common.Pair_BTC_NUSD, start.Add(150*time.Second)))
+	assert.EqualValues(t, sdk.ZeroDec(), vpoolKeeper.GetQuoteVolume(ctx, common.Pair_BTC_NUSD, start.Add(time.Hour)))
+}
+
+func TestPruneTradeVolumes(t *testing.T) {
+	vpoolKeeper, _, ctx := getKeeper(t)
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 4; i++ {
+		vpoolKeeper.addTradeVolume(
+			ctx.WithBlockTime(start.Add(time.Duration(i)*time.Minute+30*time.Second)),
+			common.Pair_BTC_NUSD, sdk.OneDec(), sdk.OneDec(),
+		)
+	}
+	ctx = ctx.WithBlockTime(start.Add(3*time.Minute + 30*time.Second))
+
+	getVolumeTimes := func() (times []time.Time) {
+		for _, key := range vpoolKeeper.TradeVolumes.Iterate(ctx, collections.PairRange[common.AssetPair, time.Time]{}).Keys() {
+			times = append(times, key.K2())
+		}
+		return times
+	}
+
+	t.Log("a zero retention keeps every trade volume")
+	vpoolKeeper.PruneTradeVolumes(ctx, common.Pair_BTC_NUSD, 0)
+	assert.Len(t, getVolumeTimes(), 4)
+
+	t.Log("the trade volumes before the retention window are pruned")
+	vpoolKeeper.PruneTradeVolumes(ctx, common.Pair_BTC_NUSD, 2*time.Minute)
+	assert.EqualValues(t, []time.Time{start.Add(2 * time.Minute), start.Add(3 * time.Minute)}, getVolumeTimes())
+
+	t.Log("a backlog of expired trade volumes is pruned over several calls, the oldest first")
+	for i := 0; i < types.MaxSnapshotsPrunedPerBlock+10; i++ {
+		vpoolKeeper.addTradeVolume(
+			ctx.WithBlockTime(start.Add(time.Duration(i)*types.VolumeInterval)),
+			common.Pair_BTC_NUSD, sdk.OneDec(), sdk.OneDec(),
+		)
+	}
+	ctx = ctx.WithBlockTime(start.Add(24 * time.Hour))
+	vpoolKeeper.PruneTradeVolumes(ctx, common.Pair_BTC_NUSD, time.Hour)
+	assert.Len(t, getVolumeTimes(), 10)
+	vpoolKeeper.PruneTradeVolumes(ctx, common.Pair_BTC_NUSD, time.Hour)
+	assert.Empty(t, getVolumeTimes())
+}
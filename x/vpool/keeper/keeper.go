@@ -8,6 +8,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/NibiruChain/nibiru/x/common"
@@ -17,11 +18,18 @@ import (
 func NewKeeper(
 	codec codec.BinaryCodec,
 	storeKey sdk.StoreKey,
+	paramSubspace paramtypes.Subspace,
 	pricefeedKeeper types.PricefeedKeeper,
 ) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSubspace.HasKeyTable() {
+		paramSubspace = paramSubspace.WithKeyTable(types.ParamKeyTable())
+	}
+
 	return Keeper{
 		codec:           codec,
 		storeKey:        storeKey,
+		paramSubspace:   paramSubspace,
 		pricefeedKeeper: pricefeedKeeper,
 		Pools:           collections.NewMap(storeKey, 0, common.AssetPairKeyEncoder, collections.ProtoValueEncoder[types.VPool](codec)),
 		ReserveSnapshots: collections.NewMap(
@@ -29,27 +37,68 @@ func NewKeeper(
 			collections.PairKeyEncoder(common.AssetPairKeyEncoder, collections.TimeKeyEncoder),
 			collections.ProtoValueEncoder[types.ReserveSnapshot](codec),
 		),
+		Settlements: collections.NewMap(storeKey, 2, common.AssetPairKeyEncoder, collections.ProtoValueEncoder[types.PoolSettlement](codec)),
+		TradeVolumes: collections.NewMap(
+			storeKey, 3,
+			collections.PairKeyEncoder(common.AssetPairKeyEncoder, collections.TimeKeyEncoder),
+			collections.ProtoValueEncoder[types.TradeVolume](codec),
+		),
+		DownsampleCursors: collections.NewMap(storeKey, 4, common.AssetPairKeyEncoder, collections.TimeValueEncoder),
 	}
 }
 
 type Keeper struct {
 	codec           codec.BinaryCodec
 	storeKey        sdk.StoreKey
+	paramSubspace   paramtypes.Subspace
 	pricefeedKeeper types.PricefeedKeeper
+	// the lookbacks of the TWAPs of the other modules, nil if none
+	twapLookbackKeeper types.TwapLookbackKeeper
 
 	Pools            collections.Map[common.AssetPair, types.VPool]
 	ReserveSnapshots collections.Map[collections.Pair[common.AssetPair, time.Time], types.ReserveSnapshot]
+	Settlements      collections.Map[common.AssetPair, types.PoolSettlement]
+	TradeVolumes     collections.Map[collections.Pair[common.AssetPair, time.Time], types.TradeVolume]
+	// DownsampleCursors holds, for each pair, the time from which the
+	// downsampling of the reserve snapshots resumes, see DownsampleSnapshots.
+	DownsampleCursors collections.Map[common.AssetPair, time.Time]
+}
+
+// SetTwapLookbackKeeper sets the keeper whose TWAP lookbacks the reserve
+// snapshots are kept for. The keeper is copied into the other modules, so it
+// must be set before the vpool module and its proposal handler are created.
+func (k *Keeper) SetTwapLookbackKeeper(twapLookbackKeeper types.TwapLookbackKeeper) *Keeper {
+	if k.twapLookbackKeeper != nil {
+		panic("cannot set the vpool twap lookback keeper twice")
+	}
+
+	k.twapLookbackKeeper = twapLookbackKeeper
+
+	return k
 }
 
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
+// GetParams get all parameters as types.Params
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSubspace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams set the params
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSubspace.SetParamSet(ctx, &params)
+}
+
 /*
 SwapBaseForQuote
 Trades baseAssets in exchange for quoteAssets.
 The base asset is a crypto asset like BTC.
 The quote asset is a stablecoin like NUSD.
+A FROZEN or SETTLED pool rejects the swap. The swaps of a REDUCE_ONLY pool go
+through, the perp module only letting the trades decreasing a position swap.
 
 args:
   - ctx: cosmos-sdk context
@@ -84,6 +133,10 @@ func (k Keeper) SwapBaseForQuote(
 		return sdk.Dec{}, types.ErrPairNotSupported
 	}
 
+	if err = k.requireSwappable(ctx, pool); err != nil {
+		return sdk.Dec{}, err
+	}
+
 	if !pool.HasEnoughBaseReserve(baseAmt) {
 		return sdk.Dec{}, types.ErrOverTradingLimit
 	}
@@ -112,15 +165,19 @@ func (k Keeper) SwapBaseForQuote(
 	if dir == types.Direction_ADD_TO_POOL {
 		pool.IncreaseBaseAssetReserve(baseAmt)
 		pool.DecreaseQuoteAssetReserve(quoteAmt)
+		pool.AddToNetBaseSize(baseAmt.Neg())
 	} else if dir == types.Direction_REMOVE_FROM_POOL {
 		pool.DecreaseBaseAssetReserve(baseAmt)
 		pool.IncreaseQuoteAssetReserve(quoteAmt)
+		pool.AddToNetBaseSize(baseAmt)
 	}
 
 	if err = k.updatePool(ctx, pool, skipFluctuationLimitCheck); err != nil {
 		return sdk.Dec{}, fmt.Errorf("error updating reserve: %w", err)
 	}
 
+	k.addTradeVolume(ctx, pair, baseAmt, quoteAmt)
+
 	if err := ctx.EventManager().EmitTypedEvent(&types.MarkPriceChangedEvent{
 		Pair:           pair.String(),
 		Price:          pool.GetMarkPrice(),
@@ -141,6 +198,8 @@ SwapQuoteForBase
 Trades quoteAssets in exchange for baseAssets.
 The quote asset is a stablecoin like NUSD.
 The base asset is a crypto asset like BTC or ETH.
+A FROZEN or SETTLED pool rejects the swap. The swaps of a REDUCE_ONLY pool go
+through, the perp module only letting the trades decreasing a position swap.
 
 args:
   - ctx: cosmos-sdk context
@@ -175,6 +234,10 @@ func (k Keeper) SwapQuoteForBase(
 		return sdk.Dec{}, types.ErrPairNotSupported
 	}
 
+	if err = k.requireSwappable(ctx, pool); err != nil {
+		return sdk.Dec{}, err
+	}
+
 	// check trade limit ratio on quote in either direction
 	if !pool.HasEnoughQuoteReserve(quoteAmt) {
 		return sdk.Dec{}, types.ErrOverTradingLimit.Wrapf(
@@ -205,15 +268,19 @@ func (k Keeper) SwapQuoteForBase(
 	if dir == types.Direction_ADD_TO_POOL {
 		pool.DecreaseBaseAssetReserve(baseAmt)
 		pool.IncreaseQuoteAssetReserve(quoteAmt)
+		pool.AddToNetBaseSize(baseAmt)
 	} else if dir == types.Direction_REMOVE_FROM_POOL {
 		pool.IncreaseBaseAssetReserve(baseAmt)
 		pool.DecreaseQuoteAssetReserve(quoteAmt)
+		pool.AddToNetBaseSize(baseAmt.Neg())
 	}
 
 	if err = k.updatePool(ctx, pool, skipFluctuationLimitCheck); err != nil {
 		return sdk.Dec{}, fmt.Errorf("error updating reserve: %w", err)
 	}
 
+	k.addTradeVolume(ctx, pair, baseAmt, quoteAmt)
+
 	if err := ctx.EventManager().EmitTypedEvent(&types.MarkPriceChangedEvent{
 		Pair:           pair.String(),
 		Price:          pool.GetMarkPrice(),
@@ -270,12 +337,10 @@ func (k Keeper) checkFluctuationLimitRatio(ctx sdk.Context, pool types.VPool) er
 		return nil
 	}
 
-	it := k.ReserveSnapshots.Iterate(ctx, collections.PairRange[common.AssetPair, time.Time]{}.Prefix(pool.Pair).Descending())
-	defer it.Close()
-	if !it.Valid() {
-		return fmt.Errorf("error getting last snapshot number for pair %s", pool.Pair)
+	latestSnapshot, err := k.getLastSnapshot(ctx, pool.Pair)
+	if err != nil {
+		return err
 	}
-	latestSnapshot := it.Value()
 	if pool.IsOverFluctuationLimitInRelationWithSnapshot(latestSnapshot) {
 		return types.ErrOverFluctuationLimit
 	}
@@ -283,6 +348,16 @@ func (k Keeper) checkFluctuationLimitRatio(ctx sdk.Context, pool types.VPool) er
 	return nil
 }
 
+// getLastSnapshot returns the latest reserve snapshot of a pair.
+func (k Keeper) getLastSnapshot(ctx sdk.Context, pair common.AssetPair) (types.ReserveSnapshot, error) {
+	it := k.ReserveSnapshots.Iterate(ctx, collections.PairRange[common.AssetPair, time.Time]{}.Prefix(pair).Descending())
+	defer it.Close()
+	if !it.Valid() {
+		return types.ReserveSnapshot{}, fmt.Errorf("error getting last snapshot number for pair %s", pair)
+	}
+	return it.Value(), nil
+}
+
 /*
 IsOverSpreadLimit compares the current spot price of the vpool (given by pair) to the underlying's index price (given by an oracle).
 It panics if you provide it with a pair that doesn't exist in the state.
@@ -202,6 +202,15 @@ func TestSwapQuoteForBase(t *testing.T) {
 				require.NoError(t, err)
 				assert.EqualValuesf(t, tc.expectedQuoteReserve, pool.QuoteAssetReserve, "pool quote asset reserve mismatch")
 				assert.EqualValuesf(t, tc.expectedBaseReserve, pool.BaseAssetReserve, "pool base asset reserve mismatch")
+
+				t.Log("assert trade volume")
+				volume := vpoolKeeper.TradeVolumes.GetOr(
+					ctx,
+					collections.Join(common.Pair_BTC_NUSD, ctx.BlockTime().Truncate(types.VolumeInterval)),
+					types.NewTradeVolume(common.Pair_BTC_NUSD, ctx.BlockTime().Truncate(types.VolumeInterval)),
+				)
+				assert.EqualValues(t, tc.quoteAmount, volume.QuoteVolume)
+				assert.EqualValues(t, tc.expectedBaseAmount, volume.BaseVolume)
 			}
 		})
 	}
@@ -394,6 +403,15 @@ func TestSwapBaseForQuote(t *testing.T) {
 				require.NoError(t, err)
 				assert.Equal(t, tc.expectedQuoteReserve, pool.QuoteAssetReserve)
 				assert.Equal(t, tc.expectedBaseReserve, pool.BaseAssetReserve)
+
+				t.Log("assert trade volume")
+				volume := vpoolKeeper.TradeVolumes.GetOr(
+					ctx,
+					collections.Join(common.Pair_BTC_NUSD, ctx.BlockTime().Truncate(types.VolumeInterval)),
+					types.NewTradeVolume(common.Pair_BTC_NUSD, ctx.BlockTime().Truncate(types.VolumeInterval)),
+				)
+				assert.EqualValues(t, tc.baseAmt, volume.BaseVolume)
+				assert.EqualValues(t, tc.expectedQuoteAssetAmount, volume.QuoteVolume)
 			}
 		})
 	}
@@ -440,6 +458,7 @@ func TestGetVpools(t *testing.T) {
 		MaxOracleSpreadRatio:   sdk.OneDec(),
 		MaintenanceMarginRatio: sdk.MustNewDecFromStr("0.0625"),
 		MaxLeverage:            sdk.MustNewDecFromStr("15"),
+		Status:                 types.PoolStatus_ACTIVE,
 	})
 	require.EqualValues(t, pools[1], types.VPool{
 		Pair:                   common.Pair_ETH_NUSD,
@@ -450,6 +469,7 @@ func TestGetVpools(t *testing.T) {
 		MaxOracleSpreadRatio:   sdk.OneDec(),
 		MaintenanceMarginRatio: sdk.MustNewDecFromStr("0.0625"),
 		MaxLeverage:            sdk.MustNewDecFromStr("15"),
+		Status:                 types.PoolStatus_ACTIVE,
 	})
 }
 
@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/vpool/types"
+)
+
+// Migrator handles the in-place store migrations of the x/vpool module.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate2to3 migrates the x/vpool store from consensus version 2 to 3. The
+// module had no params before version 3, so the snapshot params are set to
+// their defaults, keeping any already set. The snapshots kept until version 3
+// are pruned and downsampled by the EndBlocker over the following blocks, see
+// PruneSnapshots and DownsampleSnapshots.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	params := types.DefaultParams()
+	m.keeper.paramSubspace.GetParamSetIfExists(ctx, &params)
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	m.keeper.SetParams(ctx, params)
+	return nil
+}
@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/x/vpool/types"
+)
+
+func TestMigrate2to3(t *testing.T) {
+	t.Run("the params are set to their defaults", func(t *testing.T) {
+		// version 2 had no params
+		vpoolKeeper, _, ctx := getKeeper(t)
+		paramStore := prefix.NewStore(ctx.KVStore(vpoolKeeper.storeKey), []byte(vpoolKeeper.paramSubspace.Name()+"/"))
+		params := types.DefaultParams()
+		for _, pair := range params.ParamSetPairs() {
+			paramStore.Delete(pair.Key)
+		}
+		require.False(t, vpoolKeeper.paramSubspace.Has(ctx, []byte("SnapshotRetention")))
+
+		require.NoError(t, NewMigrator(vpoolKeeper).Migrate2to3(ctx))
+		assert.Equal(t, types.DefaultParams(), vpoolKeeper.GetParams(ctx))
+	})
+
+	t.Run("the params already set are kept", func(t *testing.T) {
+		vpoolKeeper, _, ctx := getKeeper(t)
+		params := types.DefaultParams()
+		params.SnapshotRetention = 48 * time.Hour
+		vpoolKeeper.SetParams(ctx, params)
+
+		require.NoError(t, NewMigrator(vpoolKeeper).Migrate2to3(ctx))
+		assert.Equal(t, params, vpoolKeeper.GetParams(ctx))
+	})
+}
@@ -0,0 +1,145 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/collections"
+	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/vpool/types"
+)
+
+/*
+SetOraclePeg turns a pool into an ORACLE_PEGGED pool with the given curve and
+anchors it around the pricefeed price right away, replacing its reserves. It is
+meant for a pool being created, whose market has no position to revalue yet.
+
+args:
+  - ctx: cosmos-sdk context
+  - pair: the pair of the pool
+  - config: the curve of the pool, its quote depth and spread
+
+ret:
+  - pool: the anchored pool
+  - err: error if the pool doesn't exist, is settled, the config is invalid or
+    the pair has no pricefeed price
+*/
+func (k Keeper) SetOraclePeg(
+	ctx sdk.Context, pair common.AssetPair, config types.OraclePegConfig,
+) (pool types.VPool, err error) {
+	if err = config.Validate(); err != nil {
+		return types.VPool{}, err
+	}
+
+	pool, err = k.Pools.Get(ctx, pair)
+	if err != nil {
+		return types.VPool{}, types.ErrPairNotSupported.Wrap(pair.String())
+	}
+	if k.poolStatus(ctx, pool) == types.PoolStatus_SETTLED {
+		return types.VPool{}, types.ErrPoolSettled.Wrap(pair.String())
+	}
+
+	netBaseSize := sdk.ZeroDec()
+	pool.CurveType = types.CurveType_ORACLE_PEGGED
+	pool.OraclePegConfig = &config
+	pool.NetBaseSize = &netBaseSize
+	return k.anchorPool(ctx, pool, false /* withinFluctuationLimit */)
+}
+
+/*
+AnchorOraclePeggedPool anchors the curve of an ORACLE_PEGGED pool around the
+pricefeed price, kept within the fluctuation limit of the last snapshot so that
+a pricefeed jump is followed over several blocks. It is run by the BeginBlocker
+for every oracle-pegged pool.
+
+The anchoring is value-neutral: the net position of the traders of the pool is
+worth the same before and after, see VPool.GetValueNeutralReserves, so moving
+the curve takes nothing from nor owes anything to the vault of x/perp. The new
+reserves are snapshotted, so that the trades of the block are limited around
+the anchored price and the TWAPs start from it.
+
+args:
+  - ctx: cosmos-sdk context
+  - pair: the pair of the pool
+
+ret:
+  - pool: the anchored pool
+  - err: error if the pool doesn't exist, isn't oracle-pegged, is settled or
+    the pair has no pricefeed price
+*/
+func (k Keeper) AnchorOraclePeggedPool(ctx sdk.Context, pair common.AssetPair) (pool types.VPool, err error) {
+	pool, err = k.Pools.Get(ctx, pair)
+	if err != nil {
+		return types.VPool{}, types.ErrPairNotSupported.Wrap(pair.String())
+	}
+	if !pool.IsOraclePegged() {
+		return types.VPool{}, fmt.Errorf("pool %s is not oracle-pegged", pair)
+	}
+	if k.poolStatus(ctx, pool) == types.PoolStatus_SETTLED {
+		return types.VPool{}, types.ErrPoolSettled.Wrap(pair.String())
+	}
+
+	return k.anchorPool(ctx, pool, true /* withinFluctuationLimit */)
+}
+
+// anchorPool sets the value-neutral reserves of an oracle-pegged pool around the
+// pricefeed price and snapshots them. The anchored price is kept within the
+// fluctuation limit of the last snapshot if withinFluctuationLimit.
+func (k Keeper) anchorPool(ctx sdk.Context, pool types.VPool, withinFluctuationLimit bool) (types.VPool, error) {
+	indexPrice, err := k.pricefeedKeeper.GetCurrentPrice(ctx, pool.Pair.BaseDenom(), pool.Pair.QuoteDenom())
+	if err != nil {
+		return types.VPool{}, err
+	}
+	if !indexPrice.Price.IsPositive() {
+		return types.VPool{}, types.ErrNoValidPrice.Wrap(pool.Pair.String())
+	}
+
+	targetPrice := indexPrice.Price
+	if withinFluctuationLimit && pool.FluctuationLimitRatio.IsPositive() {
+		if lastSnapshot, err := k.getLastSnapshot(ctx, pool.Pair); err == nil {
+			targetPrice = sdk.MinDec(
+				sdk.MaxDec(targetPrice, lastSnapshot.GetLowerMarkPriceFluctuationLimit(pool.FluctuationLimitRatio)),
+				lastSnapshot.GetUpperMarkPriceFluctuationLimit(pool.FluctuationLimitRatio),
+			)
+		}
+	}
+
+	oldMarkPrice := pool.GetMarkPrice()
+	baseReserve, quoteReserve, anchorPrice, err := pool.GetValueNeutralReserves(targetPrice)
+	if err != nil {
+		return types.VPool{}, err
+	}
+	pool.BaseAssetReserve, pool.QuoteAssetReserve = baseReserve, quoteReserve
+	if err = pool.ValidateReserves(); err != nil {
+		return types.VPool{}, err
+	}
+	// the anchored price is already within the fluctuation limit
+	if err = k.updatePool(ctx, pool, true /* skipFluctuationCheck */); err != nil {
+		return types.VPool{}, err
+	}
+	k.ReserveSnapshots.Insert(
+		ctx,
+		collections.Join(pool.Pair, ctx.BlockTime()),
+		types.NewPoolReserveSnapshot(pool, ctx.BlockTime()),
+	)
+
+	if err = ctx.EventManager().EmitTypedEvent(&types.MarkPriceChangedEvent{
+		Pair:           pool.Pair.String(),
+		Price:          pool.GetMarkPrice(),
+		BlockTimestamp: ctx.BlockTime(),
+	}); err != nil {
+		return types.VPool{}, err
+	}
+
+	return pool, ctx.EventManager().EmitTypedEvent(&types.PoolPegAnchoredEvent{
+		Pair:            pool.Pair.String(),
+		IndexPrice:      indexPrice.Price,
+		OldMarkPrice:    oldMarkPrice,
+		NewBaseReserve:  pool.BaseAssetReserve,
+		NewQuoteReserve: pool.QuoteAssetReserve,
+		BlockHeight:     ctx.BlockHeight(),
+		BlockTimestamp:  ctx.BlockTime(),
+		AnchorPrice:     anchorPrice,
+	})
+}
@@ -0,0 +1,182 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/collections"
+	"github.com/NibiruChain/nibiru/x/common"
+	pftypes "github.com/NibiruChain/nibiru/x/pricefeed/types"
+	"github.com/NibiruChain/nibiru/x/testutil"
+	"github.com/NibiruChain/nibiru/x/vpool/types"
+)
+
+func TestOraclePeggedPool(t *testing.T) {
+	vpoolKeeper, mocks, ctx := getKeeper(t)
+	ctx = ctx.WithBlockHeight(1).WithBlockTime(time.Now())
+	vpoolKeeper.CreatePool(
+		ctx,
+		common.Pair_BTC_NUSD,
+		/* tradeLimitRatio */ sdk.MustNewDecFromStr("0.9"),
+		/* quoteAssetReserve */ sdk.NewDec(10_000_000),
+		/* baseAssetReserve */ sdk.NewDec(5_000_000),
+		/* fluctuationLimitRatio */ sdk.MustNewDecFromStr("0.1"),
+		/* maxOracleSpreadRatio */ sdk.MustNewDecFromStr("0.1"),
+		/* maintenanceMarginRatio */ sdk.MustNewDecFromStr("0.0625"),
+		/* maxLeverage */ sdk.MustNewDecFromStr("15"),
+	)
+
+	indexPrice := sdk.NewDec(8)
+	mocks.mockPricefeedKeeper.EXPECT().
+		GetCurrentPrice(gomock.Any(), common.DenomBTC, common.DenomNUSD).
+		DoAndReturn(func(sdk.Context, string, string) (pftypes.CurrentPrice, error) {
+			return pftypes.CurrentPrice{PairID: common.Pair_BTC_NUSD.String(), Price: indexPrice}, nil
+		}).
+		AnyTimes()
+	mocks.mockPricefeedKeeper.EXPECT().
+		IsActivePair(gomock.Any(), common.Pair_BTC_NUSD.String()).
+		Return(true).
+		AnyTimes()
+
+	config := types.OraclePegConfig{
+		QuoteDepth:  sdk.NewDec(20_000_000),
+		SpreadRatio: sdk.MustNewDecFromStr("0.01"),
+	}
+
+	t.Log("an invalid curve is rejected")
+	_, err := vpoolKeeper.SetOraclePeg(ctx, common.Pair_BTC_NUSD, types.OraclePegConfig{
+		QuoteDepth:  sdk.ZeroDec(),
+		SpreadRatio: sdk.ZeroDec(),
+	})
+	require.Error(t, err)
+
+	t.Log("a pool which isn't oracle-pegged cannot be anchored")
+	_, err = vpoolKeeper.AnchorOraclePeggedPool(ctx, common.Pair_BTC_NUSD)
+	require.Error(t, err)
+
+	t.Log("the pool is anchored at the index price of 8, past the fluctuation limit")
+	pool, err := vpoolKeeper.SetOraclePeg(ctx, common.Pair_BTC_NUSD, config)
+	require.NoError(t, err)
+	assert.EqualValues(t, types.CurveType_ORACLE_PEGGED, pool.CurveType)
+	assert.EqualValues(t, sdk.NewDec(2_500_000), pool.BaseAssetReserve)
+	assert.EqualValues(t, sdk.NewDec(20_000_000), pool.QuoteAssetReserve)
+	stored, err := vpoolKeeper.Pools.Get(ctx, common.Pair_BTC_NUSD)
+	require.NoError(t, err)
+	assert.EqualValues(t, pool, stored)
+
+	snapshot, err := vpoolKeeper.ReserveSnapshots.Get(ctx, collections.Join(common.Pair_BTC_NUSD, ctx.BlockTime()))
+	require.NoError(t, err)
+	assert.EqualValues(t, sdk.NewDec(8), snapshot.QuoteAssetReserve.Quo(snapshot.BaseAssetReserve))
+	assert.EqualValues(t, &config, snapshot.OraclePegConfig)
+	testutil.RequireHasTypedEvent(t, ctx, &types.PoolPegAnchoredEvent{
+		Pair:            common.Pair_BTC_NUSD.String(),
+		IndexPrice:      sdk.NewDec(8),
+		OldMarkPrice:    sdk.NewDec(2),
+		NewBaseReserve:  sdk.NewDec(2_500_000),
+		NewQuoteReserve: sdk.NewDec(20_000_000),
+		BlockHeight:     ctx.BlockHeight(),
+		BlockTimestamp:  ctx.BlockTime(),
+		AnchorPrice:     pool.GetMarkPrice(),
+	})
+
+	t.Log("the swap TWAPs quote the spread of the curve")
+	twap, err := vpoolKeeper.calcTwap(ctx, common.Pair_BTC_NUSD, types.TwapCalcOption_QUOTE_ASSET_SWAP,
+		types.Direction_ADD_TO_POOL, sdk.NewDec(100_000), 0)
+	require.NoError(t, err)
+	expectedBaseAmount, err := pool.GetBaseAmountByQuoteAmount(types.Direction_ADD_TO_POOL, sdk.NewDec(100_000))
+	require.NoError(t, err)
+	assert.EqualValues(t, expectedBaseAmount, twap)
+
+	t.Log("the depth quotes the spread of the curve, up to the fluctuation limits of 7.2 and 8.8")
+	for dir, limitPrice := range map[types.Direction]float64{
+		types.Direction_ADD_TO_POOL:      8.8,
+		types.Direction_REMOVE_FROM_POOL: 7.2,
+	} {
+		levels, err := vpoolKeeper.GetPoolDepth(ctx, common.Pair_BTC_NUSD, dir, 4)
+		require.NoError(t, err)
+		require.Len(t, levels, 4)
+		for _, level := range levels {
+			baseAmount, err := pool.GetBaseAmountByQuoteAmount(dir, level.QuoteAmount)
+			require.NoError(t, err)
+			assert.EqualValues(t, baseAmount, level.BaseAmount)
+		}
+		assert.InDelta(t, limitPrice, levels[3].MarkPrice.MustFloat64(), 1e-6)
+	}
+
+	t.Log("a swap gets the curve amount less the spread, within the fluctuation limit of the anchor")
+	constantProductPool := pool
+	constantProductPool.CurveType, constantProductPool.OraclePegConfig = types.CurveType_CONSTANT_PRODUCT, nil
+	curveBaseAmount, err := constantProductPool.GetBaseAmountByQuoteAmount(types.Direction_ADD_TO_POOL, sdk.NewDec(100_000))
+	require.NoError(t, err)
+	baseAmount, err := vpoolKeeper.SwapQuoteForBase(
+		ctx, common.Pair_BTC_NUSD, types.Direction_ADD_TO_POOL, sdk.NewDec(100_000), sdk.ZeroDec(), false)
+	require.NoError(t, err)
+	assert.EqualValues(t, curveBaseAmount.Quo(sdk.MustNewDecFromStr("1.01")), baseAmount)
+
+	t.Log("the swap is tracked in the net base size of the traders")
+	pool, err = vpoolKeeper.GetPool(ctx, common.Pair_BTC_NUSD)
+	require.NoError(t, err)
+	assert.EqualValues(t, baseAmount, pool.GetNetBaseSize())
+	netLongValue := func(pool types.VPool) sdk.Dec {
+		value, err := pool.GetQuoteAmountByBaseAmount(types.Direction_ADD_TO_POOL, pool.GetNetBaseSize())
+		require.NoError(t, err)
+		return value
+	}
+	valueBefore := netLongValue(pool)
+
+	t.Log("the next block anchors the pool toward the new index price of 10, as far as the net long keeps its value")
+	indexPrice = sdk.NewDec(10)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(ctx.BlockTime().Add(5 * time.Second))
+	pool, err = vpoolKeeper.AnchorOraclePeggedPool(ctx, common.Pair_BTC_NUSD)
+	require.NoError(t, err)
+	assert.InDelta(t, valueBefore.MustFloat64(), netLongValue(pool).MustFloat64(), 1e-6)
+	// the shallowest curve is reached before the fluctuation limit of 8.8
+	assert.InDelta(t, 2_000_000, pool.QuoteAssetReserve.MustFloat64(), 1e-6)
+	assert.True(t, pool.GetMarkPrice().GT(sdk.NewDec(8)))
+	assert.True(t, pool.GetMarkPrice().LT(sdk.MustNewDecFromStr("8.8")))
+
+	t.Log("the pool follows the index price back toward 8, as far as the deepest curve keeps the value of the net long")
+	indexPrice = sdk.NewDec(8)
+	oldMarkPrice := pool.GetMarkPrice()
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(ctx.BlockTime().Add(5 * time.Second)).
+		WithEventManager(sdk.NewEventManager())
+	pool, err = vpoolKeeper.AnchorOraclePeggedPool(ctx, common.Pair_BTC_NUSD)
+	require.NoError(t, err)
+	assert.True(t, pool.GetMarkPrice().LT(oldMarkPrice))
+	assert.True(t, pool.GetMarkPrice().GT(sdk.NewDec(8)))
+	assert.InDelta(t, valueBefore.MustFloat64(), netLongValue(pool).MustFloat64(), 1e-6)
+	testutil.RequireHasTypedEvent(t, ctx, &types.PoolPegAnchoredEvent{
+		Pair:            common.Pair_BTC_NUSD.String(),
+		IndexPrice:      sdk.NewDec(8),
+		OldMarkPrice:    oldMarkPrice,
+		NewBaseReserve:  pool.BaseAssetReserve,
+		NewQuoteReserve: pool.QuoteAssetReserve,
+		BlockHeight:     ctx.BlockHeight(),
+		BlockTimestamp:  ctx.BlockTime(),
+		AnchorPrice:     pool.GetMarkPrice(),
+	})
+
+	t.Log("an oracle-pegged pool cannot be repegged nor have its depth adjusted")
+	_, err = vpoolKeeper.RepegPool(ctx, common.Pair_BTC_NUSD)
+	require.ErrorIs(t, err, types.ErrOraclePeggedPool)
+	_, err = vpoolKeeper.AdjustPoolDepth(ctx, common.Pair_BTC_NUSD, sdk.NewDec(2))
+	require.ErrorIs(t, err, types.ErrOraclePeggedPool)
+
+	t.Log("a settled pool is not anchored anymore")
+	mocks.mockPricefeedKeeper.EXPECT().
+		GetCurrentTWAP(ctx, common.DenomBTC, common.DenomNUSD).
+		Return(sdk.NewDec(10), nil)
+	_, err = vpoolKeeper.SettlePool(ctx, common.Pair_BTC_NUSD, types.SettlementPriceSource_PRICEFEED_TWAP, 0)
+	require.NoError(t, err)
+	_, err = vpoolKeeper.AnchorOraclePeggedPool(ctx, common.Pair_BTC_NUSD)
+	require.ErrorIs(t, err, types.ErrPoolSettled)
+
+	t.Log("an unknown pool cannot be anchored")
+	_, err = vpoolKeeper.AnchorOraclePeggedPool(ctx, common.Pair_ETH_NUSD)
+	require.ErrorIs(t, err, types.ErrPairNotSupported)
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/NibiruChain/nibiru/collections"
@@ -32,6 +33,7 @@ func (k Keeper) CreatePool(
 		MaxOracleSpreadRatio:   maxOracleSpreadRatio,
 		MaintenanceMarginRatio: maintenanceMarginRatio,
 		MaxLeverage:            maxLeverage,
+		Status:                 types.PoolStatus_ACTIVE,
 	})
 
 	k.ReserveSnapshots.Insert(
@@ -79,6 +81,15 @@ func (k Keeper) ExistsPool(ctx sdk.Context, pair common.AssetPair) bool {
 	return err == nil
 }
 
+// GetPool returns the pool of a pair, or ErrPairNotSupported if it doesn't exist.
+func (k Keeper) GetPool(ctx sdk.Context, pair common.AssetPair) (types.VPool, error) {
+	pool, err := k.Pools.Get(ctx, pair)
+	if err != nil {
+		return types.VPool{}, types.ErrPairNotSupported.Wrap(pair.String())
+	}
+	return pool, nil
+}
+
 // GetPoolPrices returns the mark price, twap (mark) price, and index price for a vpool.
 // An error is returned if the pool does not exist.
 // No error is returned if the prices don't exist, however.
@@ -125,3 +136,189 @@ func (k Keeper) GetPoolPrices(
 		BlockNumber:   ctx.BlockHeight(),
 	}, nil
 }
+
+/*
+EditPoolConfig sets the risk parameters of an existing pool, leaving its
+reserves untouched. The config of a settled pool cannot be edited.
+
+args:
+  - ctx: cosmos-sdk context
+  - pair: the pair of the pool
+  - tradeLimitRatio: the limit on trading amounts
+  - fluctuationLimitRatio: the maximum price change a trade can create
+  - maxOracleSpreadRatio: the maximum spread between the mark and the index price
+  - maintenanceMarginRatio: the maintenance margin ratio of the pool's market
+  - maxLeverage: the maximum leverage of the pool's market
+
+ret:
+  - err: error if the pool doesn't exist, is settled or the config is invalid
+*/
+func (k Keeper) EditPoolConfig(
+	ctx sdk.Context,
+	pair common.AssetPair,
+	tradeLimitRatio sdk.Dec,
+	fluctuationLimitRatio sdk.Dec,
+	maxOracleSpreadRatio sdk.Dec,
+	maintenanceMarginRatio sdk.Dec,
+	maxLeverage sdk.Dec,
+) error {
+	pool, err := k.Pools.Get(ctx, pair)
+	if err != nil {
+		return types.ErrPairNotSupported.Wrap(pair.String())
+	}
+	if k.IsPoolSettled(ctx, pair) {
+		return types.ErrPoolSettled.Wrap(pair.String())
+	}
+
+	pool.TradeLimitRatio = tradeLimitRatio
+	pool.FluctuationLimitRatio = fluctuationLimitRatio
+	pool.MaxOracleSpreadRatio = maxOracleSpreadRatio
+	pool.MaintenanceMarginRatio = maintenanceMarginRatio
+	pool.MaxLeverage = maxLeverage
+	if err = pool.ValidateConfig(); err != nil {
+		return err
+	}
+	k.Pools.Insert(ctx, pair, pool)
+
+	return ctx.EventManager().EmitTypedEvent(&types.PoolConfigEditedEvent{
+		Pair:                   pair.String(),
+		TradeLimitRatio:        tradeLimitRatio,
+		FluctuationLimitRatio:  fluctuationLimitRatio,
+		MaxOracleSpreadRatio:   maxOracleSpreadRatio,
+		MaintenanceMarginRatio: maintenanceMarginRatio,
+		MaxLeverage:            maxLeverage,
+		BlockHeight:            ctx.BlockHeight(),
+		BlockTimestamp:         ctx.BlockTime(),
+	})
+}
+
+/*
+RepegPool rescales the reserves of a pool so that its mark price matches the
+pricefeed price, keeping the product of the reserves unchanged. The new reserves
+skip the fluctuation limit check. A settled or oracle-pegged pool cannot be
+repegged.
+
+The repeg changes the value of the positions of the pool's market: the caller
+is responsible for paying for it, see the RepegPool of x/perp.
+
+args:
+  - ctx: cosmos-sdk context
+  - pair: the pair of the pool
+
+ret:
+  - pool: the repegged pool
+  - err: error if the pool doesn't exist, is settled or oracle-pegged, or has no pricefeed price
+*/
+func (k Keeper) RepegPool(ctx sdk.Context, pair common.AssetPair) (pool types.VPool, err error) {
+	pool, err = k.Pools.Get(ctx, pair)
+	if err != nil {
+		return types.VPool{}, types.ErrPairNotSupported.Wrap(pair.String())
+	}
+	if k.poolStatus(ctx, pool) == types.PoolStatus_SETTLED {
+		return types.VPool{}, types.ErrPoolSettled.Wrap(pair.String())
+	}
+	if pool.IsOraclePegged() {
+		// the next block anchors the pool around the pricefeed price again
+		return types.VPool{}, types.ErrOraclePeggedPool.Wrap(pair.String())
+	}
+
+	indexPrice, err := k.pricefeedKeeper.GetCurrentPrice(ctx, pair.BaseDenom(), pair.QuoteDenom())
+	if err != nil {
+		return types.VPool{}, err
+	}
+	if !indexPrice.Price.IsPositive() {
+		return types.VPool{}, types.ErrNoValidPrice.Wrap(pair.String())
+	}
+
+	// base * quote = k and quote / base = price
+	invariant := pool.BaseAssetReserve.Mul(pool.QuoteAssetReserve)
+	newBaseReserve, err := invariant.Quo(indexPrice.Price).ApproxSqrt()
+	if err != nil {
+		return types.VPool{}, err
+	}
+	if !newBaseReserve.IsPositive() {
+		return types.VPool{}, types.ErrNonPositiveReserves.Wrap(pair.String())
+	}
+
+	oldBaseReserve, oldQuoteReserve := pool.BaseAssetReserve, pool.QuoteAssetReserve
+	pool.BaseAssetReserve = newBaseReserve
+	pool.QuoteAssetReserve = newBaseReserve.Mul(indexPrice.Price)
+	if err = k.updatePool(ctx, pool, true /* skipFluctuationCheck */); err != nil {
+		return types.VPool{}, err
+	}
+
+	if err = ctx.EventManager().EmitTypedEvent(&types.MarkPriceChangedEvent{
+		Pair:           pair.String(),
+		Price:          pool.GetMarkPrice(),
+		BlockTimestamp: ctx.BlockTime(),
+	}); err != nil {
+		return types.VPool{}, err
+	}
+
+	return pool, ctx.EventManager().EmitTypedEvent(&types.PoolRepeggedEvent{
+		Pair:            pair.String(),
+		OldBaseReserve:  oldBaseReserve,
+		OldQuoteReserve: oldQuoteReserve,
+		NewBaseReserve:  pool.BaseAssetReserve,
+		NewQuoteReserve: pool.QuoteAssetReserve,
+		PegPrice:        indexPrice.Price,
+		BlockHeight:     ctx.BlockHeight(),
+		BlockTimestamp:  ctx.BlockTime(),
+	})
+}
+
+/*
+AdjustPoolDepth multiplies both reserves of a pool by a multiplier, scaling the
+product of the reserves by its square and keeping the mark price unchanged. A
+settled or oracle-pegged pool cannot be adjusted.
+
+The adjustment changes the value of the positions of the pool's market: the
+caller is responsible for paying for it, see the AdjustPoolDepth of x/perp.
+
+args:
+  - ctx: cosmos-sdk context
+  - pair: the pair of the pool
+  - multiplier: the positive factor the reserves are multiplied by
+
+ret:
+  - pool: the adjusted pool
+  - err: error if the pool doesn't exist, is settled or oracle-pegged, or the multiplier isn't positive
+*/
+func (k Keeper) AdjustPoolDepth(ctx sdk.Context, pair common.AssetPair, multiplier sdk.Dec) (pool types.VPool, err error) {
+	if multiplier.IsNil() || !multiplier.IsPositive() {
+		return types.VPool{}, fmt.Errorf("depth multiplier must be positive, not: %s", multiplier)
+	}
+
+	pool, err = k.Pools.Get(ctx, pair)
+	if err != nil {
+		return types.VPool{}, types.ErrPairNotSupported.Wrap(pair.String())
+	}
+	if k.poolStatus(ctx, pool) == types.PoolStatus_SETTLED {
+		return types.VPool{}, types.ErrPoolSettled.Wrap(pair.String())
+	}
+	if pool.IsOraclePegged() {
+		// the next block anchors the pool around the pricefeed price again
+		return types.VPool{}, types.ErrOraclePeggedPool.Wrap(pair.String())
+	}
+
+	oldBaseReserve, oldQuoteReserve := pool.BaseAssetReserve, pool.QuoteAssetReserve
+	pool.BaseAssetReserve = oldBaseReserve.Mul(multiplier)
+	pool.QuoteAssetReserve = oldQuoteReserve.Mul(multiplier)
+	if err = pool.ValidateReserves(); err != nil {
+		return types.VPool{}, err
+	}
+	if err = k.updatePool(ctx, pool, true /* skipFluctuationCheck */); err != nil {
+		return types.VPool{}, err
+	}
+
+	return pool, ctx.EventManager().EmitTypedEvent(&types.PoolDepthAdjustedEvent{
+		Pair:            pair.String(),
+		Multiplier:      multiplier,
+		OldBaseReserve:  oldBaseReserve,
+		OldQuoteReserve: oldQuoteReserve,
+		NewBaseReserve:  pool.BaseAssetReserve,
+		NewQuoteReserve: pool.QuoteAssetReserve,
+		BlockHeight:     ctx.BlockHeight(),
+		BlockTimestamp:  ctx.BlockTime(),
+	})
+}
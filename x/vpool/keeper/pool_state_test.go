@@ -11,6 +11,7 @@ import (
 
 	"github.com/NibiruChain/nibiru/x/common"
 	pftypes "github.com/NibiruChain/nibiru/x/pricefeed/types"
+	"github.com/NibiruChain/nibiru/x/testutil"
 	"github.com/NibiruChain/nibiru/x/vpool/types"
 )
 
@@ -198,3 +199,185 @@ func TestGetPoolPrices(t *testing.T) {
 		})
 	}
 }
+
+func TestEditPoolConfig(t *testing.T) {
+	vpoolKeeper, mocks, ctx := getKeeper(t)
+	ctx = ctx.WithBlockHeight(1).WithBlockTime(time.Now())
+	vpoolKeeper.CreatePool(
+		ctx,
+		common.Pair_BTC_NUSD,
+		/* tradeLimitRatio */ sdk.MustNewDecFromStr("0.9"),
+		/* quoteAssetReserve */ sdk.NewDec(10_000_000),
+		/* baseAssetReserve */ sdk.NewDec(5_000_000),
+		/* fluctuationLimitRatio */ sdk.MustNewDecFromStr("0.1"),
+		/* maxOracleSpreadRatio */ sdk.MustNewDecFromStr("0.1"),
+		/* maintenanceMarginRatio */ sdk.MustNewDecFromStr("0.0625"),
+		/* maxLeverage */ sdk.MustNewDecFromStr("15"),
+	)
+
+	t.Log("edit the config of the pool")
+	require.NoError(t, vpoolKeeper.EditPoolConfig(
+		ctx,
+		common.Pair_BTC_NUSD,
+		/* tradeLimitRatio */ sdk.MustNewDecFromStr("0.5"),
+		/* fluctuationLimitRatio */ sdk.MustNewDecFromStr("0.2"),
+		/* maxOracleSpreadRatio */ sdk.MustNewDecFromStr("0.3"),
+		/* maintenanceMarginRatio */ sdk.MustNewDecFromStr("0.1"),
+		/* maxLeverage */ sdk.MustNewDecFromStr("10"),
+	))
+	pool, err := vpoolKeeper.Pools.Get(ctx, common.Pair_BTC_NUSD)
+	require.NoError(t, err)
+	assert.EqualValues(t, sdk.MustNewDecFromStr("0.5"), pool.TradeLimitRatio)
+	assert.EqualValues(t, sdk.MustNewDecFromStr("0.2"), pool.FluctuationLimitRatio)
+	assert.EqualValues(t, sdk.MustNewDecFromStr("0.3"), pool.MaxOracleSpreadRatio)
+	assert.EqualValues(t, sdk.MustNewDecFromStr("0.1"), pool.MaintenanceMarginRatio)
+	assert.EqualValues(t, sdk.MustNewDecFromStr("10"), pool.MaxLeverage)
+	assert.EqualValues(t, sdk.NewDec(10_000_000), pool.QuoteAssetReserve)
+	assert.EqualValues(t, sdk.NewDec(5_000_000), pool.BaseAssetReserve)
+
+	t.Log("a max leverage below the maintenance margin ratio is rejected")
+	require.Error(t, vpoolKeeper.EditPoolConfig(
+		ctx,
+		common.Pair_BTC_NUSD,
+		sdk.MustNewDecFromStr("0.5"),
+		sdk.MustNewDecFromStr("0.2"),
+		sdk.MustNewDecFromStr("0.3"),
+		sdk.MustNewDecFromStr("0.5"),
+		sdk.MustNewDecFromStr("10"),
+	))
+
+	t.Log("an unknown pool is rejected")
+	require.ErrorIs(t, vpoolKeeper.EditPoolConfig(
+		ctx,
+		common.Pair_ETH_NUSD,
+		sdk.MustNewDecFromStr("0.5"),
+		sdk.MustNewDecFromStr("0.2"),
+		sdk.MustNewDecFromStr("0.3"),
+		sdk.MustNewDecFromStr("0.1"),
+		sdk.MustNewDecFromStr("10"),
+	), types.ErrPairNotSupported)
+
+	t.Log("the config of a settled pool cannot be edited")
+	mocks.mockPricefeedKeeper.EXPECT().
+		GetCurrentTWAP(ctx, common.DenomBTC, common.DenomNUSD).
+		Return(sdk.NewDec(2), nil)
+	_, err = vpoolKeeper.SettlePool(ctx, common.Pair_BTC_NUSD, types.SettlementPriceSource_PRICEFEED_TWAP, 0)
+	require.NoError(t, err)
+	require.ErrorIs(t, vpoolKeeper.EditPoolConfig(
+		ctx,
+		common.Pair_BTC_NUSD,
+		sdk.MustNewDecFromStr("0.5"),
+		sdk.MustNewDecFromStr("0.2"),
+		sdk.MustNewDecFromStr("0.3"),
+		sdk.MustNewDecFromStr("0.1"),
+		sdk.MustNewDecFromStr("10"),
+	), types.ErrPoolSettled)
+}
+
+func TestRepegPool(t *testing.T) {
+	vpoolKeeper, mocks, ctx := getKeeper(t)
+	ctx = ctx.WithBlockHeight(1).WithBlockTime(time.Now())
+	vpoolKeeper.CreatePool(
+		ctx,
+		common.Pair_BTC_NUSD,
+		/* tradeLimitRatio */ sdk.MustNewDecFromStr("0.9"),
+		/* quoteAssetReserve */ sdk.NewDec(10_000_000),
+		/* baseAssetReserve */ sdk.NewDec(5_000_000),
+		/* fluctuationLimitRatio */ sdk.MustNewDecFromStr("0.1"),
+		/* maxOracleSpreadRatio */ sdk.MustNewDecFromStr("0.1"),
+		/* maintenanceMarginRatio */ sdk.MustNewDecFromStr("0.0625"),
+		/* maxLeverage */ sdk.MustNewDecFromStr("15"),
+	)
+	mocks.mockPricefeedKeeper.EXPECT().
+		GetCurrentPrice(ctx, common.DenomBTC, common.DenomNUSD).
+		Return(pftypes.CurrentPrice{PairID: common.Pair_BTC_NUSD.String(), Price: sdk.NewDec(8)}, nil).
+		AnyTimes()
+
+	t.Log("the mark price moves from 2 to the index price of 8, past the fluctuation limit")
+	pool, err := vpoolKeeper.RepegPool(ctx, common.Pair_BTC_NUSD)
+	require.NoError(t, err)
+	assert.EqualValues(t, sdk.NewDec(2_500_000), pool.BaseAssetReserve)
+	assert.EqualValues(t, sdk.NewDec(20_000_000), pool.QuoteAssetReserve)
+	assert.EqualValues(t, sdk.NewDec(8), pool.GetMarkPrice())
+
+	stored, err := vpoolKeeper.Pools.Get(ctx, common.Pair_BTC_NUSD)
+	require.NoError(t, err)
+	assert.EqualValues(t, pool, stored)
+	testutil.RequireHasTypedEvent(t, ctx, &types.PoolRepeggedEvent{
+		Pair:            common.Pair_BTC_NUSD.String(),
+		OldBaseReserve:  sdk.NewDec(5_000_000),
+		OldQuoteReserve: sdk.NewDec(10_000_000),
+		NewBaseReserve:  sdk.NewDec(2_500_000),
+		NewQuoteReserve: sdk.NewDec(20_000_000),
+		PegPrice:        sdk.NewDec(8),
+		BlockHeight:     ctx.BlockHeight(),
+		BlockTimestamp:  ctx.BlockTime(),
+	})
+
+	t.Log("a settled pool cannot be repegged")
+	mocks.mockPricefeedKeeper.EXPECT().
+		GetCurrentTWAP(ctx, common.DenomBTC, common.DenomNUSD).
+		Return(sdk.NewDec(8), nil)
+	_, err = vpoolKeeper.SettlePool(ctx, common.Pair_BTC_NUSD, types.SettlementPriceSource_PRICEFEED_TWAP, 0)
+	require.NoError(t, err)
+	_, err = vpoolKeeper.RepegPool(ctx, common.Pair_BTC_NUSD)
+	require.ErrorIs(t, err, types.ErrPoolSettled)
+
+	t.Log("an unknown pool cannot be repegged")
+	_, err = vpoolKeeper.RepegPool(ctx, common.Pair_ETH_NUSD)
+	require.ErrorIs(t, err, types.ErrPairNotSupported)
+}
+
+func TestAdjustPoolDepth(t *testing.T) {
+	vpoolKeeper, mocks, ctx := getKeeper(t)
+	ctx = ctx.WithBlockHeight(1).WithBlockTime(time.Now())
+	vpoolKeeper.CreatePool(
+		ctx,
+		common.Pair_BTC_NUSD,
+		/* tradeLimitRatio */ sdk.MustNewDecFromStr("0.9"),
+		/* quoteAssetReserve */ sdk.NewDec(10_000_000),
+		/* baseAssetReserve */ sdk.NewDec(5_000_000),
+		/* fluctuationLimitRatio */ sdk.MustNewDecFromStr("0.1"),
+		/* maxOracleSpreadRatio */ sdk.MustNewDecFromStr("0.1"),
+		/* maintenanceMarginRatio */ sdk.MustNewDecFromStr("0.0625"),
+		/* maxLeverage */ sdk.MustNewDecFromStr("15"),
+	)
+
+	t.Log("the reserves are scaled, keeping the mark price of 2")
+	pool, err := vpoolKeeper.AdjustPoolDepth(ctx, common.Pair_BTC_NUSD, sdk.MustNewDecFromStr("1.5"))
+	require.NoError(t, err)
+	assert.EqualValues(t, sdk.NewDec(7_500_000), pool.BaseAssetReserve)
+	assert.EqualValues(t, sdk.NewDec(15_000_000), pool.QuoteAssetReserve)
+	assert.EqualValues(t, sdk.NewDec(2), pool.GetMarkPrice())
+
+	stored, err := vpoolKeeper.Pools.Get(ctx, common.Pair_BTC_NUSD)
+	require.NoError(t, err)
+	assert.EqualValues(t, pool, stored)
+	testutil.RequireHasTypedEvent(t, ctx, &types.PoolDepthAdjustedEvent{
+		Pair:            common.Pair_BTC_NUSD.String(),
+		Multiplier:      sdk.MustNewDecFromStr("1.5"),
+		OldBaseReserve:  sdk.NewDec(5_000_000),
+		OldQuoteReserve: sdk.NewDec(10_000_000),
+		NewBaseReserve:  sdk.NewDec(7_500_000),
+		NewQuoteReserve: sdk.NewDec(15_000_000),
+		BlockHeight:     ctx.BlockHeight(),
+		BlockTimestamp:  ctx.BlockTime(),
+	})
+
+	t.Log("the multiplier must be positive")
+	_, err = vpoolKeeper.AdjustPoolDepth(ctx, common.Pair_BTC_NUSD, sdk.ZeroDec())
+	require.Error(t, err)
+
+	t.Log("a settled pool cannot be adjusted")
+	mocks.mockPricefeedKeeper.EXPECT().
+		GetCurrentTWAP(ctx, common.DenomBTC, common.DenomNUSD).
+		Return(sdk.NewDec(2), nil)
+	_, err = vpoolKeeper.SettlePool(ctx, common.Pair_BTC_NUSD, types.SettlementPriceSource_PRICEFEED_TWAP, 0)
+	require.NoError(t, err)
+	_, err = vpoolKeeper.AdjustPoolDepth(ctx, common.Pair_BTC_NUSD, sdk.OneDec())
+	require.ErrorIs(t, err, types.ErrPoolSettled)
+
+	t.Log("an unknown pool cannot be adjusted")
+	_, err = vpoolKeeper.AdjustPoolDepth(ctx, common.Pair_ETH_NUSD, sdk.OneDec())
+	require.ErrorIs(t, err, types.ErrPairNotSupported)
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/NibiruChain/nibiru/collections"
@@ -97,6 +99,197 @@ func (k Keeper) GetQuoteAssetPrice(
 	return pool.GetBaseAmountByQuoteAmount(dir, quoteAmount)
 }
 
+/*
+EstimateSwapQuoteForBase estimates the outcome of SwapQuoteForBase without
+swapping: the base asset amount, and whether the swap would fail the trade limit
+and the fluctuation limit checks. A pool that cannot swap returns an error.
+
+args:
+  - ctx: cosmos-sdk context
+  - pair: the trading token pair
+  - dir: add or remove
+  - quoteAmt: the amount of quote asset
+
+ret:
+  - estimate: the estimated swap
+  - err: error
+*/
+func (k Keeper) EstimateSwapQuoteForBase(
+	ctx sdk.Context,
+	pair common.AssetPair,
+	dir types.Direction,
+	quoteAmt sdk.Dec,
+) (estimate types.SwapEstimate, err error) {
+	pool, err := k.Pools.Get(ctx, pair)
+	if err != nil {
+		return types.SwapEstimate{}, types.ErrPairNotSupported
+	}
+
+	if err = k.requireSwappable(ctx, pool); err != nil {
+		return types.SwapEstimate{}, err
+	}
+
+	baseAmt, err := pool.GetBaseAmountByQuoteAmount(dir, quoteAmt)
+	if err != nil {
+		return types.SwapEstimate{}, err
+	}
+	estimate.Amount = baseAmt
+	estimate.OverTradingLimit = !pool.HasEnoughQuoteReserve(quoteAmt) || !pool.HasEnoughBaseReserve(baseAmt)
+
+	if dir == types.Direction_ADD_TO_POOL {
+		pool.DecreaseBaseAssetReserve(baseAmt)
+		pool.IncreaseQuoteAssetReserve(quoteAmt)
+	} else if dir == types.Direction_REMOVE_FROM_POOL {
+		pool.IncreaseBaseAssetReserve(baseAmt)
+		pool.DecreaseQuoteAssetReserve(quoteAmt)
+	}
+
+	return k.estimateSwapOutcome(ctx, pool, estimate)
+}
+
+/*
+EstimateSwapBaseForQuote estimates the outcome of SwapBaseForQuote without
+swapping: the quote asset amount, and whether the swap would fail the trade limit
+and the fluctuation limit checks. A pool that cannot swap returns an error.
+
+args:
+  - ctx: cosmos-sdk context
+  - pair: the trading token pair
+  - dir: add or remove
+  - baseAmt: the amount of base asset
+
+ret:
+  - estimate: the estimated swap
+  - err: error
+*/
+func (k Keeper) EstimateSwapBaseForQuote(
+	ctx sdk.Context,
+	pair common.AssetPair,
+	dir types.Direction,
+	baseAmt sdk.Dec,
+) (estimate types.SwapEstimate, err error) {
+	pool, err := k.Pools.Get(ctx, pair)
+	if err != nil {
+		return types.SwapEstimate{}, types.ErrPairNotSupported
+	}
+
+	if err = k.requireSwappable(ctx, pool); err != nil {
+		return types.SwapEstimate{}, err
+	}
+
+	quoteAmt, err := pool.GetQuoteAmountByBaseAmount(dir, baseAmt)
+	if err != nil {
+		return types.SwapEstimate{}, err
+	}
+	estimate.Amount = quoteAmt
+	estimate.OverTradingLimit = !pool.HasEnoughBaseReserve(baseAmt) || !pool.HasEnoughQuoteReserve(quoteAmt)
+
+	if dir == types.Direction_ADD_TO_POOL {
+		pool.IncreaseBaseAssetReserve(baseAmt)
+		pool.DecreaseQuoteAssetReserve(quoteAmt)
+	} else if dir == types.Direction_REMOVE_FROM_POOL {
+		pool.DecreaseBaseAssetReserve(baseAmt)
+		pool.IncreaseQuoteAssetReserve(quoteAmt)
+	}
+
+	return k.estimateSwapOutcome(ctx, pool, estimate)
+}
+
+// estimateSwapOutcome fills the mark price and the fluctuation limit check of an
+// estimate from the pool after the swap.
+func (k Keeper) estimateSwapOutcome(
+	ctx sdk.Context, poolAfter types.VPool, estimate types.SwapEstimate,
+) (types.SwapEstimate, error) {
+	estimate.MarkPriceAfter = poolAfter.GetMarkPrice()
+
+	err := k.checkFluctuationLimitRatio(ctx, poolAfter)
+	switch {
+	case errors.Is(err, types.ErrOverFluctuationLimit):
+		estimate.OverFluctuationLimit = true
+	case err != nil:
+		return types.SwapEstimate{}, err
+	}
+
+	return estimate, nil
+}
+
+/*
+GetPoolDepth returns the ladder of the mark prices a pool moves to against the
+cumulative quote amount swapped in a direction. The levels are evenly spaced in
+quote amount up to the largest swap the trade limit and fluctuation limit checks
+currently allow.
+
+args:
+  - ctx: cosmos-sdk context
+  - pair: the trading token pair
+  - dir: add (buying base assets) or remove (selling base assets) quote assets
+  - steps: the number of levels, at most types.MaxPoolDepthSteps
+
+ret:
+  - levels: the levels, by increasing quote amount
+  - err: error
+*/
+func (k Keeper) GetPoolDepth(
+	ctx sdk.Context,
+	pair common.AssetPair,
+	dir types.Direction,
+	steps uint32,
+) (levels []types.PoolDepthLevel, err error) {
+	if steps == 0 || steps > types.MaxPoolDepthSteps {
+		return nil, fmt.Errorf("steps must be between 1 and %d, not: %d", types.MaxPoolDepthSteps, steps)
+	}
+
+	pool, err := k.Pools.Get(ctx, pair)
+	if err != nil {
+		return nil, types.ErrPairNotSupported
+	}
+
+	lastSnapshot, err := k.getLastSnapshot(ctx, pair)
+	if err != nil {
+		return nil, err
+	}
+
+	maxQuoteAmount, err := pool.GetMaxQuoteAmount(dir, lastSnapshot)
+	if err != nil {
+		return nil, err
+	}
+	if maxQuoteAmount.IsZero() {
+		return []types.PoolDepthLevel{}, nil
+	}
+
+	levels = make([]types.PoolDepthLevel, 0, steps)
+	for i := uint32(1); i <= steps; i++ {
+		quoteAmount := maxQuoteAmount.MulInt64(int64(i)).QuoInt64(int64(steps))
+		baseAmount, err := pool.GetBaseAmountByQuoteAmount(dir, quoteAmount)
+		if err != nil {
+			return nil, err
+		}
+
+		poolAfter := pool
+		if dir == types.Direction_ADD_TO_POOL {
+			poolAfter.DecreaseBaseAssetReserve(baseAmount)
+			poolAfter.IncreaseQuoteAssetReserve(quoteAmount)
+		} else {
+			poolAfter.IncreaseBaseAssetReserve(baseAmount)
+			poolAfter.DecreaseQuoteAssetReserve(quoteAmount)
+		}
+
+		// the rounding of the largest swap can break a limit by a hair
+		if !pool.HasEnoughQuoteReserve(quoteAmount) || !pool.HasEnoughBaseReserve(baseAmount) ||
+			poolAfter.IsOverFluctuationLimitInRelationWithSnapshot(lastSnapshot) {
+			break
+		}
+
+		levels = append(levels, types.PoolDepthLevel{
+			QuoteAmount: quoteAmount,
+			BaseAmount:  baseAmount,
+			MarkPrice:   poolAfter.GetMarkPrice(),
+		})
+	}
+
+	return levels, nil
+}
+
 /*
 GetMarkPriceTWAP
 Returns the twap of the spot price (y/x).
@@ -132,6 +325,7 @@ GetBaseAssetTWAP
 Returns the amount of quote assets required to achieve a move of baseAssetAmount in a direction,
 based on historical snapshots.
 e.g. if removing <baseAssetAmount> base assets from the pool, returns the amount of quote assets do so.
+The lookback cannot reach the downsampled snapshots, see calcTwap.
 
 args:
   - ctx: cosmos-sdk context
@@ -165,6 +359,10 @@ func (k Keeper) GetBaseAssetTWAP(
 Gets the time-weighted average price from [ ctx.BlockTime() - interval, ctx.BlockTime() )
 Note the open-ended right bracket.
 
+The QUOTE_ASSET_SWAP and BASE_ASSET_SWAP TWAPs return ErrDownsampledTWAP over
+windows reaching downsampled snapshots, whose swap prices are lost, see
+DownsampleSnapshots.
+
 args:
   - ctx: cosmos-sdk context
   - pair: the token pair
@@ -210,12 +408,29 @@ func (k Keeper) calcTwap(
 		return sdk.OneDec().Neg(), types.ErrNoValidTWAP
 	}
 
+	// the downsampled snapshots only keep the spot price
+	if twapCalcOption != types.TwapCalcOption_SPOT {
+		for _, s := range snapshots {
+			if s.Downsampled {
+				return sdk.Dec{}, types.ErrDownsampledTWAP.Wrapf(
+					"%s twap of %s over %s", twapCalcOption, pair, lookbackInterval)
+			}
+		}
+	}
+
 	return calcTwap(ctx, snapshots, lowerLimitTimestampMs, twapCalcOption, direction, assetAmount)
 }
 
 // calcTwap walks through a slice of PriceSnapshots and tallies up the prices weighted by the amount of time they were active for.
 // Callers of this function should already check if the snapshot slice is empty. Passing an empty snapshot slice will result in a panic.
-func calcTwap(ctx sdk.Context, snapshots []types.ReserveSnapshot, lowerLimitTimestampMs int64, twapCalcOption types.TwapCalcOption, direction types.Direction, assetAmt sdk.Dec) (sdk.Dec, error) {
+func calcTwap(
+	ctx sdk.Context,
+	snapshots []types.ReserveSnapshot,
+	lowerLimitTimestampMs int64,
+	twapCalcOption types.TwapCalcOption,
+	direction types.Direction,
+	assetAmt sdk.Dec,
+) (sdk.Dec, error) {
 	// circuit-breaker when there's only one snapshot to process
 	if len(snapshots) == 1 {
 		return getPriceWithSnapshot(
@@ -561,3 +561,184 @@ func TestCalcTwap(t *testing.T) {
 		})
 	}
 }
+
+func TestEstimateSwaps(t *testing.T) {
+	tests := []struct {
+		name      string
+		swapQuote bool
+		direction types.Direction
+		amount    sdk.Dec
+
+		expectedOverTradingLimit     bool
+		expectedOverFluctuationLimit bool
+	}{
+		{
+			name:      "quote for base within the limits",
+			swapQuote: true,
+			direction: types.Direction_ADD_TO_POOL,
+			amount:    sdk.NewDec(100_000),
+		},
+		{
+			name:                         "quote for base over the fluctuation limit",
+			swapQuote:                    true,
+			direction:                    types.Direction_ADD_TO_POOL,
+			amount:                       sdk.NewDec(1_000_000),
+			expectedOverFluctuationLimit: true,
+		},
+		{
+			name:                         "quote for base over the trading limit",
+			swapQuote:                    true,
+			direction:                    types.Direction_REMOVE_FROM_POOL,
+			amount:                       sdk.NewDec(9_500_000),
+			expectedOverTradingLimit:     true,
+			expectedOverFluctuationLimit: true,
+		},
+		{
+			name:      "base for quote within the limits",
+			swapQuote: false,
+			direction: types.Direction_ADD_TO_POOL,
+			amount:    sdk.NewDec(100_000),
+		},
+		{
+			name:                         "base for quote over the fluctuation limit",
+			swapQuote:                    false,
+			direction:                    types.Direction_REMOVE_FROM_POOL,
+			amount:                       sdk.NewDec(1_000_000),
+			expectedOverFluctuationLimit: true,
+		},
+		{
+			name:                         "base for quote over the trading limit",
+			swapQuote:                    false,
+			direction:                    types.Direction_ADD_TO_POOL,
+			amount:                       sdk.NewDec(4_600_000),
+			expectedOverTradingLimit:     true,
+			expectedOverFluctuationLimit: true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			pfKeeper := mock.NewMockPricefeedKeeper(gomock.NewController(t))
+			pfKeeper.EXPECT().IsActivePair(gomock.Any(), gomock.Any()).Return(true).AnyTimes()
+
+			vpoolKeeper, ctx := VpoolKeeper(t, pfKeeper)
+			vpoolKeeper.CreatePool(
+				ctx,
+				common.Pair_BTC_NUSD,
+				/* tradeLimitRatio */ sdk.MustNewDecFromStr("0.9"),
+				/* quoteAssetReserve */ sdk.NewDec(10_000_000),
+				/* baseAssetReserve */ sdk.NewDec(5_000_000),
+				/* fluctuationLimitRatio */ sdk.MustNewDecFromStr("0.1"),
+				/* maxOracleSpreadRatio */ sdk.MustNewDecFromStr("0.1"),
+				/* maintenanceMarginRatio */ sdk.MustNewDecFromStr("0.0625"),
+				/* maxLeverage */ sdk.MustNewDecFromStr("15"),
+			)
+
+			var estimate types.SwapEstimate
+			var amount sdk.Dec
+			var err, swapErr error
+			cacheCtx, _ := ctx.CacheContext()
+			if tc.swapQuote {
+				estimate, err = vpoolKeeper.EstimateSwapQuoteForBase(ctx, common.Pair_BTC_NUSD, tc.direction, tc.amount)
+				amount, swapErr = vpoolKeeper.SwapQuoteForBase(cacheCtx, common.Pair_BTC_NUSD, tc.direction, tc.amount, sdk.ZeroDec(), false)
+			} else {
+				estimate, err = vpoolKeeper.EstimateSwapBaseForQuote(ctx, common.Pair_BTC_NUSD, tc.direction, tc.amount)
+				amount, swapErr = vpoolKeeper.SwapBaseForQuote(cacheCtx, common.Pair_BTC_NUSD, tc.direction, tc.amount, sdk.ZeroDec(), false)
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedOverTradingLimit, estimate.OverTradingLimit)
+			require.Equal(t, tc.expectedOverFluctuationLimit, estimate.OverFluctuationLimit)
+
+			t.Log("the estimate matches the swap")
+			switch {
+			case tc.expectedOverTradingLimit:
+				require.ErrorIs(t, swapErr, types.ErrOverTradingLimit)
+			case tc.expectedOverFluctuationLimit:
+				require.ErrorIs(t, swapErr, types.ErrOverFluctuationLimit)
+			default:
+				require.NoError(t, swapErr)
+				require.EqualValues(t, amount, estimate.Amount)
+				markPrice, err := vpoolKeeper.GetMarkPrice(cacheCtx, common.Pair_BTC_NUSD)
+				require.NoError(t, err)
+				require.EqualValues(t, markPrice, estimate.MarkPriceAfter)
+			}
+
+			t.Log("the estimate leaves the pool untouched")
+			pool, err := vpoolKeeper.Pools.Get(ctx, common.Pair_BTC_NUSD)
+			require.NoError(t, err)
+			require.EqualValues(t, sdk.NewDec(10_000_000), pool.QuoteAssetReserve)
+			require.EqualValues(t, sdk.NewDec(5_000_000), pool.BaseAssetReserve)
+		})
+	}
+
+	t.Run("unknown pair", func(t *testing.T) {
+		vpoolKeeper, ctx := VpoolKeeper(t, mock.NewMockPricefeedKeeper(gomock.NewController(t)))
+
+		_, err := vpoolKeeper.EstimateSwapQuoteForBase(ctx, common.Pair_BTC_NUSD, types.Direction_ADD_TO_POOL, sdk.OneDec())
+		require.ErrorIs(t, err, types.ErrPairNotSupported)
+		_, err = vpoolKeeper.EstimateSwapBaseForQuote(ctx, common.Pair_BTC_NUSD, types.Direction_ADD_TO_POOL, sdk.OneDec())
+		require.ErrorIs(t, err, types.ErrPairNotSupported)
+	})
+}
+
+func TestGetPoolDepth(t *testing.T) {
+	pfKeeper := mock.NewMockPricefeedKeeper(gomock.NewController(t))
+	pfKeeper.EXPECT().IsActivePair(gomock.Any(), gomock.Any()).Return(true).AnyTimes()
+
+	vpoolKeeper, ctx := VpoolKeeper(t, pfKeeper)
+	vpoolKeeper.CreatePool(
+		ctx,
+		common.Pair_BTC_NUSD,
+		/* tradeLimitRatio */ sdk.MustNewDecFromStr("0.9"),
+		/* quoteAssetReserve */ sdk.NewDec(10_000_000),
+		/* baseAssetReserve */ sdk.NewDec(5_000_000),
+		/* fluctuationLimitRatio */ sdk.MustNewDecFromStr("0.1"),
+		/* maxOracleSpreadRatio */ sdk.MustNewDecFromStr("0.1"),
+		/* maintenanceMarginRatio */ sdk.MustNewDecFromStr("0.0625"),
+		/* maxLeverage */ sdk.MustNewDecFromStr("15"),
+	)
+
+	for _, dir := range []types.Direction{types.Direction_ADD_TO_POOL, types.Direction_REMOVE_FROM_POOL} {
+		levels, err := vpoolKeeper.GetPoolDepth(ctx, common.Pair_BTC_NUSD, dir, 4)
+		require.NoError(t, err)
+		require.Len(t, levels, 4)
+
+		t.Logf("the levels of %s are evenly spaced", dir)
+		for i, level := range levels {
+			require.EqualValues(t, levels[3].QuoteAmount.MulInt64(int64(i+1)).QuoInt64(4), level.QuoteAmount)
+			if i > 0 {
+				require.True(t, level.BaseAmount.GT(levels[i-1].BaseAmount))
+				if dir == types.Direction_ADD_TO_POOL {
+					require.True(t, level.MarkPrice.GT(levels[i-1].MarkPrice))
+				} else {
+					require.True(t, level.MarkPrice.LT(levels[i-1].MarkPrice))
+				}
+			}
+		}
+
+		t.Logf("the last level of %s is the largest swap allowed", dir)
+		cacheCtx, _ := ctx.CacheContext()
+		baseAmount, err := vpoolKeeper.SwapQuoteForBase(cacheCtx, common.Pair_BTC_NUSD, dir, levels[3].QuoteAmount, sdk.ZeroDec(), false)
+		require.NoError(t, err)
+		require.EqualValues(t, levels[3].BaseAmount, baseAmount)
+		markPrice, err := vpoolKeeper.GetMarkPrice(cacheCtx, common.Pair_BTC_NUSD)
+		require.NoError(t, err)
+		require.EqualValues(t, levels[3].MarkPrice, markPrice)
+
+		cacheCtx, _ = ctx.CacheContext()
+		_, err = vpoolKeeper.SwapQuoteForBase(cacheCtx, common.Pair_BTC_NUSD, dir,
+			levels[3].QuoteAmount.Mul(sdk.MustNewDecFromStr("1.001")), sdk.ZeroDec(), false)
+		require.ErrorIs(t, err, types.ErrOverFluctuationLimit)
+	}
+
+	t.Log("invalid steps")
+	_, err := vpoolKeeper.GetPoolDepth(ctx, common.Pair_BTC_NUSD, types.Direction_ADD_TO_POOL, 0)
+	require.Error(t, err)
+	_, err = vpoolKeeper.GetPoolDepth(ctx, common.Pair_BTC_NUSD, types.Direction_ADD_TO_POOL, types.MaxPoolDepthSteps+1)
+	require.Error(t, err)
+
+	t.Log("unknown pair")
+	_, err = vpoolKeeper.GetPoolDepth(ctx, common.Pair_ETH_NUSD, types.Direction_ADD_TO_POOL, 4)
+	require.ErrorIs(t, err, types.ErrPairNotSupported)
+}
@@ -106,3 +106,194 @@ func (q queryServer) BaseAssetPrice(
 		PriceInQuoteDenom: priceInQuoteDenom,
 	}, nil
 }
+
+func (q queryServer) MarkPriceCandles(
+	goCtx context.Context,
+	req *types.QueryMarkPriceCandlesRequest,
+) (resp *types.QueryMarkPriceCandlesResponse, err error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pair, err := common.NewAssetPair(req.Pair)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if _, err = types.ValidateCandleRange(req.Resolution, req.From, req.To); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	candles, err := q.k.GetMarkPriceCandles(ctx, pair, req.Resolution, req.From, req.To)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryMarkPriceCandlesResponse{
+		Candles: candles,
+	}, nil
+}
+
+func (q queryServer) Twap(
+	goCtx context.Context,
+	req *types.QueryTwapRequest,
+) (resp *types.QueryTwapResponse, err error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pair, err := common.NewAssetPair(req.Pair)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if req.LookbackInterval <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "lookback interval must be positive, not: %s", req.LookbackInterval)
+	}
+
+	direction, assetAmount := types.Direction_DIRECTION_UNSPECIFIED, sdk.ZeroDec()
+	switch req.TwapCalcOption {
+	case types.TwapCalcOption_SPOT:
+	case types.TwapCalcOption_QUOTE_ASSET_SWAP, types.TwapCalcOption_BASE_ASSET_SWAP:
+		if req.Direction != types.Direction_ADD_TO_POOL && req.Direction != types.Direction_REMOVE_FROM_POOL {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid direction: %s", req.Direction)
+		}
+		if req.AssetAmount.IsNil() || !req.AssetAmount.IsPositive() {
+			return nil, status.Errorf(codes.InvalidArgument, "asset amount must be positive, not: %s", req.AssetAmount)
+		}
+		direction, assetAmount = req.Direction, req.AssetAmount
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "invalid twap calc option: %s", req.TwapCalcOption)
+	}
+
+	if !q.k.ExistsPool(ctx, pair) {
+		return nil, types.ErrPairNotSupported.Wrap(pair.String())
+	}
+
+	twap, err := q.k.calcTwap(ctx, pair, req.TwapCalcOption, direction, assetAmount, req.LookbackInterval)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryTwapResponse{
+		Twap: twap,
+	}, nil
+}
+
+func (q queryServer) EstimateSwapQuoteForBase(
+	goCtx context.Context,
+	req *types.QueryEstimateSwapQuoteForBaseRequest,
+) (resp *types.QueryEstimateSwapQuoteForBaseResponse, err error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pair, err := common.NewAssetPair(req.Pair)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err = validateSwapEstimateRequest(req.Direction, req.QuoteAmount); err != nil {
+		return nil, err
+	}
+
+	estimate, err := q.k.EstimateSwapQuoteForBase(ctx, pair, req.Direction, req.QuoteAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryEstimateSwapQuoteForBaseResponse{
+		BaseAmount:           estimate.Amount,
+		MarkPriceAfter:       estimate.MarkPriceAfter,
+		OverTradingLimit:     estimate.OverTradingLimit,
+		OverFluctuationLimit: estimate.OverFluctuationLimit,
+	}, nil
+}
+
+func (q queryServer) EstimateSwapBaseForQuote(
+	goCtx context.Context,
+	req *types.QueryEstimateSwapBaseForQuoteRequest,
+) (resp *types.QueryEstimateSwapBaseForQuoteResponse, err error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pair, err := common.NewAssetPair(req.Pair)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err = validateSwapEstimateRequest(req.Direction, req.BaseAmount); err != nil {
+		return nil, err
+	}
+
+	estimate, err := q.k.EstimateSwapBaseForQuote(ctx, pair, req.Direction, req.BaseAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryEstimateSwapBaseForQuoteResponse{
+		QuoteAmount:          estimate.Amount,
+		MarkPriceAfter:       estimate.MarkPriceAfter,
+		OverTradingLimit:     estimate.OverTradingLimit,
+		OverFluctuationLimit: estimate.OverFluctuationLimit,
+	}, nil
+}
+
+func validateSwapEstimateRequest(direction types.Direction, amount sdk.Dec) error {
+	if direction != types.Direction_ADD_TO_POOL && direction != types.Direction_REMOVE_FROM_POOL {
+		return status.Errorf(codes.InvalidArgument, "invalid direction: %s", direction)
+	}
+	if amount.IsNil() || !amount.IsPositive() {
+		return status.Errorf(codes.InvalidArgument, "amount must be positive, not: %s", amount)
+	}
+	return nil
+}
+
+func (q queryServer) PoolDepth(
+	goCtx context.Context,
+	req *types.QueryPoolDepthRequest,
+) (resp *types.QueryPoolDepthResponse, err error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pair, err := common.NewAssetPair(req.Pair)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if req.Direction != types.Direction_ADD_TO_POOL && req.Direction != types.Direction_REMOVE_FROM_POOL {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid direction: %s", req.Direction)
+	}
+
+	if req.Steps == 0 || req.Steps > types.MaxPoolDepthSteps {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"steps must be between 1 and %d, not: %d", types.MaxPoolDepthSteps, req.Steps)
+	}
+
+	markPrice, err := q.k.GetMarkPrice(ctx, pair)
+	if err != nil {
+		return nil, types.ErrPairNotSupported.Wrap(pair.String())
+	}
+
+	levels, err := q.k.GetPoolDepth(ctx, pair, req.Direction, req.Steps)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPoolDepthResponse{
+		MarkPrice: markPrice,
+		Levels:    levels,
+	}, nil
+}
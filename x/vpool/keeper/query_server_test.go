@@ -95,3 +95,193 @@ func TestQueryAllPools(t *testing.T) {
 	assert.EqualValues(t, pool.Pair, resp.Pools[0].Pair)
 	assert.EqualValues(t, poolPricesWanted, resp.Prices[0])
 }
+
+func TestQueryTwap(t *testing.T) {
+	vpoolKeeper, _, ctx := getKeeper(t)
+	queryServer := NewQuerier(vpoolKeeper)
+
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	vpoolKeeper.Pools.Insert(ctx, common.Pair_BTC_NUSD, types.VPool{
+		Pair:              common.Pair_BTC_NUSD,
+		BaseAssetReserve:  sdk.NewDec(10),
+		QuoteAssetReserve: sdk.NewDec(200),
+	})
+	setSnapshots(ctx, vpoolKeeper, start, []time.Duration{0, time.Minute}, []int64{10, 20})
+	ctx = ctx.WithBlockTime(start.Add(2 * time.Minute))
+
+	t.Log("the spot price twap")
+	resp, err := queryServer.Twap(sdk.WrapSDKContext(ctx), &types.QueryTwapRequest{
+		Pair:             common.Pair_BTC_NUSD.String(),
+		TwapCalcOption:   types.TwapCalcOption_SPOT,
+		LookbackInterval: 2 * time.Minute,
+	})
+	require.NoError(t, err)
+	assert.EqualValues(t, sdk.NewDec(15), resp.Twap)
+
+	t.Log("the base asset swap twap")
+	resp, err = queryServer.Twap(sdk.WrapSDKContext(ctx), &types.QueryTwapRequest{
+		Pair:             common.Pair_BTC_NUSD.String(),
+		TwapCalcOption:   types.TwapCalcOption_BASE_ASSET_SWAP,
+		Direction:        types.Direction_ADD_TO_POOL,
+		AssetAmount:      sdk.OneDec(),
+		LookbackInterval: 2 * time.Minute,
+	})
+	require.NoError(t, err)
+	firstPool := types.VPool{BaseAssetReserve: sdk.NewDec(10), QuoteAssetReserve: sdk.NewDec(100)}
+	firstQuote, err := firstPool.GetQuoteAmountByBaseAmount(types.Direction_ADD_TO_POOL, sdk.OneDec())
+	require.NoError(t, err)
+	secondPool := types.VPool{BaseAssetReserve: sdk.NewDec(10), QuoteAssetReserve: sdk.NewDec(200)}
+	secondQuote, err := secondPool.GetQuoteAmountByBaseAmount(types.Direction_ADD_TO_POOL, sdk.OneDec())
+	require.NoError(t, err)
+	assert.EqualValues(t, firstQuote.Add(secondQuote).QuoInt64(2), resp.Twap)
+
+	for _, tc := range []struct {
+		name string
+		req  *types.QueryTwapRequest
+	}{
+		{
+			name: "unspecified twap calc option",
+			req: &types.QueryTwapRequest{
+				Pair:             common.Pair_BTC_NUSD.String(),
+				LookbackInterval: time.Minute,
+			},
+		},
+		{
+			name: "swap without a direction",
+			req: &types.QueryTwapRequest{
+				Pair:             common.Pair_BTC_NUSD.String(),
+				TwapCalcOption:   types.TwapCalcOption_QUOTE_ASSET_SWAP,
+				AssetAmount:      sdk.OneDec(),
+				LookbackInterval: time.Minute,
+			},
+		},
+		{
+			name: "swap without an amount",
+			req: &types.QueryTwapRequest{
+				Pair:             common.Pair_BTC_NUSD.String(),
+				TwapCalcOption:   types.TwapCalcOption_QUOTE_ASSET_SWAP,
+				Direction:        types.Direction_REMOVE_FROM_POOL,
+				LookbackInterval: time.Minute,
+			},
+		},
+		{
+			name: "zero lookback interval",
+			req: &types.QueryTwapRequest{
+				Pair:           common.Pair_BTC_NUSD.String(),
+				TwapCalcOption: types.TwapCalcOption_SPOT,
+			},
+		},
+		{
+			name: "unknown pair",
+			req: &types.QueryTwapRequest{
+				Pair:             common.Pair_ETH_NUSD.String(),
+				TwapCalcOption:   types.TwapCalcOption_SPOT,
+				LookbackInterval: time.Minute,
+			},
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := queryServer.Twap(sdk.WrapSDKContext(ctx), tc.req)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestQueryEstimateSwaps(t *testing.T) {
+	vpoolKeeper, _, ctx := getKeeper(t)
+	queryServer := NewQuerier(vpoolKeeper)
+	vpoolKeeper.CreatePool(
+		ctx,
+		common.Pair_BTC_NUSD,
+		/* tradeLimitRatio */ sdk.MustNewDecFromStr("0.9"),
+		/* quoteAssetReserve */ sdk.NewDec(10_000_000),
+		/* baseAssetReserve */ sdk.NewDec(5_000_000),
+		/* fluctuationLimitRatio */ sdk.MustNewDecFromStr("0.1"),
+		/* maxOracleSpreadRatio */ sdk.MustNewDecFromStr("0.1"),
+		/* maintenanceMarginRatio */ sdk.MustNewDecFromStr("0.0625"),
+		/* maxLeverage */ sdk.MustNewDecFromStr("15"),
+	)
+
+	t.Log("estimate a swap of quote for base")
+	quoteResp, err := queryServer.EstimateSwapQuoteForBase(sdk.WrapSDKContext(ctx), &types.QueryEstimateSwapQuoteForBaseRequest{
+		Pair:        common.Pair_BTC_NUSD.String(),
+		Direction:   types.Direction_ADD_TO_POOL,
+		QuoteAmount: sdk.NewDec(1_000_000),
+	})
+	require.NoError(t, err)
+	assert.EqualValues(t, sdk.MustNewDecFromStr("454545.454545454545454545"), quoteResp.BaseAmount)
+	assert.False(t, quoteResp.OverTradingLimit)
+	assert.True(t, quoteResp.OverFluctuationLimit)
+
+	t.Log("estimate a swap of base for quote")
+	baseResp, err := queryServer.EstimateSwapBaseForQuote(sdk.WrapSDKContext(ctx), &types.QueryEstimateSwapBaseForQuoteRequest{
+		Pair:       common.Pair_BTC_NUSD.String(),
+		Direction:  types.Direction_REMOVE_FROM_POOL,
+		BaseAmount: sdk.NewDec(4_600_000),
+	})
+	require.NoError(t, err)
+	assert.True(t, baseResp.OverTradingLimit)
+	assert.True(t, baseResp.OverFluctuationLimit)
+
+	t.Log("invalid requests")
+	_, err = queryServer.EstimateSwapQuoteForBase(sdk.WrapSDKContext(ctx), &types.QueryEstimateSwapQuoteForBaseRequest{
+		Pair:        common.Pair_BTC_NUSD.String(),
+		QuoteAmount: sdk.OneDec(),
+	})
+	require.Error(t, err)
+	_, err = queryServer.EstimateSwapBaseForQuote(sdk.WrapSDKContext(ctx), &types.QueryEstimateSwapBaseForQuoteRequest{
+		Pair:       common.Pair_BTC_NUSD.String(),
+		Direction:  types.Direction_ADD_TO_POOL,
+		BaseAmount: sdk.ZeroDec(),
+	})
+	require.Error(t, err)
+}
+
+func TestQueryPoolDepth(t *testing.T) {
+	vpoolKeeper, _, ctx := getKeeper(t)
+	queryServer := NewQuerier(vpoolKeeper)
+	vpoolKeeper.CreatePool(
+		ctx,
+		common.Pair_BTC_NUSD,
+		/* tradeLimitRatio */ sdk.MustNewDecFromStr("0.9"),
+		/* quoteAssetReserve */ sdk.NewDec(10_000_000),
+		/* baseAssetReserve */ sdk.NewDec(5_000_000),
+		/* fluctuationLimitRatio */ sdk.ZeroDec(),
+		/* maxOracleSpreadRatio */ sdk.MustNewDecFromStr("0.1"),
+		/* maintenanceMarginRatio */ sdk.MustNewDecFromStr("0.0625"),
+		/* maxLeverage */ sdk.MustNewDecFromStr("15"),
+	)
+
+	t.Log("without a fluctuation limit, buying is bound by the quote trade limit")
+	resp, err := queryServer.PoolDepth(sdk.WrapSDKContext(ctx), &types.QueryPoolDepthRequest{
+		Pair:      common.Pair_BTC_NUSD.String(),
+		Direction: types.Direction_ADD_TO_POOL,
+		Steps:     2,
+	})
+	require.NoError(t, err)
+	assert.EqualValues(t, sdk.NewDec(2), resp.MarkPrice)
+	assert.EqualValues(t, []types.PoolDepthLevel{
+		{
+			QuoteAmount: sdk.NewDec(4_500_000),
+			BaseAmount:  sdk.MustNewDecFromStr("1551724.137931034482758621"),
+			MarkPrice:   sdk.MustNewDecFromStr("4.205"),
+		},
+		{
+			QuoteAmount: sdk.NewDec(9_000_000),
+			BaseAmount:  sdk.MustNewDecFromStr("2368421.052631578947368421"),
+			MarkPrice:   sdk.MustNewDecFromStr("7.22"),
+		},
+	}, resp.Levels)
+
+	t.Log("invalid requests")
+	for _, req := range []*types.QueryPoolDepthRequest{
+		{Pair: common.Pair_BTC_NUSD.String(), Steps: 2},
+		{Pair: common.Pair_BTC_NUSD.String(), Direction: types.Direction_ADD_TO_POOL},
+		{Pair: common.Pair_BTC_NUSD.String(), Direction: types.Direction_ADD_TO_POOL, Steps: types.MaxPoolDepthSteps + 1},
+		{Pair: common.Pair_ETH_NUSD.String(), Direction: types.Direction_ADD_TO_POOL, Steps: 2},
+	} {
+		_, err := queryServer.PoolDepth(sdk.WrapSDKContext(ctx), req)
+		require.Error(t, err)
+	}
+}
@@ -0,0 +1,205 @@
+package keeper
+
+import (
+	"errors"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/collections"
+	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/vpool/types"
+)
+
+/*
+SettlePool moves the vpool of the pair to the SETTLED status and fixes the
+price at which the positions of its perp market are settled. A settled vpool
+rejects every swap and cannot change status anymore.
+
+args:
+  - ctx: cosmos-sdk context
+  - pair: the pair of the vpool to settle
+  - priceSource: where the settlement price is taken from
+  - lookbackInterval: lookback window of the mark TWAP, unused for the pricefeed
+    TWAP. It cannot exceed the snapshot retention.
+
+ret:
+  - settlement: the settlement of the vpool
+  - err: error
+*/
+func (k Keeper) SettlePool(
+	ctx sdk.Context,
+	pair common.AssetPair,
+	priceSource types.SettlementPriceSource,
+	lookbackInterval time.Duration,
+) (settlement types.PoolSettlement, err error) {
+	pool, err := k.Pools.Get(ctx, pair)
+	if err != nil {
+		return types.PoolSettlement{}, types.ErrPairNotSupported.Wrap(pair.String())
+	}
+	if k.IsPoolSettled(ctx, pair) {
+		return types.PoolSettlement{}, types.ErrPoolSettled.Wrap(pair.String())
+	}
+	if priceSource != types.SettlementPriceSource_PRICEFEED_TWAP {
+		if retention := k.GetSnapshotRetention(ctx, pair); retention > 0 && lookbackInterval > retention {
+			return types.PoolSettlement{}, types.ErrLookbackOverRetention.Wrapf(
+				"%s over %s", lookbackInterval, retention)
+		}
+	}
+
+	settlementPrice, priceSource, err := k.computeSettlementPrice(ctx, pair, priceSource, lookbackInterval)
+	if err != nil {
+		return types.PoolSettlement{}, err
+	}
+
+	settlement = types.PoolSettlement{
+		Pair:            pair,
+		SettlementPrice: settlementPrice,
+		PriceSource:     priceSource,
+		TimestampMs:     ctx.BlockTime().UnixMilli(),
+		BlockNumber:     ctx.BlockHeight(),
+	}
+	k.Settlements.Insert(ctx, pair, settlement)
+	if err = k.setPoolStatus(ctx, pool, types.PoolStatus_SETTLED); err != nil {
+		return types.PoolSettlement{}, err
+	}
+
+	return settlement, ctx.EventManager().EmitTypedEvent(&types.PoolSettledEvent{
+		Pair:            pair.String(),
+		SettlementPrice: settlementPrice,
+		PriceSource:     priceSource.String(),
+		BlockHeight:     ctx.BlockHeight(),
+		BlockTimestamp:  ctx.BlockTime(),
+	})
+}
+
+/*
+computeSettlementPrice returns the settlement price of the vpool and the
+source it was taken from. The MARK_TWAP_OR_PRICEFEED_TWAP source takes the mark
+TWAP, or the pricefeed TWAP when the mark TWAP cannot be computed or is not
+positive.
+*/
+func (k Keeper) computeSettlementPrice(
+	ctx sdk.Context,
+	pair common.AssetPair,
+	priceSource types.SettlementPriceSource,
+	lookbackInterval time.Duration,
+) (settlementPrice sdk.Dec, usedSource types.SettlementPriceSource, err error) {
+	switch priceSource {
+	case types.SettlementPriceSource_PRICEFEED_TWAP:
+		settlementPrice, err = k.pricefeedKeeper.GetCurrentTWAP(ctx, pair.Token0, pair.Token1)
+	case types.SettlementPriceSource_MARK_TWAP:
+		settlementPrice, err = k.GetMarkPriceTWAP(ctx, pair, lookbackInterval)
+	case types.SettlementPriceSource_MARK_TWAP_OR_PRICEFEED_TWAP:
+		settlementPrice, err = k.GetMarkPriceTWAP(ctx, pair, lookbackInterval)
+		if err == nil && settlementPrice.IsPositive() {
+			return settlementPrice, types.SettlementPriceSource_MARK_TWAP, nil
+		}
+		k.Logger(ctx).Info("falling back to the pricefeed twap for the settlement price", "pair", pair.String())
+		return k.computeSettlementPrice(ctx, pair, types.SettlementPriceSource_PRICEFEED_TWAP, lookbackInterval)
+	default:
+		return sdk.Dec{}, priceSource, types.ErrInvalidSettlementPriceSource.Wrap(priceSource.String())
+	}
+	if err != nil {
+		return sdk.Dec{}, priceSource, err
+	}
+	if !settlementPrice.IsPositive() {
+		return sdk.Dec{}, priceSource, types.ErrNoValidPrice.Wrapf(
+			"settlement price of %s must be positive, not: %s", pair, settlementPrice)
+	}
+	return settlementPrice, priceSource, nil
+}
+
+// GetSettlementPrice returns the price at which the positions of a settled vpool are settled.
+// An error is returned if the vpool is not settled.
+func (k Keeper) GetSettlementPrice(ctx sdk.Context, pair common.AssetPair) (sdk.Dec, error) {
+	settlement, err := k.Settlements.Get(ctx, pair)
+	if errors.Is(err, collections.ErrNotFound) {
+		return sdk.Dec{}, types.ErrPoolNotSettled.Wrap(pair.String())
+	} else if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	return settlement.SettlementPrice, nil
+}
+
+// IsPoolSettled returns true if the vpool was settled, after which the
+// positions of its market can only be settled.
+func (k Keeper) IsPoolSettled(ctx sdk.Context, pair common.AssetPair) bool {
+	_, err := k.Settlements.Get(ctx, pair)
+	return err == nil
+}
+
+// GetPoolStatus returns the status of the vpool, or POOL_STATUS_UNSPECIFIED
+// if the vpool does not exist.
+func (k Keeper) GetPoolStatus(ctx sdk.Context, pair common.AssetPair) types.PoolStatus {
+	pool, err := k.Pools.Get(ctx, pair)
+	if err != nil {
+		return types.PoolStatus_POOL_STATUS_UNSPECIFIED
+	}
+	return k.poolStatus(ctx, pool)
+}
+
+// poolStatus returns the status of the pool, the pools settled before the
+// statuses existed being SETTLED.
+func (k Keeper) poolStatus(ctx sdk.Context, pool types.VPool) types.PoolStatus {
+	if k.IsPoolSettled(ctx, pool.Pair) {
+		return types.PoolStatus_SETTLED
+	}
+	return pool.GetPoolStatus()
+}
+
+/*
+SetPoolStatus moves the vpool of the pair between the ACTIVE, REDUCE_ONLY and
+FROZEN statuses. A vpool is settled with SettlePool.
+
+args:
+  - ctx: cosmos-sdk context
+  - pair: the pair of the vpool
+  - status: the new status of the vpool
+
+ret:
+  - err: error if the vpool does not exist, is settled or the status is invalid
+*/
+func (k Keeper) SetPoolStatus(ctx sdk.Context, pair common.AssetPair, status types.PoolStatus) error {
+	if err := types.ValidatePoolStatusChange(status); err != nil {
+		return err
+	}
+
+	pool, err := k.Pools.Get(ctx, pair)
+	if err != nil {
+		return types.ErrPairNotSupported.Wrap(pair.String())
+	}
+	if k.poolStatus(ctx, pool) == types.PoolStatus_SETTLED {
+		return types.ErrPoolSettled.Wrap(pair.String())
+	}
+
+	return k.setPoolStatus(ctx, pool, status)
+}
+
+// setPoolStatus saves the new status of the pool and emits a PoolStatusChangedEvent.
+func (k Keeper) setPoolStatus(ctx sdk.Context, pool types.VPool, status types.PoolStatus) error {
+	oldStatus := pool.GetPoolStatus()
+	pool.Status = status
+	k.Pools.Insert(ctx, pool.Pair, pool)
+
+	return ctx.EventManager().EmitTypedEvent(&types.PoolStatusChangedEvent{
+		Pair:           pool.Pair.String(),
+		OldStatus:      oldStatus.String(),
+		NewStatus:      status.String(),
+		BlockHeight:    ctx.BlockHeight(),
+		BlockTimestamp: ctx.BlockTime(),
+	})
+}
+
+// requireSwappable returns an error if the vpool is FROZEN or SETTLED.
+func (k Keeper) requireSwappable(ctx sdk.Context, pool types.VPool) error {
+	switch k.poolStatus(ctx, pool) {
+	case types.PoolStatus_FROZEN:
+		return types.ErrPoolFrozen.Wrap(pool.Pair.String())
+	case types.PoolStatus_SETTLED:
+		return types.ErrPoolSettled.Wrap(pool.Pair.String())
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,231 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/collections"
+	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/testutil"
+	"github.com/NibiruChain/nibiru/x/vpool/types"
+)
+
+func TestSettlePool(t *testing.T) {
+	tests := []struct {
+		name            string
+		priceSource     types.SettlementPriceSource
+		lookback        time.Duration
+		createPool      bool
+		deleteSnapshots bool
+		settled         bool
+
+		expectedPrice  sdk.Dec
+		expectedSource types.SettlementPriceSource
+		expectedErr    error
+	}{
+		{
+			name:           "settle at the pricefeed twap",
+			priceSource:    types.SettlementPriceSource_PRICEFEED_TWAP,
+			createPool:     true,
+			expectedPrice:  sdk.NewDec(15),
+			expectedSource: types.SettlementPriceSource_PRICEFEED_TWAP,
+		},
+		{
+			name:           "settle at the mark twap",
+			priceSource:    types.SettlementPriceSource_MARK_TWAP,
+			createPool:     true,
+			expectedPrice:  sdk.NewDec(10),
+			expectedSource: types.SettlementPriceSource_MARK_TWAP,
+		},
+		{
+			name:           "settle at the mark twap without falling back",
+			priceSource:    types.SettlementPriceSource_MARK_TWAP_OR_PRICEFEED_TWAP,
+			createPool:     true,
+			expectedPrice:  sdk.NewDec(10),
+			expectedSource: types.SettlementPriceSource_MARK_TWAP,
+		},
+		{
+			name:            "fall back to the pricefeed twap without mark twap",
+			priceSource:     types.SettlementPriceSource_MARK_TWAP_OR_PRICEFEED_TWAP,
+			createPool:      true,
+			deleteSnapshots: true,
+			expectedPrice:   sdk.NewDec(15),
+			expectedSource:  types.SettlementPriceSource_PRICEFEED_TWAP,
+		},
+		{
+			name:        "fail - pool does not exist",
+			priceSource: types.SettlementPriceSource_MARK_TWAP,
+			expectedErr: types.ErrPairNotSupported,
+		},
+		{
+			name:        "fail - pool already settled",
+			priceSource: types.SettlementPriceSource_MARK_TWAP,
+			createPool:  true,
+			settled:     true,
+			expectedErr: types.ErrPoolSettled,
+		},
+		{
+			name:        "fail - mark twap lookback over the snapshot retention",
+			priceSource: types.SettlementPriceSource_MARK_TWAP_OR_PRICEFEED_TWAP,
+			lookback:    types.DefaultParams().SnapshotRetention + time.Hour,
+			createPool:  true,
+			expectedErr: types.ErrLookbackOverRetention,
+		},
+		{
+			name:        "fail - unspecified price source",
+			priceSource: types.SettlementPriceSource_SETTLEMENT_PRICE_SOURCE_UNSPECIFIED,
+			createPool:  true,
+			expectedErr: types.ErrInvalidSettlementPriceSource,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			vpoolKeeper, mocks, ctx := getKeeper(t)
+			ctx = ctx.WithBlockHeight(1).WithBlockTime(time.Now())
+			mocks.mockPricefeedKeeper.EXPECT().
+				GetCurrentTWAP(ctx, common.DenomBTC, common.DenomNUSD).
+				Return(sdk.NewDec(15), nil).AnyTimes()
+
+			if tc.createPool {
+				vpoolKeeper.CreatePool(
+					ctx,
+					common.Pair_BTC_NUSD,
+					/* tradeLimitRatio */ sdk.OneDec(),
+					/* quoteAssetReserve */ sdk.NewDec(10_000_000),
+					/* baseAssetReserve */ sdk.NewDec(1_000_000),
+					/* fluctuationLimitRatio */ sdk.OneDec(),
+					/* maxOracleSpreadRatio */ sdk.OneDec(),
+					/* maintenanceMarginRatio */ sdk.MustNewDecFromStr("0.0625"),
+					/* maxLeverage */ sdk.MustNewDecFromStr("15"),
+				)
+			}
+			if tc.deleteSnapshots {
+				for _, key := range vpoolKeeper.ReserveSnapshots.Iterate(
+					ctx, collections.PairRange[common.AssetPair, time.Time]{}).Keys() {
+					require.NoError(t, vpoolKeeper.ReserveSnapshots.Delete(ctx, key))
+				}
+			}
+			if tc.settled {
+				_, err := vpoolKeeper.SettlePool(ctx, common.Pair_BTC_NUSD, types.SettlementPriceSource_PRICEFEED_TWAP, 0)
+				require.NoError(t, err)
+			}
+
+			lookback := tc.lookback
+			if lookback == 0 {
+				lookback = time.Hour
+			}
+			settlement, err := vpoolKeeper.SettlePool(ctx, common.Pair_BTC_NUSD, tc.priceSource, lookback)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.EqualValues(t, tc.expectedPrice, settlement.SettlementPrice)
+			assert.EqualValues(t, tc.expectedSource, settlement.PriceSource)
+			assert.True(t, vpoolKeeper.IsPoolSettled(ctx, common.Pair_BTC_NUSD))
+			assert.EqualValues(t, types.PoolStatus_SETTLED, vpoolKeeper.GetPoolStatus(ctx, common.Pair_BTC_NUSD))
+
+			price, err := vpoolKeeper.GetSettlementPrice(ctx, common.Pair_BTC_NUSD)
+			require.NoError(t, err)
+			assert.EqualValues(t, tc.expectedPrice, price)
+
+			testutil.RequireHasTypedEvent(t, ctx, &types.PoolSettledEvent{
+				Pair:            common.Pair_BTC_NUSD.String(),
+				SettlementPrice: tc.expectedPrice,
+				PriceSource:     tc.expectedSource.String(),
+				BlockHeight:     ctx.BlockHeight(),
+				BlockTimestamp:  ctx.BlockTime(),
+			})
+		})
+	}
+}
+
+func TestSettledPoolRejectsSwaps(t *testing.T) {
+	vpoolKeeper, mocks, ctx := getKeeper(t)
+	mocks.mockPricefeedKeeper.EXPECT().IsActivePair(ctx, common.Pair_BTC_NUSD.String()).Return(true).AnyTimes()
+	vpoolKeeper.CreatePool(
+		ctx,
+		common.Pair_BTC_NUSD,
+		/* tradeLimitRatio */ sdk.OneDec(),
+		/* quoteAssetReserve */ sdk.NewDec(10_000_000),
+		/* baseAssetReserve */ sdk.NewDec(1_000_000),
+		/* fluctuationLimitRatio */ sdk.OneDec(),
+		/* maxOracleSpreadRatio */ sdk.OneDec(),
+		/* maintenanceMarginRatio */ sdk.MustNewDecFromStr("0.0625"),
+		/* maxLeverage */ sdk.MustNewDecFromStr("15"),
+	)
+
+	_, err := vpoolKeeper.GetSettlementPrice(ctx, common.Pair_BTC_NUSD)
+	require.ErrorIs(t, err, types.ErrPoolNotSettled)
+
+	_, err = vpoolKeeper.SettlePool(ctx, common.Pair_BTC_NUSD, types.SettlementPriceSource_MARK_TWAP, time.Hour)
+	require.NoError(t, err)
+
+	_, err = vpoolKeeper.SwapQuoteForBase(
+		ctx, common.Pair_BTC_NUSD, types.Direction_ADD_TO_POOL, sdk.NewDec(10), sdk.ZeroDec(), false)
+	require.ErrorIs(t, err, types.ErrPoolSettled)
+
+	_, err = vpoolKeeper.SwapBaseForQuote(
+		ctx, common.Pair_BTC_NUSD, types.Direction_ADD_TO_POOL, sdk.NewDec(10), sdk.ZeroDec(), false)
+	require.ErrorIs(t, err, types.ErrPoolSettled)
+}
+
+func TestSetPoolStatus(t *testing.T) {
+	vpoolKeeper, mocks, ctx := getKeeper(t)
+	ctx = ctx.WithBlockHeight(1).WithBlockTime(time.Now())
+	mocks.mockPricefeedKeeper.EXPECT().IsActivePair(ctx, common.Pair_BTC_NUSD.String()).Return(true).AnyTimes()
+	vpoolKeeper.CreatePool(
+		ctx,
+		common.Pair_BTC_NUSD,
+		/* tradeLimitRatio */ sdk.OneDec(),
+		/* quoteAssetReserve */ sdk.NewDec(10_000_000),
+		/* baseAssetReserve */ sdk.NewDec(1_000_000),
+		/* fluctuationLimitRatio */ sdk.OneDec(),
+		/* maxOracleSpreadRatio */ sdk.OneDec(),
+		/* maintenanceMarginRatio */ sdk.MustNewDecFromStr("0.0625"),
+		/* maxLeverage */ sdk.MustNewDecFromStr("15"),
+	)
+	assert.EqualValues(t, types.PoolStatus_ACTIVE, vpoolKeeper.GetPoolStatus(ctx, common.Pair_BTC_NUSD))
+	assert.EqualValues(t, types.PoolStatus_POOL_STATUS_UNSPECIFIED, vpoolKeeper.GetPoolStatus(ctx, common.Pair_ETH_NUSD))
+
+	swap := func() error {
+		_, err := vpoolKeeper.SwapQuoteForBase(
+			ctx, common.Pair_BTC_NUSD, types.Direction_ADD_TO_POOL, sdk.NewDec(10), sdk.ZeroDec(), false)
+		return err
+	}
+
+	t.Log("a frozen pool rejects the swaps")
+	require.NoError(t, vpoolKeeper.SetPoolStatus(ctx, common.Pair_BTC_NUSD, types.PoolStatus_FROZEN))
+	assert.EqualValues(t, types.PoolStatus_FROZEN, vpoolKeeper.GetPoolStatus(ctx, common.Pair_BTC_NUSD))
+	testutil.RequireHasTypedEvent(t, ctx, &types.PoolStatusChangedEvent{
+		Pair:           common.Pair_BTC_NUSD.String(),
+		OldStatus:      types.PoolStatus_ACTIVE.String(),
+		NewStatus:      types.PoolStatus_FROZEN.String(),
+		BlockHeight:    ctx.BlockHeight(),
+		BlockTimestamp: ctx.BlockTime(),
+	})
+	require.ErrorIs(t, swap(), types.ErrPoolFrozen)
+	_, err := vpoolKeeper.SwapBaseForQuote(
+		ctx, common.Pair_BTC_NUSD, types.Direction_ADD_TO_POOL, sdk.NewDec(10), sdk.ZeroDec(), false)
+	require.ErrorIs(t, err, types.ErrPoolFrozen)
+
+	t.Log("a reduce only pool swaps")
+	require.NoError(t, vpoolKeeper.SetPoolStatus(ctx, common.Pair_BTC_NUSD, types.PoolStatus_REDUCE_ONLY))
+	require.NoError(t, swap())
+
+	t.Log("a pool is not settled through a status change")
+	require.ErrorIs(t, vpoolKeeper.SetPoolStatus(ctx, common.Pair_BTC_NUSD, types.PoolStatus_SETTLED), types.ErrInvalidPoolStatus)
+	require.ErrorIs(t, vpoolKeeper.SetPoolStatus(ctx, common.Pair_ETH_NUSD, types.PoolStatus_ACTIVE), types.ErrPairNotSupported)
+
+	t.Log("a settled pool cannot change status anymore")
+	_, err = vpoolKeeper.SettlePool(ctx, common.Pair_BTC_NUSD, types.SettlementPriceSource_MARK_TWAP, time.Hour)
+	require.NoError(t, err)
+	require.ErrorIs(t, vpoolKeeper.SetPoolStatus(ctx, common.Pair_BTC_NUSD, types.PoolStatus_ACTIVE), types.ErrPoolSettled)
+	require.ErrorIs(t, swap(), types.ErrPoolSettled)
+}
@@ -1,8 +1,11 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/NibiruChain/nibiru/collections"
 	"github.com/NibiruChain/nibiru/x/common"
 	"github.com/NibiruChain/nibiru/x/vpool/types"
 )
@@ -61,6 +64,10 @@ func getPriceWithSnapshot(
 			MaxOracleSpreadRatio:   sdk.ZeroDec(), // unused
 			MaintenanceMarginRatio: sdk.ZeroDec(), // unused
 			MaxLeverage:            sdk.ZeroDec(), // unused
+			OraclePegConfig:        snapshot.OraclePegConfig,
+		}
+		if snapshot.OraclePegConfig != nil {
+			pool.CurveType = types.CurveType_ORACLE_PEGGED
 		}
 		return pool.GetBaseAmountByQuoteAmount(snapshotPriceOpts.direction, snapshotPriceOpts.assetAmount)
 
@@ -74,9 +81,232 @@ func getPriceWithSnapshot(
 			MaxOracleSpreadRatio:   sdk.ZeroDec(), // unused
 			MaintenanceMarginRatio: sdk.ZeroDec(), // unused
 			MaxLeverage:            sdk.ZeroDec(), // unused
+			OraclePegConfig:        snapshot.OraclePegConfig,
+		}
+		if snapshot.OraclePegConfig != nil {
+			pool.CurveType = types.CurveType_ORACLE_PEGGED
 		}
 		return pool.GetQuoteAmountByBaseAmount(snapshotPriceOpts.direction, snapshotPriceOpts.assetAmount)
 	}
 
 	return sdk.ZeroDec(), nil
 }
+
+// GetSnapshotRetention returns how long the reserve snapshots of a pair are
+// kept: the snapshot retention of the params, raised to the longest TWAP
+// lookback read over the pair. Zero keeps the snapshots forever.
+func (k Keeper) GetSnapshotRetention(ctx sdk.Context, pair common.AssetPair) time.Duration {
+	retention := k.GetParams(ctx).SnapshotRetention
+	if retention <= 0 || k.twapLookbackKeeper == nil {
+		return retention
+	}
+
+	markPriceLookback, swapLookback := k.twapLookbackKeeper.GetTwapLookbacks(ctx, pair)
+	for _, lookback := range []time.Duration{markPriceLookback, swapLookback} {
+		if lookback > retention {
+			retention = lookback
+		}
+	}
+	return retention
+}
+
+// GetSnapshotDownsampleAfter returns the age after which the reserve snapshots
+// of a pair are downsampled: the snapshot downsample age of the params, raised
+// to the longest swap TWAP lookback read over the pair since the swap TWAPs
+// refuse downsampled snapshots. Zero disables the downsampling.
+func (k Keeper) GetSnapshotDownsampleAfter(ctx sdk.Context, pair common.AssetPair) time.Duration {
+	after := k.GetParams(ctx).SnapshotDownsampleAfter
+	if after <= 0 || k.twapLookbackKeeper == nil {
+		return after
+	}
+
+	if _, swapLookback := k.twapLookbackKeeper.GetTwapLookbacks(ctx, pair); swapLookback > after {
+		after = swapLookback
+	}
+	return after
+}
+
+/*
+PruneSnapshots deletes the reserve snapshots of a pair older than the retention
+window, except the latest of them: it gives its price to the start of a TWAP
+window as long as the retention, see calcTwap.
+
+At most types.MaxSnapshotsPrunedPerBlock snapshots are deleted per call, the
+oldest first, so a backlog of expired snapshots, left by an upgrade or a
+shorter retention, is pruned over the following blocks.
+
+args:
+  - ctx: cosmos-sdk context
+  - pair: the token pair
+  - retention: how long the snapshots are kept, zero to keep them forever
+*/
+func (k Keeper) PruneSnapshots(ctx sdk.Context, pair common.AssetPair, retention time.Duration) {
+	if retention <= 0 {
+		return
+	}
+
+	latestExpired := k.ReserveSnapshots.Iterate(
+		ctx,
+		collections.PairRange[common.AssetPair, time.Time]{}.
+			Prefix(pair).
+			EndInclusive(ctx.BlockTime().Add(-retention)).
+			Descending(),
+	)
+	if !latestExpired.Valid() {
+		latestExpired.Close()
+		return
+	}
+	kept := latestExpired.Key().K2()
+	latestExpired.Close()
+
+	// collected before writing to the store
+	var keys []collections.Pair[common.AssetPair, time.Time]
+	iter := k.ReserveSnapshots.Iterate(
+		ctx,
+		collections.PairRange[common.AssetPair, time.Time]{}.
+			Prefix(pair).
+			EndExclusive(kept),
+	)
+	for ; iter.Valid() && len(keys) < types.MaxSnapshotsPrunedPerBlock; iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		_ = k.ReserveSnapshots.Delete(ctx, key)
+	}
+}
+
+/*
+DownsampleSnapshots aggregates the reserve snapshots of a pair older than the
+downsampling age into a single snapshot per interval.
+
+The aggregated snapshot takes the place of the first snapshot of the interval.
+Its base reserve and its spot price are the time-weighted averages of the ones
+of the snapshots it replaces, so the mark price TWAP over windows spanning whole
+intervals is unchanged, and a window starting inside an interval prices its part
+of the interval at the average of the interval.
+
+The swap prices of the replaced snapshots aren't linear in their reserves and
+cannot be reproduced from the aggregate, so the aggregate is marked as
+downsampled and the QUOTE_ASSET_SWAP and BASE_ASSET_SWAP TWAPs, and
+GetBaseAssetTWAP, refuse windows reaching it, see calcTwap.
+
+The intervals are downsampled once complete, the earliest first, resuming from
+the cursor of the pair. A call visits at most
+types.MaxSnapshotsDownsampledPerBlock snapshots, rounded up to a whole interval,
+so a backlog of intervals, left by an upgrade or a shorter downsampling age, is
+downsampled over the following blocks. A pair without a cursor resumes from its
+first snapshot, the intervals already downsampled being left as they are.
+
+args:
+  - ctx: cosmos-sdk context
+  - pair: the token pair
+  - after: the age of the downsampled snapshots, zero to keep them per block
+  - interval: the interval the snapshots are aggregated over
+*/
+func (k Keeper) DownsampleSnapshots(
+	ctx sdk.Context, pair common.AssetPair, after time.Duration, interval time.Duration,
+) {
+	if after <= 0 || interval <= 0 {
+		return
+	}
+
+	// the intervals ending at the latest before the horizon are complete
+	horizon := ctx.BlockTime().Add(-after).Truncate(interval)
+	cursor := k.DownsampleCursors.GetOr(ctx, pair, time.Time{})
+	if !cursor.Before(horizon) {
+		return
+	}
+
+	// the snapshots of the complete intervals to downsample, the earliest first,
+	// collected before writing to the store
+	var intervals []snapshotInterval
+	var intervalSnapshots []snapshotEntry
+	var intervalStart time.Time
+	visited := 0
+	iter := k.ReserveSnapshots.Iterate(
+		ctx,
+		collections.PairRange[common.AssetPair, time.Time]{}.
+			Prefix(pair).
+			StartInclusive(cursor),
+	)
+	for ; iter.Valid(); iter.Next() {
+		entry := iter.KeyValue()
+		start := entry.Key.K2().Truncate(interval)
+		if len(intervalSnapshots) > 0 && !start.Equal(intervalStart) {
+			// the snapshot following the interval ends the period of its last snapshot
+			if len(intervalSnapshots) > 1 {
+				intervals = append(intervals, snapshotInterval{
+					snapshots:       intervalSnapshots,
+					nextTimestampMs: entry.Value.TimestampMs,
+				})
+			}
+			intervalSnapshots = nil
+			cursor = start
+			if visited >= types.MaxSnapshotsDownsampledPerBlock {
+				break
+			}
+		}
+		if !start.Before(horizon) {
+			break
+		}
+		intervalStart = start
+		intervalSnapshots = append(intervalSnapshots, entry)
+		visited++
+	}
+	iter.Close()
+
+	for _, i := range intervals {
+		k.aggregateSnapshots(ctx, i.snapshots, i.nextTimestampMs)
+	}
+	k.DownsampleCursors.Insert(ctx, pair, cursor)
+}
+
+// snapshotEntry is a reserve snapshot with its key in the store.
+type snapshotEntry = collections.KeyValue[collections.Pair[common.AssetPair, time.Time], types.ReserveSnapshot]
+
+// snapshotInterval holds the snapshots of a complete interval, the earliest
+// first, and the timestamp of the snapshot following them.
+type snapshotInterval struct {
+	snapshots       []snapshotEntry
+	nextTimestampMs int64
+}
+
+// aggregateSnapshots replaces the snapshots of an interval, the earliest first,
+// by their time-weighted average stored in place of the earliest one, each
+// snapshot weighing the time until the snapshot following it, the last one the
+// time until nextTimestampMs.
+func (k Keeper) aggregateSnapshots(
+	ctx sdk.Context,
+	snapshots []snapshotEntry,
+	nextTimestampMs int64,
+) {
+	earliest := snapshots[0]
+	periodMs := nextTimestampMs - earliest.Value.TimestampMs
+	if periodMs <= 0 {
+		return
+	}
+
+	cumulativeBase := sdk.ZeroDec()
+	cumulativePrice := sdk.ZeroDec()
+	for i := len(snapshots) - 1; i >= 0; i-- {
+		kv := snapshots[i]
+		weightMs := nextTimestampMs - kv.Value.TimestampMs
+		cumulativeBase = cumulativeBase.Add(kv.Value.BaseAssetReserve.MulInt64(weightMs))
+		cumulativePrice = cumulativePrice.Add(
+			kv.Value.QuoteAssetReserve.Quo(kv.Value.BaseAssetReserve).MulInt64(weightMs))
+		nextTimestampMs = kv.Value.TimestampMs
+	}
+
+	baseReserve := cumulativeBase.QuoInt64(periodMs)
+	aggregate := earliest.Value
+	aggregate.BaseAssetReserve = baseReserve
+	aggregate.QuoteAssetReserve = cumulativePrice.QuoInt64(periodMs).Mul(baseReserve)
+	aggregate.Downsampled = true
+
+	for _, kv := range snapshots[1:] {
+		_ = k.ReserveSnapshots.Delete(ctx, kv.Key)
+	}
+	k.ReserveSnapshots.Insert(ctx, earliest.Key, aggregate)
+}
@@ -5,8 +5,10 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/NibiruChain/nibiru/collections"
 	"github.com/NibiruChain/nibiru/x/common"
 	"github.com/NibiruChain/nibiru/x/vpool/types"
 )
@@ -100,3 +102,180 @@ func TestGetSnapshotPrice(t *testing.T) {
 		})
 	}
 }
+
+// setSnapshots replaces the reserve snapshots of the BTC:NUSD pair with
+// snapshots of a base reserve of 10 at the given mark prices, taken at the
+// given offsets from start.
+func setSnapshots(ctx sdk.Context, vpoolKeeper Keeper, start time.Time, offsets []time.Duration, prices []int64) {
+	for _, key := range vpoolKeeper.ReserveSnapshots.Iterate(ctx, collections.PairRange[common.AssetPair, time.Time]{}).Keys() {
+		_ = vpoolKeeper.ReserveSnapshots.Delete(ctx, key)
+	}
+	for i, offset := range offsets {
+		timestamp := start.Add(offset)
+		vpoolKeeper.ReserveSnapshots.Insert(
+			ctx,
+			collections.Join(common.Pair_BTC_NUSD, timestamp),
+			types.NewReserveSnapshot(common.Pair_BTC_NUSD, sdk.NewDec(10), sdk.NewDec(10*prices[i]), timestamp),
+		)
+	}
+}
+
+func getSnapshotTimes(ctx sdk.Context, vpoolKeeper Keeper) (times []time.Time) {
+	for _, key := range vpoolKeeper.ReserveSnapshots.Iterate(ctx, collections.PairRange[common.AssetPair, time.Time]{}).Keys() {
+		times = append(times, key.K2())
+	}
+	return times
+}
+
+func TestPruneSnapshots(t *testing.T) {
+	vpoolKeeper, _, ctx := getKeeper(t)
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	setSnapshots(ctx, vpoolKeeper, start,
+		[]time.Duration{0, time.Minute, 2 * time.Minute, 3 * time.Minute},
+		[]int64{10, 20, 30, 40},
+	)
+	ctx = ctx.WithBlockTime(start.Add(3*time.Minute + 30*time.Second))
+
+	twapBefore, err := vpoolKeeper.GetMarkPriceTWAP(ctx, common.Pair_BTC_NUSD, 2*time.Minute)
+	require.NoError(t, err)
+
+	t.Log("a zero retention keeps every snapshot")
+	vpoolKeeper.PruneSnapshots(ctx, common.Pair_BTC_NUSD, 0)
+	assert.Len(t, getSnapshotTimes(ctx, vpoolKeeper), 4)
+
+	t.Log("the snapshots before the retention window are pruned but the latest of them")
+	vpoolKeeper.PruneSnapshots(ctx, common.Pair_BTC_NUSD, 2*time.Minute)
+	assert.EqualValues(t,
+		[]time.Time{start.Add(time.Minute), start.Add(2 * time.Minute), start.Add(3 * time.Minute)},
+		getSnapshotTimes(ctx, vpoolKeeper))
+
+	twapAfter, err := vpoolKeeper.GetMarkPriceTWAP(ctx, common.Pair_BTC_NUSD, 2*time.Minute)
+	require.NoError(t, err)
+	assert.EqualValues(t, twapBefore, twapAfter)
+
+	t.Log("the latest snapshot is never pruned")
+	ctx = ctx.WithBlockTime(start.Add(time.Hour))
+	vpoolKeeper.PruneSnapshots(ctx, common.Pair_BTC_NUSD, 2*time.Minute)
+	assert.EqualValues(t, []time.Time{start.Add(3 * time.Minute)}, getSnapshotTimes(ctx, vpoolKeeper))
+
+	t.Log("a backlog of expired snapshots is pruned over several calls, the oldest first")
+	var offsets []time.Duration
+	var prices []int64
+	for i := 0; i < types.MaxSnapshotsPrunedPerBlock+10; i++ {
+		offsets = append(offsets, time.Duration(i)*time.Second)
+		prices = append(prices, 10)
+	}
+	setSnapshots(ctx, vpoolKeeper, start, offsets, prices)
+	vpoolKeeper.PruneSnapshots(ctx, common.Pair_BTC_NUSD, 2*time.Minute)
+	times := getSnapshotTimes(ctx, vpoolKeeper)
+	assert.Len(t, times, 10)
+	assert.EqualValues(t, start.Add(types.MaxSnapshotsPrunedPerBlock*time.Second), times[0])
+	vpoolKeeper.PruneSnapshots(ctx, common.Pair_BTC_NUSD, 2*time.Minute)
+	assert.EqualValues(t, []time.Time{start.Add(offsets[len(offsets)-1])}, getSnapshotTimes(ctx, vpoolKeeper))
+}
+
+func TestDownsampleSnapshots(t *testing.T) {
+	vpoolKeeper, _, ctx := getKeeper(t)
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	setSnapshots(ctx, vpoolKeeper, start,
+		[]time.Duration{
+			0, 20 * time.Second, 40 * time.Second, // first minute
+			time.Minute, 90 * time.Second, // second minute
+			2 * time.Minute, 2*time.Minute + 10*time.Second, // third minute, not downsampled yet
+			3 * time.Minute,
+		},
+		[]int64{10, 20, 30, 40, 50, 60, 70, 80},
+	)
+	ctx = ctx.WithBlockTime(start.Add(3*time.Minute + 30*time.Second))
+
+	twapBefore, err := vpoolKeeper.GetMarkPriceTWAP(ctx, common.Pair_BTC_NUSD, 3*time.Minute+30*time.Second)
+	require.NoError(t, err)
+
+	t.Log("a zero age keeps the per-block snapshots")
+	vpoolKeeper.DownsampleSnapshots(ctx, common.Pair_BTC_NUSD, 0, time.Minute)
+	assert.Len(t, getSnapshotTimes(ctx, vpoolKeeper), 8)
+
+	t.Log("the complete minutes older than the age are aggregated")
+	vpoolKeeper.DownsampleSnapshots(ctx, common.Pair_BTC_NUSD, time.Minute, time.Minute)
+	assert.EqualValues(t,
+		[]time.Time{
+			start,
+			start.Add(time.Minute),
+			start.Add(2 * time.Minute), start.Add(2*time.Minute + 10*time.Second),
+			start.Add(3 * time.Minute),
+		},
+		getSnapshotTimes(ctx, vpoolKeeper))
+
+	first, err := vpoolKeeper.ReserveSnapshots.Get(ctx, collections.Join(common.Pair_BTC_NUSD, start))
+	require.NoError(t, err)
+	expectedFirst := types.NewReserveSnapshot(common.Pair_BTC_NUSD, sdk.NewDec(10), sdk.NewDec(200), start)
+	expectedFirst.Downsampled = true
+	assert.EqualValues(t, expectedFirst, first)
+	second, err := vpoolKeeper.ReserveSnapshots.Get(ctx, collections.Join(common.Pair_BTC_NUSD, start.Add(time.Minute)))
+	require.NoError(t, err)
+	expectedSecond := types.NewReserveSnapshot(common.Pair_BTC_NUSD, sdk.NewDec(10), sdk.NewDec(450), start.Add(time.Minute))
+	expectedSecond.Downsampled = true
+	assert.EqualValues(t, expectedSecond, second)
+
+	t.Log("the mark price twap over whole minutes is unchanged")
+	twapAfter, err := vpoolKeeper.GetMarkPriceTWAP(ctx, common.Pair_BTC_NUSD, 3*time.Minute+30*time.Second)
+	require.NoError(t, err)
+	assert.EqualValues(t, twapBefore, twapAfter)
+
+	t.Log("the swap twaps refuse the downsampled snapshots")
+	_, err = vpoolKeeper.GetBaseAssetTWAP(ctx, common.Pair_BTC_NUSD, types.Direction_ADD_TO_POOL, sdk.OneDec(), 2*time.Minute)
+	require.ErrorIs(t, err, types.ErrDownsampledTWAP)
+	_, err = vpoolKeeper.GetBaseAssetTWAP(ctx, common.Pair_BTC_NUSD, types.Direction_ADD_TO_POOL, sdk.OneDec(), time.Minute)
+	require.NoError(t, err)
+
+	t.Log("downsampling again is a no-op")
+	vpoolKeeper.DownsampleSnapshots(ctx, common.Pair_BTC_NUSD, time.Minute, time.Minute)
+	assert.Len(t, getSnapshotTimes(ctx, vpoolKeeper), 5)
+
+	t.Log("the next minute is aggregated once complete")
+	ctx = ctx.WithBlockTime(start.Add(4 * time.Minute))
+	vpoolKeeper.DownsampleSnapshots(ctx, common.Pair_BTC_NUSD, time.Minute, time.Minute)
+	assert.EqualValues(t,
+		[]time.Time{start, start.Add(time.Minute), start.Add(2 * time.Minute), start.Add(3 * time.Minute)},
+		getSnapshotTimes(ctx, vpoolKeeper))
+}
+
+func TestDownsampleSnapshotsBacklog(t *testing.T) {
+	vpoolKeeper, _, ctx := getKeeper(t)
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	// 30 minutes of a snapshot every 10 seconds, and the snapshot ending them
+	var offsets []time.Duration
+	var prices []int64
+	for i := 0; i <= 30*6; i++ {
+		offsets = append(offsets, time.Duration(i)*10*time.Second)
+		prices = append(prices, int64(10+i))
+	}
+	setSnapshots(ctx, vpoolKeeper, start, offsets, prices)
+	ctx = ctx.WithBlockTime(start.Add(31 * time.Minute))
+
+	twapBefore, err := vpoolKeeper.GetMarkPriceTWAP(ctx, common.Pair_BTC_NUSD, 31*time.Minute)
+	require.NoError(t, err)
+
+	t.Log("the earliest minutes are aggregated first, up to the snapshots visited per call")
+	vpoolKeeper.DownsampleSnapshots(ctx, common.Pair_BTC_NUSD, time.Minute, time.Minute)
+	// 17 minutes of 6 snapshots make the first 102 snapshots visited
+	assert.Len(t, getSnapshotTimes(ctx, vpoolKeeper), 17+13*6+1)
+	cursor, err := vpoolKeeper.DownsampleCursors.Get(ctx, common.Pair_BTC_NUSD)
+	require.NoError(t, err)
+	assert.EqualValues(t, start.Add(17*time.Minute), cursor)
+
+	t.Log("the next call resumes from the cursor")
+	vpoolKeeper.DownsampleSnapshots(ctx, common.Pair_BTC_NUSD, time.Minute, time.Minute)
+	times := getSnapshotTimes(ctx, vpoolKeeper)
+	require.Len(t, times, 31)
+	for i, timestamp := range times {
+		assert.EqualValues(t, start.Add(time.Duration(i)*time.Minute), timestamp)
+	}
+	cursor, err = vpoolKeeper.DownsampleCursors.Get(ctx, common.Pair_BTC_NUSD)
+	require.NoError(t, err)
+	assert.EqualValues(t, start.Add(30*time.Minute), cursor)
+
+	twapAfter, err := vpoolKeeper.GetMarkPriceTWAP(ctx, common.Pair_BTC_NUSD, 31*time.Minute)
+	require.NoError(t, err)
+	assert.EqualValues(t, twapBefore, twapAfter)
+}
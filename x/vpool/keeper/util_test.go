@@ -9,6 +9,7 @@ import (
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/libs/log"
@@ -23,21 +24,32 @@ func VpoolKeeper(t *testing.T, pricefeedKeeper types.PricefeedKeeper) (
 	vpoolKeeper Keeper, ctx sdk.Context,
 ) {
 	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	transientStoreKey := sdk.NewTransientStoreKey("transient" + types.StoreKey)
 
 	db := tmdb.NewMemDB()
 	stateStore := store.NewCommitMultiStore(db)
 	stateStore.MountStoreWithDB(storeKey, sdk.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(transientStoreKey, sdk.StoreTypeTransient, nil)
 	require.NoError(t, stateStore.LoadLatestVersion())
 
+	protoCodec := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
 	vpoolKeeper = NewKeeper(
-		codec.NewProtoCodec(codectypes.NewInterfaceRegistry()),
-		storeKey, pricefeedKeeper,
+		protoCodec,
+		storeKey,
+		newParamSubspace(protoCodec, storeKey, transientStoreKey),
+		pricefeedKeeper,
 	)
 	ctx = sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger())
+	vpoolKeeper.SetParams(ctx, types.DefaultParams())
 
 	return vpoolKeeper, ctx
 }
 
+// newParamSubspace returns the vpool param subspace, stored in the vpool store.
+func newParamSubspace(protoCodec codec.BinaryCodec, storeKey sdk.StoreKey, transientStoreKey sdk.StoreKey) paramtypes.Subspace {
+	return paramtypes.NewSubspace(protoCodec, codec.NewLegacyAmino(), storeKey, transientStoreKey, types.ModuleName)
+}
+
 // holds mocks for interfaces defined in vpool/types/expected_keepers.go
 type mockedDependencies struct {
 	mockPricefeedKeeper *mock.MockPricefeedKeeper
@@ -72,10 +84,12 @@ func getKeeper(t *testing.T) (Keeper, mockedDependencies, sdk.Context) {
 	k := NewKeeper(
 		protoCodec,
 		storeKey,
+		newParamSubspace(protoCodec, storeKey, transientStoreKey),
 		mockedPricefeedKeeper,
 	)
 
 	ctx := sdk.NewContext(commitMultiStore, tmproto.Header{}, false, log.NewNopLogger())
+	k.SetParams(ctx, types.DefaultParams())
 
 	return k, mockedDependencies{
 		mockPricefeedKeeper: mockedPricefeedKeeper,
@@ -0,0 +1,71 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/common"
+)
+
+const (
+	// VolumeInterval is the interval the traded volumes are accumulated over,
+	// the smallest resolution of the mark price candles.
+	VolumeInterval = time.Minute
+
+	// MaxCandles is the maximum number of candles returned by a query.
+	MaxCandles = 1_000
+)
+
+func NewTradeVolume(pair common.AssetPair, intervalStart time.Time) TradeVolume {
+	return TradeVolume{
+		Pair:        pair,
+		BaseVolume:  sdk.ZeroDec(),
+		QuoteVolume: sdk.ZeroDec(),
+		TimestampMs: intervalStart.UnixMilli(),
+	}
+}
+
+func (v TradeVolume) Validate() error {
+	if err := v.Pair.Validate(); err != nil {
+		return err
+	}
+
+	if v.BaseVolume.IsNil() || v.BaseVolume.IsNegative() {
+		return fmt.Errorf("base volume cannot be negative: %s", v.BaseVolume)
+	}
+
+	if v.QuoteVolume.IsNil() || v.QuoteVolume.IsNegative() {
+		return fmt.Errorf("quote volume cannot be negative: %s", v.QuoteVolume)
+	}
+
+	if v.TimestampMs < 0 {
+		return fmt.Errorf("timestamp from trade volume cannot be negative: %d", v.TimestampMs)
+	}
+
+	return nil
+}
+
+// ValidateCandleRange checks the resolution and the time range of a candle
+// query, returning the number of candles it spans.
+func ValidateCandleRange(resolution time.Duration, from, to time.Time) (int, error) {
+	if resolution <= 0 || resolution%VolumeInterval != 0 {
+		return 0, fmt.Errorf("resolution must be a positive multiple of %s, not: %s", VolumeInterval, resolution)
+	}
+
+	if !from.Before(to) {
+		return 0, fmt.Errorf("from (%s) must be before to (%s)", from, to)
+	}
+
+	span := to.Sub(from.Truncate(resolution))
+	numCandles := int64(span / resolution)
+	if span%resolution != 0 {
+		numCandles++
+	}
+	if numCandles > MaxCandles {
+		return 0, fmt.Errorf("the range spans %d candles, over the maximum of %d", numCandles, MaxCandles)
+	}
+
+	return int(numCandles), nil
+}
@@ -0,0 +1,77 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateCandleRange(t *testing.T) {
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name               string
+		resolution         time.Duration
+		from               time.Time
+		to                 time.Time
+		expectedNumCandles int
+		shouldErr          bool
+	}{
+		{
+			name:               "whole candles",
+			resolution:         time.Hour,
+			from:               start,
+			to:                 start.Add(24 * time.Hour),
+			expectedNumCandles: 24,
+		},
+		{
+			name:               "the first and last candles contain from and to",
+			resolution:         time.Hour,
+			from:               start.Add(30 * time.Minute),
+			to:                 start.Add(2*time.Hour + time.Second),
+			expectedNumCandles: 3,
+		},
+		{
+			name:       "resolution not a multiple of the volume interval",
+			resolution: 90 * time.Second,
+			from:       start,
+			to:         start.Add(time.Hour),
+			shouldErr:  true,
+		},
+		{
+			name:       "zero resolution",
+			resolution: 0,
+			from:       start,
+			to:         start.Add(time.Hour),
+			shouldErr:  true,
+		},
+		{
+			name:       "to not after from",
+			resolution: time.Minute,
+			from:       start,
+			to:         start,
+			shouldErr:  true,
+		},
+		{
+			name:       "too many candles",
+			resolution: time.Minute,
+			from:       start,
+			to:         start.Add((MaxCandles + 1) * time.Minute),
+			shouldErr:  true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			numCandles, err := ValidateCandleRange(tc.resolution, tc.from, tc.to)
+			if tc.shouldErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedNumCandles, numCandles)
+		})
+	}
+}
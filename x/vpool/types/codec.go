@@ -16,7 +16,13 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		/* implementations */
 	)
 
-	registry.RegisterImplementations((*govtypes.Content)(nil), &CreatePoolProposal{})
+	registry.RegisterImplementations(
+		(*govtypes.Content)(nil),
+		&CreatePoolProposal{},
+		&SettlePoolProposal{},
+		&PoolStatusProposal{},
+		&EditPoolConfigProposal{},
+	)
 
 	// msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -13,5 +13,13 @@ var (
 	ErrNoValidPrice         = sdkerrors.Register(ModuleName, 8, "no valid prices available")
 	ErrNoValidTWAP          = sdkerrors.Register(ModuleName, 9, "TWAP price not found")
 	// Could replace ErrBaseReserveAtZero and ErrQUoteReserveAtZero if wrapped
-	ErrNonPositiveReserves = sdkerrors.Register(ModuleName, 10, "base and quote reserves must always be positive")
+	ErrNonPositiveReserves          = sdkerrors.Register(ModuleName, 10, "base and quote reserves must always be positive")
+	ErrPoolFrozen                   = sdkerrors.Register(ModuleName, 11, "pool is frozen")
+	ErrPoolNotSettled               = sdkerrors.Register(ModuleName, 12, "pool is not settled")
+	ErrInvalidSettlementPriceSource = sdkerrors.Register(ModuleName, 13, "invalid settlement price source")
+	ErrPoolSettled                  = sdkerrors.Register(ModuleName, 14, "pool is settled")
+	ErrInvalidPoolStatus            = sdkerrors.Register(ModuleName, 15, "invalid pool status")
+	ErrOraclePeggedPool             = sdkerrors.Register(ModuleName, 16, "not supported by oracle-pegged pools")
+	ErrDownsampledTWAP              = sdkerrors.Register(ModuleName, 17, "swap TWAP over downsampled reserve snapshots")
+	ErrLookbackOverRetention        = sdkerrors.Register(ModuleName, 18, "lookback window over the snapshot retention")
 )
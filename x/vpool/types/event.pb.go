@@ -240,48 +240,507 @@ func (m *MarkPriceChangedEvent) GetBlockTimestamp() time.Time {
 	return time.Time{}
 }
 
+// Emitted when a vpool is settled by governance, fixing the settlement price of
+// its market.
+type PoolSettledEvent struct {
+	Pair            string                                 `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	SettlementPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=settlement_price,json=settlementPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"settlement_price"`
+	PriceSource     string                                 `protobuf:"bytes,3,opt,name=price_source,json=priceSource,proto3" json:"price_source,omitempty"`
+	BlockHeight     int64                                  `protobuf:"varint,4,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	BlockTimestamp  time.Time                              `protobuf:"bytes,5,opt,name=block_timestamp,json=blockTimestamp,proto3,stdtime" json:"block_timestamp"`
+}
+
+func (m *PoolSettledEvent) Reset()         { *m = PoolSettledEvent{} }
+func (m *PoolSettledEvent) String() string { return proto.CompactTextString(m) }
+func (*PoolSettledEvent) ProtoMessage()    {}
+func (*PoolSettledEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_faeff0bc76489252, []int{4}
+}
+func (m *PoolSettledEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolSettledEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolSettledEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolSettledEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolSettledEvent.Merge(m, src)
+}
+func (m *PoolSettledEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolSettledEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolSettledEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolSettledEvent proto.InternalMessageInfo
+
+func (m *PoolSettledEvent) GetPair() string {
+	if m != nil {
+		return m.Pair
+	}
+	return ""
+}
+
+func (m *PoolSettledEvent) GetPriceSource() string {
+	if m != nil {
+		return m.PriceSource
+	}
+	return ""
+}
+
+func (m *PoolSettledEvent) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *PoolSettledEvent) GetBlockTimestamp() time.Time {
+	if m != nil {
+		return m.BlockTimestamp
+	}
+	return time.Time{}
+}
+
+// Emitted when the status of a vpool changes.
+type PoolStatusChangedEvent struct {
+	Pair           string    `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	OldStatus      string    `protobuf:"bytes,2,opt,name=old_status,json=oldStatus,proto3" json:"old_status,omitempty"`
+	NewStatus      string    `protobuf:"bytes,3,opt,name=new_status,json=newStatus,proto3" json:"new_status,omitempty"`
+	BlockHeight    int64     `protobuf:"varint,4,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	BlockTimestamp time.Time `protobuf:"bytes,5,opt,name=block_timestamp,json=blockTimestamp,proto3,stdtime" json:"block_timestamp"`
+}
+
+func (m *PoolStatusChangedEvent) Reset()         { *m = PoolStatusChangedEvent{} }
+func (m *PoolStatusChangedEvent) String() string { return proto.CompactTextString(m) }
+func (*PoolStatusChangedEvent) ProtoMessage()    {}
+func (*PoolStatusChangedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_faeff0bc76489252, []int{5}
+}
+func (m *PoolStatusChangedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolStatusChangedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolStatusChangedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolStatusChangedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolStatusChangedEvent.Merge(m, src)
+}
+func (m *PoolStatusChangedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolStatusChangedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolStatusChangedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolStatusChangedEvent proto.InternalMessageInfo
+
+func (m *PoolStatusChangedEvent) GetPair() string {
+	if m != nil {
+		return m.Pair
+	}
+	return ""
+}
+
+func (m *PoolStatusChangedEvent) GetOldStatus() string {
+	if m != nil {
+		return m.OldStatus
+	}
+	return ""
+}
+
+func (m *PoolStatusChangedEvent) GetNewStatus() string {
+	if m != nil {
+		return m.NewStatus
+	}
+	return ""
+}
+
+func (m *PoolStatusChangedEvent) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *PoolStatusChangedEvent) GetBlockTimestamp() time.Time {
+	if m != nil {
+		return m.BlockTimestamp
+	}
+	return time.Time{}
+}
+
+// Emitted when governance edits the risk parameters of a vpool.
+type PoolConfigEditedEvent struct {
+	Pair                   string                                 `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	TradeLimitRatio        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=trade_limit_ratio,json=tradeLimitRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"trade_limit_ratio"`
+	FluctuationLimitRatio  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=fluctuation_limit_ratio,json=fluctuationLimitRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fluctuation_limit_ratio"`
+	MaxOracleSpreadRatio   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=max_oracle_spread_ratio,json=maxOracleSpreadRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_oracle_spread_ratio"`
+	MaintenanceMarginRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=maintenance_margin_ratio,json=maintenanceMarginRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"maintenance_margin_ratio"`
+	MaxLeverage            github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=max_leverage,json=maxLeverage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_leverage"`
+	BlockHeight            int64                                  `protobuf:"varint,7,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	BlockTimestamp         time.Time                              `protobuf:"bytes,8,opt,name=block_timestamp,json=blockTimestamp,proto3,stdtime" json:"block_timestamp"`
+}
+
+func (m *PoolConfigEditedEvent) Reset()         { *m = PoolConfigEditedEvent{} }
+func (m *PoolConfigEditedEvent) String() string { return proto.CompactTextString(m) }
+func (*PoolConfigEditedEvent) ProtoMessage()    {}
+func (*PoolConfigEditedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_faeff0bc76489252, []int{6}
+}
+func (m *PoolConfigEditedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolConfigEditedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolConfigEditedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolConfigEditedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolConfigEditedEvent.Merge(m, src)
+}
+func (m *PoolConfigEditedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolConfigEditedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolConfigEditedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolConfigEditedEvent proto.InternalMessageInfo
+
+func (m *PoolConfigEditedEvent) GetPair() string {
+	if m != nil {
+		return m.Pair
+	}
+	return ""
+}
+
+func (m *PoolConfigEditedEvent) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *PoolConfigEditedEvent) GetBlockTimestamp() time.Time {
+	if m != nil {
+		return m.BlockTimestamp
+	}
+	return time.Time{}
+}
+
+// Emitted when the reserves of a vpool are repegged to the pricefeed price,
+// keeping their product unchanged.
+type PoolRepeggedEvent struct {
+	Pair            string                                 `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	OldBaseReserve  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=old_base_reserve,json=oldBaseReserve,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"old_base_reserve"`
+	OldQuoteReserve github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=old_quote_reserve,json=oldQuoteReserve,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"old_quote_reserve"`
+	NewBaseReserve  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=new_base_reserve,json=newBaseReserve,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"new_base_reserve"`
+	NewQuoteReserve github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=new_quote_reserve,json=newQuoteReserve,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"new_quote_reserve"`
+	// The pricefeed price the mark price is repegged to.
+	PegPrice       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=peg_price,json=pegPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"peg_price"`
+	BlockHeight    int64                                  `protobuf:"varint,7,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	BlockTimestamp time.Time                              `protobuf:"bytes,8,opt,name=block_timestamp,json=blockTimestamp,proto3,stdtime" json:"block_timestamp"`
+}
+
+func (m *PoolRepeggedEvent) Reset()         { *m = PoolRepeggedEvent{} }
+func (m *PoolRepeggedEvent) String() string { return proto.CompactTextString(m) }
+func (*PoolRepeggedEvent) ProtoMessage()    {}
+func (*PoolRepeggedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_faeff0bc76489252, []int{7}
+}
+func (m *PoolRepeggedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolRepeggedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolRepeggedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolRepeggedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolRepeggedEvent.Merge(m, src)
+}
+func (m *PoolRepeggedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolRepeggedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolRepeggedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolRepeggedEvent proto.InternalMessageInfo
+
+func (m *PoolRepeggedEvent) GetPair() string {
+	if m != nil {
+		return m.Pair
+	}
+	return ""
+}
+
+func (m *PoolRepeggedEvent) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *PoolRepeggedEvent) GetBlockTimestamp() time.Time {
+	if m != nil {
+		return m.BlockTimestamp
+	}
+	return time.Time{}
+}
+
+// Emitted when the depth of a vpool is scaled, multiplying both reserves and
+// keeping the mark price unchanged.
+type PoolDepthAdjustedEvent struct {
+	Pair string `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	// The factor both reserves are multiplied by, the invariant being
+	// multiplied by its square.
+	Multiplier      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=multiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"multiplier"`
+	OldBaseReserve  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=old_base_reserve,json=oldBaseReserve,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"old_base_reserve"`
+	OldQuoteReserve github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=old_quote_reserve,json=oldQuoteReserve,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"old_quote_reserve"`
+	NewBaseReserve  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=new_base_reserve,json=newBaseReserve,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"new_base_reserve"`
+	NewQuoteReserve github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=new_quote_reserve,json=newQuoteReserve,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"new_quote_reserve"`
+	BlockHeight     int64                                  `protobuf:"varint,7,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	BlockTimestamp  time.Time                              `protobuf:"bytes,8,opt,name=block_timestamp,json=blockTimestamp,proto3,stdtime" json:"block_timestamp"`
+}
+
+func (m *PoolDepthAdjustedEvent) Reset()         { *m = PoolDepthAdjustedEvent{} }
+func (m *PoolDepthAdjustedEvent) String() string { return proto.CompactTextString(m) }
+func (*PoolDepthAdjustedEvent) ProtoMessage()    {}
+func (*PoolDepthAdjustedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_faeff0bc76489252, []int{8}
+}
+func (m *PoolDepthAdjustedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolDepthAdjustedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolDepthAdjustedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolDepthAdjustedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolDepthAdjustedEvent.Merge(m, src)
+}
+func (m *PoolDepthAdjustedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolDepthAdjustedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolDepthAdjustedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolDepthAdjustedEvent proto.InternalMessageInfo
+
+func (m *PoolDepthAdjustedEvent) GetPair() string {
+	if m != nil {
+		return m.Pair
+	}
+	return ""
+}
+
+func (m *PoolDepthAdjustedEvent) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *PoolDepthAdjustedEvent) GetBlockTimestamp() time.Time {
+	if m != nil {
+		return m.BlockTimestamp
+	}
+	return time.Time{}
+}
+
+// Emitted when the curve of an oracle-pegged vpool is anchored around the
+// pricefeed price.
+type PoolPegAnchoredEvent struct {
+	Pair string `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	// The pricefeed price the curve is anchored around.
+	IndexPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=index_price,json=indexPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"index_price"`
+	// The mark price of the vpool before the anchoring.
+	OldMarkPrice    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=old_mark_price,json=oldMarkPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"old_mark_price"`
+	NewBaseReserve  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=new_base_reserve,json=newBaseReserve,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"new_base_reserve"`
+	NewQuoteReserve github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=new_quote_reserve,json=newQuoteReserve,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"new_quote_reserve"`
+	BlockHeight     int64                                  `protobuf:"varint,6,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	BlockTimestamp  time.Time                              `protobuf:"bytes,7,opt,name=block_timestamp,json=blockTimestamp,proto3,stdtime" json:"block_timestamp"`
+	// The price the curve is anchored at, the new mark price: the pricefeed
+	// price, kept within the fluctuation limit of the last snapshot and within
+	// the prices keeping the value of the net position of the traders.
+	AnchorPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=anchor_price,json=anchorPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"anchor_price"`
+}
+
+func (m *PoolPegAnchoredEvent) Reset()         { *m = PoolPegAnchoredEvent{} }
+func (m *PoolPegAnchoredEvent) String() string { return proto.CompactTextString(m) }
+func (*PoolPegAnchoredEvent) ProtoMessage()    {}
+func (*PoolPegAnchoredEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_faeff0bc76489252, []int{9}
+}
+func (m *PoolPegAnchoredEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolPegAnchoredEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolPegAnchoredEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolPegAnchoredEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolPegAnchoredEvent.Merge(m, src)
+}
+func (m *PoolPegAnchoredEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolPegAnchoredEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolPegAnchoredEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolPegAnchoredEvent proto.InternalMessageInfo
+
+func (m *PoolPegAnchoredEvent) GetPair() string {
+	if m != nil {
+		return m.Pair
+	}
+	return ""
+}
+
+func (m *PoolPegAnchoredEvent) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *PoolPegAnchoredEvent) GetBlockTimestamp() time.Time {
+	if m != nil {
+		return m.BlockTimestamp
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*ReserveSnapshotSavedEvent)(nil), "nibiru.vpool.v1.ReserveSnapshotSavedEvent")
 	proto.RegisterType((*SwapQuoteForBaseEvent)(nil), "nibiru.vpool.v1.SwapQuoteForBaseEvent")
 	proto.RegisterType((*SwapBaseForQuoteEvent)(nil), "nibiru.vpool.v1.SwapBaseForQuoteEvent")
 	proto.RegisterType((*MarkPriceChangedEvent)(nil), "nibiru.vpool.v1.MarkPriceChangedEvent")
+	proto.RegisterType((*PoolSettledEvent)(nil), "nibiru.vpool.v1.PoolSettledEvent")
+	proto.RegisterType((*PoolStatusChangedEvent)(nil), "nibiru.vpool.v1.PoolStatusChangedEvent")
+	proto.RegisterType((*PoolConfigEditedEvent)(nil), "nibiru.vpool.v1.PoolConfigEditedEvent")
+	proto.RegisterType((*PoolRepeggedEvent)(nil), "nibiru.vpool.v1.PoolRepeggedEvent")
+	proto.RegisterType((*PoolDepthAdjustedEvent)(nil), "nibiru.vpool.v1.PoolDepthAdjustedEvent")
+	proto.RegisterType((*PoolPegAnchoredEvent)(nil), "nibiru.vpool.v1.PoolPegAnchoredEvent")
 }
 
 func init() { proto.RegisterFile("vpool/v1/event.proto", fileDescriptor_faeff0bc76489252) }
 
 var fileDescriptor_faeff0bc76489252 = []byte{
-	// 488 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x94, 0x4d, 0x6f, 0xd3, 0x30,
-	0x18, 0xc7, 0x6b, 0xba, 0x4d, 0xcc, 0x2d, 0x4c, 0x8a, 0x36, 0x29, 0xeb, 0x21, 0x2d, 0x3b, 0xa0,
-	0x4a, 0x88, 0x58, 0x85, 0x4f, 0x40, 0xf7, 0x22, 0x2e, 0x05, 0x9a, 0x72, 0xe2, 0x12, 0x39, 0x99,
-	0x49, 0xac, 0x36, 0x79, 0x82, 0xed, 0x04, 0xf8, 0x16, 0x3b, 0xf2, 0x71, 0x38, 0xee, 0xc6, 0x8e,
-	0x88, 0xc3, 0x40, 0xed, 0x17, 0x41, 0xb6, 0x93, 0x8a, 0x03, 0x70, 0xc8, 0x6d, 0xa7, 0xd8, 0xfe,
-	0x3f, 0xcf, 0xcf, 0xcf, 0x5b, 0x8c, 0x0f, 0xab, 0x02, 0x60, 0x45, 0xaa, 0x09, 0x61, 0x15, 0xcb,
-	0x95, 0x5f, 0x08, 0x50, 0xe0, 0x1c, 0xe4, 0x3c, 0xe2, 0xa2, 0xf4, 0x8d, 0xe8, 0x57, 0x93, 0xc1,
-	0x61, 0x02, 0x09, 0x18, 0x8d, 0xe8, 0x95, 0x35, 0x1b, 0x78, 0x31, 0xc8, 0x0c, 0x24, 0x89, 0xa8,
-	0x64, 0xa4, 0x9a, 0x44, 0x4c, 0xd1, 0x09, 0x89, 0x81, 0xe7, 0xb5, 0x7e, 0x6c, 0xf5, 0xd0, 0x3a,
-	0xda, 0x4d, 0x2d, 0x0d, 0x13, 0x80, 0x64, 0xc5, 0x88, 0xd9, 0x45, 0xe5, 0x7b, 0xa2, 0x78, 0xc6,
-	0xa4, 0xa2, 0x59, 0x61, 0x0d, 0x4e, 0xbe, 0x74, 0xf1, 0x71, 0xc0, 0x24, 0x13, 0x15, 0x5b, 0xe4,
-	0xb4, 0x90, 0x29, 0xa8, 0x05, 0xad, 0xd8, 0xe5, 0xb9, 0x0e, 0xd3, 0x71, 0xf0, 0x4e, 0x41, 0xb9,
-	0x70, 0xd1, 0x08, 0x8d, 0xf7, 0x03, 0xb3, 0x76, 0x16, 0xf8, 0xc1, 0x87, 0x12, 0x14, 0x0b, 0x85,
-	0x75, 0x73, 0xef, 0x69, 0x71, 0xea, 0x5f, 0xdf, 0x0e, 0x3b, 0x3f, 0x6e, 0x87, 0x8f, 0x13, 0xae,
-	0xd2, 0x32, 0xf2, 0x63, 0xc8, 0xea, 0x50, 0xea, 0xcf, 0x53, 0x79, 0xb9, 0x24, 0xea, 0x73, 0xc1,
-	0xa4, 0x7f, 0xc6, 0xe2, 0xa0, 0x6f, 0x20, 0xf5, 0xd5, 0xce, 0x1c, 0xf7, 0x75, 0x76, 0x5b, 0x66,
-	0xb7, 0x15, 0xb3, 0xa7, 0x19, 0x0d, 0x72, 0x86, 0x71, 0x46, 0xc5, 0x32, 0x2c, 0x04, 0x8f, 0x99,
-	0xbb, 0xd3, 0x0a, 0xb8, 0xaf, 0x09, 0x6f, 0x34, 0xc0, 0x79, 0x84, 0xfb, 0xd1, 0x0a, 0xe2, 0x65,
-	0x98, 0x32, 0x9e, 0xa4, 0xca, 0xdd, 0x1d, 0xa1, 0x71, 0x37, 0xe8, 0x99, 0xb3, 0x97, 0xe6, 0xc8,
-	0x99, 0xe1, 0x03, 0x6b, 0xb2, 0x2d, 0xb2, 0xbb, 0x37, 0x42, 0xe3, 0xde, 0xb3, 0x81, 0x6f, 0xdb,
-	0xe0, 0x37, 0x6d, 0xf0, 0xdf, 0x36, 0x16, 0xd3, 0xfb, 0x3a, 0xa4, 0xab, 0x9f, 0x43, 0x14, 0x3c,
-	0x34, 0xce, 0x5b, 0xe5, 0xe4, 0x1b, 0xc2, 0x47, 0x8b, 0x8f, 0xb4, 0x98, 0xeb, 0x42, 0x5d, 0x80,
-	0x98, 0x52, 0xc9, 0xfe, 0xdd, 0x96, 0x39, 0xb6, 0x15, 0x0d, 0x69, 0x06, 0x65, 0xae, 0x5a, 0x76,
-	0xa5, 0x67, 0x18, 0x2f, 0x0c, 0xc2, 0x79, 0x8d, 0x4d, 0x41, 0x1b, 0x62, 0xbb, 0x9e, 0x60, 0x8d,
-	0xb0, 0xc0, 0x6d, 0x46, 0x3a, 0x93, 0x0b, 0x10, 0x26, 0xb1, 0xbb, 0x9d, 0xd1, 0x57, 0x84, 0x8f,
-	0x66, 0xcd, 0x8c, 0x9c, 0xa6, 0x34, 0x4f, 0xfe, 0xf7, 0xeb, 0x9c, 0xe1, 0x5d, 0x3b, 0x8d, 0xed,
-	0x52, 0xb1, 0xce, 0x7f, 0x1b, 0xb3, 0x6e, 0xfb, 0x31, 0x9b, 0x9e, 0x5f, 0xaf, 0x3d, 0x74, 0xb3,
-	0xf6, 0xd0, 0xaf, 0xb5, 0x87, 0xae, 0x36, 0x5e, 0xe7, 0x66, 0xe3, 0x75, 0xbe, 0x6f, 0xbc, 0xce,
-	0xbb, 0x27, 0x7f, 0xc4, 0xf5, 0xca, 0xbc, 0x54, 0xa7, 0x29, 0xe5, 0x39, 0xb1, 0xaf, 0x16, 0xf9,
-	0x44, 0xec, 0xa3, 0x66, 0x02, 0x8c, 0xf6, 0xcc, 0xa5, 0xcf, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff,
-	0x61, 0xf5, 0x5c, 0x01, 0xea, 0x04, 0x00, 0x00,
+	// 962 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x98, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0xc7, 0xcd, 0xe8, 0x23, 0xd6, 0x48, 0x8d, 0x6c, 0xc1, 0x4e, 0x14, 0x03, 0x95, 0xdd, 0x1c,
+	0x0a, 0x03, 0x45, 0x45, 0xb8, 0x7d, 0x02, 0xcb, 0x76, 0x50, 0xa0, 0x71, 0x6c, 0x49, 0x39, 0xa4,
+	0xbe, 0x10, 0x2b, 0x72, 0x4c, 0x6e, 0x4d, 0xee, 0xb2, 0xe4, 0x52, 0x52, 0x1f, 0xa0, 0xf7, 0x9c,
+	0x8a, 0x3e, 0x4e, 0x8f, 0x39, 0x14, 0x68, 0xd0, 0x53, 0x11, 0x14, 0x69, 0x61, 0xbf, 0x48, 0xb1,
+	0xbb, 0x94, 0x2a, 0x25, 0xae, 0x6a, 0xac, 0xea, 0x00, 0x39, 0x59, 0xe4, 0xec, 0xfe, 0xe6, 0xeb,
+	0x3f, 0xe4, 0x9a, 0xb0, 0x31, 0x8c, 0x39, 0x0f, 0xed, 0xe1, 0x9e, 0x8d, 0x43, 0x64, 0xa2, 0x1d,
+	0x27, 0x5c, 0xf0, 0x46, 0x9d, 0xd1, 0x01, 0x4d, 0xb2, 0xb6, 0x32, 0xb6, 0x87, 0x7b, 0x5b, 0x1b,
+	0x3e, 0xf7, 0xb9, 0xb2, 0xd9, 0xf2, 0x97, 0x5e, 0xb6, 0xd5, 0x72, 0x79, 0x1a, 0xf1, 0xd4, 0x1e,
+	0x90, 0x14, 0xed, 0xe1, 0xde, 0x00, 0x05, 0xd9, 0xb3, 0x5d, 0x4e, 0x59, 0x6e, 0x7f, 0xa8, 0xed,
+	0x8e, 0xde, 0xa8, 0x2f, 0x72, 0xd3, 0xb6, 0xcf, 0xb9, 0x1f, 0xa2, 0xad, 0xae, 0x06, 0xd9, 0xb9,
+	0x2d, 0x68, 0x84, 0xa9, 0x20, 0x51, 0xac, 0x17, 0x3c, 0xfa, 0xa9, 0x00, 0x0f, 0x7b, 0x98, 0x62,
+	0x32, 0xc4, 0x3e, 0x23, 0x71, 0x1a, 0x70, 0xd1, 0x27, 0x43, 0xf4, 0x8e, 0x64, 0x98, 0x8d, 0x06,
+	0x14, 0x63, 0x42, 0x93, 0xa6, 0xb5, 0x63, 0xed, 0x56, 0x7a, 0xea, 0x77, 0xa3, 0x0f, 0x1f, 0x7d,
+	0x97, 0x71, 0x81, 0x4e, 0xa2, 0xb7, 0x35, 0xef, 0x48, 0x63, 0xa7, 0xfd, 0xf2, 0xcd, 0xf6, 0xca,
+	0xeb, 0x37, 0xdb, 0x9f, 0xfa, 0x54, 0x04, 0xd9, 0xa0, 0xed, 0xf2, 0x28, 0x0f, 0x25, 0xff, 0xf3,
+	0x79, 0xea, 0x5d, 0xd8, 0xe2, 0xfb, 0x18, 0xd3, 0xf6, 0x21, 0xba, 0xbd, 0x9a, 0x82, 0xe4, 0xae,
+	0x1b, 0x5d, 0xa8, 0xc9, 0xec, 0xa6, 0xcc, 0x82, 0x11, 0xb3, 0x2a, 0x19, 0x13, 0xe4, 0x31, 0x40,
+	0x44, 0x92, 0x0b, 0x27, 0x4e, 0xa8, 0x8b, 0xcd, 0xa2, 0x11, 0xb0, 0x22, 0x09, 0xa7, 0x12, 0xd0,
+	0xf8, 0x04, 0x6a, 0x83, 0x90, 0xbb, 0x17, 0x4e, 0x80, 0xd4, 0x0f, 0x44, 0xb3, 0xb4, 0x63, 0xed,
+	0x16, 0x7a, 0x55, 0x75, 0xef, 0x2b, 0x75, 0xab, 0x71, 0x0c, 0x75, 0xbd, 0x64, 0x5a, 0xe4, 0x66,
+	0x79, 0xc7, 0xda, 0xad, 0x7e, 0xb1, 0xd5, 0xd6, 0x6d, 0x68, 0x4f, 0xda, 0xd0, 0x7e, 0x36, 0x59,
+	0xd1, 0x59, 0x95, 0x21, 0xbd, 0xf8, 0x73, 0xdb, 0xea, 0xdd, 0x53, 0x9b, 0xa7, 0x96, 0x47, 0xbf,
+	0x5a, 0xb0, 0xd9, 0x1f, 0x91, 0xb8, 0x2b, 0x0b, 0xf5, 0x98, 0x27, 0x1d, 0x92, 0xe2, 0xbf, 0xb7,
+	0xa5, 0x0b, 0xba, 0xa2, 0x0e, 0x89, 0x78, 0xc6, 0x84, 0x61, 0x57, 0xaa, 0x8a, 0xb1, 0xaf, 0x10,
+	0x8d, 0x13, 0x50, 0x05, 0x9d, 0x10, 0xcd, 0x7a, 0x02, 0x12, 0xa1, 0x81, 0xd3, 0x8c, 0x64, 0x26,
+	0x8f, 0x79, 0xa2, 0x12, 0xfb, 0xb0, 0x33, 0xfa, 0xd9, 0x82, 0xcd, 0xe3, 0x89, 0x46, 0x0e, 0x02,
+	0xc2, 0xfc, 0x45, 0xa3, 0x73, 0x08, 0x25, 0xad, 0x46, 0xb3, 0x54, 0xf4, 0xe6, 0xeb, 0x64, 0x56,
+	0x58, 0x42, 0x66, 0x3f, 0xde, 0x81, 0xb5, 0x53, 0xce, 0xc3, 0x3e, 0x0a, 0x11, 0x2e, 0x8a, 0xfe,
+	0x1b, 0x58, 0x4b, 0xd5, 0x9a, 0x08, 0x99, 0x70, 0x96, 0x49, 0xa4, 0xfe, 0x0f, 0x67, 0x3a, 0x5c,
+	0x8a, 0xe7, 0xa4, 0x3c, 0x4b, 0xdc, 0x7c, 0xfc, 0x7b, 0x55, 0x75, 0xaf, 0xaf, 0x6e, 0xbd, 0x33,
+	0x7f, 0xc5, 0x1b, 0xcd, 0x5f, 0x69, 0x89, 0xc2, 0xfc, 0x61, 0xc1, 0x7d, 0x55, 0x18, 0x41, 0x44,
+	0x96, 0xfe, 0x67, 0x73, 0x3f, 0x06, 0xe0, 0xa1, 0xe7, 0xa4, 0x6a, 0xb5, 0x2e, 0x4c, 0xaf, 0xc2,
+	0x43, 0x4f, 0x6f, 0x97, 0x66, 0x86, 0xa3, 0x89, 0x59, 0x27, 0x58, 0x61, 0x38, 0xca, 0xcd, 0xef,
+	0x3f, 0xbd, 0x1f, 0x4a, 0xb0, 0x29, 0xd3, 0x3b, 0xe0, 0xec, 0x9c, 0xfa, 0x47, 0x1e, 0x15, 0x8b,
+	0xb2, 0x3b, 0x83, 0x75, 0x91, 0x10, 0x0f, 0x9d, 0x90, 0x46, 0x54, 0x38, 0x09, 0x11, 0x94, 0x9b,
+	0x76, 0x5f, 0x81, 0x9e, 0x48, 0x4e, 0x4f, 0x62, 0x1a, 0xe7, 0xf0, 0xe0, 0x3c, 0xcc, 0x5c, 0x91,
+	0xc9, 0x2b, 0x36, 0xe7, 0xc1, 0x6c, 0x42, 0x37, 0x67, 0x70, 0x33, 0x7e, 0x10, 0x1e, 0x44, 0x64,
+	0xec, 0xf0, 0x84, 0xb8, 0x21, 0x3a, 0x69, 0x9c, 0x20, 0xf1, 0x72, 0x3f, 0x66, 0xaf, 0x87, 0x8d,
+	0x88, 0x8c, 0x4f, 0x14, 0xad, 0xaf, 0x60, 0xda, 0x4d, 0x00, 0xcd, 0x88, 0x50, 0x26, 0x90, 0x11,
+	0xe6, 0xa2, 0x13, 0x91, 0xc4, 0xa7, 0x2c, 0xf7, 0x53, 0x32, 0xf2, 0x73, 0x7f, 0x86, 0x77, 0xac,
+	0x70, 0xda, 0x53, 0x17, 0x6a, 0x32, 0xa1, 0x10, 0x87, 0x98, 0x10, 0x1f, 0x9b, 0x65, 0x23, 0x7a,
+	0x35, 0x22, 0xe3, 0x27, 0x39, 0xe2, 0x1d, 0x1d, 0xde, 0xbd, 0x91, 0x0e, 0x57, 0x97, 0xd0, 0xe1,
+	0x6f, 0x45, 0x58, 0x97, 0x3a, 0xec, 0x61, 0x8c, 0xfe, 0xc2, 0x09, 0x7b, 0x0e, 0x6b, 0x72, 0xc2,
+	0xe6, 0x0e, 0x0a, 0x66, 0x12, 0xbc, 0xc7, 0x43, 0xaf, 0x33, 0x73, 0x56, 0x38, 0x83, 0x75, 0x49,
+	0x9e, 0x3f, 0xd7, 0x98, 0x69, 0xaf, 0xce, 0x43, 0xaf, 0x3b, 0x7b, 0xb4, 0x79, 0x0e, 0x6b, 0x72,
+	0xf0, 0xe7, 0xa2, 0x36, 0x93, 0xdb, 0x3d, 0x86, 0xa3, 0xb7, 0xa2, 0x96, 0xe4, 0xf9, 0xa8, 0xcd,
+	0x14, 0x56, 0x67, 0x38, 0x9a, 0x8b, 0xfa, 0x6b, 0xa8, 0xc4, 0xe8, 0xe7, 0x4f, 0x79, 0x33, 0x5d,
+	0xad, 0xc6, 0xe8, 0x5f, 0x7f, 0x76, 0xba, 0x7d, 0x51, 0xbd, 0x2e, 0xea, 0x67, 0xf7, 0x21, 0xc6,
+	0x22, 0xd8, 0xf7, 0xbe, 0xcd, 0xd2, 0x85, 0x4f, 0xb7, 0xa7, 0x00, 0x51, 0x16, 0x0a, 0x1a, 0x87,
+	0x14, 0x13, 0x43, 0x4d, 0xcd, 0x10, 0xae, 0x55, 0x6a, 0xe1, 0xf6, 0x94, 0x5a, 0xbc, 0x3d, 0xa5,
+	0x96, 0x6e, 0x4f, 0xa9, 0xe5, 0xff, 0x47, 0xa9, 0xef, 0x5f, 0x5c, 0xbf, 0x14, 0x61, 0x43, 0x8a,
+	0xeb, 0x14, 0xfd, 0x7d, 0xe6, 0x06, 0x3c, 0x59, 0x24, 0xad, 0x13, 0xa8, 0x52, 0xe6, 0xe1, 0x78,
+	0xa9, 0x03, 0x13, 0x28, 0x84, 0x1e, 0xa6, 0x67, 0x20, 0x35, 0xe1, 0xcc, 0xfc, 0x6f, 0x63, 0xa6,
+	0xac, 0x1a, 0x0f, 0xbd, 0xe9, 0xd1, 0xf5, 0x03, 0x7d, 0x4a, 0xbd, 0xdd, 0xfb, 0xf2, 0x8d, 0x7a,
+	0x7f, 0xd7, 0xbc, 0xf7, 0xf2, 0x95, 0x4b, 0x54, 0xcf, 0xf3, 0xda, 0xaf, 0x9a, 0xbd, 0x72, 0x35,
+	0x43, 0x95, 0xbe, 0x73, 0xf4, 0xf2, 0xb2, 0x65, 0xbd, 0xba, 0x6c, 0x59, 0x7f, 0x5d, 0xb6, 0xac,
+	0x17, 0x57, 0xad, 0x95, 0x57, 0x57, 0xad, 0x95, 0xdf, 0xaf, 0x5a, 0x2b, 0x67, 0x9f, 0xcd, 0xe0,
+	0x9e, 0xaa, 0x4f, 0x05, 0x07, 0x01, 0xa1, 0xcc, 0xd6, 0x9f, 0x0d, 0xec, 0xb1, 0xad, 0xbf, 0x2a,
+	0x28, 0xee, 0xa0, 0xac, 0xf2, 0xf8, 0xf2, 0xef, 0x01, 0x00, 0x6f, 0x27, 0x87, 0x38, 0x6b, 0x10,
+	0x00, 0x00,
 }
 
 func (m *ReserveSnapshotSavedEvent) Marshal() (dAtA []byte, err error) {
@@ -505,99 +964,2441 @@ func (m *MarkPriceChangedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvent(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *PoolSettledEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ReserveSnapshotSavedEvent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *PoolSettledEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolSettledEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Pair)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.BlockTimestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.BlockTimestamp):])
+	if err3 != nil {
+		return 0, err3
 	}
-	l = m.QuoteReserve.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.BaseReserve.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.MarkPrice.Size()
-	n += 1 + l + sovEvent(uint64(l))
+	i -= n3
+	i = encodeVarintEvent(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x2a
 	if m.BlockHeight != 0 {
-		n += 1 + sovEvent(uint64(m.BlockHeight))
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.BlockTimestamp)
-	n += 1 + l + sovEvent(uint64(l))
-	return n
+	if len(m.PriceSource) > 0 {
+		i -= len(m.PriceSource)
+		copy(dAtA[i:], m.PriceSource)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.PriceSource)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.SettlementPrice.Size()
+		i -= size
+		if _, err := m.SettlementPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Pair) > 0 {
+		i -= len(m.Pair)
+		copy(dAtA[i:], m.Pair)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Pair)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *SwapQuoteForBaseEvent) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PoolStatusChangedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolStatusChangedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolStatusChangedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Pair)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.BlockTimestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.BlockTimestamp):])
+	if err4 != nil {
+		return 0, err4
 	}
-	l = m.QuoteAmount.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.BaseAmount.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	return n
+	i -= n4
+	i = encodeVarintEvent(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x2a
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.NewStatus) > 0 {
+		i -= len(m.NewStatus)
+		copy(dAtA[i:], m.NewStatus)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.NewStatus)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OldStatus) > 0 {
+		i -= len(m.OldStatus)
+		copy(dAtA[i:], m.OldStatus)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.OldStatus)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Pair) > 0 {
+		i -= len(m.Pair)
+		copy(dAtA[i:], m.Pair)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Pair)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *SwapBaseForQuoteEvent) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PoolConfigEditedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolConfigEditedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolConfigEditedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Pair)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.BlockTimestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.BlockTimestamp):])
+	if err5 != nil {
+		return 0, err5
 	}
-	l = m.QuoteAmount.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.BaseAmount.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	return n
+	i -= n5
+	i = encodeVarintEvent(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x42
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.MaxLeverage.Size()
+		i -= size
+		if _, err := m.MaxLeverage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MaintenanceMarginRatio.Size()
+		i -= size
+		if _, err := m.MaintenanceMarginRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MaxOracleSpreadRatio.Size()
+		i -= size
+		if _, err := m.MaxOracleSpreadRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.FluctuationLimitRatio.Size()
+		i -= size
+		if _, err := m.FluctuationLimitRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.TradeLimitRatio.Size()
+		i -= size
+		if _, err := m.TradeLimitRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Pair) > 0 {
+		i -= len(m.Pair)
+		copy(dAtA[i:], m.Pair)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Pair)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MarkPriceChangedEvent) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PoolRepeggedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolRepeggedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolRepeggedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Pair)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.BlockTimestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.BlockTimestamp):])
+	if err6 != nil {
+		return 0, err6
 	}
-	l = m.Price.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.BlockTimestamp)
-	n += 1 + l + sovEvent(uint64(l))
-	return n
+	i -= n6
+	i = encodeVarintEvent(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x42
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.PegPrice.Size()
+		i -= size
+		if _, err := m.PegPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.NewQuoteReserve.Size()
+		i -= size
+		if _, err := m.NewQuoteReserve.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.NewBaseReserve.Size()
+		i -= size
+		if _, err := m.NewBaseReserve.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.OldQuoteReserve.Size()
+		i -= size
+		if _, err := m.OldQuoteReserve.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.OldBaseReserve.Size()
+		i -= size
+		if _, err := m.OldBaseReserve.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Pair) > 0 {
+		i -= len(m.Pair)
+		copy(dAtA[i:], m.Pair)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Pair)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovEvent(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvent(x uint64) (n int) {
-	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *PoolDepthAdjustedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolDepthAdjustedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolDepthAdjustedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.BlockTimestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.BlockTimestamp):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintEvent(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x42
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.NewQuoteReserve.Size()
+		i -= size
+		if _, err := m.NewQuoteReserve.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.NewBaseReserve.Size()
+		i -= size
+		if _, err := m.NewBaseReserve.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.OldQuoteReserve.Size()
+		i -= size
+		if _, err := m.OldQuoteReserve.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.OldBaseReserve.Size()
+		i -= size
+		if _, err := m.OldBaseReserve.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Multiplier.Size()
+		i -= size
+		if _, err := m.Multiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Pair) > 0 {
+		i -= len(m.Pair)
+		copy(dAtA[i:], m.Pair)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Pair)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolPegAnchoredEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolPegAnchoredEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolPegAnchoredEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.AnchorPrice.Size()
+		i -= size
+		if _, err := m.AnchorPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.BlockTimestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.BlockTimestamp):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintEvent(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x3a
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.NewQuoteReserve.Size()
+		i -= size
+		if _, err := m.NewQuoteReserve.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.NewBaseReserve.Size()
+		i -= size
+		if _, err := m.NewBaseReserve.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.OldMarkPrice.Size()
+		i -= size
+		if _, err := m.OldMarkPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.IndexPrice.Size()
+		i -= size
+		if _, err := m.IndexPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Pair) > 0 {
+		i -= len(m.Pair)
+		copy(dAtA[i:], m.Pair)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Pair)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvent(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ReserveSnapshotSavedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pair)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.QuoteReserve.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.BaseReserve.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.MarkPrice.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvent(uint64(m.BlockHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.BlockTimestamp)
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *SwapQuoteForBaseEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pair)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.QuoteAmount.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.BaseAmount.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *SwapBaseForQuoteEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pair)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.QuoteAmount.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.BaseAmount.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *MarkPriceChangedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pair)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.BlockTimestamp)
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *PoolSettledEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pair)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.SettlementPrice.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.PriceSource)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvent(uint64(m.BlockHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.BlockTimestamp)
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *PoolStatusChangedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pair)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.OldStatus)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.NewStatus)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvent(uint64(m.BlockHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.BlockTimestamp)
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *PoolConfigEditedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pair)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.TradeLimitRatio.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.FluctuationLimitRatio.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.MaxOracleSpreadRatio.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.MaintenanceMarginRatio.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.MaxLeverage.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvent(uint64(m.BlockHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.BlockTimestamp)
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *PoolRepeggedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pair)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.OldBaseReserve.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.OldQuoteReserve.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.NewBaseReserve.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.NewQuoteReserve.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.PegPrice.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvent(uint64(m.BlockHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.BlockTimestamp)
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *PoolDepthAdjustedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pair)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.Multiplier.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.OldBaseReserve.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.OldQuoteReserve.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.NewBaseReserve.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.NewQuoteReserve.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvent(uint64(m.BlockHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.BlockTimestamp)
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *PoolPegAnchoredEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pair)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.IndexPrice.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.OldMarkPrice.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.NewBaseReserve.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.NewQuoteReserve.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvent(uint64(m.BlockHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.BlockTimestamp)
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.AnchorPrice.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func sovEvent(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvent(x uint64) (n int) {
+	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ReserveSnapshotSavedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReserveSnapshotSavedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReserveSnapshotSavedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteReserve", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.QuoteReserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseReserve", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseReserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MarkPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.BlockTimestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SwapQuoteForBaseEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapQuoteForBaseEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapQuoteForBaseEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.QuoteAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SwapBaseForQuoteEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapBaseForQuoteEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapBaseForQuoteEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.QuoteAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarkPriceChangedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarkPriceChangedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarkPriceChangedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.BlockTimestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolSettledEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolSettledEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolSettledEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SettlementPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceSource", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceSource = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.BlockTimestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolStatusChangedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolStatusChangedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolStatusChangedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldStatus = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewStatus = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.BlockTimestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolConfigEditedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolConfigEditedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolConfigEditedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeLimitRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TradeLimitRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FluctuationLimitRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FluctuationLimitRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOracleSpreadRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxOracleSpreadRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceMarginRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaintenanceMarginRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLeverage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxLeverage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.BlockTimestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolRepeggedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolRepeggedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolRepeggedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldBaseReserve", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OldBaseReserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldQuoteReserve", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OldQuoteReserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewBaseReserve", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NewBaseReserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewQuoteReserve", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NewQuoteReserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PegPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PegPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.BlockTimestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *ReserveSnapshotSavedEvent) Unmarshal(dAtA []byte) error {
+func (m *PoolDepthAdjustedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -620,10 +3421,10 @@ func (m *ReserveSnapshotSavedEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReserveSnapshotSavedEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolDepthAdjustedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReserveSnapshotSavedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolDepthAdjustedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -660,7 +3461,7 @@ func (m *ReserveSnapshotSavedEvent) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuoteReserve", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -688,13 +3489,13 @@ func (m *ReserveSnapshotSavedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.QuoteReserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Multiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseReserve", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldBaseReserve", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -722,13 +3523,13 @@ func (m *ReserveSnapshotSavedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BaseReserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OldBaseReserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarkPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldQuoteReserve", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -756,34 +3557,15 @@ func (m *ReserveSnapshotSavedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MarkPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OldQuoteReserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
-			}
-			m.BlockHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewBaseReserve", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -793,78 +3575,29 @@ func (m *ReserveSnapshotSavedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.BlockTimestamp, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NewBaseReserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvent(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SwapQuoteForBaseEvent) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvent
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SwapQuoteForBaseEvent: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SwapQuoteForBaseEvent: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewQuoteReserve", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -892,13 +3625,15 @@ func (m *SwapQuoteForBaseEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pair = string(dAtA[iNdEx:postIndex])
+			if err := m.NewQuoteReserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAmount", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			var stringLen uint64
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -908,31 +3643,16 @@ func (m *SwapQuoteForBaseEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.QuoteAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimestamp", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -942,23 +3662,22 @@ func (m *SwapQuoteForBaseEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BaseAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.BlockTimestamp, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -983,7 +3702,7 @@ func (m *SwapQuoteForBaseEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SwapBaseForQuoteEvent) Unmarshal(dAtA []byte) error {
+func (m *PoolPegAnchoredEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1006,10 +3725,10 @@ func (m *SwapBaseForQuoteEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SwapBaseForQuoteEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolPegAnchoredEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SwapBaseForQuoteEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolPegAnchoredEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1046,7 +3765,7 @@ func (m *SwapBaseForQuoteEvent) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1074,13 +3793,13 @@ func (m *SwapBaseForQuoteEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.QuoteAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.IndexPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldMarkPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1108,63 +3827,13 @@ func (m *SwapBaseForQuoteEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BaseAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OldMarkPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvent(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MarkPriceChangedEvent) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvent
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MarkPriceChangedEvent: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MarkPriceChangedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewBaseReserve", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1192,11 +3861,13 @@ func (m *MarkPriceChangedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pair = string(dAtA[iNdEx:postIndex])
+			if err := m.NewBaseReserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewQuoteReserve", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1224,11 +3895,30 @@ func (m *MarkPriceChangedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NewQuoteReserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimestamp", wireType)
 			}
@@ -1261,6 +3951,40 @@ func (m *MarkPriceChangedEvent) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnchorPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AnchorPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
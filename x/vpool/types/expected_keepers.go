@@ -1,8 +1,11 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/NibiruChain/nibiru/x/common"
 	pftypes "github.com/NibiruChain/nibiru/x/pricefeed/types"
 )
 
@@ -11,4 +14,13 @@ type PricefeedKeeper interface {
 		pftypes.CurrentPrice, error,
 	)
 	IsActivePair(ctx sdk.Context, pairID string) bool
+	GetCurrentTWAP(ctx sdk.Context, token0 string, token1 string) (sdk.Dec, error)
+}
+
+// TwapLookbackKeeper defines the expected interface of the module reading the
+// TWAPs of the vpools, whose lookbacks the reserve snapshots are kept for.
+type TwapLookbackKeeper interface {
+	// GetTwapLookbacks returns the longest lookback of the mark price TWAPs
+	// and of the swap TWAPs read over the pair.
+	GetTwapLookbacks(ctx sdk.Context, pair common.AssetPair) (markPriceLookback time.Duration, swapLookback time.Duration)
 }
@@ -10,16 +10,23 @@ import (
 // DefaultGenesis returns the default Capability genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		Vpools:    []VPool{},
-		Snapshots: []ReserveSnapshot{},
+		Vpools:       []VPool{},
+		Snapshots:    []ReserveSnapshot{},
+		Settlements:  []PoolSettlement{},
+		Params:       DefaultParams(),
+		TradeVolumes: []TradeVolume{},
 	}
 }
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
 	// validate vpools
-	vpools := make(map[string]struct{}, len(gs.Vpools))
+	vpools := make(map[string]VPool, len(gs.Vpools))
 	for _, p := range gs.Vpools {
 		if err := p.Validate(); err != nil {
 			return err
@@ -28,7 +35,7 @@ func (gs GenesisState) Validate() error {
 		if _, exists := vpools[pair]; exists {
 			return fmt.Errorf("duplicate vpool: %s", pair)
 		}
-		vpools[pair] = struct{}{}
+		vpools[pair] = p
 	}
 
 	for _, snapshot := range gs.Snapshots {
@@ -37,6 +44,33 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	for _, volume := range gs.TradeVolumes {
+		if err := volume.Validate(); err != nil {
+			return err
+		}
+	}
+
+	settlements := make(map[string]struct{}, len(gs.Settlements))
+	for _, settlement := range gs.Settlements {
+		pair := settlement.Pair.String()
+		if _, exists := vpools[pair]; !exists {
+			return fmt.Errorf("settlement of unknown vpool: %s", pair)
+		}
+		if _, exists := settlements[pair]; exists {
+			return fmt.Errorf("duplicate settlement: %s", pair)
+		}
+		if settlement.SettlementPrice.IsNil() || !settlement.SettlementPrice.IsPositive() {
+			return fmt.Errorf("settlement price of %s must be positive, not: %s", pair, settlement.SettlementPrice)
+		}
+		settlements[pair] = struct{}{}
+	}
+
+	for pair, pool := range vpools {
+		if _, settled := settlements[pair]; pool.Status == PoolStatus_SETTLED && !settled {
+			return fmt.Errorf("settled vpool without a settlement: %s", pair)
+		}
+	}
+
 	return nil
 }
 
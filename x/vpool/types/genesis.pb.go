@@ -25,8 +25,11 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the vpool module's genesis state.
 type GenesisState struct {
-	Vpools    []VPool           `protobuf:"bytes,1,rep,name=vpools,proto3" json:"vpools"`
-	Snapshots []ReserveSnapshot `protobuf:"bytes,2,rep,name=snapshots,proto3" json:"snapshots"`
+	Vpools       []VPool           `protobuf:"bytes,1,rep,name=vpools,proto3" json:"vpools"`
+	Snapshots    []ReserveSnapshot `protobuf:"bytes,2,rep,name=snapshots,proto3" json:"snapshots"`
+	Settlements  []PoolSettlement  `protobuf:"bytes,3,rep,name=settlements,proto3" json:"settlements"`
+	Params       Params            `protobuf:"bytes,4,opt,name=params,proto3" json:"params"`
+	TradeVolumes []TradeVolume     `protobuf:"bytes,5,rep,name=trade_volumes,json=tradeVolumes,proto3" json:"trade_volumes"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -76,6 +79,27 @@ func (m *GenesisState) GetSnapshots() []ReserveSnapshot {
 	return nil
 }
 
+func (m *GenesisState) GetSettlements() []PoolSettlement {
+	if m != nil {
+		return m.Settlements
+	}
+	return nil
+}
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetTradeVolumes() []TradeVolume {
+	if m != nil {
+		return m.TradeVolumes
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "nibiru.vpool.v1.GenesisState")
 }
@@ -83,22 +107,27 @@ func init() {
 func init() { proto.RegisterFile("vpool/v1/genesis.proto", fileDescriptor_fc3ffc8cca622811) }
 
 var fileDescriptor_fc3ffc8cca622811 = []byte{
-	// 231 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x2b, 0x2b, 0xc8, 0xcf,
-	0xcf, 0xd1, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca,
-	0x2f, 0xc9, 0x17, 0xe2, 0xcf, 0xcb, 0x4c, 0xca, 0x2c, 0x2a, 0xd5, 0x03, 0x4b, 0xeb, 0x95, 0x19,
-	0x4a, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0xe5, 0xf4, 0x41, 0x2c, 0x88, 0x32, 0x29, 0x11, 0xb8,
-	0xf6, 0xe2, 0x92, 0xc4, 0x92, 0x54, 0x88, 0xa8, 0x52, 0x17, 0x23, 0x17, 0x8f, 0x3b, 0xc4, 0xb8,
-	0x60, 0x90, 0xb0, 0x90, 0x09, 0x17, 0x1b, 0x58, 0x61, 0xb1, 0x04, 0xa3, 0x02, 0xb3, 0x06, 0xb7,
-	0x91, 0x98, 0x1e, 0x9a, 0xf1, 0x7a, 0x61, 0x01, 0xf9, 0xf9, 0x39, 0x4e, 0x2c, 0x27, 0xee, 0xc9,
-	0x33, 0x04, 0x41, 0xd5, 0x0a, 0xb9, 0x70, 0x71, 0x16, 0xe7, 0x25, 0x16, 0x14, 0x67, 0xe4, 0x97,
-	0x14, 0x4b, 0x30, 0x81, 0x35, 0x2a, 0x60, 0x68, 0x0c, 0x4a, 0x2d, 0x4e, 0x2d, 0x2a, 0x4b, 0x0d,
-	0x86, 0x2a, 0x84, 0x1a, 0x81, 0xd0, 0xe8, 0xe4, 0x7a, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72,
-	0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7,
-	0x72, 0x0c, 0x51, 0xda, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x7e,
-	0x60, 0x63, 0x9d, 0x33, 0x12, 0x33, 0xf3, 0xf4, 0x21, 0x56, 0xe8, 0x57, 0xe8, 0x43, 0x3c, 0x57,
-	0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0xf6, 0x9a, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x67,
-	0x5a, 0x0d, 0x00, 0x31, 0x01, 0x00, 0x00,
+	// 317 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0xc1, 0x4a, 0xc3, 0x40,
+	0x10, 0x86, 0x93, 0xb6, 0x16, 0xdc, 0x56, 0x84, 0xa5, 0xd4, 0x50, 0x64, 0x5b, 0x3c, 0x15, 0x84,
+	0x5d, 0x5a, 0xf5, 0x05, 0xaa, 0xd2, 0x9b, 0x48, 0x2b, 0x3d, 0x78, 0x91, 0xad, 0x0e, 0x69, 0x20,
+	0xc9, 0x86, 0xcc, 0x36, 0xe8, 0x5b, 0xf8, 0x58, 0x3d, 0x16, 0xbc, 0x78, 0x12, 0x69, 0x5f, 0x44,
+	0xb2, 0x1b, 0x5b, 0x31, 0xb7, 0x30, 0xff, 0xf7, 0x7f, 0x93, 0x65, 0x48, 0x3b, 0x4b, 0x94, 0x0a,
+	0x45, 0x36, 0x10, 0x3e, 0xc4, 0x80, 0x01, 0xf2, 0x24, 0x55, 0x5a, 0xd1, 0xe3, 0x38, 0x98, 0x07,
+	0xe9, 0x92, 0x9b, 0x98, 0x67, 0x83, 0x4e, 0xcb, 0x57, 0xbe, 0x32, 0x99, 0xc8, 0xbf, 0x2c, 0xd6,
+	0x69, 0xed, 0xea, 0xa8, 0xa5, 0x06, 0x3b, 0x3d, 0xfb, 0xa8, 0x90, 0xe6, 0xd8, 0xea, 0xa6, 0xf9,
+	0x98, 0x5e, 0x92, 0xba, 0x01, 0xd1, 0x73, 0x7b, 0xd5, 0x7e, 0x63, 0xd8, 0xe6, 0xff, 0xf4, 0x7c,
+	0x76, 0xaf, 0x54, 0x38, 0xaa, 0xad, 0xbe, 0xba, 0xce, 0xa4, 0x60, 0xe9, 0x0d, 0x39, 0xc4, 0x58,
+	0x26, 0xb8, 0x50, 0x1a, 0xbd, 0x8a, 0x29, 0xf6, 0x4a, 0xc5, 0x09, 0x20, 0xa4, 0x19, 0x4c, 0x0b,
+	0xb0, 0x50, 0xec, 0x8b, 0x74, 0x4c, 0x1a, 0x08, 0x5a, 0x87, 0x10, 0x41, 0xac, 0xd1, 0xab, 0x1a,
+	0x4f, 0xb7, 0xe4, 0xc9, 0xf7, 0x4f, 0x77, 0x5c, 0xa1, 0xf9, 0xdb, 0xa4, 0x57, 0xa4, 0x9e, 0xc8,
+	0x54, 0x46, 0xe8, 0xd5, 0x7a, 0x6e, 0xbf, 0x31, 0x3c, 0x29, 0x3b, 0x4c, 0xfc, 0xfb, 0x0a, 0x0b,
+	0xd3, 0x31, 0x39, 0xd2, 0xa9, 0x7c, 0x81, 0xa7, 0x4c, 0x85, 0xcb, 0x08, 0xd0, 0x3b, 0x30, 0x7f,
+	0x70, 0x5a, 0x6a, 0x3f, 0xe4, 0xd4, 0xcc, 0x40, 0x85, 0xa2, 0xa9, 0xf7, 0x23, 0x1c, 0xdd, 0xae,
+	0x36, 0xcc, 0x5d, 0x6f, 0x98, 0xfb, 0xbd, 0x61, 0xee, 0xfb, 0x96, 0x39, 0xeb, 0x2d, 0x73, 0x3e,
+	0xb7, 0xcc, 0x79, 0x3c, 0xf7, 0x03, 0xbd, 0x58, 0xce, 0xf9, 0xb3, 0x8a, 0xc4, 0x9d, 0xb1, 0x5e,
+	0x2f, 0x64, 0x10, 0x0b, 0xbb, 0x41, 0xbc, 0x0a, 0x7b, 0x25, 0xfd, 0x96, 0x00, 0xce, 0xeb, 0xe6,
+	0x46, 0x17, 0x3f, 0x03, 0x00, 0x43, 0x9f, 0x8f, 0x47, 0xfa, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -121,6 +150,44 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TradeVolumes) > 0 {
+		for iNdEx := len(m.TradeVolumes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TradeVolumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Settlements) > 0 {
+		for iNdEx := len(m.Settlements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Settlements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Snapshots) > 0 {
 		for iNdEx := len(m.Snapshots) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -181,6 +248,20 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Settlements) > 0 {
+		for _, e := range m.Settlements {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.TradeVolumes) > 0 {
+		for _, e := range m.TradeVolumes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -287,6 +368,107 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Settlements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Settlements = append(m.Settlements, PoolSettlement{})
+			if err := m.Settlements[len(m.Settlements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeVolumes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TradeVolumes = append(m.TradeVolumes, TradeVolume{})
+			if err := m.TradeVolumes[len(m.TradeVolumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,20 +1,36 @@
 package types
 
 import (
+	"fmt"
+
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/NibiruChain/nibiru/x/common"
 )
 
 const (
-	ProposalTypeCreatePool = "CreatePool"
+	ProposalTypeCreatePool     = "CreatePool"
+	ProposalTypeSettlePool     = "SettlePool"
+	ProposalTypePoolStatus     = "PoolStatus"
+	ProposalTypeEditPoolConfig = "EditPoolConfig"
 )
 
-var _ govtypes.Content = &CreatePoolProposal{}
+var (
+	_ govtypes.Content = &CreatePoolProposal{}
+	_ govtypes.Content = &SettlePoolProposal{}
+	_ govtypes.Content = &PoolStatusProposal{}
+	_ govtypes.Content = &EditPoolConfigProposal{}
+)
 
 func init() {
 	govtypes.RegisterProposalType(ProposalTypeCreatePool)
 	govtypes.RegisterProposalTypeCodec(&CreatePoolProposal{}, "nibiru/CreatePoolProposal")
+	govtypes.RegisterProposalType(ProposalTypeSettlePool)
+	govtypes.RegisterProposalTypeCodec(&SettlePoolProposal{}, "nibiru/SettlePoolProposal")
+	govtypes.RegisterProposalType(ProposalTypePoolStatus)
+	govtypes.RegisterProposalTypeCodec(&PoolStatusProposal{}, "nibiru/PoolStatusProposal")
+	govtypes.RegisterProposalType(ProposalTypeEditPoolConfig)
+	govtypes.RegisterProposalTypeCodec(&EditPoolConfigProposal{}, "nibiru/EditPoolConfigProposal")
 }
 
 func (m *CreatePoolProposal) ProposalRoute() string {
@@ -43,7 +59,99 @@ func (m *CreatePoolProposal) ValidateBasic() error {
 		MaxOracleSpreadRatio:   m.MaxOracleSpreadRatio,
 		MaintenanceMarginRatio: m.MaintenanceMarginRatio,
 		MaxLeverage:            m.MaxLeverage,
+		CurveType:              m.CurveType,
+		OraclePegConfig:        m.OraclePegConfig,
 	}
 
 	return pool.Validate()
 }
+
+func (m *SettlePoolProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+func (m *SettlePoolProposal) ProposalType() string {
+	return ProposalTypeSettlePool
+}
+
+func (m *SettlePoolProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(m); err != nil {
+		return err
+	}
+
+	if _, err := common.NewAssetPair(m.Pair); err != nil {
+		return err
+	}
+
+	switch m.PriceSource {
+	case SettlementPriceSource_PRICEFEED_TWAP:
+		return nil
+	case SettlementPriceSource_MARK_TWAP, SettlementPriceSource_MARK_TWAP_OR_PRICEFEED_TWAP:
+		if m.TwapLookbackWindow <= 0 {
+			return fmt.Errorf("twap lookback window must be positive, not: %s", m.TwapLookbackWindow)
+		}
+		return nil
+	default:
+		return ErrInvalidSettlementPriceSource.Wrap(m.PriceSource.String())
+	}
+}
+
+func (m *PoolStatusProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+func (m *PoolStatusProposal) ProposalType() string {
+	return ProposalTypePoolStatus
+}
+
+func (m *PoolStatusProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(m); err != nil {
+		return err
+	}
+
+	if _, err := common.NewAssetPair(m.Pair); err != nil {
+		return err
+	}
+
+	return ValidatePoolStatusChange(m.Status)
+}
+
+func (m *EditPoolConfigProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+func (m *EditPoolConfigProposal) ProposalType() string {
+	return ProposalTypeEditPoolConfig
+}
+
+func (m *EditPoolConfigProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(m); err != nil {
+		return err
+	}
+
+	if _, err := common.NewAssetPair(m.Pair); err != nil {
+		return err
+	}
+
+	pool := &VPool{
+		TradeLimitRatio:        m.TradeLimitRatio,
+		FluctuationLimitRatio:  m.FluctuationLimitRatio,
+		MaxOracleSpreadRatio:   m.MaxOracleSpreadRatio,
+		MaintenanceMarginRatio: m.MaintenanceMarginRatio,
+		MaxLeverage:            m.MaxLeverage,
+	}
+	return pool.ValidateConfig()
+}
+
+// ValidatePoolStatusChange checks that a pool can be moved to the status by
+// governance, a pool being SETTLED with a SettlePoolProposal instead.
+func ValidatePoolStatusChange(status PoolStatus) error {
+	switch status {
+	case PoolStatus_ACTIVE, PoolStatus_REDUCE_ONLY, PoolStatus_FROZEN:
+		return nil
+	case PoolStatus_SETTLED:
+		return ErrInvalidPoolStatus.Wrap("a pool is settled with a SettlePoolProposal")
+	default:
+		return ErrInvalidPoolStatus.Wrap(status.String())
+	}
+}
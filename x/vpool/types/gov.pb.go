@@ -8,15 +8,19 @@ import (
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -45,6 +49,13 @@ type CreatePoolProposal struct {
 	MaintenanceMarginRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=maintenance_margin_ratio,json=maintenanceMarginRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"maintenance_margin_ratio"`
 	// max_leverage
 	MaxLeverage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=max_leverage,json=maxLeverage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_leverage"`
+	// curve_type is the curve the pool prices its swaps with, CONSTANT_PRODUCT
+	// when unspecified.
+	CurveType CurveType `protobuf:"varint,11,opt,name=curve_type,json=curveType,proto3,enum=nibiru.vpool.v1.CurveType" json:"curve_type,omitempty"`
+	// oracle_peg_config is the curve of an ORACLE_PEGGED pool, required for
+	// ORACLE_PEGGED pools only. The pool is anchored around the pricefeed price
+	// when created, replacing the reserves of the proposal.
+	OraclePegConfig *OraclePegConfig `protobuf:"bytes,12,opt,name=oracle_peg_config,json=oraclePegConfig,proto3" json:"oracle_peg_config,omitempty"`
 }
 
 func (m *CreatePoolProposal) Reset()         { *m = CreatePoolProposal{} }
@@ -101,41 +112,303 @@ func (m *CreatePoolProposal) GetPair() string {
 	return ""
 }
 
+func (m *CreatePoolProposal) GetCurveType() CurveType {
+	if m != nil {
+		return m.CurveType
+	}
+	return CurveType_CURVE_TYPE_UNSPECIFIED
+}
+
+func (m *CreatePoolProposal) GetOraclePegConfig() *OraclePegConfig {
+	if m != nil {
+		return m.OraclePegConfig
+	}
+	return nil
+}
+
+// SettlePoolProposal freezes a vpool and fixes the price at which the positions
+// of its perp market are settled.
+type SettlePoolProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// pair represents the pair of the vpool.
+	Pair string `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	// price_source is where the settlement price is taken from.
+	PriceSource SettlementPriceSource `protobuf:"varint,4,opt,name=price_source,json=priceSource,proto3,enum=nibiru.vpool.v1.SettlementPriceSource" json:"price_source,omitempty"`
+	// twap_lookback_window is the lookback window of the mark TWAP,
+	// required when the price source is MARK_TWAP or MARK_TWAP_OR_PRICEFEED_TWAP.
+	TwapLookbackWindow time.Duration `protobuf:"bytes,5,opt,name=twap_lookback_window,json=twapLookbackWindow,proto3,stdduration" json:"twap_lookback_window"`
+}
+
+func (m *SettlePoolProposal) Reset()         { *m = SettlePoolProposal{} }
+func (m *SettlePoolProposal) String() string { return proto.CompactTextString(m) }
+func (*SettlePoolProposal) ProtoMessage()    {}
+func (*SettlePoolProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a393460ab414204, []int{1}
+}
+func (m *SettlePoolProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SettlePoolProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SettlePoolProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SettlePoolProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SettlePoolProposal.Merge(m, src)
+}
+func (m *SettlePoolProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SettlePoolProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SettlePoolProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SettlePoolProposal proto.InternalMessageInfo
+
+func (m *SettlePoolProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *SettlePoolProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *SettlePoolProposal) GetPair() string {
+	if m != nil {
+		return m.Pair
+	}
+	return ""
+}
+
+func (m *SettlePoolProposal) GetPriceSource() SettlementPriceSource {
+	if m != nil {
+		return m.PriceSource
+	}
+	return SettlementPriceSource_SETTLEMENT_PRICE_SOURCE_UNSPECIFIED
+}
+
+func (m *SettlePoolProposal) GetTwapLookbackWindow() time.Duration {
+	if m != nil {
+		return m.TwapLookbackWindow
+	}
+	return 0
+}
+
+// PoolStatusProposal moves a vpool between the ACTIVE, REDUCE_ONLY and FROZEN
+// statuses. A vpool is SETTLED with a SettlePoolProposal.
+type PoolStatusProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// pair represents the pair of the vpool.
+	Pair string `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	// status is the new status of the vpool.
+	Status PoolStatus `protobuf:"varint,4,opt,name=status,proto3,enum=nibiru.vpool.v1.PoolStatus" json:"status,omitempty"`
+}
+
+func (m *PoolStatusProposal) Reset()         { *m = PoolStatusProposal{} }
+func (m *PoolStatusProposal) String() string { return proto.CompactTextString(m) }
+func (*PoolStatusProposal) ProtoMessage()    {}
+func (*PoolStatusProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a393460ab414204, []int{2}
+}
+func (m *PoolStatusProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolStatusProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolStatusProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolStatusProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolStatusProposal.Merge(m, src)
+}
+func (m *PoolStatusProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolStatusProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolStatusProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolStatusProposal proto.InternalMessageInfo
+
+func (m *PoolStatusProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *PoolStatusProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *PoolStatusProposal) GetPair() string {
+	if m != nil {
+		return m.Pair
+	}
+	return ""
+}
+
+func (m *PoolStatusProposal) GetStatus() PoolStatus {
+	if m != nil {
+		return m.Status
+	}
+	return PoolStatus_POOL_STATUS_UNSPECIFIED
+}
+
+// EditPoolConfigProposal edits the risk parameters of an existing vpool. The
+// reserves are left untouched, see the RepegPoolProposal of x/perp.
+type EditPoolConfigProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// pair represents the pair of the vpool.
+	Pair string `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	// trade_limit_ratio represents the limit on trading amounts.
+	TradeLimitRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=trade_limit_ratio,json=tradeLimitRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"trade_limit_ratio"`
+	// fluctuation_limit_ratio represents the maximum price
+	// percentage difference a trade can create on the pool.
+	FluctuationLimitRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=fluctuation_limit_ratio,json=fluctuationLimitRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fluctuation_limit_ratio"`
+	// max_oracle_spread_ratio represents the maximum price percentage
+	// difference that can exist between oracle price and vpool prices after a trade.
+	MaxOracleSpreadRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=max_oracle_spread_ratio,json=maxOracleSpreadRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_oracle_spread_ratio"`
+	// maintenance_margin_ratio
+	MaintenanceMarginRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=maintenance_margin_ratio,json=maintenanceMarginRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"maintenance_margin_ratio"`
+	// max_leverage
+	MaxLeverage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=max_leverage,json=maxLeverage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_leverage"`
+}
+
+func (m *EditPoolConfigProposal) Reset()         { *m = EditPoolConfigProposal{} }
+func (m *EditPoolConfigProposal) String() string { return proto.CompactTextString(m) }
+func (*EditPoolConfigProposal) ProtoMessage()    {}
+func (*EditPoolConfigProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a393460ab414204, []int{3}
+}
+func (m *EditPoolConfigProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EditPoolConfigProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EditPoolConfigProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EditPoolConfigProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EditPoolConfigProposal.Merge(m, src)
+}
+func (m *EditPoolConfigProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *EditPoolConfigProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_EditPoolConfigProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EditPoolConfigProposal proto.InternalMessageInfo
+
+func (m *EditPoolConfigProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *EditPoolConfigProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *EditPoolConfigProposal) GetPair() string {
+	if m != nil {
+		return m.Pair
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*CreatePoolProposal)(nil), "nibiru.vpool.v1.CreatePoolProposal")
+	proto.RegisterType((*SettlePoolProposal)(nil), "nibiru.vpool.v1.SettlePoolProposal")
+	proto.RegisterType((*PoolStatusProposal)(nil), "nibiru.vpool.v1.PoolStatusProposal")
+	proto.RegisterType((*EditPoolConfigProposal)(nil), "nibiru.vpool.v1.EditPoolConfigProposal")
 }
 
 func init() { proto.RegisterFile("vpool/v1/gov.proto", fileDescriptor_8a393460ab414204) }
 
 var fileDescriptor_8a393460ab414204 = []byte{
-	// 424 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0xd3, 0xb1, 0x6e, 0xd4, 0x30,
-	0x18, 0x07, 0xf0, 0x0b, 0xb4, 0x07, 0x75, 0x91, 0x4a, 0xcd, 0x41, 0x23, 0x86, 0xb4, 0x62, 0x40,
-	0x48, 0x88, 0x58, 0x15, 0x4f, 0x40, 0x0b, 0x5b, 0x81, 0x72, 0x6c, 0x15, 0x22, 0xfa, 0x2e, 0xf7,
-	0x35, 0x67, 0x61, 0xfb, 0x0b, 0xb6, 0x13, 0x1d, 0x2f, 0xc0, 0xcc, 0x63, 0x75, 0xec, 0x88, 0x18,
-	0x2a, 0x74, 0xf7, 0x22, 0xc8, 0xce, 0x0d, 0x29, 0x63, 0xa6, 0x38, 0xfe, 0xac, 0xdf, 0x5f, 0xb6,
-	0xf4, 0x67, 0xbc, 0xad, 0x89, 0x94, 0x68, 0x8f, 0x45, 0x45, 0x6d, 0x5e, 0x5b, 0xf2, 0xc4, 0xf7,
-	0x8c, 0x9c, 0x49, 0xdb, 0xe4, 0x71, 0x94, 0xb7, 0xc7, 0x4f, 0x27, 0x15, 0x55, 0x14, 0x67, 0x22,
-	0xac, 0xba, 0x63, 0xcf, 0x7e, 0x8e, 0x19, 0x3f, 0xb5, 0x08, 0x1e, 0xcf, 0x89, 0xd4, 0xb9, 0xa5,
-	0x9a, 0x1c, 0x28, 0x3e, 0x61, 0xdb, 0x5e, 0x7a, 0x85, 0x69, 0x72, 0x94, 0xbc, 0xd8, 0x99, 0x76,
-	0x3f, 0xfc, 0x88, 0xed, 0xce, 0xd1, 0x95, 0x56, 0xd6, 0x5e, 0x92, 0x49, 0xef, 0xc4, 0x59, 0x7f,
-	0x8b, 0x73, 0xb6, 0x55, 0x83, 0xb4, 0xe9, 0xdd, 0x38, 0x8a, 0x6b, 0x7e, 0xc1, 0xf6, 0xbd, 0x85,
-	0x39, 0x16, 0x4a, 0x6a, 0xe9, 0x0b, 0x0b, 0x5e, 0x52, 0xba, 0x15, 0x0e, 0x9c, 0xe4, 0x57, 0x37,
-	0x87, 0xa3, 0x3f, 0x37, 0x87, 0xcf, 0x2b, 0xe9, 0x17, 0xcd, 0x2c, 0x2f, 0x49, 0x8b, 0x92, 0x9c,
-	0x26, 0xb7, 0xf9, 0xbc, 0x72, 0xf3, 0x6f, 0xc2, 0xff, 0xa8, 0xd1, 0xe5, 0x6f, 0xb1, 0x9c, 0xee,
-	0x45, 0xe8, 0x2c, 0x38, 0xd3, 0xc0, 0xf0, 0xaf, 0xec, 0xd1, 0xf7, 0x86, 0x3c, 0x16, 0xe0, 0x1c,
-	0xfa, 0xc2, 0xa2, 0x43, 0xdb, 0x62, 0xba, 0x3d, 0x48, 0xdf, 0x8f, 0xd4, 0x9b, 0x20, 0x4d, 0x3b,
-	0x88, 0x7f, 0x61, 0x7c, 0x06, 0xee, 0x7f, 0x7e, 0x3c, 0x88, 0x7f, 0x18, 0xa4, 0x5b, 0xfa, 0x25,
-	0x3b, 0xb8, 0x54, 0x4d, 0xe9, 0x9b, 0x70, 0x17, 0x73, 0xeb, 0x7d, 0xee, 0x0d, 0x8a, 0x78, 0xdc,
-	0xe3, 0x7a, 0xaf, 0x84, 0xec, 0x40, 0xc3, 0xb2, 0x20, 0x0b, 0xa5, 0xc2, 0xc2, 0xd5, 0x16, 0x61,
-	0xbe, 0xc9, 0xb9, 0x3f, 0x28, 0x67, 0xa2, 0x61, 0xf9, 0x31, 0x6a, 0x9f, 0x23, 0xd6, 0xc5, 0x2c,
-	0x58, 0xaa, 0x41, 0x1a, 0x8f, 0x06, 0x4c, 0x89, 0x85, 0x06, 0x5b, 0x49, 0xb3, 0xc9, 0xd9, 0x19,
-	0x94, 0xf3, 0xa4, 0xe7, 0xbd, 0x8f, 0x5c, 0x97, 0xf4, 0x89, 0x3d, 0x08, 0x17, 0x52, 0xd8, 0xa2,
-	0x85, 0x0a, 0x53, 0x36, 0x48, 0xdf, 0xd5, 0xb0, 0x3c, 0xdb, 0x10, 0x27, 0xef, 0xae, 0x56, 0x59,
-	0x72, 0xbd, 0xca, 0x92, 0xbf, 0xab, 0x2c, 0xf9, 0xb5, 0xce, 0x46, 0xd7, 0xeb, 0x6c, 0xf4, 0x7b,
-	0x9d, 0x8d, 0x2e, 0x5e, 0xf6, 0xb8, 0x0f, 0xb1, 0x54, 0xa7, 0x0b, 0x90, 0x46, 0x74, 0x05, 0x13,
-	0x4b, 0xd1, 0xb5, 0x2f, 0xba, 0xb3, 0x71, 0xac, 0xd5, 0xeb, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0x4f, 0xe9, 0xb5, 0x83, 0x93, 0x03, 0x00, 0x00,
+	// 682 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x95, 0xcf, 0x4f, 0xd4, 0x40,
+	0x14, 0xc7, 0xb7, 0x08, 0x0b, 0xcc, 0x12, 0x90, 0x71, 0x85, 0x8a, 0x49, 0xd9, 0xec, 0x81, 0x90,
+	0x18, 0xdb, 0xb0, 0x9c, 0x3c, 0xca, 0xc2, 0xc1, 0x64, 0xd5, 0xb5, 0xab, 0x31, 0x21, 0xc6, 0x66,
+	0xb6, 0x7d, 0x5b, 0x26, 0xb4, 0x9d, 0x3a, 0x33, 0x2d, 0xcb, 0xd5, 0x7f, 0x40, 0x2f, 0x26, 0xfe,
+	0x49, 0x1c, 0x3c, 0x70, 0x34, 0x1e, 0xd0, 0xc0, 0x3f, 0x62, 0x66, 0x5a, 0x64, 0x81, 0xc4, 0xc3,
+	0x1a, 0x48, 0x3c, 0xed, 0xcc, 0xbc, 0xd7, 0xcf, 0xfb, 0x31, 0xf3, 0xbe, 0x8b, 0x70, 0x9e, 0x32,
+	0x16, 0x39, 0xf9, 0x86, 0x13, 0xb2, 0xdc, 0x4e, 0x39, 0x93, 0x0c, 0x2f, 0x24, 0xb4, 0x4f, 0x79,
+	0x66, 0x6b, 0x93, 0x9d, 0x6f, 0xac, 0xd4, 0x43, 0x16, 0x32, 0x6d, 0x73, 0xd4, 0xaa, 0x70, 0x5b,
+	0xb1, 0x42, 0xc6, 0xc2, 0x08, 0x1c, 0xbd, 0xeb, 0x67, 0x03, 0x27, 0xc8, 0x38, 0x91, 0x94, 0x25,
+	0xa5, 0xbd, 0xfe, 0x07, 0x2d, 0x24, 0x91, 0x50, 0x9c, 0x36, 0x3f, 0x4d, 0x23, 0xdc, 0xe6, 0x40,
+	0x24, 0x74, 0x19, 0x8b, 0xba, 0x9c, 0xa5, 0x4c, 0x90, 0x08, 0xd7, 0xd1, 0x94, 0xa4, 0x32, 0x02,
+	0xd3, 0x68, 0x18, 0xeb, 0xb3, 0x6e, 0xb1, 0xc1, 0x0d, 0x54, 0x0b, 0x40, 0xf8, 0x9c, 0xa6, 0x8a,
+	0x6b, 0x4e, 0x68, 0xdb, 0xe8, 0x11, 0xc6, 0x68, 0x32, 0x25, 0x94, 0x9b, 0x77, 0xb4, 0x49, 0xaf,
+	0xf1, 0x2e, 0x5a, 0x94, 0x9c, 0x04, 0xe0, 0x45, 0x34, 0xa6, 0xd2, 0xd3, 0x49, 0x99, 0x93, 0xca,
+	0x61, 0xcb, 0x3e, 0x3a, 0x59, 0xad, 0xfc, 0x38, 0x59, 0x5d, 0x0b, 0xa9, 0xdc, 0xcb, 0xfa, 0xb6,
+	0xcf, 0x62, 0xc7, 0x67, 0x22, 0x66, 0xa2, 0xfc, 0x79, 0x2c, 0x82, 0x7d, 0x47, 0x1e, 0xa6, 0x20,
+	0xec, 0x6d, 0xf0, 0xdd, 0x05, 0x0d, 0xea, 0x28, 0x8e, 0xab, 0x30, 0xf8, 0x3d, 0xba, 0xf7, 0x21,
+	0x63, 0x12, 0x3c, 0x22, 0x04, 0x48, 0x8f, 0x83, 0x00, 0x9e, 0x83, 0x39, 0x35, 0x16, 0x7d, 0x51,
+	0xa3, 0x9e, 0x2a, 0x92, 0x5b, 0x80, 0xf0, 0x3b, 0x84, 0xfb, 0x44, 0x5c, 0xc5, 0x57, 0xc7, 0xc2,
+	0xdf, 0x55, 0xa4, 0x4b, 0xf4, 0x01, 0x5a, 0x1e, 0x44, 0x99, 0x2f, 0x33, 0x7d, 0x4f, 0x97, 0xfa,
+	0x33, 0x3d, 0x56, 0x88, 0xfb, 0x23, 0xb8, 0x91, 0x2e, 0x01, 0x5a, 0x8e, 0xc9, 0xd0, 0x63, 0x9c,
+	0xf8, 0x11, 0x78, 0x22, 0xe5, 0x40, 0x82, 0x32, 0xce, 0xcc, 0x58, 0x71, 0xea, 0x31, 0x19, 0xbe,
+	0xd4, 0xb4, 0x9e, 0x86, 0x15, 0x61, 0xf6, 0x90, 0x19, 0x13, 0x9a, 0x48, 0x48, 0x48, 0xe2, 0x83,
+	0x17, 0x13, 0x1e, 0xd2, 0xa4, 0x8c, 0x33, 0x3b, 0x56, 0x9c, 0xa5, 0x11, 0xde, 0x73, 0x8d, 0x2b,
+	0x22, 0xbd, 0x42, 0x73, 0xaa, 0xa0, 0x08, 0x72, 0xe0, 0x24, 0x04, 0x13, 0x8d, 0x45, 0xaf, 0xc5,
+	0x64, 0xd8, 0x29, 0x11, 0xf8, 0x09, 0x42, 0x7e, 0xc6, 0x73, 0xf0, 0x94, 0xdd, 0xac, 0x35, 0x8c,
+	0xf5, 0xf9, 0xd6, 0x8a, 0x7d, 0x65, 0xf4, 0xec, 0xb6, 0x72, 0x79, 0x7d, 0x98, 0x82, 0x3b, 0xeb,
+	0x9f, 0x2f, 0x71, 0x07, 0x2d, 0x96, 0xad, 0x4d, 0x21, 0xf4, 0x7c, 0x96, 0x0c, 0x68, 0x68, 0xce,
+	0x35, 0x8c, 0xf5, 0x5a, 0xab, 0x71, 0x8d, 0x50, 0xb4, 0xad, 0x0b, 0x61, 0x5b, 0xfb, 0xb9, 0x0b,
+	0xec, 0xf2, 0x41, 0xf3, 0xe3, 0x04, 0xc2, 0x3d, 0x90, 0x32, 0xba, 0xb9, 0x89, 0x7c, 0x86, 0xe6,
+	0x52, 0x4e, 0x7d, 0xf0, 0x04, 0xcb, 0xb8, 0x0f, 0x7a, 0x18, 0xe7, 0x5b, 0x6b, 0xd7, 0x72, 0x2d,
+	0xd2, 0x88, 0x21, 0x91, 0x5d, 0xe5, 0xde, 0xd3, 0xde, 0x6e, 0x2d, 0xbd, 0xd8, 0xe0, 0x37, 0xa8,
+	0x2e, 0x0f, 0x48, 0xea, 0x45, 0x8c, 0xed, 0xf7, 0x89, 0xbf, 0xef, 0x1d, 0xd0, 0x24, 0x60, 0x07,
+	0x7a, 0x02, 0x6b, 0xad, 0x07, 0x76, 0x21, 0x4a, 0xf6, 0xb9, 0x28, 0xd9, 0xdb, 0xa5, 0x28, 0x6d,
+	0xcd, 0xa8, 0xcb, 0xfa, 0xfa, 0x73, 0xd5, 0x70, 0xb1, 0x02, 0x74, 0xca, 0xef, 0xdf, 0xea, 0xcf,
+	0x9b, 0x5f, 0x0c, 0x84, 0x55, 0xf9, 0x3d, 0x49, 0x64, 0x26, 0x6e, 0xa4, 0x09, 0x9b, 0xa8, 0x2a,
+	0x34, 0xbd, 0x2c, 0xff, 0xe1, 0xb5, 0xf2, 0x2f, 0x12, 0x70, 0x4b, 0xd7, 0xe6, 0xb7, 0x49, 0xb4,
+	0xb4, 0x13, 0x50, 0xa9, 0x4c, 0xc5, 0x7d, 0xfd, 0x77, 0x92, 0xf9, 0x17, 0xd1, 0x99, 0xba, 0x25,
+	0xd1, 0xa9, 0xde, 0x92, 0xe8, 0x4c, 0xdf, 0xa8, 0xe8, 0xcc, 0xfc, 0xb3, 0xe8, 0x6c, 0xed, 0x1c,
+	0x9d, 0x5a, 0xc6, 0xf1, 0xa9, 0x65, 0xfc, 0x3a, 0xb5, 0x8c, 0xcf, 0x67, 0x56, 0xe5, 0xf8, 0xcc,
+	0xaa, 0x7c, 0x3f, 0xb3, 0x2a, 0xbb, 0x8f, 0x46, 0x70, 0x2f, 0xf4, 0xbb, 0x6c, 0xef, 0x11, 0x9a,
+	0x38, 0xc5, 0x1b, 0x75, 0x86, 0x4e, 0xf1, 0x6f, 0xae, 0xb9, 0xfd, 0xaa, 0x1e, 0xaf, 0xcd, 0xdf,
+	0x03, 0x00, 0x59, 0xc5, 0xbc, 0x0f, 0x3e, 0x08, 0x00, 0x00,
 }
 
 func (m *CreatePoolProposal) Marshal() (dAtA []byte, err error) {
@@ -158,6 +431,23 @@ func (m *CreatePoolProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.OraclePegConfig != nil {
+		{
+			size, err := m.OraclePegConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.CurveType != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.CurveType))
+		i--
+		dAtA[i] = 0x58
+	}
 	{
 		size := m.MaxLeverage.Size()
 		i -= size
@@ -224,87 +514,1012 @@ func (m *CreatePoolProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		if _, err := m.TradeLimitRatio.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i = encodeVarintGov(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.Pair) > 0 {
-		i -= len(m.Pair)
-		copy(dAtA[i:], m.Pair)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Pair)))
-		i--
-		dAtA[i] = 0x1a
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Pair) > 0 {
+		i -= len(m.Pair)
+		copy(dAtA[i:], m.Pair)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Pair)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SettlePoolProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SettlePoolProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SettlePoolProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.TwapLookbackWindow, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.TwapLookbackWindow):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintGov(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x2a
+	if m.PriceSource != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.PriceSource))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Pair) > 0 {
+		i -= len(m.Pair)
+		copy(dAtA[i:], m.Pair)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Pair)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolStatusProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolStatusProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolStatusProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Pair) > 0 {
+		i -= len(m.Pair)
+		copy(dAtA[i:], m.Pair)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Pair)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EditPoolConfigProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EditPoolConfigProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EditPoolConfigProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxLeverage.Size()
+		i -= size
+		if _, err := m.MaxLeverage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.MaintenanceMarginRatio.Size()
+		i -= size
+		if _, err := m.MaintenanceMarginRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.MaxOracleSpreadRatio.Size()
+		i -= size
+		if _, err := m.MaxOracleSpreadRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.FluctuationLimitRatio.Size()
+		i -= size
+		if _, err := m.FluctuationLimitRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.TradeLimitRatio.Size()
+		i -= size
+		if _, err := m.TradeLimitRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Pair) > 0 {
+		i -= len(m.Pair)
+		copy(dAtA[i:], m.Pair)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Pair)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGov(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CreatePoolProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Pair)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = m.TradeLimitRatio.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = m.QuoteAssetReserve.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = m.BaseAssetReserve.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = m.FluctuationLimitRatio.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = m.MaxOracleSpreadRatio.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = m.MaintenanceMarginRatio.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = m.MaxLeverage.Size()
+	n += 1 + l + sovGov(uint64(l))
+	if m.CurveType != 0 {
+		n += 1 + sovGov(uint64(m.CurveType))
+	}
+	if m.OraclePegConfig != nil {
+		l = m.OraclePegConfig.Size()
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *SettlePoolProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Pair)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.PriceSource != 0 {
+		n += 1 + sovGov(uint64(m.PriceSource))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.TwapLookbackWindow)
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func (m *PoolStatusProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Pair)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovGov(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *EditPoolConfigProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Pair)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = m.TradeLimitRatio.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = m.FluctuationLimitRatio.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = m.MaxOracleSpreadRatio.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = m.MaintenanceMarginRatio.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = m.MaxLeverage.Size()
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func sovGov(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGov(x uint64) (n int) {
+	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CreatePoolProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreatePoolProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreatePoolProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeLimitRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TradeLimitRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAssetReserve", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.QuoteAssetReserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAssetReserve", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseAssetReserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FluctuationLimitRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FluctuationLimitRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOracleSpreadRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxOracleSpreadRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceMarginRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaintenanceMarginRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLeverage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxLeverage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurveType", wireType)
+			}
+			m.CurveType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurveType |= CurveType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OraclePegConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OraclePegConfig == nil {
+				m.OraclePegConfig = &OraclePegConfig{}
+			}
+			if err := m.OraclePegConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SettlePoolProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SettlePoolProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SettlePoolProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceSource", wireType)
+			}
+			m.PriceSource = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriceSource |= SettlementPriceSource(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapLookbackWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.TwapLookbackWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGov(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *CreatePoolProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
-	}
-	l = len(m.Pair)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.TradeLimitRatio.Size()
-	n += 1 + l + sovGov(uint64(l))
-	l = m.QuoteAssetReserve.Size()
-	n += 1 + l + sovGov(uint64(l))
-	l = m.BaseAssetReserve.Size()
-	n += 1 + l + sovGov(uint64(l))
-	l = m.FluctuationLimitRatio.Size()
-	n += 1 + l + sovGov(uint64(l))
-	l = m.MaxOracleSpreadRatio.Size()
-	n += 1 + l + sovGov(uint64(l))
-	l = m.MaintenanceMarginRatio.Size()
-	n += 1 + l + sovGov(uint64(l))
-	l = m.MaxLeverage.Size()
-	n += 1 + l + sovGov(uint64(l))
-	return n
-}
-
-func sovGov(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGov(x uint64) (n int) {
-	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *CreatePoolProposal) Unmarshal(dAtA []byte) error {
+func (m *PoolStatusProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -327,10 +1542,10 @@ func (m *CreatePoolProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreatePoolProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolStatusProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreatePoolProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolStatusProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -430,8 +1645,77 @@ func (m *CreatePoolProposal) Unmarshal(dAtA []byte) error {
 			m.Pair = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= PoolStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EditPoolConfigProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EditPoolConfigProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EditPoolConfigProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TradeLimitRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -459,13 +1743,11 @@ func (m *CreatePoolProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TradeLimitRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAssetReserve", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -493,13 +1775,43 @@ func (m *CreatePoolProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.QuoteAssetReserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Pair = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseAssetReserve", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeLimitRatio", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -527,11 +1839,11 @@ func (m *CreatePoolProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BaseAssetReserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TradeLimitRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field FluctuationLimitRatio", wireType)
 			}
@@ -565,7 +1877,7 @@ func (m *CreatePoolProposal) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field MaxOracleSpreadRatio", wireType)
 			}
@@ -599,7 +1911,7 @@ func (m *CreatePoolProposal) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceMarginRatio", wireType)
 			}
@@ -633,7 +1945,7 @@ func (m *CreatePoolProposal) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 8:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field MaxLeverage", wireType)
 			}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -34,6 +35,234 @@ func TestCreatePoolProposal_ValidateBasic(t *testing.T) {
 			},
 			expectErr: false,
 		},
+
+		"oracle pegged": {
+			m: &CreatePoolProposal{
+				Title:                  "add proposal",
+				Description:            "some weird description",
+				Pair:                   "valid:pair",
+				TradeLimitRatio:        sdk.MustNewDecFromStr("0.10"),
+				QuoteAssetReserve:      sdk.NewDec(1_000_000),
+				BaseAssetReserve:       sdk.NewDec(1_000_000),
+				FluctuationLimitRatio:  sdk.MustNewDecFromStr("0.10"),
+				MaxOracleSpreadRatio:   sdk.MustNewDecFromStr("0.10"),
+				MaintenanceMarginRatio: sdk.MustNewDecFromStr("0.0625"),
+				MaxLeverage:            sdk.MustNewDecFromStr("15"),
+				CurveType:              CurveType_ORACLE_PEGGED,
+				OraclePegConfig: &OraclePegConfig{
+					QuoteDepth:  sdk.NewDec(1_000_000),
+					SpreadRatio: sdk.MustNewDecFromStr("0.001"),
+				},
+			},
+			expectErr: false,
+		},
+
+		"oracle pegged without a config": {
+			m: &CreatePoolProposal{
+				Title:                  "add proposal",
+				Description:            "some weird description",
+				Pair:                   "valid:pair",
+				TradeLimitRatio:        sdk.MustNewDecFromStr("0.10"),
+				QuoteAssetReserve:      sdk.NewDec(1_000_000),
+				BaseAssetReserve:       sdk.NewDec(1_000_000),
+				FluctuationLimitRatio:  sdk.MustNewDecFromStr("0.10"),
+				MaxOracleSpreadRatio:   sdk.MustNewDecFromStr("0.10"),
+				MaintenanceMarginRatio: sdk.MustNewDecFromStr("0.0625"),
+				MaxLeverage:            sdk.MustNewDecFromStr("15"),
+				CurveType:              CurveType_ORACLE_PEGGED,
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := tc.m.ValidateBasic()
+			if err == nil && tc.expectErr {
+				t.Fatal("error expected")
+			} else if err != nil && !tc.expectErr {
+				t.Fatal("unexpected error")
+			}
+		})
+	}
+}
+
+func TestSettlePoolProposal_ValidateBasic(t *testing.T) {
+	type test struct {
+		m         *SettlePoolProposal
+		expectErr bool
+	}
+
+	cases := map[string]test{
+		"invalid pair": {&SettlePoolProposal{
+			Title:       "settle proposal",
+			Description: "some weird description",
+			Pair:        "invalidpair",
+			PriceSource: SettlementPriceSource_PRICEFEED_TWAP,
+		}, true},
+
+		"unspecified price source": {&SettlePoolProposal{
+			Title:       "settle proposal",
+			Description: "some weird description",
+			Pair:        "valid:pair",
+		}, true},
+
+		"mark twap without lookback window": {&SettlePoolProposal{
+			Title:       "settle proposal",
+			Description: "some weird description",
+			Pair:        "valid:pair",
+			PriceSource: SettlementPriceSource_MARK_TWAP,
+		}, true},
+
+		"success pricefeed twap": {&SettlePoolProposal{
+			Title:       "settle proposal",
+			Description: "some weird description",
+			Pair:        "valid:pair",
+			PriceSource: SettlementPriceSource_PRICEFEED_TWAP,
+		}, false},
+
+		"mark twap or pricefeed twap without lookback window": {&SettlePoolProposal{
+			Title:       "settle proposal",
+			Description: "some weird description",
+			Pair:        "valid:pair",
+			PriceSource: SettlementPriceSource_MARK_TWAP_OR_PRICEFEED_TWAP,
+		}, true},
+
+		"success mark twap or pricefeed twap": {&SettlePoolProposal{
+			Title:              "settle proposal",
+			Description:        "some weird description",
+			Pair:               "valid:pair",
+			PriceSource:        SettlementPriceSource_MARK_TWAP_OR_PRICEFEED_TWAP,
+			TwapLookbackWindow: 15 * time.Minute,
+		}, false},
+
+		"success mark twap": {&SettlePoolProposal{
+			Title:              "settle proposal",
+			Description:        "some weird description",
+			Pair:               "valid:pair",
+			PriceSource:        SettlementPriceSource_MARK_TWAP,
+			TwapLookbackWindow: 15 * time.Minute,
+		}, false},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := tc.m.ValidateBasic()
+			if err == nil && tc.expectErr {
+				t.Fatal("error expected")
+			} else if err != nil && !tc.expectErr {
+				t.Fatal("unexpected error")
+			}
+		})
+	}
+}
+
+func TestPoolStatusProposal_ValidateBasic(t *testing.T) {
+	type test struct {
+		m         *PoolStatusProposal
+		expectErr bool
+	}
+
+	cases := map[string]test{
+		"invalid pair": {&PoolStatusProposal{
+			Title:       "pool status proposal",
+			Description: "some weird description",
+			Pair:        "invalidpair",
+			Status:      PoolStatus_FROZEN,
+		}, true},
+
+		"unspecified status": {&PoolStatusProposal{
+			Title:       "pool status proposal",
+			Description: "some weird description",
+			Pair:        "valid:pair",
+		}, true},
+
+		"settled status": {&PoolStatusProposal{
+			Title:       "pool status proposal",
+			Description: "some weird description",
+			Pair:        "valid:pair",
+			Status:      PoolStatus_SETTLED,
+		}, true},
+
+		"success reduce only": {&PoolStatusProposal{
+			Title:       "pool status proposal",
+			Description: "some weird description",
+			Pair:        "valid:pair",
+			Status:      PoolStatus_REDUCE_ONLY,
+		}, false},
+
+		"success active": {&PoolStatusProposal{
+			Title:       "pool status proposal",
+			Description: "some weird description",
+			Pair:        "valid:pair",
+			Status:      PoolStatus_ACTIVE,
+		}, false},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := tc.m.ValidateBasic()
+			if err == nil && tc.expectErr {
+				t.Fatal("error expected")
+			} else if err != nil && !tc.expectErr {
+				t.Fatal("unexpected error")
+			}
+		})
+	}
+}
+
+func TestEditPoolConfigProposal_ValidateBasic(t *testing.T) {
+	type test struct {
+		m         *EditPoolConfigProposal
+		expectErr bool
+	}
+
+	cases := map[string]test{
+		"invalid pair": {&EditPoolConfigProposal{
+			Title:                  "edit pool config proposal",
+			Description:            "some weird description",
+			Pair:                   "invalidpair",
+			TradeLimitRatio:        sdk.MustNewDecFromStr("0.1"),
+			FluctuationLimitRatio:  sdk.MustNewDecFromStr("0.1"),
+			MaxOracleSpreadRatio:   sdk.MustNewDecFromStr("0.1"),
+			MaintenanceMarginRatio: sdk.MustNewDecFromStr("0.0625"),
+			MaxLeverage:            sdk.MustNewDecFromStr("10"),
+		}, true},
+
+		"missing ratio": {&EditPoolConfigProposal{
+			Title:                  "edit pool config proposal",
+			Description:            "some weird description",
+			Pair:                   "valid:pair",
+			TradeLimitRatio:        sdk.MustNewDecFromStr("0.1"),
+			FluctuationLimitRatio:  sdk.MustNewDecFromStr("0.1"),
+			MaxOracleSpreadRatio:   sdk.MustNewDecFromStr("0.1"),
+			MaintenanceMarginRatio: sdk.MustNewDecFromStr("0.0625"),
+		}, true},
+
+		"trade limit ratio above 1": {&EditPoolConfigProposal{
+			Title:                  "edit pool config proposal",
+			Description:            "some weird description",
+			Pair:                   "valid:pair",
+			TradeLimitRatio:        sdk.MustNewDecFromStr("1.1"),
+			FluctuationLimitRatio:  sdk.MustNewDecFromStr("0.1"),
+			MaxOracleSpreadRatio:   sdk.MustNewDecFromStr("0.1"),
+			MaintenanceMarginRatio: sdk.MustNewDecFromStr("0.0625"),
+			MaxLeverage:            sdk.MustNewDecFromStr("10"),
+		}, true},
+
+		"success": {&EditPoolConfigProposal{
+			Title:                  "edit pool config proposal",
+			Description:            "some weird description",
+			Pair:                   "valid:pair",
+			TradeLimitRatio:        sdk.MustNewDecFromStr("0.1"),
+			FluctuationLimitRatio:  sdk.MustNewDecFromStr("0.1"),
+			MaxOracleSpreadRatio:   sdk.MustNewDecFromStr("0.1"),
+			MaintenanceMarginRatio: sdk.MustNewDecFromStr("0.0625"),
+			MaxLeverage:            sdk.MustNewDecFromStr("10"),
+		}, false},
 	}
 
 	for name, tc := range cases {
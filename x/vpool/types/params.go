@@ -0,0 +1,93 @@
+package types
+
+import (
+	fmt "fmt"
+	"time"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// ParamKeyTable the param key table for the vpool module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// ParamSetPairs get the params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(
+			[]byte("SnapshotRetention"),
+			&p.SnapshotRetention,
+			validateNonNegativeDuration,
+		),
+		paramtypes.NewParamSetPair(
+			[]byte("SnapshotDownsampleAfter"),
+			&p.SnapshotDownsampleAfter,
+			validateNonNegativeDuration,
+		),
+		paramtypes.NewParamSetPair(
+			[]byte("SnapshotDownsampleInterval"),
+			&p.SnapshotDownsampleInterval,
+			validateNonNegativeDuration,
+		),
+	}
+}
+
+// NewParams creates a new Params instance
+func NewParams(
+	snapshotRetention time.Duration,
+	snapshotDownsampleAfter time.Duration,
+	snapshotDownsampleInterval time.Duration,
+) Params {
+	return Params{
+		SnapshotRetention:          snapshotRetention,
+		SnapshotDownsampleAfter:    snapshotDownsampleAfter,
+		SnapshotDownsampleInterval: snapshotDownsampleInterval,
+	}
+}
+
+// DefaultParams returns a default set of parameters.
+func DefaultParams() Params {
+	return NewParams(
+		/* snapshotRetention */ 7*24*time.Hour,
+		/* snapshotDownsampleAfter */ time.Hour,
+		/* snapshotDownsampleInterval */ time.Minute,
+	)
+}
+
+// Validate validates the set of params
+func (p *Params) Validate() error {
+	for _, duration := range []time.Duration{
+		p.SnapshotRetention, p.SnapshotDownsampleAfter, p.SnapshotDownsampleInterval,
+	} {
+		if err := validateNonNegativeDuration(duration); err != nil {
+			return err
+		}
+	}
+
+	if p.SnapshotDownsampleAfter == 0 {
+		return nil
+	}
+	if p.SnapshotDownsampleInterval == 0 {
+		return fmt.Errorf("snapshot downsample interval must be positive when downsampling")
+	}
+	if p.SnapshotRetention != 0 && p.SnapshotDownsampleAfter >= p.SnapshotRetention {
+		return fmt.Errorf(
+			"snapshot downsample after (%s) must be below the snapshot retention (%s)",
+			p.SnapshotDownsampleAfter, p.SnapshotRetention)
+	}
+	return nil
+}
+
+func validateNonNegativeDuration(i interface{}) error {
+	val, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if val < 0 {
+		return fmt.Errorf("duration must not be negative, current value is %s", val.String())
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParams_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		params    Params
+		expectErr bool
+	}{
+		"default params": {
+			params:    DefaultParams(),
+			expectErr: false,
+		},
+		"pruning and downsampling disabled": {
+			params:    NewParams(0, 0, 0),
+			expectErr: false,
+		},
+		"downsampling without pruning": {
+			params:    NewParams(0, time.Hour, time.Minute),
+			expectErr: false,
+		},
+		"negative retention": {
+			params:    NewParams(-time.Hour, 0, 0),
+			expectErr: true,
+		},
+		"downsampling without interval": {
+			params:    NewParams(24*time.Hour, time.Hour, 0),
+			expectErr: true,
+		},
+		"downsampling after the retention": {
+			params:    NewParams(time.Hour, time.Hour, time.Minute),
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := tc.params.Validate()
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -6,6 +6,14 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// MaxPoolDepthSteps is the maximum number of levels of a pool depth ladder.
+const MaxPoolDepthSteps = 100
+
+// MaxOraclePegDepthRatio bounds the quote reserve of an anchored ORACLE_PEGGED
+// pool with a net position between its quote depth divided and multiplied by
+// the ratio.
+const MaxOraclePegDepthRatio = 10
+
 // HasEnoughQuoteReserve returns true if there is enough quote reserve based on
 // quoteReserve * tradeLimitRatio
 func (p *VPool) HasEnoughQuoteReserve(quoteAmount sdk.Dec) bool {
@@ -55,6 +63,15 @@ func (p *VPool) GetBaseAmountByQuoteAmount(
 	baseAssetsAfter := invariant.Quo(quoteAssetsAfter)
 	baseAmount = baseAssetsAfter.Sub(p.BaseAssetReserve).Abs()
 
+	if p.IsOraclePegged() {
+		// less base for the quote added, more base for the quote removed
+		if dir == Direction_ADD_TO_POOL {
+			baseAmount = baseAmount.Quo(sdk.OneDec().Add(p.OraclePegConfig.SpreadRatio))
+		} else {
+			baseAmount = baseAmount.Quo(sdk.OneDec().Sub(p.OraclePegConfig.SpreadRatio))
+		}
+	}
+
 	return baseAmount, nil
 }
 
@@ -97,9 +114,242 @@ func (p *VPool) GetQuoteAmountByBaseAmount(
 	quoteAssetsAfter := invariant.Quo(baseAssetsAfter)
 	quoteAmount = quoteAssetsAfter.Sub(p.QuoteAssetReserve).Abs()
 
+	if p.IsOraclePegged() {
+		// less quote for the base added, more quote for the base removed
+		if dir == Direction_ADD_TO_POOL {
+			quoteAmount = quoteAmount.Mul(sdk.OneDec().Sub(p.OraclePegConfig.SpreadRatio))
+		} else {
+			quoteAmount = quoteAmount.Mul(sdk.OneDec().Add(p.OraclePegConfig.SpreadRatio))
+		}
+	}
+
 	return quoteAmount, nil
 }
 
+/*
+GetMaxQuoteAmount returns the largest amount of quote asset a swap in a direction
+can currently trade: the swap stays within the trade limit ratio of both
+reserves and, when the fluctuation limit ratio is set, within the fluctuation
+limit of the mark price of the last snapshot. The base reserve of an
+oracle-pegged pool moves by the curve amount less the spread, as in
+GetBaseAmountByQuoteAmount.
+
+args:
+  - dir: add to pool or remove from pool
+  - lastSnapshot: the snapshot the fluctuation limit is checked against
+
+ret:
+  - maxQuoteAmount: the largest quote amount allowed, zero if no swap is allowed
+  - err: error
+*/
+func (p *VPool) GetMaxQuoteAmount(dir Direction, lastSnapshot ReserveSnapshot) (maxQuoteAmount sdk.Dec, err error) {
+	invariant := p.QuoteAssetReserve.Mul(p.BaseAssetReserve) // x * y = k
+	maxQuoteAmount = p.QuoteAssetReserve.Mul(p.TradeLimitRatio)
+
+	// the base swapped is the curve amount divided by the spread factor s
+	spreadFactor := sdk.OneDec()
+
+	switch dir {
+	case Direction_ADD_TO_POOL:
+		if p.IsOraclePegged() {
+			spreadFactor = sdk.OneDec().Add(p.OraclePegConfig.SpreadRatio)
+		}
+		// the base removed stays within the trade limit: y + dy <= k / (x * (1 - tradeLimitRatio * s))
+		baseTradeLimitRatio := p.TradeLimitRatio.Mul(spreadFactor)
+		if baseTradeLimitRatio.LT(sdk.OneDec()) {
+			baseAfter := p.BaseAssetReserve.Mul(sdk.OneDec().Sub(baseTradeLimitRatio))
+			maxQuoteAmount = sdk.MinDec(maxQuoteAmount, invariant.Quo(baseAfter).Sub(p.QuoteAssetReserve))
+		}
+		// the mark price stays below the upper limit
+		if !p.FluctuationLimitRatio.IsZero() {
+			quoteAfter, err := p.getQuoteReserveAtMarkPrice(
+				lastSnapshot.GetUpperMarkPriceFluctuationLimit(p.FluctuationLimitRatio), spreadFactor)
+			if err != nil {
+				return sdk.Dec{}, err
+			}
+			maxQuoteAmount = sdk.MinDec(maxQuoteAmount, quoteAfter.Sub(p.QuoteAssetReserve))
+		}
+	case Direction_REMOVE_FROM_POOL:
+		if p.IsOraclePegged() {
+			spreadFactor = sdk.OneDec().Sub(p.OraclePegConfig.SpreadRatio)
+		}
+		// the base added stays within the trade limit: y - dy >= k / (x * (1 + tradeLimitRatio * s))
+		baseAfter := p.BaseAssetReserve.Mul(sdk.OneDec().Add(p.TradeLimitRatio.Mul(spreadFactor)))
+		maxQuoteAmount = sdk.MinDec(maxQuoteAmount, p.QuoteAssetReserve.Sub(invariant.Quo(baseAfter)))
+		// the mark price stays above the lower limit
+		if !p.FluctuationLimitRatio.IsZero() {
+			quoteAfter, err := p.getQuoteReserveAtMarkPrice(
+				lastSnapshot.GetLowerMarkPriceFluctuationLimit(p.FluctuationLimitRatio), spreadFactor)
+			if err != nil {
+				return sdk.Dec{}, err
+			}
+			maxQuoteAmount = sdk.MinDec(maxQuoteAmount, p.QuoteAssetReserve.Sub(quoteAfter))
+		}
+	default:
+		return sdk.Dec{}, fmt.Errorf("invalid direction: %s", dir)
+	}
+
+	return sdk.MaxDec(maxQuoteAmount, sdk.ZeroDec()), nil
+}
+
+// getQuoteReserveAtMarkPrice returns the quote reserve q reached by a swap that ends at the given mark price,
+// when the base reserve moves by the curve amount divided by the spread factor s:
+// s * q^2 / (x * ((s - 1) * q + y)) = price, which is q^2 / k = price on the constant product curve.
+func (p *VPool) getQuoteReserveAtMarkPrice(price sdk.Dec, spreadFactor sdk.Dec) (sdk.Dec, error) {
+	invariant := p.QuoteAssetReserve.Mul(p.BaseAssetReserve) // x * y = k
+	halfLinear := price.Mul(p.BaseAssetReserve).Mul(spreadFactor.Sub(sdk.OneDec())).Quo(spreadFactor.MulInt64(2))
+	root, err := halfLinear.Mul(halfLinear).Add(price.Mul(invariant).Quo(spreadFactor)).ApproxSqrt()
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	return halfLinear.Add(root), nil
+}
+
+// IsOraclePegged returns true if the pool is anchored around the pricefeed price
+// and quotes its swaps with a spread.
+func (p *VPool) IsOraclePegged() bool {
+	return p.CurveType == CurveType_ORACLE_PEGGED && p.OraclePegConfig != nil
+}
+
+// ValidateCurve checks the curve type and that only ORACLE_PEGGED pools, and
+// all of them, have a valid oracle peg config.
+func ValidateCurve(curveType CurveType, oraclePegConfig *OraclePegConfig) error {
+	switch curveType {
+	case CurveType_CURVE_TYPE_UNSPECIFIED, CurveType_CONSTANT_PRODUCT:
+		if oraclePegConfig != nil {
+			return fmt.Errorf("the %s curve has no oracle peg config", curveType)
+		}
+		return nil
+	case CurveType_ORACLE_PEGGED:
+		if oraclePegConfig == nil {
+			return fmt.Errorf("the %s curve requires an oracle peg config", curveType)
+		}
+		return oraclePegConfig.Validate()
+	default:
+		return fmt.Errorf("invalid curve type: %d", curveType)
+	}
+}
+
+// Validate checks that the quote depth is positive and the spread ratio is
+// between 0 and 1.
+func (c *OraclePegConfig) Validate() error {
+	if c.QuoteDepth.IsNil() || !c.QuoteDepth.IsPositive() {
+		return fmt.Errorf("oracle peg quote depth must be positive, not: %s", c.QuoteDepth)
+	}
+	if c.SpreadRatio.IsNil() || c.SpreadRatio.IsNegative() || c.SpreadRatio.GTE(sdk.OneDec()) {
+		return fmt.Errorf("oracle peg spread ratio must be 0 <= ratio < 1, not: %s", c.SpreadRatio)
+	}
+	return nil
+}
+
+// GetAnchoredReserves returns the reserves of the curve anchored at a price:
+// the quote depth, and the base reserve putting the mark price at the price.
+func (c *OraclePegConfig) GetAnchoredReserves(price sdk.Dec) (baseReserve sdk.Dec, quoteReserve sdk.Dec) {
+	return c.QuoteDepth.Quo(price), c.QuoteDepth
+}
+
+/*
+GetValueNeutralReserves returns the reserves anchoring an ORACLE_PEGGED pool
+as close as possible to a price while keeping the value of the net position of
+its traders, what closing it on the pool pays for a net long or costs for a net
+short. The quote reserve is the depth at which the net position is worth the
+same at the anchored price, within MaxOraclePegDepthRatio of the quote depth of
+the curve. The anchored price is limited to the prices such a depth exists for,
+so a move against the net position is followed as far as the depth allows.
+
+Without a net position, the pool is anchored at the price with the quote depth
+of the curve.
+
+args:
+  - price: the price to anchor the pool at
+
+ret:
+  - baseReserve: the anchored base reserve
+  - quoteReserve: the anchored quote reserve
+  - anchorPrice: the price the pool is anchored at, its new mark price
+  - err: error if the net position cannot be closed on the pool or is worth
+    more than the deepest curve allows
+*/
+func (p *VPool) GetValueNeutralReserves(price sdk.Dec) (baseReserve, quoteReserve, anchorPrice sdk.Dec, err error) {
+	netBaseSize := p.GetNetBaseSize()
+	if netBaseSize.IsZero() {
+		baseReserve, quoteReserve = p.OraclePegConfig.GetAnchoredReserves(price)
+		return baseReserve, quoteReserve, price, nil
+	}
+
+	minDepth := p.OraclePegConfig.QuoteDepth.QuoInt64(MaxOraclePegDepthRatio)
+	maxDepth := p.OraclePegConfig.QuoteDepth.MulInt64(MaxOraclePegDepthRatio)
+
+	if netBaseSize.IsPositive() {
+		// closing the net long n at depth y and price P pays y*n*P / (y + n*P) * (1 - spread)
+		value, err := p.GetQuoteAmountByBaseAmount(Direction_ADD_TO_POOL, netBaseSize)
+		if err != nil {
+			return sdk.Dec{}, sdk.Dec{}, sdk.Dec{}, err
+		}
+		bidRatio := sdk.OneDec().Sub(p.OraclePegConfig.SpreadRatio)
+		// the price keeping the value at a depth, lower for a deeper curve
+		priceAtDepth := func(depth sdk.Dec) (sdk.Dec, bool) {
+			excess := depth.Mul(bidRatio).Sub(value)
+			if !excess.IsPositive() {
+				return sdk.Dec{}, false
+			}
+			return value.Mul(depth).Quo(netBaseSize.Mul(excess)), true
+		}
+
+		minPrice, ok := priceAtDepth(maxDepth)
+		if !ok {
+			return sdk.Dec{}, sdk.Dec{}, sdk.Dec{}, fmt.Errorf(
+				"the net long %s of %s is worth more than the deepest curve allows", netBaseSize, p.Pair)
+		}
+		anchorPrice = sdk.MaxDec(price, minPrice)
+		if maxPrice, ok := priceAtDepth(minDepth); ok {
+			anchorPrice = sdk.MinDec(anchorPrice, maxPrice)
+		}
+
+		notional := netBaseSize.Mul(anchorPrice)
+		quoteReserve = value.Mul(notional).Quo(notional.Mul(bidRatio).Sub(value))
+	} else {
+		// closing the net short n at depth y and price P costs y*n*P / (y - n*P) * (1 + spread)
+		size := netBaseSize.Abs()
+		cost, err := p.GetQuoteAmountByBaseAmount(Direction_REMOVE_FROM_POOL, size)
+		if err != nil {
+			return sdk.Dec{}, sdk.Dec{}, sdk.Dec{}, err
+		}
+		askRatio := sdk.OneDec().Add(p.OraclePegConfig.SpreadRatio)
+		// the price keeping the cost at a depth, higher for a deeper curve
+		priceAtDepth := func(depth sdk.Dec) sdk.Dec {
+			return cost.Mul(depth).Quo(size.Mul(cost.Add(depth.Mul(askRatio))))
+		}
+
+		anchorPrice = sdk.MinDec(sdk.MaxDec(price, priceAtDepth(minDepth)), priceAtDepth(maxDepth))
+
+		notional := size.Mul(anchorPrice)
+		quoteReserve = cost.Mul(notional).Quo(cost.Sub(notional.Mul(askRatio)))
+	}
+
+	return quoteReserve.Quo(anchorPrice), quoteReserve, anchorPrice, nil
+}
+
+// GetNetBaseSize returns the base asset held net by the traders of an
+// ORACLE_PEGGED pool, zero when it isn't tracked.
+func (p *VPool) GetNetBaseSize() sdk.Dec {
+	if p.NetBaseSize == nil {
+		return sdk.ZeroDec()
+	}
+	return *p.NetBaseSize
+}
+
+// AddToNetBaseSize adds the base asset the traders of an ORACLE_PEGGED pool
+// took out of it, negative for the base they put in, to their net base size.
+// It is only tracked for the ORACLE_PEGGED pools.
+func (p *VPool) AddToNetBaseSize(amount sdk.Dec) {
+	if !p.IsOraclePegged() {
+		return
+	}
+	netBaseSize := p.GetNetBaseSize().Add(amount)
+	p.NetBaseSize = &netBaseSize
+}
+
 // IncreaseBaseAssetReserve increases the quote reserve by amount
 func (p *VPool) IncreaseBaseAssetReserve(amount sdk.Dec) {
 	p.BaseAssetReserve = p.BaseAssetReserve.Add(amount)
@@ -133,9 +383,8 @@ func (m *VPool) Validate() error {
 		return fmt.Errorf("invalid asset pair: %w", err)
 	}
 
-	// trade limit ratio always between 0 and 1
-	if m.TradeLimitRatio.LT(sdk.ZeroDec()) || m.TradeLimitRatio.GT(sdk.OneDec()) {
-		return fmt.Errorf("trade limit ratio must be 0 <= ratio <= 1")
+	if err := m.ValidateConfig(); err != nil {
+		return err
 	}
 
 	// quote asset reserve always > 0
@@ -148,6 +397,33 @@ func (m *VPool) Validate() error {
 		return fmt.Errorf("base asset reserve must be > 0")
 	}
 
+	if _, ok := PoolStatus_name[int32(m.Status)]; !ok {
+		return fmt.Errorf("invalid pool status: %d", m.Status)
+	}
+
+	if err := ValidateCurve(m.CurveType, m.OraclePegConfig); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ValidateConfig checks the risk parameters of the pool, the ones governance
+// can edit after the pool is created.
+func (m *VPool) ValidateConfig() error {
+	for _, ratio := range []sdk.Dec{
+		m.TradeLimitRatio, m.FluctuationLimitRatio, m.MaxOracleSpreadRatio, m.MaintenanceMarginRatio, m.MaxLeverage,
+	} {
+		if ratio.IsNil() {
+			return fmt.Errorf("the pool config must set every ratio")
+		}
+	}
+
+	// trade limit ratio always between 0 and 1
+	if m.TradeLimitRatio.LT(sdk.ZeroDec()) || m.TradeLimitRatio.GT(sdk.OneDec()) {
+		return fmt.Errorf("trade limit ratio must be 0 <= ratio <= 1")
+	}
+
 	// fluctuation limit ratio between 0 and 1
 	if m.FluctuationLimitRatio.LT(sdk.ZeroDec()) || m.FluctuationLimitRatio.GT(sdk.OneDec()) {
 		return fmt.Errorf("fluctuation limit ratio must be 0 <= ratio <= 1")
@@ -173,6 +449,15 @@ func (m *VPool) Validate() error {
 	return nil
 }
 
+// GetPoolStatus returns the status of the pool, pools created before the
+// statuses existed being ACTIVE.
+func (m *VPool) GetPoolStatus() PoolStatus {
+	if m.Status == PoolStatus_POOL_STATUS_UNSPECIFIED {
+		return PoolStatus_ACTIVE
+	}
+	return m.Status
+}
+
 // GetMarkPrice returns the price of the asset.
 func (p VPool) GetMarkPrice() sdk.Dec {
 	if p.BaseAssetReserve.IsNil() || p.BaseAssetReserve.IsZero() ||
@@ -226,3 +511,18 @@ func (p VPool) IsOverSpreadLimit(indexPrice sdk.Dec) bool {
 	return p.GetMarkPrice().Sub(indexPrice).
 		Quo(indexPrice).Abs().GTE(p.MaxOracleSpreadRatio)
 }
+
+// SwapEstimate is the outcome of a hypothetical swap on a vpool, and the limit
+// checks the swap would fail.
+type SwapEstimate struct {
+	// the base asset amount when swapping quote assets, the quote asset amount
+	// when swapping base assets
+	Amount sdk.Dec
+	// the mark price of the vpool after the swap
+	MarkPriceAfter sdk.Dec
+	// whether the swap moves more reserves than the trade limit ratio allows
+	OverTradingLimit bool
+	// whether the swap moves the mark price beyond the fluctuation limit ratio
+	// from the last snapshot
+	OverFluctuationLimit bool
+}
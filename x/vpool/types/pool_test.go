@@ -563,3 +563,283 @@ func TestVPool_IsOverFluctuationLimit(t *testing.T) {
 		})
 	}
 }
+
+func TestVPool_GetMaxQuoteAmount(t *testing.T) {
+	tests := []struct {
+		name                  string
+		direction             Direction
+		tradeLimitRatio       sdk.Dec
+		fluctuationLimitRatio sdk.Dec
+	}{
+		{
+			name:                  "buying is bound by the quote trade limit",
+			direction:             Direction_ADD_TO_POOL,
+			tradeLimitRatio:       sdk.MustNewDecFromStr("0.9"),
+			fluctuationLimitRatio: sdk.ZeroDec(),
+		},
+		{
+			name:                  "buying is bound by the base trade limit",
+			direction:             Direction_ADD_TO_POOL,
+			tradeLimitRatio:       sdk.MustNewDecFromStr("0.1"),
+			fluctuationLimitRatio: sdk.ZeroDec(),
+		},
+		{
+			name:                  "buying is bound by the fluctuation limit",
+			direction:             Direction_ADD_TO_POOL,
+			tradeLimitRatio:       sdk.MustNewDecFromStr("0.9"),
+			fluctuationLimitRatio: sdk.MustNewDecFromStr("0.1"),
+		},
+		{
+			name:                  "selling is bound by the base trade limit",
+			direction:             Direction_REMOVE_FROM_POOL,
+			tradeLimitRatio:       sdk.MustNewDecFromStr("0.9"),
+			fluctuationLimitRatio: sdk.ZeroDec(),
+		},
+		{
+			name:                  "selling is bound by the fluctuation limit",
+			direction:             Direction_REMOVE_FROM_POOL,
+			tradeLimitRatio:       sdk.MustNewDecFromStr("0.9"),
+			fluctuationLimitRatio: sdk.MustNewDecFromStr("0.1"),
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			pool := VPool{
+				Pair:                  common.Pair_BTC_NUSD,
+				QuoteAssetReserve:     sdk.NewDec(10_000_000),
+				BaseAssetReserve:      sdk.NewDec(5_000_000),
+				TradeLimitRatio:       tc.tradeLimitRatio,
+				FluctuationLimitRatio: tc.fluctuationLimitRatio,
+			}
+			snapshot := NewReserveSnapshot(common.Pair_BTC_NUSD, pool.BaseAssetReserve, pool.QuoteAssetReserve, time.Now())
+
+			allowed := func(quoteAmount sdk.Dec) bool {
+				baseAmount, err := pool.GetBaseAmountByQuoteAmount(tc.direction, quoteAmount)
+				require.NoError(t, err)
+				poolAfter := pool
+				if tc.direction == Direction_ADD_TO_POOL {
+					poolAfter.DecreaseBaseAssetReserve(baseAmount)
+					poolAfter.IncreaseQuoteAssetReserve(quoteAmount)
+				} else {
+					poolAfter.IncreaseBaseAssetReserve(baseAmount)
+					poolAfter.DecreaseQuoteAssetReserve(quoteAmount)
+				}
+				return pool.HasEnoughQuoteReserve(quoteAmount) && pool.HasEnoughBaseReserve(baseAmount) &&
+					!poolAfter.IsOverFluctuationLimitInRelationWithSnapshot(snapshot)
+			}
+
+			maxQuoteAmount, err := pool.GetMaxQuoteAmount(tc.direction, snapshot)
+			require.NoError(t, err)
+			require.True(t, maxQuoteAmount.IsPositive())
+			assert.True(t, allowed(maxQuoteAmount.Mul(sdk.MustNewDecFromStr("0.999999"))))
+			assert.False(t, allowed(maxQuoteAmount.Mul(sdk.MustNewDecFromStr("1.000001"))))
+		})
+	}
+
+	t.Run("no swap is allowed beyond the fluctuation limit", func(t *testing.T) {
+		pool := VPool{
+			Pair:                  common.Pair_BTC_NUSD,
+			QuoteAssetReserve:     sdk.NewDec(10_000_000),
+			BaseAssetReserve:      sdk.NewDec(5_000_000),
+			TradeLimitRatio:       sdk.MustNewDecFromStr("0.9"),
+			FluctuationLimitRatio: sdk.MustNewDecFromStr("0.1"),
+		}
+		// the mark price of the snapshot is 4, twice the mark price of the pool
+		snapshot := NewReserveSnapshot(common.Pair_BTC_NUSD, sdk.NewDec(5_000_000), sdk.NewDec(20_000_000), time.Now())
+
+		maxQuoteAmount, err := pool.GetMaxQuoteAmount(Direction_REMOVE_FROM_POOL, snapshot)
+		require.NoError(t, err)
+		assert.True(t, maxQuoteAmount.IsZero())
+	})
+}
+
+func TestVPool_OraclePeggedSwapAmounts(t *testing.T) {
+	pool := &VPool{
+		Pair:              common.Pair_BTC_NUSD,
+		QuoteAssetReserve: sdk.NewDec(1000),
+		BaseAssetReserve:  sdk.NewDec(1000),
+		CurveType:         CurveType_ORACLE_PEGGED,
+		OraclePegConfig: &OraclePegConfig{
+			QuoteDepth:  sdk.NewDec(1000),
+			SpreadRatio: sdk.MustNewDecFromStr("0.25"),
+		},
+	}
+
+	t.Log("adding 1000 quote removes 500 base from the curve, 400 with the spread")
+	baseAmount, err := pool.GetBaseAmountByQuoteAmount(Direction_ADD_TO_POOL, sdk.NewDec(1000))
+	require.NoError(t, err)
+	assert.EqualValues(t, sdk.NewDec(400), baseAmount)
+
+	t.Log("removing 500 quote adds 1000 base to the curve, 1000 / 0.75 with the spread")
+	baseAmount, err = pool.GetBaseAmountByQuoteAmount(Direction_REMOVE_FROM_POOL, sdk.NewDec(500))
+	require.NoError(t, err)
+	assert.EqualValues(t, sdk.MustNewDecFromStr("1333.333333333333333333"), baseAmount)
+
+	t.Log("adding 1000 base removes 500 quote from the curve, 375 with the spread")
+	quoteAmount, err := pool.GetQuoteAmountByBaseAmount(Direction_ADD_TO_POOL, sdk.NewDec(1000))
+	require.NoError(t, err)
+	assert.EqualValues(t, sdk.NewDec(375), quoteAmount)
+
+	t.Log("removing 500 base adds 1000 quote to the curve, 1250 with the spread")
+	quoteAmount, err = pool.GetQuoteAmountByBaseAmount(Direction_REMOVE_FROM_POOL, sdk.NewDec(500))
+	require.NoError(t, err)
+	assert.EqualValues(t, sdk.NewDec(1250), quoteAmount)
+}
+
+func TestVPool_GetValueNeutralReserves(t *testing.T) {
+	newPool := func(netBaseSize sdk.Dec) *VPool {
+		return &VPool{
+			Pair:              common.Pair_BTC_NUSD,
+			QuoteAssetReserve: sdk.NewDec(1000),
+			BaseAssetReserve:  sdk.NewDec(1000),
+			CurveType:         CurveType_ORACLE_PEGGED,
+			OraclePegConfig: &OraclePegConfig{
+				QuoteDepth:  sdk.NewDec(1000),
+				SpreadRatio: sdk.MustNewDecFromStr("0.1"),
+			},
+			NetBaseSize: &netBaseSize,
+		}
+	}
+	// closeValue is what closing the net position on the pool pays, negative for what it costs
+	closeValue := func(t *testing.T, pool *VPool) float64 {
+		netBaseSize := pool.GetNetBaseSize()
+		if netBaseSize.IsPositive() {
+			value, err := pool.GetQuoteAmountByBaseAmount(Direction_ADD_TO_POOL, netBaseSize)
+			require.NoError(t, err)
+			return value.MustFloat64()
+		}
+		cost, err := pool.GetQuoteAmountByBaseAmount(Direction_REMOVE_FROM_POOL, netBaseSize.Abs())
+		require.NoError(t, err)
+		return -cost.MustFloat64()
+	}
+
+	cases := map[string]struct {
+		netBaseSize         sdk.Dec
+		price               sdk.Dec
+		expectedAnchorPrice float64
+		// zero when the depth isn't at a bound
+		expectedQuoteReserve float64
+	}{
+		"no net position, anchored at the quote depth": {
+			netBaseSize: sdk.ZeroDec(), price: sdk.NewDec(2), expectedAnchorPrice: 2, expectedQuoteReserve: 1000,
+		},
+		"net long at the mark price keeps the reserves": {
+			netBaseSize: sdk.NewDec(100), price: sdk.OneDec(), expectedAnchorPrice: 1, expectedQuoteReserve: 1000,
+		},
+		"net long, price up": {
+			netBaseSize: sdk.NewDec(100), price: sdk.NewDec(2), expectedAnchorPrice: 2,
+		},
+		"net long, price down to the deepest curve": {
+			// 81.81 * 10000 / (100 * (0.9 * 10000 - 81.81))
+			netBaseSize: sdk.NewDec(100), price: sdk.MustNewDecFromStr("0.5"), expectedAnchorPrice: 0.917431, expectedQuoteReserve: 10_000,
+		},
+		"net long, price up to the shallowest curve": {
+			// 81.81 * 100 / (100 * (0.9 * 100 - 81.81))
+			netBaseSize: sdk.NewDec(100), price: sdk.NewDec(20), expectedAnchorPrice: 10, expectedQuoteReserve: 100,
+		},
+		"net short at the mark price keeps the reserves": {
+			netBaseSize: sdk.NewDec(-100), price: sdk.OneDec(), expectedAnchorPrice: 1, expectedQuoteReserve: 1000,
+		},
+		"net short, price down": {
+			netBaseSize: sdk.NewDec(-100), price: sdk.MustNewDecFromStr("0.8"), expectedAnchorPrice: 0.8,
+		},
+		"net short, price up to the deepest curve": {
+			// 122.22 * 10000 / (100 * (122.22 + 1.1 * 10000))
+			netBaseSize: sdk.NewDec(-100), price: sdk.NewDec(2), expectedAnchorPrice: 1.098901, expectedQuoteReserve: 10_000,
+		},
+		"net short, price down to the shallowest curve": {
+			// 122.22 * 100 / (100 * (122.22 + 1.1 * 100))
+			netBaseSize: sdk.NewDec(-100), price: sdk.MustNewDecFromStr("0.1"), expectedAnchorPrice: 0.526316, expectedQuoteReserve: 100,
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			pool := newPool(tc.netBaseSize)
+			valueBefore := closeValue(t, pool)
+
+			baseReserve, quoteReserve, anchorPrice, err := pool.GetValueNeutralReserves(tc.price)
+			require.NoError(t, err)
+			assert.InDelta(t, tc.expectedAnchorPrice, anchorPrice.MustFloat64(), 1e-6)
+			assert.InDelta(t, anchorPrice.MustFloat64(), quoteReserve.Quo(baseReserve).MustFloat64(), 1e-9)
+			if tc.expectedQuoteReserve != 0 {
+				assert.InDelta(t, tc.expectedQuoteReserve, quoteReserve.MustFloat64(), 1e-6)
+			}
+
+			t.Log("the net position is worth the same on the anchored reserves")
+			pool.BaseAssetReserve, pool.QuoteAssetReserve = baseReserve, quoteReserve
+			assert.InDelta(t, valueBefore, closeValue(t, pool), 1e-9)
+		})
+	}
+
+	t.Log("a net long worth more than the deepest curve allows cannot be anchored")
+	pool := newPool(sdk.NewDec(100_000))
+	pool.BaseAssetReserve, pool.QuoteAssetReserve = sdk.NewDec(100_000), sdk.NewDec(100_000)
+	pool.OraclePegConfig.QuoteDepth = sdk.NewDec(1)
+	_, _, _, err := pool.GetValueNeutralReserves(sdk.OneDec())
+	require.Error(t, err)
+}
+
+func TestValidateCurve(t *testing.T) {
+	validConfig := &OraclePegConfig{
+		QuoteDepth:  sdk.NewDec(1_000_000),
+		SpreadRatio: sdk.MustNewDecFromStr("0.001"),
+	}
+
+	cases := map[string]struct {
+		curveType       CurveType
+		oraclePegConfig *OraclePegConfig
+		expectErr       bool
+	}{
+		"unspecified":      {curveType: CurveType_CURVE_TYPE_UNSPECIFIED},
+		"constant product": {curveType: CurveType_CONSTANT_PRODUCT},
+		"oracle pegged":    {curveType: CurveType_ORACLE_PEGGED, oraclePegConfig: validConfig},
+		"zero spread": {
+			curveType:       CurveType_ORACLE_PEGGED,
+			oraclePegConfig: &OraclePegConfig{QuoteDepth: sdk.NewDec(1_000_000), SpreadRatio: sdk.ZeroDec()},
+		},
+		"constant product with an oracle peg config": {
+			curveType:       CurveType_CONSTANT_PRODUCT,
+			oraclePegConfig: validConfig,
+			expectErr:       true,
+		},
+		"oracle pegged without a config": {
+			curveType: CurveType_ORACLE_PEGGED,
+			expectErr: true,
+		},
+		"zero quote depth": {
+			curveType:       CurveType_ORACLE_PEGGED,
+			oraclePegConfig: &OraclePegConfig{QuoteDepth: sdk.ZeroDec(), SpreadRatio: sdk.ZeroDec()},
+			expectErr:       true,
+		},
+		"spread ratio of one": {
+			curveType:       CurveType_ORACLE_PEGGED,
+			oraclePegConfig: &OraclePegConfig{QuoteDepth: sdk.NewDec(1_000_000), SpreadRatio: sdk.OneDec()},
+			expectErr:       true,
+		},
+		"negative spread ratio": {
+			curveType:       CurveType_ORACLE_PEGGED,
+			oraclePegConfig: &OraclePegConfig{QuoteDepth: sdk.NewDec(1_000_000), SpreadRatio: sdk.NewDec(-1)},
+			expectErr:       true,
+		},
+		"invalid curve type": {
+			curveType: CurveType(3),
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := ValidateCurve(tc.curveType, tc.oraclePegConfig)
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -9,6 +9,19 @@ import (
 	"github.com/NibiruChain/nibiru/x/common"
 )
 
+const (
+	// MaxSnapshotsPrunedPerBlock bounds the number of reserve snapshots, and
+	// the number of trade volumes, the EndBlocker prunes per pair in a single
+	// block.
+	MaxSnapshotsPrunedPerBlock = 100
+
+	// MaxSnapshotsDownsampledPerBlock bounds the number of reserve snapshots
+	// the EndBlocker visits to downsample them per pair in a single block. An
+	// interval is always downsampled whole, so it can be exceeded by the
+	// snapshots of the last interval.
+	MaxSnapshotsDownsampledPerBlock = 100
+)
+
 func NewReserveSnapshot(
 	pair common.AssetPair,
 	baseAssetReserve, quoteAssetReserve sdk.Dec,
@@ -22,6 +35,17 @@ func NewReserveSnapshot(
 	}
 }
 
+// NewPoolReserveSnapshot returns a snapshot of the reserves of a pool, with the
+// curve of the pool if it is oracle-pegged.
+func NewPoolReserveSnapshot(pool VPool, blockTime time.Time) ReserveSnapshot {
+	snapshot := NewReserveSnapshot(pool.Pair, pool.BaseAssetReserve, pool.QuoteAssetReserve, blockTime)
+	if pool.IsOraclePegged() {
+		config := *pool.OraclePegConfig
+		snapshot.OraclePegConfig = &config
+	}
+	return snapshot
+}
+
 func (s ReserveSnapshot) Validate() error {
 	err := s.Pair.Validate()
 	if err != nil {
@@ -40,6 +64,12 @@ func (s ReserveSnapshot) Validate() error {
 		return fmt.Errorf("timestamp from snapshot cannot be negative: %d", s.TimestampMs)
 	}
 
+	if s.OraclePegConfig != nil {
+		if err := s.OraclePegConfig.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -9,16 +9,20 @@ import (
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "github.com/regen-network/cosmos-proto"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -89,6 +93,162 @@ func (TwapCalcOption) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_e9da3afd19017067, []int{1}
 }
 
+// Enumerates the sources of the settlement price of a vpool.
+type SettlementPriceSource int32
+
+const (
+	SettlementPriceSource_SETTLEMENT_PRICE_SOURCE_UNSPECIFIED SettlementPriceSource = 0
+	// Time-weighted average of the pricefeed (index) price.
+	SettlementPriceSource_PRICEFEED_TWAP SettlementPriceSource = 1
+	// Time-weighted average of the mark price of the vpool.
+	SettlementPriceSource_MARK_TWAP SettlementPriceSource = 2
+	// Time-weighted average of the mark price of the vpool, or of the pricefeed
+	// price when the mark TWAP cannot be computed.
+	SettlementPriceSource_MARK_TWAP_OR_PRICEFEED_TWAP SettlementPriceSource = 3
+)
+
+var SettlementPriceSource_name = map[int32]string{
+	0: "SETTLEMENT_PRICE_SOURCE_UNSPECIFIED",
+	1: "PRICEFEED_TWAP",
+	2: "MARK_TWAP",
+	3: "MARK_TWAP_OR_PRICEFEED_TWAP",
+}
+
+var SettlementPriceSource_value = map[string]int32{
+	"SETTLEMENT_PRICE_SOURCE_UNSPECIFIED": 0,
+	"PRICEFEED_TWAP":                      1,
+	"MARK_TWAP":                           2,
+	"MARK_TWAP_OR_PRICEFEED_TWAP":         3,
+}
+
+func (x SettlementPriceSource) String() string {
+	return proto.EnumName(SettlementPriceSource_name, int32(x))
+}
+
+func (SettlementPriceSource) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e9da3afd19017067, []int{2}
+}
+
+// Enumerates the lifecycle statuses of a vpool.
+type PoolStatus int32
+
+const (
+	// Pools created before the statuses were introduced, treated as ACTIVE.
+	PoolStatus_POOL_STATUS_UNSPECIFIED PoolStatus = 0
+	// The pool can be traded on.
+	PoolStatus_ACTIVE PoolStatus = 1
+	// The positions of the pool's perp market can only be decreased or closed.
+	PoolStatus_REDUCE_ONLY PoolStatus = 2
+	// The pool rejects every swap until it is made ACTIVE or REDUCE_ONLY again.
+	PoolStatus_FROZEN PoolStatus = 3
+	// The pool is frozen for good and the positions of its perp market are
+	// settled at the settlement price.
+	PoolStatus_SETTLED PoolStatus = 4
+)
+
+var PoolStatus_name = map[int32]string{
+	0: "POOL_STATUS_UNSPECIFIED",
+	1: "ACTIVE",
+	2: "REDUCE_ONLY",
+	3: "FROZEN",
+	4: "SETTLED",
+}
+
+var PoolStatus_value = map[string]int32{
+	"POOL_STATUS_UNSPECIFIED": 0,
+	"ACTIVE":                  1,
+	"REDUCE_ONLY":             2,
+	"FROZEN":                  3,
+	"SETTLED":                 4,
+}
+
+func (x PoolStatus) String() string {
+	return proto.EnumName(PoolStatus_name, int32(x))
+}
+
+func (PoolStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e9da3afd19017067, []int{3}
+}
+
+// Enumerates the curves a vpool can price its swaps with.
+type CurveType int32
+
+const (
+	// Pools created before the curve types were introduced, treated as
+	// CONSTANT_PRODUCT.
+	CurveType_CURVE_TYPE_UNSPECIFIED CurveType = 0
+	// The reserves follow base * quote = k and the trades move the mark price.
+	CurveType_CONSTANT_PRODUCT CurveType = 1
+	// The reserves are anchored around the pricefeed price at the start of every
+	// block, following base * quote = k within the block, and the trades are
+	// quoted with a spread around the curve.
+	CurveType_ORACLE_PEGGED CurveType = 2
+)
+
+var CurveType_name = map[int32]string{
+	0: "CURVE_TYPE_UNSPECIFIED",
+	1: "CONSTANT_PRODUCT",
+	2: "ORACLE_PEGGED",
+}
+
+var CurveType_value = map[string]int32{
+	"CURVE_TYPE_UNSPECIFIED": 0,
+	"CONSTANT_PRODUCT":       1,
+	"ORACLE_PEGGED":          2,
+}
+
+func (x CurveType) String() string {
+	return proto.EnumName(CurveType_name, int32(x))
+}
+
+func (CurveType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e9da3afd19017067, []int{4}
+}
+
+// OraclePegConfig is the curve of an ORACLE_PEGGED vpool.
+type OraclePegConfig struct {
+	// The quote reserve the curve is anchored with every block, the depth of the
+	// pool: the larger, the less a trade moves the mark price within a block.
+	QuoteDepth github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=quote_depth,json=quoteDepth,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quote_depth"`
+	// The ratio the trades are quoted away from the curve, against the trader:
+	// buying the base asset costs (1 + spread_ratio) times the curve, selling it
+	// returns (1 - spread_ratio) times the curve. Between 0 and 1.
+	SpreadRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=spread_ratio,json=spreadRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"spread_ratio"`
+}
+
+func (m *OraclePegConfig) Reset()         { *m = OraclePegConfig{} }
+func (m *OraclePegConfig) String() string { return proto.CompactTextString(m) }
+func (*OraclePegConfig) ProtoMessage()    {}
+func (*OraclePegConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9da3afd19017067, []int{0}
+}
+func (m *OraclePegConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OraclePegConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OraclePegConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OraclePegConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OraclePegConfig.Merge(m, src)
+}
+func (m *OraclePegConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *OraclePegConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_OraclePegConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OraclePegConfig proto.InternalMessageInfo
+
 // A virtual pool used only for price discovery of perpetual futures contracts.
 // No real liquidity exists in this pool.
 type VPool struct {
@@ -108,13 +268,25 @@ type VPool struct {
 	MaintenanceMarginRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=maintenance_margin_ratio,json=maintenanceMarginRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"maintenance_margin_ratio"`
 	// max_leverage
 	MaxLeverage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=max_leverage,json=maxLeverage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_leverage"`
+	// status is where the pool is in its lifecycle.
+	Status PoolStatus `protobuf:"varint,11,opt,name=status,proto3,enum=nibiru.vpool.v1.PoolStatus" json:"status,omitempty"`
+	// curve_type is the curve the pool prices its swaps with.
+	CurveType CurveType `protobuf:"varint,12,opt,name=curve_type,json=curveType,proto3,enum=nibiru.vpool.v1.CurveType" json:"curve_type,omitempty"`
+	// oracle_peg_config is the curve of an ORACLE_PEGGED pool, nil for the
+	// other curve types.
+	OraclePegConfig *OraclePegConfig `protobuf:"bytes,13,opt,name=oracle_peg_config,json=oraclePegConfig,proto3" json:"oracle_peg_config,omitempty"`
+	// net_base_size is the base asset held net by the traders of an
+	// ORACLE_PEGGED pool, the base they took out of the pool less the base they
+	// put in, tracked so that the anchoring keeps the value of their net
+	// position. Nil for the other curve types.
+	NetBaseSize *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,14,opt,name=net_base_size,json=netBaseSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"net_base_size,omitempty"`
 }
 
 func (m *VPool) Reset()         { *m = VPool{} }
 func (m *VPool) String() string { return proto.CompactTextString(m) }
 func (*VPool) ProtoMessage()    {}
 func (*VPool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e9da3afd19017067, []int{0}
+	return fileDescriptor_e9da3afd19017067, []int{1}
 }
 func (m *VPool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -150,6 +322,27 @@ func (m *VPool) GetPair() common.AssetPair {
 	return common.AssetPair{}
 }
 
+func (m *VPool) GetStatus() PoolStatus {
+	if m != nil {
+		return m.Status
+	}
+	return PoolStatus_POOL_STATUS_UNSPECIFIED
+}
+
+func (m *VPool) GetCurveType() CurveType {
+	if m != nil {
+		return m.CurveType
+	}
+	return CurveType_CURVE_TYPE_UNSPECIFIED
+}
+
+func (m *VPool) GetOraclePegConfig() *OraclePegConfig {
+	if m != nil {
+		return m.OraclePegConfig
+	}
+	return nil
+}
+
 // CurrentTWAP states defines the numerator and denominator for the TWAP calculation
 type CurrentTWAP struct {
 	PairID      string                                 `protobuf:"bytes,1,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty"`
@@ -162,7 +355,7 @@ func (m *CurrentTWAP) Reset()         { *m = CurrentTWAP{} }
 func (m *CurrentTWAP) String() string { return proto.CompactTextString(m) }
 func (*CurrentTWAP) ProtoMessage()    {}
 func (*CurrentTWAP) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e9da3afd19017067, []int{1}
+	return fileDescriptor_e9da3afd19017067, []int{2}
 }
 func (m *CurrentTWAP) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -206,13 +399,20 @@ type ReserveSnapshot struct {
 	QuoteAssetReserve github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=quote_asset_reserve,json=quoteAssetReserve,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quote_asset_reserve"`
 	// milliseconds since unix epoch
 	TimestampMs int64 `protobuf:"varint,3,opt,name=timestamp_ms,json=timestampMs,proto3" json:"timestamp_ms,omitempty"`
+	// whether the snapshot is the time-weighted average of the snapshots of a
+	// downsampled interval, which keeps their spot price but not their swap
+	// prices
+	Downsampled bool `protobuf:"varint,6,opt,name=downsampled,proto3" json:"downsampled,omitempty"`
+	// the curve of an oracle-pegged pool at the time of the snapshot, whose
+	// swaps are quoted with its spread; nil for a constant product pool
+	OraclePegConfig *OraclePegConfig `protobuf:"bytes,7,opt,name=oracle_peg_config,json=oraclePegConfig,proto3" json:"oracle_peg_config,omitempty"`
 }
 
 func (m *ReserveSnapshot) Reset()         { *m = ReserveSnapshot{} }
 func (m *ReserveSnapshot) String() string { return proto.CompactTextString(m) }
 func (*ReserveSnapshot) ProtoMessage()    {}
 func (*ReserveSnapshot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e9da3afd19017067, []int{2}
+	return fileDescriptor_e9da3afd19017067, []int{3}
 }
 func (m *ReserveSnapshot) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -255,6 +455,224 @@ func (m *ReserveSnapshot) GetTimestampMs() int64 {
 	return 0
 }
 
+func (m *ReserveSnapshot) GetDownsampled() bool {
+	if m != nil {
+		return m.Downsampled
+	}
+	return false
+}
+
+func (m *ReserveSnapshot) GetOraclePegConfig() *OraclePegConfig {
+	if m != nil {
+		return m.OraclePegConfig
+	}
+	return nil
+}
+
+// the amounts traded on a vpool over an interval of one minute, the volume of
+// the mark price candles
+type TradeVolume struct {
+	Pair common.AssetPair `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair"`
+	// the base asset swapped in either direction
+	BaseVolume github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=base_volume,json=baseVolume,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_volume"`
+	// the quote asset swapped in either direction
+	QuoteVolume github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=quote_volume,json=quoteVolume,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quote_volume"`
+	// the start of the interval, in milliseconds since unix epoch
+	TimestampMs int64 `protobuf:"varint,4,opt,name=timestamp_ms,json=timestampMs,proto3" json:"timestamp_ms,omitempty"`
+}
+
+func (m *TradeVolume) Reset()         { *m = TradeVolume{} }
+func (m *TradeVolume) String() string { return proto.CompactTextString(m) }
+func (*TradeVolume) ProtoMessage()    {}
+func (*TradeVolume) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9da3afd19017067, []int{4}
+}
+func (m *TradeVolume) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TradeVolume) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TradeVolume.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TradeVolume) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TradeVolume.Merge(m, src)
+}
+func (m *TradeVolume) XXX_Size() int {
+	return m.Size()
+}
+func (m *TradeVolume) XXX_DiscardUnknown() {
+	xxx_messageInfo_TradeVolume.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TradeVolume proto.InternalMessageInfo
+
+func (m *TradeVolume) GetPair() common.AssetPair {
+	if m != nil {
+		return m.Pair
+	}
+	return common.AssetPair{}
+}
+
+func (m *TradeVolume) GetTimestampMs() int64 {
+	if m != nil {
+		return m.TimestampMs
+	}
+	return 0
+}
+
+// Params defines the parameters of the vpool module.
+type Params struct {
+	// How long the reserve snapshots are kept. The snapshots older than this are
+	// pruned, except the latest of them which gives the price at the start of
+	// the window. It is raised to the longest TWAP lookback window read over a
+	// pair. Zero keeps the snapshots forever.
+	SnapshotRetention time.Duration `protobuf:"bytes,1,opt,name=snapshot_retention,json=snapshotRetention,proto3,stdduration" json:"snapshot_retention,omitempty" yaml:"snapshot_retention"`
+	// The age after which the per-block reserve snapshots are downsampled to one
+	// snapshot per snapshot_downsample_interval. The downsampled snapshots only
+	// keep the spot price, so it is raised to the longest swap TWAP lookback
+	// window read over a pair. Zero disables the downsampling.
+	SnapshotDownsampleAfter time.Duration `protobuf:"bytes,2,opt,name=snapshot_downsample_after,json=snapshotDownsampleAfter,proto3,stdduration" json:"snapshot_downsample_after,omitempty" yaml:"snapshot_downsample_after"`
+	// The interval the downsampled reserve snapshots are aggregated over, e.g.
+	// one minute or one hour.
+	SnapshotDownsampleInterval time.Duration `protobuf:"bytes,3,opt,name=snapshot_downsample_interval,json=snapshotDownsampleInterval,proto3,stdduration" json:"snapshot_downsample_interval,omitempty" yaml:"snapshot_downsample_interval"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9da3afd19017067, []int{5}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetSnapshotRetention() time.Duration {
+	if m != nil {
+		return m.SnapshotRetention
+	}
+	return 0
+}
+
+func (m *Params) GetSnapshotDownsampleAfter() time.Duration {
+	if m != nil {
+		return m.SnapshotDownsampleAfter
+	}
+	return 0
+}
+
+func (m *Params) GetSnapshotDownsampleInterval() time.Duration {
+	if m != nil {
+		return m.SnapshotDownsampleInterval
+	}
+	return 0
+}
+
+// PoolSettlement fixes the price at which the positions of a vpool settled by
+// governance are settled.
+type PoolSettlement struct {
+	Pair            common.AssetPair                       `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair"`
+	SettlementPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=settlement_price,json=settlementPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"settlement_price"`
+	// the source the settlement price was taken from
+	PriceSource SettlementPriceSource `protobuf:"varint,3,opt,name=price_source,json=priceSource,proto3,enum=nibiru.vpool.v1.SettlementPriceSource" json:"price_source,omitempty"`
+	// milliseconds since unix epoch at which the pool was settled
+	TimestampMs int64 `protobuf:"varint,4,opt,name=timestamp_ms,json=timestampMs,proto3" json:"timestamp_ms,omitempty"`
+	// the block number at which the pool was settled
+	BlockNumber int64 `protobuf:"varint,5,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+}
+
+func (m *PoolSettlement) Reset()         { *m = PoolSettlement{} }
+func (m *PoolSettlement) String() string { return proto.CompactTextString(m) }
+func (*PoolSettlement) ProtoMessage()    {}
+func (*PoolSettlement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9da3afd19017067, []int{6}
+}
+func (m *PoolSettlement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolSettlement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolSettlement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolSettlement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolSettlement.Merge(m, src)
+}
+func (m *PoolSettlement) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolSettlement) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolSettlement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolSettlement proto.InternalMessageInfo
+
+func (m *PoolSettlement) GetPair() common.AssetPair {
+	if m != nil {
+		return m.Pair
+	}
+	return common.AssetPair{}
+}
+
+func (m *PoolSettlement) GetPriceSource() SettlementPriceSource {
+	if m != nil {
+		return m.PriceSource
+	}
+	return SettlementPriceSource_SETTLEMENT_PRICE_SOURCE_UNSPECIFIED
+}
+
+func (m *PoolSettlement) GetTimestampMs() int64 {
+	if m != nil {
+		return m.TimestampMs
+	}
+	return 0
+}
+
+func (m *PoolSettlement) GetBlockNumber() int64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
 // PoolPrices is a simple structure that displays a snapshot of the mark and index
 // prices for an asset. Empty strings for the indexPrice or twapMark fields
 // indicate that the price is currently unavailable.
@@ -278,7 +696,7 @@ func (m *PoolPrices) Reset()         { *m = PoolPrices{} }
 func (m *PoolPrices) String() string { return proto.CompactTextString(m) }
 func (*PoolPrices) ProtoMessage()    {}
 func (*PoolPrices) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e9da3afd19017067, []int{3}
+	return fileDescriptor_e9da3afd19017067, []int{7}
 }
 func (m *PoolPrices) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -338,68 +756,158 @@ func (m *PoolPrices) GetBlockNumber() int64 {
 func init() {
 	proto.RegisterEnum("nibiru.vpool.v1.Direction", Direction_name, Direction_value)
 	proto.RegisterEnum("nibiru.vpool.v1.TwapCalcOption", TwapCalcOption_name, TwapCalcOption_value)
+	proto.RegisterEnum("nibiru.vpool.v1.SettlementPriceSource", SettlementPriceSource_name, SettlementPriceSource_value)
+	proto.RegisterEnum("nibiru.vpool.v1.PoolStatus", PoolStatus_name, PoolStatus_value)
+	proto.RegisterEnum("nibiru.vpool.v1.CurveType", CurveType_name, CurveType_value)
+	proto.RegisterType((*OraclePegConfig)(nil), "nibiru.vpool.v1.OraclePegConfig")
 	proto.RegisterType((*VPool)(nil), "nibiru.vpool.v1.VPool")
 	proto.RegisterType((*CurrentTWAP)(nil), "nibiru.vpool.v1.CurrentTWAP")
 	proto.RegisterType((*ReserveSnapshot)(nil), "nibiru.vpool.v1.ReserveSnapshot")
+	proto.RegisterType((*TradeVolume)(nil), "nibiru.vpool.v1.TradeVolume")
+	proto.RegisterType((*Params)(nil), "nibiru.vpool.v1.Params")
+	proto.RegisterType((*PoolSettlement)(nil), "nibiru.vpool.v1.PoolSettlement")
 	proto.RegisterType((*PoolPrices)(nil), "nibiru.vpool.v1.PoolPrices")
 }
 
 func init() { proto.RegisterFile("vpool/v1/state.proto", fileDescriptor_e9da3afd19017067) }
 
 var fileDescriptor_e9da3afd19017067 = []byte{
-	// 817 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0xdf, 0x6e, 0x1b, 0x45,
-	0x14, 0xc6, 0x6d, 0x27, 0x4e, 0xe3, 0xe3, 0x34, 0x76, 0x27, 0x09, 0xdd, 0x16, 0x64, 0x07, 0x23,
-	0xa1, 0xaa, 0x08, 0x5b, 0x2d, 0x77, 0xdc, 0xf9, 0x5f, 0x91, 0x25, 0x3b, 0xbb, 0x5d, 0xbb, 0xad,
-	0x54, 0x21, 0x46, 0xe3, 0xf5, 0xd4, 0x19, 0x79, 0x67, 0x66, 0x99, 0x9d, 0x75, 0xdd, 0xb7, 0xe0,
-	0x92, 0x27, 0xe0, 0x39, 0xb8, 0xec, 0x65, 0x2f, 0x11, 0x17, 0x11, 0x24, 0x6f, 0xc0, 0x13, 0xa0,
-	0x99, 0x5d, 0x8a, 0x8d, 0xb8, 0x61, 0x81, 0xab, 0xcd, 0x7c, 0xe7, 0xe4, 0xf7, 0x8d, 0xcf, 0x9e,
-	0x73, 0x16, 0x4e, 0xd7, 0x91, 0x94, 0x61, 0x67, 0xfd, 0xa8, 0x13, 0x6b, 0xa2, 0x69, 0x3b, 0x52,
-	0x52, 0x4b, 0x54, 0x13, 0x6c, 0xce, 0x54, 0xd2, 0xb6, 0xc1, 0xf6, 0xfa, 0xd1, 0xfd, 0x7b, 0x81,
-	0x8c, 0xb9, 0x8c, 0xb1, 0x0d, 0x77, 0xd2, 0x43, 0x9a, 0x7b, 0xff, 0x74, 0x29, 0x97, 0x32, 0xd5,
-	0xcd, 0x5f, 0x99, 0x7a, 0x12, 0x48, 0xce, 0xa5, 0xe8, 0xa4, 0x8f, 0x54, 0x6c, 0xfd, 0x5a, 0x86,
-	0xf2, 0x73, 0x4f, 0xca, 0x10, 0x3d, 0x86, 0xfd, 0x88, 0x30, 0xe5, 0x14, 0xcf, 0x8b, 0x0f, 0xaa,
-	0x8f, 0x9d, 0x76, 0xe6, 0x97, 0x65, 0x77, 0xe3, 0x98, 0x6a, 0x8f, 0x30, 0xd5, 0xdb, 0x7f, 0x7b,
-	0xd5, 0x2c, 0xf8, 0x36, 0x17, 0x7d, 0x0d, 0x68, 0x4e, 0x62, 0x8a, 0x89, 0x89, 0x62, 0x45, 0x63,
-	0xaa, 0xd6, 0xd4, 0x29, 0x9d, 0x17, 0x1f, 0x54, 0x7a, 0x6d, 0x93, 0xf7, 0xf3, 0x55, 0xf3, 0xd3,
-	0x25, 0xd3, 0x97, 0xc9, 0xdc, 0x80, 0xb2, 0x5b, 0x66, 0x8f, 0xcf, 0xe3, 0xc5, 0xaa, 0xa3, 0xdf,
-	0x44, 0x34, 0x6e, 0x0f, 0x68, 0xe0, 0xd7, 0x0d, 0xc9, 0xda, 0xf8, 0x29, 0x07, 0x7d, 0x03, 0x27,
-	0xdf, 0x26, 0x52, 0xff, 0x15, 0xbf, 0x97, 0x0b, 0x7f, 0xc7, 0xa2, 0x76, 0xf8, 0x2f, 0xe1, 0x8e,
-	0x56, 0x64, 0x41, 0x71, 0xc8, 0x38, 0xd3, 0x58, 0x11, 0xcd, 0xa4, 0xb3, 0x9f, 0x8b, 0x5e, 0xb3,
-	0xa0, 0xb1, 0xe1, 0xf8, 0x06, 0x83, 0x5e, 0xc1, 0xdd, 0x57, 0x61, 0x12, 0xe8, 0xc4, 0x9c, 0xc4,
-	0x8e, 0x43, 0x39, 0x97, 0xc3, 0xd9, 0x16, 0x6e, 0xcb, 0x87, 0xc2, 0x5d, 0x4e, 0x36, 0x58, 0x2a,
-	0x12, 0x84, 0x14, 0xc7, 0x91, 0xa2, 0x64, 0x91, 0xf9, 0x1c, 0xe4, 0xf2, 0x39, 0xe5, 0x64, 0xe3,
-	0x5a, 0xda, 0xd4, 0xc2, 0x52, 0x9b, 0x4b, 0x70, 0x38, 0x61, 0x42, 0x53, 0x41, 0x44, 0x40, 0x31,
-	0x27, 0x6a, 0xc9, 0x44, 0xe6, 0x73, 0x2b, 0x97, 0xcf, 0x07, 0x5b, 0xbc, 0x89, 0xc5, 0xa5, 0x4e,
-	0x4f, 0xe1, 0xc8, 0xfc, 0xa0, 0x90, 0xae, 0xa9, 0x22, 0x4b, 0xea, 0x1c, 0xe6, 0xa2, 0x57, 0x39,
-	0xd9, 0x8c, 0x33, 0x44, 0xeb, 0xfb, 0x12, 0x54, 0xfb, 0x89, 0x52, 0x54, 0xe8, 0xd9, 0x8b, 0xae,
-	0x87, 0x3e, 0x81, 0x5b, 0xa6, 0x7b, 0x31, 0x5b, 0xd8, 0x66, 0xaf, 0xf4, 0xe0, 0xfa, 0xaa, 0x79,
-	0x60, 0x9a, 0x7b, 0x34, 0xf0, 0x0f, 0x4c, 0x68, 0xb4, 0x40, 0x63, 0xa8, 0x88, 0x84, 0x53, 0x45,
-	0xb4, 0x54, 0x39, 0x3b, 0xfa, 0x4f, 0x00, 0xf2, 0xa0, 0xba, 0xa0, 0x42, 0x72, 0x26, 0x2c, 0x2f,
-	0x5f, 0x0b, 0x6f, 0x23, 0xd0, 0x00, 0xca, 0x91, 0x62, 0x01, 0xcd, 0xd9, 0xb0, 0xe9, 0x3f, 0xb7,
-	0x7e, 0x28, 0x41, 0x2d, 0x1b, 0x87, 0xa9, 0x20, 0x51, 0x7c, 0x29, 0xf5, 0xfb, 0x45, 0x50, 0xfe,
-	0xd7, 0x8b, 0xa0, 0xf8, 0xff, 0x2e, 0x82, 0xd2, 0x7f, 0xb5, 0x08, 0x3e, 0x86, 0x23, 0xcd, 0x38,
-	0x8d, 0x35, 0xe1, 0x11, 0xe6, 0xb1, 0x7d, 0x3d, 0x7b, 0x7e, 0xf5, 0xbd, 0x36, 0x89, 0x5b, 0x3f,
-	0x96, 0x00, 0xcc, 0x9a, 0xf4, 0x4c, 0xd9, 0x62, 0x84, 0xb2, 0x1a, 0x55, 0xcc, 0x15, 0xb2, 0x1a,
-	0x4c, 0x00, 0x38, 0x51, 0x2b, 0x9c, 0xbe, 0x16, 0xc8, 0xd7, 0x32, 0x86, 0x60, 0x3d, 0x50, 0x13,
-	0xaa, 0x4c, 0x2c, 0xe8, 0x26, 0xe3, 0x55, 0xad, 0x13, 0x58, 0x29, 0x4d, 0xf8, 0x10, 0x2a, 0xfa,
-	0x35, 0x89, 0xcc, 0x30, 0xae, 0x9c, 0x23, 0x1b, 0x3e, 0x34, 0xc2, 0x84, 0xa8, 0x15, 0x12, 0x70,
-	0x1c, 0x9b, 0x20, 0x13, 0x6b, 0xa2, 0x18, 0x11, 0xda, 0xb9, 0x6d, 0x2f, 0xf4, 0xd5, 0x3f, 0xb8,
-	0xd0, 0x48, 0xe8, 0xdf, 0xae, 0x9a, 0x67, 0x6f, 0x08, 0x0f, 0xbf, 0x6c, 0xed, 0xd2, 0x5a, 0xfe,
-	0x6d, 0x23, 0x8c, 0xfe, 0x38, 0x9b, 0x12, 0xce, 0x43, 0x19, 0xac, 0xb0, 0x48, 0xf8, 0x9c, 0x2a,
-	0xe7, 0x38, 0x2d, 0xa1, 0xd5, 0x2e, 0xac, 0xf4, 0x70, 0x02, 0x95, 0x01, 0x53, 0x34, 0x30, 0x1b,
-	0x0c, 0xdd, 0x83, 0xb3, 0xc1, 0xc8, 0x1f, 0xf6, 0x67, 0x23, 0xf7, 0x02, 0x3f, 0xbb, 0x98, 0x7a,
-	0xc3, 0xfe, 0xe8, 0xc9, 0x68, 0x38, 0xa8, 0x17, 0x50, 0x0d, 0xaa, 0xdd, 0xc1, 0x00, 0xcf, 0x5c,
-	0xec, 0xb9, 0xee, 0xb8, 0x5e, 0x44, 0xa7, 0x50, 0xf7, 0x87, 0x13, 0xf7, 0xf9, 0x10, 0x3f, 0xf1,
-	0xdd, 0x49, 0xaa, 0x96, 0x1e, 0x2e, 0xe1, 0x78, 0xf6, 0x9a, 0x44, 0x7d, 0x12, 0x06, 0x6e, 0x64,
-	0x99, 0xe7, 0xf0, 0x91, 0x99, 0x6f, 0xdc, 0xef, 0x8e, 0xfb, 0xd8, 0xf5, 0xfe, 0x06, 0x7d, 0x08,
-	0xfb, 0x53, 0xcf, 0x9d, 0xa5, 0xcc, 0xa7, 0xcf, 0xdc, 0xd9, 0x10, 0x77, 0xa7, 0xd3, 0xe1, 0x0c,
-	0x4f, 0x5f, 0x74, 0xbd, 0x7a, 0x09, 0x9d, 0x40, 0xad, 0xd7, 0x9d, 0xee, 0x88, 0x7b, 0xbd, 0xe1,
-	0xdb, 0xeb, 0x46, 0xf1, 0xdd, 0x75, 0xa3, 0xf8, 0xcb, 0x75, 0xa3, 0xf8, 0xdd, 0x4d, 0xa3, 0xf0,
-	0xee, 0xa6, 0x51, 0xf8, 0xe9, 0xa6, 0x51, 0x78, 0xf9, 0xd9, 0x56, 0x11, 0x2f, 0xec, 0x94, 0xf4,
-	0x2f, 0x09, 0x13, 0x9d, 0x74, 0x62, 0x3a, 0x9b, 0x4e, 0xfa, 0x25, 0xb7, 0xd5, 0x9c, 0x1f, 0xd8,
-	0x0f, 0xee, 0x17, 0xbf, 0x07, 0x00, 0x00, 0xff, 0xff, 0xe8, 0xc1, 0x4c, 0x03, 0xdf, 0x07, 0x00,
-	0x00,
+	// 1468 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x97, 0xbd, 0x73, 0xdb, 0x46,
+	0x16, 0xc0, 0x05, 0x92, 0xfa, 0xe0, 0x83, 0x44, 0x52, 0x6b, 0xc9, 0xa2, 0x24, 0x8f, 0xa8, 0xa3,
+	0x66, 0x7c, 0x1e, 0xdd, 0x1d, 0x39, 0x96, 0xab, 0xbb, 0x8e, 0x02, 0x20, 0x0f, 0x7d, 0x24, 0x01,
+	0x01, 0x90, 0x3c, 0xf2, 0xdc, 0xdc, 0xce, 0x8a, 0x5c, 0x51, 0x18, 0x11, 0x1f, 0x07, 0x80, 0xb4,
+	0xe4, 0xea, 0x8a, 0x2b, 0xae, 0xbb, 0xab, 0x32, 0xfe, 0x1b, 0x52, 0xa5, 0x49, 0xea, 0x94, 0x2e,
+	0x5d, 0x66, 0x52, 0x28, 0x19, 0xb9, 0x4b, 0xe9, 0x36, 0x4d, 0x66, 0x17, 0xa0, 0x24, 0x92, 0xb2,
+	0x13, 0x23, 0x49, 0x25, 0xee, 0x7b, 0x6f, 0x7f, 0xef, 0xed, 0xc3, 0x7b, 0x6f, 0x57, 0xb0, 0x34,
+	0xf0, 0x5c, 0xb7, 0x57, 0x1d, 0x3c, 0xae, 0x06, 0x21, 0x09, 0x69, 0xc5, 0xf3, 0xdd, 0xd0, 0x45,
+	0x79, 0xc7, 0x3a, 0xb6, 0xfc, 0x7e, 0x85, 0x2b, 0x2b, 0x83, 0xc7, 0x6b, 0xab, 0x6d, 0x37, 0xb0,
+	0xdd, 0x00, 0x73, 0x75, 0x35, 0x5a, 0x44, 0xb6, 0x6b, 0x4b, 0x5d, 0xb7, 0xeb, 0x46, 0x72, 0xf6,
+	0x2b, 0x96, 0x6e, 0x74, 0x5d, 0xb7, 0xdb, 0xa3, 0x55, 0xbe, 0x3a, 0xee, 0x9f, 0x54, 0x3b, 0x7d,
+	0x9f, 0x84, 0x96, 0xeb, 0xc4, 0xfa, 0x7b, 0x6d, 0xd7, 0xb6, 0x5d, 0xa7, 0x1a, 0xfd, 0x89, 0x84,
+	0xe5, 0x2f, 0x05, 0xc8, 0xab, 0x3e, 0x69, 0xf7, 0xa8, 0x46, 0xbb, 0x92, 0xeb, 0x9c, 0x58, 0x5d,
+	0xa4, 0x82, 0xf8, 0xaf, 0xbe, 0x1b, 0x52, 0xdc, 0xa1, 0x5e, 0x78, 0x5a, 0x14, 0x36, 0x85, 0x47,
+	0xd9, 0xdd, 0xca, 0x9b, 0xcb, 0xd2, 0xd4, 0xb7, 0x97, 0xa5, 0x87, 0x5d, 0x2b, 0x3c, 0xed, 0x1f,
+	0x57, 0xda, 0xae, 0x1d, 0x07, 0x15, 0xff, 0xf9, 0x4b, 0xd0, 0x39, 0xab, 0x86, 0x17, 0x1e, 0x0d,
+	0x2a, 0x32, 0x6d, 0xeb, 0xc0, 0x11, 0x32, 0x23, 0xa0, 0x7d, 0x98, 0x0f, 0x3c, 0x9f, 0x92, 0x0e,
+	0xe6, 0x01, 0x15, 0x53, 0x89, 0x88, 0x62, 0xc4, 0xd0, 0x19, 0xa2, 0xfc, 0xe3, 0x2c, 0x4c, 0x1f,
+	0x6a, 0xae, 0xdb, 0x43, 0x3b, 0x90, 0xf1, 0x88, 0xe5, 0xf3, 0x30, 0xc5, 0x9d, 0x62, 0x25, 0xce,
+	0x63, 0x7c, 0xca, 0x5a, 0x10, 0xd0, 0x50, 0x23, 0x96, 0xbf, 0x9b, 0x61, 0xee, 0x74, 0x6e, 0x8b,
+	0xfe, 0x01, 0xe8, 0x98, 0x04, 0x14, 0x13, 0xa6, 0xc5, 0x3e, 0x0d, 0xa8, 0x3f, 0xa0, 0x09, 0xc3,
+	0x2a, 0x30, 0x12, 0x77, 0xa3, 0x47, 0x1c, 0xf4, 0x4f, 0xb8, 0x17, 0xe5, 0x6f, 0x14, 0x9f, 0x4e,
+	0x84, 0x5f, 0xe4, 0xa8, 0x11, 0xfe, 0x0b, 0x58, 0x0c, 0x7d, 0xd2, 0xa1, 0xb8, 0x67, 0xd9, 0x56,
+	0x18, 0xe7, 0x34, 0x93, 0x88, 0x9e, 0xe7, 0xa0, 0x06, 0xe3, 0xf0, 0xbc, 0xa2, 0x13, 0x58, 0x39,
+	0xe9, 0xf5, 0xdb, 0x61, 0x9f, 0xad, 0x9c, 0x11, 0x0f, 0xd3, 0x89, 0x3c, 0x2c, 0xdf, 0xc2, 0xdd,
+	0xf2, 0x43, 0x61, 0xc5, 0x26, 0xe7, 0xd8, 0xe5, 0xa5, 0x87, 0x47, 0xaa, 0x63, 0x26, 0x91, 0x9f,
+	0x25, 0x9b, 0x9c, 0x47, 0x85, 0x6c, 0xdc, 0x94, 0x09, 0x3a, 0x85, 0xa2, 0x4d, 0x2c, 0x27, 0xa4,
+	0x0e, 0x71, 0xda, 0x14, 0xdb, 0xc4, 0xef, 0x5a, 0x4e, 0xec, 0x67, 0x36, 0x91, 0x9f, 0xfb, 0xb7,
+	0x78, 0x4d, 0x8e, 0x8b, 0x3c, 0xed, 0xc3, 0x3c, 0x3b, 0x50, 0x8f, 0x0e, 0xa8, 0x4f, 0xba, 0xb4,
+	0x38, 0x97, 0xac, 0xc6, 0x6d, 0x72, 0xde, 0x88, 0x11, 0xe8, 0x09, 0xcc, 0xb0, 0x09, 0xd1, 0x0f,
+	0x8a, 0xe2, 0xa6, 0xf0, 0x28, 0xb7, 0xb3, 0x5e, 0x19, 0x9b, 0x11, 0x15, 0xd6, 0x00, 0x06, 0x37,
+	0xd1, 0x63, 0x53, 0xf4, 0x57, 0x80, 0x76, 0xdf, 0x1f, 0x50, 0xcc, 0xa0, 0xc5, 0x79, 0xbe, 0x71,
+	0x6d, 0x62, 0xa3, 0xc4, 0x4c, 0xcc, 0x0b, 0x8f, 0xea, 0xd9, 0xf6, 0xf0, 0x27, 0x6a, 0xc0, 0x62,
+	0xfc, 0x3d, 0x3c, 0xda, 0xc5, 0x6d, 0x3e, 0x0c, 0x8a, 0x0b, 0xbc, 0xad, 0x36, 0x27, 0x08, 0x63,
+	0x43, 0x43, 0xcf, 0xbb, 0xa3, 0x02, 0xd4, 0x82, 0x05, 0x87, 0x86, 0x98, 0xf7, 0x59, 0x60, 0xbd,
+	0xa2, 0xc5, 0x1c, 0xcf, 0xc8, 0xf6, 0xa7, 0x64, 0xc3, 0xa1, 0xe1, 0x2e, 0x09, 0xa8, 0x61, 0xbd,
+	0xa2, 0xcf, 0x32, 0x73, 0xd9, 0x02, 0x3c, 0xcb, 0xcc, 0x41, 0x41, 0x2c, 0xbf, 0x4e, 0x81, 0x28,
+	0xf5, 0x7d, 0x9f, 0x3a, 0xa1, 0xf9, 0xbc, 0xa6, 0xa1, 0x2d, 0x98, 0x65, 0x7d, 0x8d, 0xad, 0x4e,
+	0x3c, 0xad, 0xe0, 0xea, 0xb2, 0x34, 0xc3, 0xda, 0xbe, 0x2e, 0xeb, 0x33, 0x4c, 0x55, 0xef, 0xa0,
+	0x06, 0x64, 0x9d, 0xbe, 0x4d, 0x7d, 0x12, 0xba, 0x7e, 0xc2, 0x5e, 0xbf, 0x01, 0x20, 0x0d, 0xc4,
+	0x0e, 0x75, 0x5c, 0xdb, 0x72, 0x38, 0x2f, 0x59, 0x73, 0xdf, 0x46, 0x20, 0x19, 0xa6, 0x3d, 0xdf,
+	0x6a, 0xd3, 0x84, 0xad, 0x1c, 0x6d, 0x2e, 0x7f, 0x96, 0x86, 0x7c, 0x3c, 0x28, 0x0c, 0x87, 0x78,
+	0xc1, 0xa9, 0x1b, 0x5e, 0x8f, 0xc8, 0xe9, 0x5f, 0x3d, 0x22, 0x85, 0xdf, 0x77, 0x44, 0xa6, 0x7e,
+	0xab, 0x11, 0xf9, 0x07, 0x98, 0x0f, 0x2d, 0x9b, 0x06, 0x21, 0xb1, 0x3d, 0x6c, 0x07, 0xfc, 0xf3,
+	0xa4, 0x75, 0xf1, 0x5a, 0xd6, 0x0c, 0xd0, 0x26, 0x88, 0x1d, 0xf7, 0xa5, 0x13, 0x10, 0xdb, 0xeb,
+	0xd1, 0x0e, 0x9f, 0x3a, 0x73, 0xfa, 0x6d, 0xd1, 0xdd, 0xfd, 0x30, 0x9b, 0xb0, 0x1f, 0xca, 0xff,
+	0x4d, 0x81, 0x68, 0xb2, 0x69, 0x7b, 0xe8, 0xf6, 0xfa, 0x36, 0x4d, 0x74, 0x6f, 0xa9, 0x20, 0xf2,
+	0x8f, 0x32, 0xe0, 0x88, 0x84, 0xe9, 0x02, 0x86, 0x88, 0x83, 0xd8, 0x87, 0xf9, 0xe8, 0x3b, 0xc4,
+	0xc4, 0x84, 0x65, 0xcc, 0x19, 0x31, 0x72, 0x3c, 0xf5, 0x99, 0x89, 0xd4, 0x97, 0xff, 0x9d, 0x81,
+	0x19, 0x8d, 0xf8, 0xc4, 0x0e, 0xd0, 0xff, 0x04, 0x40, 0x41, 0x5c, 0xa7, 0xd8, 0xa7, 0x21, 0x75,
+	0xd8, 0x45, 0x11, 0x27, 0x65, 0xb5, 0x12, 0x3d, 0x69, 0x2a, 0xc3, 0x27, 0x4d, 0x45, 0x8e, 0x9f,
+	0x34, 0xbb, 0x0a, 0x0b, 0xf1, 0x87, 0xcb, 0xd2, 0x83, 0xc9, 0xcd, 0x7f, 0x76, 0x6d, 0x2b, 0xa4,
+	0xb6, 0x17, 0x5e, 0xbc, 0xbf, 0x2c, 0xad, 0x5e, 0x10, 0xbb, 0xf7, 0xb7, 0xf2, 0xa4, 0x55, 0xf9,
+	0xf5, 0x77, 0x25, 0x41, 0x5f, 0x1c, 0x2a, 0xf4, 0xa1, 0x1c, 0x7d, 0x2e, 0xc0, 0xea, 0xb5, 0xf9,
+	0x4d, 0x39, 0x60, 0x72, 0x12, 0xd2, 0x68, 0x6e, 0x7c, 0x34, 0x30, 0x23, 0x0e, 0x6c, 0xeb, 0x83,
+	0x8c, 0x91, 0xf8, 0x36, 0xc7, 0xe2, 0x1b, 0x37, 0x8e, 0xc2, 0x5c, 0x19, 0xea, 0xe5, 0x6b, 0x75,
+	0x8d, 0x69, 0xd1, 0x57, 0x02, 0x3c, 0xb8, 0x6b, 0x2f, 0xbb, 0xa2, 0xfc, 0x01, 0xe9, 0x15, 0xd3,
+	0x3f, 0x17, 0xef, 0x51, 0x1c, 0xef, 0xc3, 0x8f, 0x61, 0x46, 0x42, 0xde, 0xfa, 0x70, 0xc8, 0x43,
+	0xfb, 0x28, 0xea, 0xb5, 0xc9, 0xa8, 0xeb, 0x43, 0x83, 0x2f, 0x52, 0x90, 0xe3, 0xb7, 0x17, 0x0d,
+	0xc3, 0x1e, 0xb5, 0xa9, 0x13, 0x26, 0x6a, 0x88, 0x23, 0x28, 0x04, 0xd7, 0x04, 0x1c, 0x8d, 0xcf,
+	0x64, 0x5d, 0x91, 0xbf, 0xe1, 0x68, 0x0c, 0x83, 0xea, 0x30, 0xcf, 0x79, 0x38, 0x70, 0xfb, 0x7e,
+	0x3b, 0x6a, 0x8d, 0xdc, 0xce, 0xc3, 0x89, 0xc6, 0x37, 0x46, 0xf7, 0x19, 0xdc, 0x5a, 0x17, 0xbd,
+	0x9b, 0xc5, 0x2f, 0x68, 0x09, 0x66, 0x72, 0xdc, 0x73, 0xdb, 0x67, 0xd8, 0xe9, 0xdb, 0xc7, 0x34,
+	0x1a, 0xd5, 0x69, 0x5d, 0xe4, 0xb2, 0x16, 0x17, 0x95, 0xbf, 0x4e, 0x01, 0xb0, 0x94, 0x71, 0x37,
+	0x01, 0x42, 0x71, 0xba, 0xb2, 0xec, 0xb8, 0x71, 0x3a, 0x9a, 0x00, 0x36, 0xf1, 0xcf, 0xe2, 0x44,
+	0x40, 0xb2, 0x3b, 0x8e, 0x11, 0xa2, 0x14, 0x94, 0x40, 0xb4, 0x9c, 0x0e, 0x3d, 0x8f, 0x79, 0x22,
+	0xf7, 0x04, 0x5c, 0x14, 0x19, 0xac, 0x43, 0x36, 0x7c, 0x49, 0x3c, 0xf6, 0xae, 0x3a, 0xe3, 0x6f,
+	0x8d, 0xac, 0x3e, 0xc7, 0x04, 0x4d, 0xe2, 0x9f, 0x21, 0x07, 0x72, 0x01, 0x53, 0x5a, 0xce, 0x80,
+	0xf8, 0x16, 0x71, 0x42, 0xfe, 0x96, 0xc8, 0xee, 0x3e, 0xfd, 0x84, 0x80, 0xea, 0x4e, 0xf8, 0xfe,
+	0xb2, 0xb4, 0x1c, 0x57, 0xdc, 0x08, 0xad, 0xac, 0x2f, 0x30, 0x41, 0x7d, 0xb8, 0x9e, 0x48, 0x61,
+	0x6e, 0x22, 0x85, 0xdb, 0x4d, 0xc8, 0xca, 0x96, 0x4f, 0xdb, 0xbc, 0xd1, 0x57, 0x61, 0x59, 0xae,
+	0xeb, 0x8a, 0x64, 0xd6, 0xd5, 0x16, 0x3e, 0x68, 0x19, 0x9a, 0x22, 0xd5, 0xf7, 0xea, 0x8a, 0x5c,
+	0x98, 0x42, 0x79, 0x10, 0x6b, 0xb2, 0x8c, 0x4d, 0x15, 0x6b, 0xaa, 0xda, 0x28, 0x08, 0x68, 0x09,
+	0x0a, 0xba, 0xd2, 0x54, 0x0f, 0x15, 0xbc, 0xa7, 0xab, 0xcd, 0x48, 0x9a, 0xda, 0xee, 0x42, 0xce,
+	0x7c, 0x49, 0x3c, 0x89, 0xf4, 0xda, 0xaa, 0xc7, 0x99, 0x9b, 0xf0, 0x80, 0x3d, 0x48, 0xb0, 0x54,
+	0x6b, 0x48, 0x58, 0xd5, 0xee, 0x40, 0xcf, 0x41, 0xc6, 0xd0, 0x54, 0x33, 0x62, 0xee, 0x1f, 0xa8,
+	0xa6, 0x82, 0x6b, 0x86, 0xa1, 0x98, 0xd8, 0x78, 0x5e, 0xd3, 0x0a, 0x29, 0x74, 0x0f, 0xf2, 0xbb,
+	0x35, 0x63, 0x44, 0x98, 0xde, 0xfe, 0x8f, 0x00, 0xcb, 0x77, 0xd6, 0x19, 0xfa, 0x23, 0x6c, 0x19,
+	0x8a, 0x69, 0x36, 0x94, 0xa6, 0xd2, 0x32, 0xb1, 0xa6, 0xd7, 0x25, 0x05, 0x1b, 0xea, 0x81, 0x2e,
+	0x29, 0x63, 0x7e, 0x11, 0xe4, 0xb8, 0x76, 0x4f, 0x51, 0x64, 0xcc, 0x62, 0x2c, 0x08, 0x68, 0x01,
+	0xb2, 0xcd, 0x9a, 0xfe, 0xf7, 0x68, 0x99, 0x42, 0x25, 0x58, 0xbf, 0x5e, 0x62, 0x55, 0xc7, 0x63,
+	0xf6, 0xe9, 0x6d, 0x0c, 0x70, 0xf3, 0xe2, 0x44, 0xeb, 0xb0, 0xc2, 0xf2, 0x80, 0x0d, 0xb3, 0x66,
+	0x1e, 0x18, 0x63, 0xee, 0x00, 0x66, 0x6a, 0x92, 0x59, 0x3f, 0x54, 0x0a, 0x02, 0xcb, 0xa6, 0xae,
+	0xc8, 0x07, 0x92, 0x82, 0xd5, 0x56, 0xe3, 0xa8, 0x90, 0x62, 0xca, 0x3d, 0x5d, 0x7d, 0xa1, 0xb4,
+	0x0a, 0x69, 0x24, 0xc2, 0x6c, 0x74, 0x00, 0xb9, 0x90, 0xd9, 0xd6, 0x20, 0x7b, 0xfd, 0x32, 0x45,
+	0x6b, 0x70, 0x5f, 0x3a, 0xd0, 0x0f, 0x15, 0x6c, 0x1e, 0x69, 0xe3, 0xa7, 0x59, 0x82, 0x82, 0xa4,
+	0xb6, 0x0c, 0xb3, 0xc6, 0x0f, 0xad, 0xca, 0x07, 0x12, 0xcb, 0xe8, 0x22, 0x2c, 0xa8, 0x7a, 0x4d,
+	0x6a, 0x28, 0x58, 0x53, 0x9e, 0x3e, 0x55, 0xe4, 0x42, 0x6a, 0x57, 0x79, 0x73, 0xb5, 0x21, 0xbc,
+	0xbd, 0xda, 0x10, 0xbe, 0xbf, 0xda, 0x10, 0xfe, 0xff, 0x6e, 0x63, 0xea, 0xed, 0xbb, 0x8d, 0xa9,
+	0x6f, 0xde, 0x6d, 0x4c, 0xbd, 0xf8, 0xd3, 0xad, 0xf2, 0x6b, 0xf1, 0x9e, 0x96, 0x4e, 0x89, 0xe5,
+	0x54, 0xa3, 0xfe, 0xae, 0x9e, 0x57, 0xa3, 0x7f, 0xd3, 0x79, 0x1d, 0x1e, 0xcf, 0xf0, 0xc1, 0xf9,
+	0xe4, 0xa7, 0x01, 0x00, 0x6d, 0x2e, 0xf0, 0x09, 0xbc, 0x0f, 0x00, 0x00,
+}
+
+func (m *OraclePegConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OraclePegConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OraclePegConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SpreadRatio.Size()
+		i -= size
+		if _, err := m.SpreadRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.QuoteDepth.Size()
+		i -= size
+		if _, err := m.QuoteDepth.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
 func (m *VPool) Marshal() (dAtA []byte, err error) {
@@ -422,6 +930,40 @@ func (m *VPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NetBaseSize != nil {
+		{
+			size := m.NetBaseSize.Size()
+			i -= size
+			if _, err := m.NetBaseSize.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.OraclePegConfig != nil {
+		{
+			size, err := m.OraclePegConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.CurveType != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.CurveType))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.Status != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x58
+	}
 	{
 		size := m.MaxLeverage.Size()
 		i -= size
@@ -585,10 +1127,32 @@ func (m *ReserveSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Pair.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.OraclePegConfig != nil {
+		{
+			size, err := m.OraclePegConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Downsampled {
+		i--
+		if m.Downsampled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size, err := m.Pair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
 		i -= size
 		i = encodeVarintState(dAtA, i, uint64(size))
@@ -623,6 +1187,169 @@ func (m *ReserveSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TradeVolume) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TradeVolume) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TradeVolume) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimestampMs != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.TimestampMs))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.QuoteVolume.Size()
+		i -= size
+		if _, err := m.QuoteVolume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.BaseVolume.Size()
+		i -= size
+		if _, err := m.BaseVolume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Pair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n6, err6 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.SnapshotDownsampleInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.SnapshotDownsampleInterval):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintState(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x1a
+	n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.SnapshotDownsampleAfter, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.SnapshotDownsampleAfter):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintState(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x12
+	n8, err8 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.SnapshotRetention, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.SnapshotRetention):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintState(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolSettlement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolSettlement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolSettlement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockNumber != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.BlockNumber))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TimestampMs != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.TimestampMs))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PriceSource != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.PriceSource))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.SettlementPrice.Size()
+		i -= size
+		if _, err := m.SettlementPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Pair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *PoolPrices) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -703,6 +1430,19 @@ func encodeVarintState(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
+func (m *OraclePegConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.QuoteDepth.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.SpreadRatio.Size()
+	n += 1 + l + sovState(uint64(l))
+	return n
+}
+
 func (m *VPool) Size() (n int) {
 	if m == nil {
 		return 0
@@ -725,6 +1465,20 @@ func (m *VPool) Size() (n int) {
 	n += 1 + l + sovState(uint64(l))
 	l = m.MaxLeverage.Size()
 	n += 1 + l + sovState(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovState(uint64(m.Status))
+	}
+	if m.CurveType != 0 {
+		n += 1 + sovState(uint64(m.CurveType))
+	}
+	if m.OraclePegConfig != nil {
+		l = m.OraclePegConfig.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.NetBaseSize != nil {
+		l = m.NetBaseSize.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
 	return n
 }
 
@@ -762,6 +1516,68 @@ func (m *ReserveSnapshot) Size() (n int) {
 	}
 	l = m.Pair.Size()
 	n += 1 + l + sovState(uint64(l))
+	if m.Downsampled {
+		n += 2
+	}
+	if m.OraclePegConfig != nil {
+		l = m.OraclePegConfig.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *TradeVolume) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.BaseVolume.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.QuoteVolume.Size()
+	n += 1 + l + sovState(uint64(l))
+	if m.TimestampMs != 0 {
+		n += 1 + sovState(uint64(m.TimestampMs))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.SnapshotRetention)
+	n += 1 + l + sovState(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.SnapshotDownsampleAfter)
+	n += 1 + l + sovState(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.SnapshotDownsampleInterval)
+	n += 1 + l + sovState(uint64(l))
+	return n
+}
+
+func (m *PoolSettlement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.SettlementPrice.Size()
+	n += 1 + l + sovState(uint64(l))
+	if m.PriceSource != 0 {
+		n += 1 + sovState(uint64(m.PriceSource))
+	}
+	if m.TimestampMs != 0 {
+		n += 1 + sovState(uint64(m.TimestampMs))
+	}
+	if m.BlockNumber != 0 {
+		n += 1 + sovState(uint64(m.BlockNumber))
+	}
 	return n
 }
 
@@ -799,7 +1615,7 @@ func sovState(x uint64) (n int) {
 func sozState(x uint64) (n int) {
 	return sovState(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *VPool) Unmarshal(dAtA []byte) error {
+func (m *OraclePegConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -822,17 +1638,17 @@ func (m *VPool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VPool: wiretype end group for non-group")
+			return fmt.Errorf("proto: OraclePegConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VPool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OraclePegConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDepth", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -842,28 +1658,29 @@ func (m *VPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.QuoteDepth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseAssetReserve", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpreadRatio", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -891,15 +1708,800 @@ func (m *VPool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BaseAssetReserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SpreadRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAssetReserve", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAssetReserve", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseAssetReserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAssetReserve", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.QuoteAssetReserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeLimitRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TradeLimitRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FluctuationLimitRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FluctuationLimitRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOracleSpreadRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxOracleSpreadRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceMarginRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaintenanceMarginRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLeverage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxLeverage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= PoolStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurveType", wireType)
+			}
+			m.CurveType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurveType |= CurveType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OraclePegConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OraclePegConfig == nil {
+				m.OraclePegConfig = &OraclePegConfig{}
+			}
+			if err := m.OraclePegConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetBaseSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.NetBaseSize = &v
+			if err := m.NetBaseSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CurrentTWAP) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CurrentTWAP: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CurrentTWAP: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PairID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Numerator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Numerator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denominator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Denominator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReserveSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReserveSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReserveSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAssetReserve", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseAssetReserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAssetReserve", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.QuoteAssetReserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimestampMs", wireType)
+			}
+			m.TimestampMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimestampMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Downsampled", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -909,31 +2511,17 @@ func (m *VPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthState
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthState
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.QuoteAssetReserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
+			m.Downsampled = bool(v != 0)
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TradeLimitRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OraclePegConfig", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -943,31 +2531,83 @@ func (m *VPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TradeLimitRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.OraclePegConfig == nil {
+				m.OraclePegConfig = &OraclePegConfig{}
+			}
+			if err := m.OraclePegConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TradeVolume) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TradeVolume: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TradeVolume: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FluctuationLimitRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -977,29 +2617,28 @@ func (m *VPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FluctuationLimitRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxOracleSpreadRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseVolume", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1027,13 +2666,13 @@ func (m *VPool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxOracleSpreadRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BaseVolume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceMarginRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteVolume", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1061,15 +2700,15 @@ func (m *VPool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaintenanceMarginRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.QuoteVolume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxLeverage", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimestampMs", wireType)
 			}
-			var stringLen uint64
+			m.TimestampMs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -1079,26 +2718,11 @@ func (m *VPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TimestampMs |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthState
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthState
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.MaxLeverage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipState(dAtA[iNdEx:])
@@ -1120,7 +2744,7 @@ func (m *VPool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CurrentTWAP) Unmarshal(dAtA []byte) error {
+func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1143,49 +2767,17 @@ func (m *CurrentTWAP) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CurrentTWAP: wiretype end group for non-group")
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CurrentTWAP: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PairID", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowState
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthState
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthState
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PairID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Numerator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotRetention", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -1195,31 +2787,30 @@ func (m *CurrentTWAP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Numerator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.SnapshotRetention, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denominator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotDownsampleAfter", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -1229,31 +2820,30 @@ func (m *CurrentTWAP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Denominator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.SnapshotDownsampleAfter, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotDownsampleInterval", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -1263,23 +2853,22 @@ func (m *CurrentTWAP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.SnapshotDownsampleInterval, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1304,7 +2893,7 @@ func (m *CurrentTWAP) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReserveSnapshot) Unmarshal(dAtA []byte) error {
+func (m *PoolSettlement) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1327,17 +2916,17 @@ func (m *ReserveSnapshot) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReserveSnapshot: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolSettlement: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReserveSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolSettlement: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseAssetReserve", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -1347,29 +2936,28 @@ func (m *ReserveSnapshot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BaseAssetReserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAssetReserve", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1397,11 +2985,30 @@ func (m *ReserveSnapshot) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.QuoteAssetReserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SettlementPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceSource", wireType)
+			}
+			m.PriceSource = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriceSource |= SettlementPriceSource(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TimestampMs", wireType)
 			}
@@ -1421,10 +3028,10 @@ func (m *ReserveSnapshot) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
 			}
-			var msglen int
+			m.BlockNumber = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -1434,25 +3041,11 @@ func (m *ReserveSnapshot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.BlockNumber |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthState
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthState
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipState(dAtA[iNdEx:])